@@ -0,0 +1,320 @@
+// +build !js
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/0xProject/0x-mesh/graphql/client"
+	"github.com/0xProject/0x-mesh/metrics"
+	"github.com/0xProject/0x-mesh/zeroex"
+	"github.com/ethereum/go-ethereum/common"
+	lru "github.com/hashicorp/golang-lru"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// eventsBufferSize is the buffer size for the order events channel of each
+	// subscription.
+	eventsBufferSize = 100
+	// addOrdersTimeout is the maximum amount of time to wait for a node to
+	// validate and add a batch of orders.
+	addOrdersTimeout = 30 * time.Second
+)
+
+// meshClient is the subset of the methods of *client.Client which are used
+// by the bridge.
+type meshClient interface {
+	SubscribeToOrderEvents(ctx context.Context, events chan<- []*client.OrderEvent, opts ...client.SubscribeToOrderEventsOpts) (*client.OrderEventSubscription, error)
+	AddOrders(ctx context.Context, orders []*zeroex.SignedOrder, opts ...client.AddOrdersOpts) (*client.AddOrdersResults, error)
+	AddOrdersV4(ctx context.Context, orders []*zeroex.SignedOrderV4, opts ...client.AddOrdersOpts) (*client.AddOrdersResultsV4, error)
+}
+
+// node is a Mesh node that is being bridged.
+type node struct {
+	// label identifies the node in logs and metrics.
+	label  string
+	client meshClient
+}
+
+type bridgeConfig struct {
+	Filters             []client.OrderFilter
+	FiltersV4           []client.OrderFilterV4
+	MaxBatchSize        int
+	BatchInterval       time.Duration
+	PinOrders           bool
+	SeenOrdersCacheSize int
+}
+
+// bridge forwards new orders from each node to all of the other nodes.
+type bridge struct {
+	nodes  []*node
+	config *bridgeConfig
+	// seenOrders contains the hashes of all orders that have been received from
+	// any node recently. Orders are only bridged the first time they are seen.
+	// Since an order that is added to a node results in an ADDED event on that
+	// node, this prevents orders from being bridged back to the node they came
+	// from. Orders are marked as seen as soon as they are received (rather than
+	// after they have been added) because the ADDED event can arrive before the
+	// request to add the order returns. If the request fails, the orders are
+	// unmarked so that they are bridged again the next time they are seen.
+	seenOrders *lru.Cache
+}
+
+// orderBatch is a batch of orders received from a single node which have not
+// been bridged yet.
+type orderBatch struct {
+	orders        []*zeroex.SignedOrder
+	orderHashes   []common.Hash
+	ordersV4      []*zeroex.SignedOrderV4
+	orderHashesV4 []common.Hash
+}
+
+func (b *orderBatch) isEmpty() bool {
+	return len(b.orders) == 0 && len(b.ordersV4) == 0
+}
+
+func newBridge(nodes []*node, config *bridgeConfig) (*bridge, error) {
+	seenOrders, err := lru.New(config.SeenOrdersCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	return &bridge{
+		nodes:      nodes,
+		config:     config,
+		seenOrders: seenOrders,
+	}, nil
+}
+
+// run bridges orders until ctx is cancelled or the subscription for any of the
+// nodes fails.
+func (b *bridge) run(ctx context.Context) error {
+	innerCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	wg := &sync.WaitGroup{}
+	errChan := make(chan error, len(b.nodes))
+	for _, source := range b.nodes {
+		wg.Add(1)
+		go func(source *node) {
+			defer wg.Done()
+			if err := b.bridgeFrom(innerCtx, source); err != nil {
+				errChan <- err
+			}
+		}(source)
+	}
+
+	select {
+	case <-ctx.Done():
+		wg.Wait()
+		return nil
+	case err := <-errChan:
+		cancel()
+		wg.Wait()
+		return err
+	}
+}
+
+// bridgeFrom subscribes to the order events of the given node and forwards any
+// new orders to all of the other nodes in batches.
+func (b *bridge) bridgeFrom(ctx context.Context, source *node) error {
+	events := make(chan []*client.OrderEvent, eventsBufferSize)
+	subscription, err := source.client.SubscribeToOrderEvents(ctx, events, client.SubscribeToOrderEventsOpts{
+		Filters:   b.config.Filters,
+		FiltersV4: b.config.FiltersV4,
+		EndStates: []client.OrderEndState{client.OrderEndStateAdded},
+	})
+	if err != nil {
+		return err
+	}
+	defer subscription.Unsubscribe()
+
+	ticker := time.NewTicker(b.config.BatchInterval)
+	defer ticker.Stop()
+	batch := &orderBatch{}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case subErr, ok := <-subscription.Err():
+			if !ok {
+				return nil
+			}
+			subscriptionErrors.WithLabelValues(source.label).Inc()
+			if subErr.Fatal {
+				return fmt.Errorf("order event subscription for %s failed: %w", source.label, subErr)
+			}
+			log.WithError(subErr).WithField("node", source.label).Warn("order event subscription was interrupted; reconnecting")
+		case orderEvents := <-events:
+			for _, orderEvent := range orderEvents {
+				b.addToBatch(source, batch, orderEvent)
+			}
+			if len(batch.orders) >= b.config.MaxBatchSize || len(batch.ordersV4) >= b.config.MaxBatchSize {
+				b.forward(ctx, source, batch)
+				batch = &orderBatch{}
+			}
+		case <-ticker.C:
+			if !batch.isEmpty() {
+				b.forward(ctx, source, batch)
+				batch = &orderBatch{}
+			}
+		}
+	}
+}
+
+func (b *bridge) addToBatch(source *node, batch *orderBatch, orderEvent *client.OrderEvent) {
+	switch {
+	case orderEvent.Order != nil:
+		if b.markSeen(orderEvent.Order.Hash) {
+			ordersSkipped.WithLabelValues(metrics.ProtocolV3, source.label).Inc()
+			return
+		}
+		ordersReceived.WithLabelValues(metrics.ProtocolV3, source.label).Inc()
+		log.WithFields(log.Fields{
+			"from":      source.label,
+			"orderHash": orderEvent.Order.Hash.Hex(),
+		}).Debug("found new order over bridge")
+		batch.orders = append(batch.orders, signedOrderFromClientType(orderEvent.Order))
+		batch.orderHashes = append(batch.orderHashes, orderEvent.Order.Hash)
+	case orderEvent.OrderV4 != nil:
+		if b.markSeen(orderEvent.OrderV4.Hash) {
+			ordersSkipped.WithLabelValues(metrics.ProtocolV4, source.label).Inc()
+			return
+		}
+		ordersReceived.WithLabelValues(metrics.ProtocolV4, source.label).Inc()
+		log.WithFields(log.Fields{
+			"from":      source.label,
+			"orderHash": orderEvent.OrderV4.Hash.Hex(),
+		}).Debug("found new v4 order over bridge")
+		batch.ordersV4 = append(batch.ordersV4, signedOrderV4FromClientType(orderEvent.OrderV4))
+		batch.orderHashesV4 = append(batch.orderHashesV4, orderEvent.OrderV4.Hash)
+	}
+}
+
+// markSeen marks the given order hash as seen and returns true if it had
+// already been seen.
+func (b *bridge) markSeen(orderHash common.Hash) bool {
+	seen, _ := b.seenOrders.ContainsOrAdd(orderHash, struct{}{})
+	return seen
+}
+
+// unmarkSeen removes the given order hashes from the seen orders.
+func (b *bridge) unmarkSeen(orderHashes []common.Hash) {
+	for _, orderHash := range orderHashes {
+		b.seenOrders.Remove(orderHash)
+	}
+}
+
+// forward adds the orders in the batch to all nodes except for source. If
+// adding the orders to any node fails, they are no longer considered seen.
+func (b *bridge) forward(ctx context.Context, source *node, batch *orderBatch) {
+	opts := client.AddOrdersOpts{Pinned: b.config.PinOrders}
+	failed, failedV4 := false, false
+	for _, target := range b.nodes {
+		if target == source {
+			continue
+		}
+		if len(batch.orders) > 0 {
+			err := b.addOrders(ctx, source, target, metrics.ProtocolV3, len(batch.orders), func(ctx context.Context) (int, int, error) {
+				results, err := target.client.AddOrders(ctx, batch.orders, opts)
+				if err != nil {
+					return 0, 0, err
+				}
+				return len(results.Accepted), len(results.Rejected), nil
+			})
+			failed = failed || err != nil
+		}
+		if len(batch.ordersV4) > 0 {
+			err := b.addOrders(ctx, source, target, metrics.ProtocolV4, len(batch.ordersV4), func(ctx context.Context) (int, int, error) {
+				results, err := target.client.AddOrdersV4(ctx, batch.ordersV4, opts)
+				if err != nil {
+					return 0, 0, err
+				}
+				return len(results.Accepted), len(results.Rejected), nil
+			})
+			failedV4 = failedV4 || err != nil
+		}
+	}
+	if failed {
+		b.unmarkSeen(batch.orderHashes)
+	}
+	if failedV4 {
+		b.unmarkSeen(batch.orderHashesV4)
+	}
+}
+
+// addOrders calls add, which adds numSent orders to target and returns the
+// number of accepted and rejected orders, and records the results. It returns
+// the error returned by add, if any.
+func (b *bridge) addOrders(ctx context.Context, source, target *node, protocolVersion string, numSent int, add func(ctx context.Context) (int, int, error)) error {
+	ctx, cancel := context.WithTimeout(ctx, addOrdersTimeout)
+	defer cancel()
+	logger := log.WithFields(log.Fields{
+		"from":            source.label,
+		"to":              target.label,
+		"protocolVersion": protocolVersion,
+		"numSent":         numSent,
+	})
+	numAccepted, numRejected, err := add(ctx)
+	if err != nil {
+		addOrdersErrors.WithLabelValues(protocolVersion, target.label).Inc()
+		logger.WithError(err).Error("could not add orders")
+		return err
+	}
+	ordersForwarded.WithLabelValues(protocolVersion, target.label, metrics.ValidationAccepted).Add(float64(numAccepted))
+	ordersForwarded.WithLabelValues(protocolVersion, target.label, metrics.ValidationRejected).Add(float64(numRejected))
+	logger.WithFields(log.Fields{
+		"numAccepted": numAccepted,
+		"numRejected": numRejected,
+	}).Info("finished bridging orders")
+	return nil
+}
+
+func signedOrderFromClientType(order *client.OrderWithMetadata) *zeroex.SignedOrder {
+	return &zeroex.SignedOrder{
+		Order: zeroex.Order{
+			ChainID:               order.ChainID,
+			ExchangeAddress:       order.ExchangeAddress,
+			MakerAddress:          order.MakerAddress,
+			MakerAssetData:        order.MakerAssetData,
+			MakerFeeAssetData:     order.MakerFeeAssetData,
+			MakerAssetAmount:      order.MakerAssetAmount,
+			MakerFee:              order.MakerFee,
+			TakerAddress:          order.TakerAddress,
+			TakerAssetData:        order.TakerAssetData,
+			TakerFeeAssetData:     order.TakerFeeAssetData,
+			TakerAssetAmount:      order.TakerAssetAmount,
+			TakerFee:              order.TakerFee,
+			SenderAddress:         order.SenderAddress,
+			FeeRecipientAddress:   order.FeeRecipientAddress,
+			ExpirationTimeSeconds: order.ExpirationTimeSeconds,
+			Salt:                  order.Salt,
+		},
+		Signature: order.Signature,
+	}
+}
+
+func signedOrderV4FromClientType(order *client.OrderWithMetadataV4) *zeroex.SignedOrderV4 {
+	return &zeroex.SignedOrderV4{
+		OrderV4: zeroex.OrderV4{
+			ChainID:             order.ChainID,
+			VerifyingContract:   order.VerifyingContract,
+			MakerToken:          order.MakerToken,
+			TakerToken:          order.TakerToken,
+			MakerAmount:         order.MakerAmount,
+			TakerAmount:         order.TakerAmount,
+			TakerTokenFeeAmount: order.TakerTokenFeeAmount,
+			Maker:               order.Maker,
+			Taker:               order.Taker,
+			Sender:              order.Sender,
+			FeeRecipient:        order.FeeRecipient,
+			Pool:                order.Pool,
+			Expiry:              order.Expiry,
+			Salt:                order.Salt,
+		},
+		Signature: order.Signature,
+	}
+}
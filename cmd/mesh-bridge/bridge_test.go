@@ -0,0 +1,175 @@
+// +build !js
+
+package main
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/0xProject/0x-mesh/graphql/client"
+	"github.com/0xProject/0x-mesh/zeroex"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	errTestSubscribe = errors.New("could not subscribe")
+	errTestAddOrders = errors.New("could not add orders")
+)
+
+// testClient implements meshClient. It records the orders that are added to
+// it and returns errAddOrders (if any) instead of adding them. Subscriptions
+// are not supported.
+type testClient struct {
+	errAddOrders  error
+	addedOrders   [][]*zeroex.SignedOrder
+	addedOrdersV4 [][]*zeroex.SignedOrderV4
+	addOrdersOpts []client.AddOrdersOpts
+}
+
+func (c *testClient) SubscribeToOrderEvents(ctx context.Context, events chan<- []*client.OrderEvent, opts ...client.SubscribeToOrderEventsOpts) (*client.OrderEventSubscription, error) {
+	return nil, errTestSubscribe
+}
+
+func (c *testClient) AddOrders(ctx context.Context, orders []*zeroex.SignedOrder, opts ...client.AddOrdersOpts) (*client.AddOrdersResults, error) {
+	if c.errAddOrders != nil {
+		return nil, c.errAddOrders
+	}
+	c.addedOrders = append(c.addedOrders, orders)
+	c.addOrdersOpts = append(c.addOrdersOpts, opts...)
+	results := &client.AddOrdersResults{}
+	for range orders {
+		results.Accepted = append(results.Accepted, &client.AcceptedOrderResult{IsNew: true})
+	}
+	return results, nil
+}
+
+func (c *testClient) AddOrdersV4(ctx context.Context, orders []*zeroex.SignedOrderV4, opts ...client.AddOrdersOpts) (*client.AddOrdersResultsV4, error) {
+	if c.errAddOrders != nil {
+		return nil, c.errAddOrders
+	}
+	c.addedOrdersV4 = append(c.addedOrdersV4, orders)
+	c.addOrdersOpts = append(c.addOrdersOpts, opts...)
+	results := &client.AddOrdersResultsV4{}
+	for range orders {
+		results.Accepted = append(results.Accepted, &client.AcceptedOrderResultV4{IsNew: true})
+	}
+	return results, nil
+}
+
+func newTestBridge(t *testing.T, clients ...*testClient) *bridge {
+	nodes := make([]*node, len(clients))
+	for i, testClient := range clients {
+		nodes[i] = &node{
+			label:  string(rune('a' + i)),
+			client: testClient,
+		}
+	}
+	b, err := newBridge(nodes, &bridgeConfig{
+		MaxBatchSize:        10,
+		BatchInterval:       time.Second,
+		PinOrders:           true,
+		SeenOrdersCacheSize: 100,
+	})
+	require.NoError(t, err)
+	return b
+}
+
+func newTestOrderEvent(salt int64) *client.OrderEvent {
+	return &client.OrderEvent{
+		Order: &client.OrderWithMetadata{
+			Hash: common.BigToHash(big.NewInt(salt)),
+			Salt: big.NewInt(salt),
+		},
+		EndState: client.OrderEndStateAdded,
+	}
+}
+
+func newTestOrderEventV4(salt int64) *client.OrderEvent {
+	return &client.OrderEvent{
+		OrderV4: &client.OrderWithMetadataV4{
+			Hash: common.BigToHash(big.NewInt(salt)),
+			Salt: big.NewInt(salt),
+		},
+		EndState: client.OrderEndStateAdded,
+	}
+}
+
+func TestAddToBatchSkipsSeenOrders(t *testing.T) {
+	b := newTestBridge(t, &testClient{}, &testClient{})
+	batch := &orderBatch{}
+	b.addToBatch(b.nodes[0], batch, newTestOrderEvent(1))
+	b.addToBatch(b.nodes[0], batch, newTestOrderEventV4(2))
+	// The same orders are received from the other node after they have been
+	// bridged.
+	otherBatch := &orderBatch{}
+	b.addToBatch(b.nodes[1], otherBatch, newTestOrderEvent(1))
+	b.addToBatch(b.nodes[1], otherBatch, newTestOrderEventV4(2))
+	b.addToBatch(b.nodes[0], batch, newTestOrderEvent(3))
+
+	require.Len(t, batch.orders, 2)
+	assert.Equal(t, big.NewInt(1), batch.orders[0].Salt)
+	assert.Equal(t, big.NewInt(3), batch.orders[1].Salt)
+	assert.Equal(t, []common.Hash{common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(3))}, batch.orderHashes)
+	require.Len(t, batch.ordersV4, 1)
+	assert.Equal(t, []common.Hash{common.BigToHash(big.NewInt(2))}, batch.orderHashesV4)
+	assert.True(t, otherBatch.isEmpty())
+}
+
+func TestForwardAddsOrdersToOtherNodes(t *testing.T) {
+	source, target1, target2 := &testClient{}, &testClient{}, &testClient{}
+	b := newTestBridge(t, source, target1, target2)
+	batch := &orderBatch{}
+	b.addToBatch(b.nodes[0], batch, newTestOrderEvent(1))
+	b.addToBatch(b.nodes[0], batch, newTestOrderEventV4(2))
+
+	b.forward(context.Background(), b.nodes[0], batch)
+
+	assert.Empty(t, source.addedOrders)
+	assert.Empty(t, source.addedOrdersV4)
+	for _, target := range []*testClient{target1, target2} {
+		require.Len(t, target.addedOrders, 1)
+		assert.Equal(t, batch.orders, target.addedOrders[0])
+		require.Len(t, target.addedOrdersV4, 1)
+		assert.Equal(t, batch.ordersV4, target.addedOrdersV4[0])
+		assert.Equal(t, []client.AddOrdersOpts{{Pinned: true}, {Pinned: true}}, target.addOrdersOpts)
+	}
+	// The orders are still considered seen so that they are not bridged back.
+	assert.True(t, b.markSeen(common.BigToHash(big.NewInt(1))))
+	assert.True(t, b.markSeen(common.BigToHash(big.NewInt(2))))
+}
+
+func TestForwardUnmarksOrdersOnError(t *testing.T) {
+	source, target := &testClient{}, &testClient{errAddOrders: errTestAddOrders}
+	b := newTestBridge(t, source, target)
+	batch := &orderBatch{}
+	b.addToBatch(b.nodes[0], batch, newTestOrderEvent(1))
+	b.addToBatch(b.nodes[0], batch, newTestOrderEventV4(2))
+
+	b.forward(context.Background(), b.nodes[0], batch)
+
+	// The orders are bridged the next time they are received.
+	target.errAddOrders = nil
+	retryBatch := &orderBatch{}
+	b.addToBatch(b.nodes[0], retryBatch, newTestOrderEvent(1))
+	b.addToBatch(b.nodes[0], retryBatch, newTestOrderEventV4(2))
+	assert.Equal(t, batch.orderHashes, retryBatch.orderHashes)
+	assert.Equal(t, batch.orderHashesV4, retryBatch.orderHashesV4)
+
+	b.forward(context.Background(), b.nodes[0], retryBatch)
+	require.Len(t, target.addedOrders, 1)
+	require.Len(t, target.addedOrdersV4, 1)
+	assert.True(t, b.markSeen(common.BigToHash(big.NewInt(1))))
+	assert.True(t, b.markSeen(common.BigToHash(big.NewInt(2))))
+}
+
+func TestRunReturnsSubscriptionError(t *testing.T) {
+	b := newTestBridge(t, &testClient{}, &testClient{})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	assert.Equal(t, errTestSubscribe, b.run(ctx))
+}
@@ -1,127 +1,186 @@
 // +build !js
 
-// mesh-bridge is a short program that bridges two Mesh nodes. This is useful in cases where
-// we introduce a network-level breaking change but still want the liquidity from one network
-//to flow to another
+// mesh-bridge is a short program that bridges two or more Mesh nodes. This is
+// useful in cases where we introduce a network-level breaking change but still
+// want the liquidity from one network to flow to another, or when we want to
+// move liquidity between a private network (e.g. one with a custom order
+// filter) and the public network.
+//
+// mesh-bridge subscribes to the order events of each node via the GraphQL API
+// and adds any new v3 and v4 orders to all of the other nodes.
 package main
 
-// Note(albrow): If we want to use mesh-bridge in the future we will need to update it to
-// use the new GraphQL API. Considered low-priority as of the time of writing this comment.
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
 
-// import (
-// 	"context"
-// 	"time"
+	"github.com/0xProject/0x-mesh/graphql/client"
+	"github.com/0xProject/0x-mesh/graphql/gqltypes"
+	"github.com/0xProject/0x-mesh/metrics"
+	"github.com/plaid/go-envvar/envvar"
+	log "github.com/sirupsen/logrus"
+)
 
-// 	"github.com/0xProject/0x-mesh/rpc"
-// 	"github.com/0xProject/0x-mesh/zeroex"
-// 	ethrpc "github.com/ethereum/go-ethereum/rpc"
-// 	"github.com/plaid/go-envvar/envvar"
-// 	log "github.com/sirupsen/logrus"
-// )
+type config struct {
+	// Verbosity is the logging verbosity: 0=panic, 1=fatal, 2=error, 3=warn, 4=info, 5=debug 6=trace
+	Verbosity int `envvar:"VERBOSITY" default:"4"`
+	// GraphQLServerURLs is a comma-separated list of the GraphQL endpoints of
+	// the Mesh nodes to bridge (e.g. "http://localhost:60557/graphql"). At
+	// least two URLs are required.
+	GraphQLServerURLs string `envvar:"GRAPHQL_SERVER_URLS"`
+	// GraphQLAPIKeys is an optional comma-separated list of the API keys to use
+	// for the nodes in GraphQLServerURLs, in the same order. Leave an entry
+	// empty for nodes which don't require an API key. If only one key is given,
+	// it is used for all of the nodes. The keys need write scope since the
+	// bridge adds orders to the nodes.
+	GraphQLAPIKeys string `envvar:"GRAPHQL_API_KEYS" default:""`
+	// OrderFilters is an optional JSON-encoded list of v3 order filters in the
+	// same format as the filters argument of the GraphQL orderEvents
+	// subscription (e.g. `[{"field": "makerAddress", "kind": "EQUAL", "value":
+	// "0x..."}]`). If set, only v3 orders that match all filters are bridged.
+	OrderFilters string `envvar:"ORDER_FILTERS" default:""`
+	// OrderFiltersV4 is the v4 equivalent of OrderFilters.
+	OrderFiltersV4 string `envvar:"ORDER_FILTERS_V4" default:""`
+	// MaxBatchSize is the maximum number of orders of each version to add to
+	// the other nodes at once.
+	MaxBatchSize int `envvar:"MAX_BATCH_SIZE" default:"100"`
+	// BatchInterval is the maximum amount of time to wait for more orders
+	// before adding a batch to the other nodes.
+	BatchInterval time.Duration `envvar:"BATCH_INTERVAL" default:"2s"`
+	// PinOrders determines whether or not bridged orders are pinned by the
+	// nodes they are added to. By default, bridged orders are not pinned so
+	// that they can be evicted like any other order received from a peer.
+	PinOrders bool `envvar:"PIN_ORDERS" default:"false"`
+	// SeenOrdersCacheSize is the number of order hashes to remember in order to
+	// prevent orders from being bridged back and forth between the nodes.
+	SeenOrdersCacheSize int `envvar:"SEEN_ORDERS_CACHE_SIZE" default:"100000"`
+	// EnablePrometheusMonitoring determines whether or not to enable
+	// prometheus monitoring. The metrics are accessed by scraping
+	// {PrometheusMonitoringServerAddr}/metrics.
+	EnablePrometheusMonitoring bool `envvar:"ENABLE_PROMETHEUS_MONITORING" default:"false"`
+	// PrometheusMonitoringServerAddr is the interface and port to use for
+	// prometheus server metrics endpoint.
+	PrometheusMonitoringServerAddr string `envvar:"PROMETHEUS_SERVER_ADDR" default:"0.0.0.0:8080"`
+}
 
-// const (
-// 	firstWSRPCAddressLabel  = "FirstWSRPCAddress"
-// 	secondWSRPCAddressLabel = "SecondWSRPCAddress"
-// 	maxReceiveBatch         = 100
-// 	receiveTimeout          = 2 * time.Second
-// 	tenThousand             = 10000
-// )
+func main() {
+	var env config
+	if err := envvar.Parse(&env); err != nil {
+		panic(fmt.Sprintf("could not parse environment variables: %s", err.Error()))
+	}
+	log.SetFormatter(&log.JSONFormatter{})
+	log.SetLevel(log.Level(env.Verbosity))
 
-// type clientEnvVars struct {
-// 	FirstWSRPCAddress  string `envvar:"FIRST_WS_RPC_ADDRESS"`
-// 	SecondWSRPCAddress string `envvar:"SECOND_WS_RPC_ADDRESS"`
-// 	Verbosity          int    `envvar:"VERBOSITY"`
-// }
+	urls := strings.Split(env.GraphQLServerURLs, ",")
+	if len(urls) < 2 {
+		log.Fatal("GRAPHQL_SERVER_URLS must contain at least two URLs")
+	}
+	apiKeys, err := parseAPIKeys(env.GraphQLAPIKeys, len(urls))
+	if err != nil {
+		log.WithError(err).Fatal("could not parse GRAPHQL_API_KEYS")
+	}
+	filters, err := parseOrderFilters(env.OrderFilters)
+	if err != nil {
+		log.WithError(err).Fatal("could not parse ORDER_FILTERS")
+	}
+	filtersV4, err := parseOrderFiltersV4(env.OrderFiltersV4)
+	if err != nil {
+		log.WithError(err).Fatal("could not parse ORDER_FILTERS_V4")
+	}
 
-func main() {
-	// 	env := clientEnvVars{}
-	// 	if err := envvar.Parse(&env); err != nil {
-	// 		panic(err)
-	// 	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
-	// 	log.SetLevel(log.Level(env.Verbosity))
+	nodes := make([]*node, len(urls))
+	for i, url := range urls {
+		var opts []client.Option
+		if apiKeys[i] != "" {
+			opts = append(opts, client.WithAPIKey(apiKeys[i]))
+		}
+		nodeClient := client.New(url, opts...)
+		nodes[i] = &node{
+			label:  url,
+			client: nodeClient,
+		}
+		stats, err := nodeClient.GetStats(ctx)
+		if err != nil {
+			log.WithError(err).WithField("node", url).Fatal("could not get stats")
+		}
+		log.WithFields(log.Fields{
+			"node":        url,
+			"peerID":      stats.PeerID,
+			"pubSubTopic": stats.PubSubTopic,
+		}).Info("connected to node")
+	}
 
-	// 	firstClient, err := rpc.NewClient(env.FirstWSRPCAddress)
-	// 	if err != nil {
-	// 		log.WithError(err).Fatal("could not create client")
-	// 	}
-	// 	stats, err := firstClient.GetStats()
-	// 	if err != nil {
-	// 		log.Fatal(err)
-	// 	}
-	// 	log.WithField("stats", stats).Info("Spun up first client")
+	if env.EnablePrometheusMonitoring {
+		go func() {
+			log.WithField("prometheus_server_addr", env.PrometheusMonitoringServerAddr).Info("starting Prometheus metrics server")
+			if err := metrics.ServeMetrics(ctx, env.PrometheusMonitoringServerAddr); err != nil {
+				log.Error(err)
+			}
+		}()
+	}
 
-	// 	secondClient, err := rpc.NewClient(env.SecondWSRPCAddress)
-	// 	if err != nil {
-	// 		log.WithError(err).Fatal("could not create client")
-	// 	}
-	// 	stats, err = secondClient.GetStats()
-	// 	if err != nil {
-	// 		log.Fatal(err)
-	// 	}
-	// 	log.WithField("stats", stats).Info("Spun up second client")
+	b, err := newBridge(nodes, &bridgeConfig{
+		Filters:             filters,
+		FiltersV4:           filtersV4,
+		MaxBatchSize:        env.MaxBatchSize,
+		BatchInterval:       env.BatchInterval,
+		PinOrders:           env.PinOrders,
+		SeenOrdersCacheSize: env.SeenOrdersCacheSize,
+	})
+	if err != nil {
+		log.WithError(err).Fatal("could not create bridge")
+	}
+	if err := b.run(ctx); err != nil {
+		log.WithError(err).Fatal("bridge exited with error")
+	}
+}
 
-	// 	go func() {
-	// 		pipeOrders(secondClient, firstClient, secondWSRPCAddressLabel, firstWSRPCAddressLabel)
-	// 	}()
-	// 	pipeOrders(firstClient, secondClient, firstWSRPCAddressLabel, secondWSRPCAddressLabel)
+// parseAPIKeys returns the API key for each of the numURLs nodes. apiKeys is
+// either empty, a single key for all nodes or a comma-separated list with one
+// (possibly empty) key for each node.
+func parseAPIKeys(apiKeys string, numURLs int) ([]string, error) {
+	keys := strings.Split(apiKeys, ",")
+	for i := range keys {
+		keys[i] = strings.TrimSpace(keys[i])
+	}
+	switch len(keys) {
+	case 1:
+		result := make([]string, numURLs)
+		for i := range result {
+			result[i] = keys[0]
+		}
+		return result, nil
+	case numURLs:
+		return keys, nil
+	default:
+		return nil, fmt.Errorf("expected 1 or %d API keys but got %d", numURLs, len(keys))
+	}
 }
 
-// func pipeOrders(inClient, outClient *rpc.Client, inLabel, outLabel string) {
-// 	ctx, cancel := context.WithCancel(context.Background())
-// 	defer cancel()
-// 	orderEventsChan := make(chan []*zeroex.OrderEvent, tenThousand)
-// 	clientSubscription, err := inClient.SubscribeToOrders(ctx, orderEventsChan)
-// 	if err != nil {
-// 		log.WithError(err).Fatal("Couldn't set up OrderStream subscription")
-// 	}
-// 	defer clientSubscription.Unsubscribe()
-// 	for {
-// 		incomingSignedOrders, err := receiveBatch(orderEventsChan, clientSubscription, inLabel, outLabel)
-// 		if err != nil {
-// 			log.Fatal(err)
-// 		}
-// 		if len(incomingSignedOrders) == 0 {
-// 			continue
-// 		}
-// 		validationResults, err := outClient.AddOrders(incomingSignedOrders)
-// 		if err != nil {
-// 			log.Fatal(err)
-// 		}
-// 		log.WithFields(log.Fields{
-// 			"from":        inLabel,
-// 			"to":          outLabel,
-// 			"numSent":     len(incomingSignedOrders),
-// 			"numAccepted": len(validationResults.Accepted),
-// 			"numRejected": len(validationResults.Rejected),
-// 		}).Info("Finished bridging orders")
-// 	}
-// }
+func parseOrderFilters(filtersJSON string) ([]client.OrderFilter, error) {
+	if filtersJSON == "" {
+		return nil, nil
+	}
+	var filters []gqltypes.OrderFilter
+	if err := json.Unmarshal([]byte(filtersJSON), &filters); err != nil {
+		return nil, err
+	}
+	return gqltypes.OrderFiltersFromJSON(filters)
+}
 
-// func receiveBatch(inChan chan []*zeroex.OrderEvent, subscription *ethrpc.ClientSubscription, inLabel, outLabel string) ([]*zeroex.SignedOrder, error) {
-// 	signedOrders := []*zeroex.SignedOrder{}
-// 	timeoutChan := time.After(receiveTimeout)
-// 	for {
-// 		if len(signedOrders) >= maxReceiveBatch {
-// 			return signedOrders, nil
-// 		}
-// 		select {
-// 		case <-timeoutChan:
-// 			return signedOrders, nil
-// 		case orderEvents := <-inChan:
-// 			for _, orderEvent := range orderEvents {
-// 				if orderEvent.EndState != zeroex.ESOrderAdded {
-// 					continue
-// 				}
-// 				log.WithFields(log.Fields{
-// 					"from":      inLabel,
-// 					"to":        outLabel,
-// 					"orderHash": orderEvent.OrderHash.Hex(),
-// 				}).Info("Found new order over bridge")
-// 				signedOrders = append(signedOrders, orderEvent.SignedOrder)
-// 			}
-// 		case err := <-subscription.Err():
-// 			log.Fatal(err)
-// 		}
-// 	}
-// }
+func parseOrderFiltersV4(filtersJSON string) ([]client.OrderFilterV4, error) {
+	if filtersJSON == "" {
+		return nil, nil
+	}
+	var filters []gqltypes.OrderFilterV4
+	if err := json.Unmarshal([]byte(filtersJSON), &filters); err != nil {
+		return nil, err
+	}
+	return gqltypes.OrderFiltersV4FromJSON(filters)
+}
@@ -0,0 +1,48 @@
+// +build !js
+
+package main
+
+import (
+	"testing"
+
+	"github.com/0xProject/0x-mesh/graphql/client"
+	"github.com/0xProject/0x-mesh/graphql/gqltypes"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAPIKeys(t *testing.T) {
+	apiKeys, err := parseAPIKeys("", 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"", ""}, apiKeys)
+
+	apiKeys, err = parseAPIKeys(" key ", 3)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"key", "key", "key"}, apiKeys)
+
+	apiKeys, err = parseAPIKeys("key-1, ,key-3", 3)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"key-1", "", "key-3"}, apiKeys)
+
+	_, err = parseAPIKeys("key-1,key-2", 3)
+	assert.Error(t, err)
+}
+
+func TestParseOrderFilters(t *testing.T) {
+	filters, err := parseOrderFilters("")
+	require.NoError(t, err)
+	assert.Nil(t, filters)
+
+	filters, err = parseOrderFilters(`[{"field": "makerAddress", "kind": "EQUAL", "value": "0x0000000000000000000000000000000000000001"}]`)
+	require.NoError(t, err)
+	require.Len(t, filters, 1)
+	assert.Equal(t, client.OrderFilter{
+		Field: gqltypes.OrderFieldMakerAddress,
+		Kind:  gqltypes.FilterKindEqual,
+		Value: common.HexToAddress("0x0000000000000000000000000000000000000001"),
+	}, filters[0])
+
+	_, err = parseOrderFilters(`{`)
+	assert.Error(t, err)
+}
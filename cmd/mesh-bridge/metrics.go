@@ -0,0 +1,53 @@
+// +build !js
+
+package main
+
+import (
+	"github.com/0xProject/0x-mesh/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const nodeLabel = "node"
+
+var (
+	ordersReceived = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "mesh_bridge_orders_received_total",
+		Help: "Total number of new orders received from each node",
+	}, []string{
+		metrics.ProtocolVersionLabel,
+		nodeLabel,
+	})
+
+	ordersSkipped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "mesh_bridge_orders_skipped_total",
+		Help: "Total number of orders which were not bridged because they had already been seen",
+	}, []string{
+		metrics.ProtocolVersionLabel,
+		nodeLabel,
+	})
+
+	ordersForwarded = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "mesh_bridge_orders_forwarded_total",
+		Help: "Total number of orders added to each node, by validation status",
+	}, []string{
+		metrics.ProtocolVersionLabel,
+		nodeLabel,
+		metrics.ValidationStatusLabel,
+	})
+
+	addOrdersErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "mesh_bridge_add_orders_errors_total",
+		Help: "Total number of failed requests to add orders to each node",
+	}, []string{
+		metrics.ProtocolVersionLabel,
+		nodeLabel,
+	})
+
+	subscriptionErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "mesh_bridge_subscription_errors_total",
+		Help: "Total number of order event subscription errors for each node",
+	}, []string{
+		nodeLabel,
+	})
+)
@@ -0,0 +1,311 @@
+// +build !js
+
+package main
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/core"
+	"github.com/0xProject/0x-mesh/db"
+	"github.com/0xProject/0x-mesh/zeroex"
+	"github.com/0xProject/0x-mesh/zeroex/ordervalidator"
+	"github.com/plaid/go-envvar/envvar"
+	log "github.com/sirupsen/logrus"
+)
+
+// importBatchSize is the maximum number of orders that are validated and added
+// at once when importing orders.
+const importBatchSize = 500
+
+const dbCommandUsage = `Usage:
+  mesh db export <file>       Export all v3 and v4 orders to file.
+  mesh db import <file>       Import the orders in file. Orders are revalidated before they are added.
+  mesh db check [--repair]    Check the database for inconsistencies and optionally repair them.
+
+Orders are written as JSON lines. If the file name ends in .gz, the file is
+compressed with gzip. The database is configured with the same environment
+variables that are used when running Mesh. Mesh must not be running while
+a db command is running.`
+
+// dbCommandConfig contains the configuration options that are needed to access
+// the database without running Mesh.
+type dbCommandConfig struct {
+	// DataDir is the directory that contains the database. It must be the same
+	// as the DATA_DIR that is used when running Mesh.
+	DataDir string `envvar:"DATA_DIR" default:"0x_mesh"`
+	// DatabaseDriver is the storage backend of the database. It must be the
+	// same as the DATABASE_DRIVER that is used when running Mesh.
+	DatabaseDriver string `envvar:"DATABASE_DRIVER" default:"sqlite3"`
+}
+
+// dbCheckConfig contains the configuration options that are needed to check
+// the database. They must be the same as the ones that are used when running
+// Mesh.
+type dbCheckConfig struct {
+	DataDir                 string `envvar:"DATA_DIR" default:"0x_mesh"`
+	DatabaseDriver          string `envvar:"DATABASE_DRIVER" default:"sqlite3"`
+	EthereumChainID         int    `envvar:"ETHEREUM_CHAIN_ID"`
+	CustomContractAddresses string `envvar:"CUSTOM_CONTRACT_ADDRESSES" default:""`
+	MaxOrdersInStorage      int    `envvar:"MAX_ORDERS_IN_STORAGE" default:"100000"`
+}
+
+// runDBCommand runs `mesh db <command> <args...>`.
+func runDBCommand(args []string) error {
+	if len(args) == 0 {
+		return errors.New(dbCommandUsage)
+	}
+	switch args[0] {
+	case "export":
+		if len(args) != 2 {
+			return errors.New(dbCommandUsage)
+		}
+		return exportOrders(args[1])
+	case "import":
+		if len(args) != 2 {
+			return errors.New(dbCommandUsage)
+		}
+		return importOrders(args[1])
+	case "check":
+		if len(args) == 1 {
+			return checkDB(false)
+		} else if len(args) == 2 && args[1] == "--repair" {
+			return checkDB(true)
+		}
+		return errors.New(dbCommandUsage)
+	default:
+		return fmt.Errorf("unknown db command: %q\n\n%s", args[0], dbCommandUsage)
+	}
+}
+
+func exportOrders(path string) (err error) {
+	var config dbCommandConfig
+	if err := envvar.Parse(&config); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	database, err := core.NewDB(ctx, core.Config{DataDir: config.DataDir, DatabaseDriver: config.DatabaseDriver})
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	var w io.Writer = file
+	if isCompressed(path) {
+		gzipWriter := gzip.NewWriter(file)
+		defer func() {
+			if closeErr := gzipWriter.Close(); err == nil {
+				err = closeErr
+			}
+		}()
+		w = gzipWriter
+	}
+	bufWriter := bufio.NewWriter(w)
+	numExported, err := database.ExportOrders(bufWriter)
+	if err != nil {
+		return err
+	}
+	if err := bufWriter.Flush(); err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"file":        path,
+		"numExported": numExported,
+	}).Info("finished exporting orders")
+	return nil
+}
+
+func importOrders(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	var r io.Reader = file
+	if isCompressed(path) {
+		gzipReader, err := gzip.NewReader(file)
+		if err != nil {
+			return err
+		}
+		defer gzipReader.Close()
+		r = gzipReader
+	}
+
+	// Orders are imported by adding them to a running core.App so that they
+	// are revalidated and watched by the order watcher like any other order.
+	var coreConfig core.Config
+	if err := envvar.Parse(&coreConfig); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	app, err := core.New(ctx, coreConfig)
+	if err != nil {
+		return err
+	}
+	coreErrChan := make(chan error, 1)
+	go func() {
+		coreErrChan <- app.Start()
+	}()
+	importErrChan := make(chan error, 1)
+	go func() {
+		importErrChan <- newOrderImporter(app).importFrom(ctx, db.NewExportedOrderReader(bufio.NewReader(r)))
+	}()
+
+	select {
+	case err := <-coreErrChan:
+		if err == nil {
+			err = errors.New("core app exited before all orders were imported")
+		}
+		return err
+	case err := <-importErrChan:
+		cancel()
+		if coreErr := <-coreErrChan; coreErr != nil {
+			log.WithField("error", coreErr.Error()).Error("core app exited with error")
+		}
+		return err
+	}
+}
+
+func checkDB(repair bool) error {
+	var config dbCheckConfig
+	if err := envvar.Parse(&config); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	result, err := core.CheckDB(ctx, core.Config{
+		DataDir:                 config.DataDir,
+		DatabaseDriver:          config.DatabaseDriver,
+		EthereumChainID:         config.EthereumChainID,
+		CustomContractAddresses: config.CustomContractAddresses,
+		MaxOrdersInStorage:      config.MaxOrdersInStorage,
+	}, repair)
+	if err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"numOrdersChecked":              result.NumOrdersChecked,
+		"numOrdersWithStaleAssetData":   len(result.OrdersWithStaleAssetData),
+		"numOrdersWithUnknownContracts": len(result.OrdersWithUnknownContracts),
+		"numUnrecoverableOrders":        len(result.UnrecoverableOrders),
+		"numOrphanedMiniHeaders":        len(result.OrphanedMiniHeaders),
+		"repaired":                      result.Repaired,
+	}).Info("finished checking database")
+	if result.NumProblems() > 0 && !repair {
+		return fmt.Errorf("found %d inconsistencies in the database; run `mesh db check --repair` to repair them", result.NumProblems())
+	}
+	return nil
+}
+
+// orderImporter adds exported orders to a core.App. Orders are added in
+// batches, one for each protocol version and set of AddOrdersOpts, so that
+// each order keeps its pinned and keep* flags.
+type orderImporter struct {
+	app         *core.App
+	batches     map[types.AddOrdersOpts]*importBatch
+	numAccepted int
+	numRejected int
+}
+
+type importBatch struct {
+	orders   []*zeroex.SignedOrder
+	ordersV4 []*zeroex.SignedOrderV4
+}
+
+func newOrderImporter(app *core.App) *orderImporter {
+	return &orderImporter{
+		app:     app,
+		batches: map[types.AddOrdersOpts]*importBatch{},
+	}
+}
+
+func (i *orderImporter) importFrom(ctx context.Context, reader *db.ExportedOrderReader) error {
+	for {
+		order, err := reader.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		opts := order.AddOrdersOpts()
+		batch, found := i.batches[opts]
+		if !found {
+			batch = &importBatch{}
+			i.batches[opts] = batch
+		}
+		if order.SignedOrder != nil {
+			batch.orders = append(batch.orders, order.SignedOrder)
+		} else {
+			batch.ordersV4 = append(batch.ordersV4, order.SignedOrderV4)
+		}
+		if len(batch.orders) >= importBatchSize || len(batch.ordersV4) >= importBatchSize {
+			if err := i.addBatch(ctx, opts, batch); err != nil {
+				return err
+			}
+		}
+	}
+	for opts, batch := range i.batches {
+		if err := i.addBatch(ctx, opts, batch); err != nil {
+			return err
+		}
+	}
+	log.WithFields(log.Fields{
+		"numAccepted": i.numAccepted,
+		"numRejected": i.numRejected,
+	}).Info("finished importing orders")
+	return nil
+}
+
+// addBatch validates and adds all orders in the batch and then empties it.
+func (i *orderImporter) addBatch(ctx context.Context, opts types.AddOrdersOpts, batch *importBatch) error {
+	if len(batch.orders) > 0 {
+		results, err := i.app.AddOrders(ctx, batch.orders, opts.Pinned, &opts)
+		if err != nil {
+			return err
+		}
+		i.recordResults(results.Accepted, results.Rejected)
+		batch.orders = nil
+	}
+	if len(batch.ordersV4) > 0 {
+		results, err := i.app.AddOrdersV4(ctx, batch.ordersV4, opts.Pinned, &opts)
+		if err != nil {
+			return err
+		}
+		i.recordResults(results.Accepted, results.Rejected)
+		batch.ordersV4 = nil
+	}
+	return nil
+}
+
+func (i *orderImporter) recordResults(accepted []*ordervalidator.AcceptedOrderInfo, rejected []*ordervalidator.RejectedOrderInfo) {
+	i.numAccepted += len(accepted)
+	i.numRejected += len(rejected)
+	for _, rejectedOrderInfo := range rejected {
+		log.WithFields(log.Fields{
+			"orderHash": rejectedOrderInfo.OrderHash.Hex(),
+			"code":      rejectedOrderInfo.Status.Code,
+			"message":   rejectedOrderInfo.Status.Message,
+		}).Warn("imported order was rejected")
+	}
+}
+
+func isCompressed(path string) bool {
+	return strings.HasSuffix(path, ".gz")
+}
@@ -5,13 +5,19 @@ package main
 import (
 	"context"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/0xProject/0x-mesh/core"
 	"github.com/0xProject/0x-mesh/graphql"
 	"github.com/0xProject/0x-mesh/graphql/generated"
 
+	gqlgengraphql "github.com/99designs/gqlgen/graphql"
 	gqlserver "github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"golang.org/x/time/rate"
 )
 
 // gracefulShutdownTimeout is the maximum amount of time to allow
@@ -19,7 +25,7 @@ import (
 // the signal to shutdown.
 const gracefulShutdownTimeout = 10 * time.Second
 
-func serveGraphQL(ctx context.Context, app *core.App, config *standaloneConfig) error {
+func serveGraphQL(ctx context.Context, app *core.App, config *standaloneConfig, auth *graphql.Authenticator, rateLimiter *graphql.RateLimiter) error {
 	handler := http.NewServeMux()
 
 	// Set up handler for GraphiQL
@@ -33,8 +39,12 @@ func serveGraphQL(ctx context.Context, app *core.App, config *standaloneConfig)
 	resolver := graphql.NewResolver(app, &graphql.ResolverConfig{
 		SlowSubscriberTimeout: config.GraphQLSlowSubscriberTimeout,
 	})
-	graphQLServer := gqlserver.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: resolver}))
-	handler.Handle("/graphql", graphQLServer)
+	schema := generated.NewExecutableSchema(generated.Config{
+		Resolvers:  resolver,
+		Complexity: graphql.NewComplexityRoot(),
+	})
+	graphQLServer := newGraphQLServer(schema, auth, rateLimiter, config.GraphQLMaxComplexity)
+	handler.Handle("/graphql", rateLimiter.Middleware(auth.Middleware(graphQLServer)))
 
 	// Start the server
 	server := &http.Server{Addr: config.GraphQLServerAddr, Handler: handler}
@@ -47,6 +57,64 @@ func serveGraphQL(ctx context.Context, app *core.App, config *standaloneConfig)
 	return server.ListenAndServe()
 }
 
+// newAuthenticator returns the Authenticator for the API keys in the given
+// config. It is shared by the GraphQL and SRA servers.
+func newAuthenticator(config *standaloneConfig) *graphql.Authenticator {
+	return graphql.NewAuthenticator(splitAPIKeys(config.GraphQLReadAPIKeys), splitAPIKeys(config.GraphQLWriteAPIKeys))
+}
+
+// newRateLimiter returns the RateLimiter for the rate limits in the given
+// config. It is shared by the GraphQL and SRA servers so that clients can't
+// get around the rate limits by using both.
+func newRateLimiter(config *standaloneConfig) *graphql.RateLimiter {
+	return graphql.NewRateLimiter(graphql.RateLimiterConfig{
+		Limit:         rate.Limit(config.GraphQLRateLimit),
+		Burst:         config.GraphQLRateLimitBurst,
+		MutationLimit: rate.Limit(config.GraphQLMutationRateLimit),
+		MutationBurst: config.GraphQLMutationRateLimitBurst,
+	})
+}
+
+// newGraphQLServer is equivalent to gqlserver.NewDefaultServer except that it
+// enforces API key authentication, rate limits and (if maxComplexity is not 0)
+// a complexity limit for all operations, including subscriptions.
+func newGraphQLServer(schema gqlgengraphql.ExecutableSchema, auth *graphql.Authenticator, rateLimiter *graphql.RateLimiter, maxComplexity int) *gqlserver.Server {
+	server := gqlserver.New(schema)
+
+	server.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		InitFunc:              auth.WebsocketInit,
+	})
+	server.AddTransport(transport.Options{})
+	server.AddTransport(transport.GET{})
+	server.AddTransport(transport.POST{})
+	server.AddTransport(transport.MultipartForm{})
+
+	server.SetQueryCache(lru.New(1000))
+
+	// Authentication must happen first so that unauthenticated clients don't
+	// get parse or complexity errors and so that authenticated clients are rate
+	// limited by API key.
+	server.Use(auth)
+	server.Use(extension.Introspection{})
+	server.Use(extension.AutomaticPersistedQuery{
+		Cache: lru.New(100),
+	})
+	if maxComplexity != 0 {
+		server.Use(graphql.NewComplexityLimit(maxComplexity))
+	}
+	server.AroundOperations(rateLimiter.AroundOperations)
+
+	return server
+}
+
+func splitAPIKeys(apiKeys string) []string {
+	if apiKeys == "" {
+		return nil
+	}
+	return strings.Split(apiKeys, ",")
+}
+
 var graphiQLPage = []byte(`
 <html>
   <head>
@@ -24,8 +24,8 @@ type standaloneConfig struct {
 	// If enabled, GraphQL queries can be sent to GraphQLServerAddr at the /graphql
 	// URL. By default, the GraphQL server is disabled. Please be aware that the GraphQL
 	// API is intended to be a *private* API. If you enable the GraphQL server in
-	// production we recommend using a firewall/VPC or an authenticated proxy to restrict
-	// public access.
+	// production we recommend setting GraphQLReadAPIKeys and GraphQLWriteAPIKeys, or
+	// using a firewall/VPC or an authenticated proxy to restrict public access.
 	EnableGraphQLServer bool `envvar:"ENABLE_GRAPHQL_SERVER" default:"false"`
 	// GraphQLServerAddr is the interface and port to use for the GraphQL API.
 	// By default, 0x Mesh will listen on 0.0.0.0 (all available addresses) and
@@ -39,6 +39,53 @@ type standaloneConfig struct {
 	// See https://github.com/graphql/graphiql for more information. By default, GraphiQL
 	// is disabled.
 	EnableGraphQLPlayground bool `envvar:"ENABLE_GRAPHQL_PLAYGROUND" default:"false"`
+	// GraphQLReadAPIKeys is a comma-separated list of API keys which are allowed
+	// to send queries and subscriptions to the GraphQL API. Clients send their
+	// API key in the Authorization header ("Authorization: Bearer <key>") or, for
+	// subscriptions, in the payload of the websocket connection_init message. If
+	// neither GraphQLReadAPIKeys nor GraphQLWriteAPIKeys is set, authentication is
+	// disabled.
+	GraphQLReadAPIKeys string `envvar:"GRAPHQL_READ_API_KEYS" default:"" json:"-"`
+	// GraphQLWriteAPIKeys is a comma-separated list of API keys which are allowed
+	// to send mutations (e.g. addOrders) in addition to queries and subscriptions.
+	GraphQLWriteAPIKeys string `envvar:"GRAPHQL_WRITE_API_KEYS" default:"" json:"-"`
+	// GraphQLRateLimit is the maximum number of GraphQL operations (queries,
+	// mutations and subscriptions) per second that each client can send.
+	// Authenticated clients are identified by their API key and all other
+	// clients by their IP address. Clients exceeding the limit receive an error
+	// with the RATE_LIMITED code. By default there is no rate limit.
+	GraphQLRateLimit float64 `envvar:"GRAPHQL_RATE_LIMIT" default:"0"`
+	// GraphQLRateLimitBurst is the maximum number of GraphQL operations that each
+	// client can send at once.
+	GraphQLRateLimitBurst int `envvar:"GRAPHQL_RATE_LIMIT_BURST" default:"10"`
+	// GraphQLMutationRateLimit is the maximum number of GraphQL mutations (e.g.
+	// addOrders) per second that each client can send. Mutations also count
+	// toward GraphQLRateLimit. By default there is no rate limit.
+	GraphQLMutationRateLimit float64 `envvar:"GRAPHQL_MUTATION_RATE_LIMIT" default:"0"`
+	// GraphQLMutationRateLimitBurst is the maximum number of GraphQL mutations
+	// that each client can send at once.
+	GraphQLMutationRateLimitBurst int `envvar:"GRAPHQL_MUTATION_RATE_LIMIT_BURST" default:"5"`
+	// GraphQLMaxComplexity is the maximum complexity of a GraphQL operation.
+	// Each field counts as 1, list fields count once for each item that may be
+	// returned and each sort field adds 1 per item (e.g. the complexity of
+	// `orders(first: 100, sort: [{ field: hash, direction: ASC }]) { orders { hash } }`
+	// is 300).
+	// Operations exceeding the limit receive an error with the
+	// COMPLEXITY_LIMIT_EXCEEDED code. By default there is no limit.
+	GraphQLMaxComplexity int `envvar:"GRAPHQL_MAX_COMPLEXITY" default:"0"`
+	// EnableSRAServer determines whether or not to enable a REST server which
+	// implements a subset of the 0x Standard Relayer API. If enabled, SRA v3
+	// and v4 requests can be sent to SRAServerAddr at the /sra/v3 and /sra/v4
+	// URLs respectively. Orders submitted via the SRA server are not pinned.
+	// The SRA server uses the same API keys (GraphQLReadAPIKeys and
+	// GraphQLWriteAPIKeys) and rate limits as the GraphQL API. Submitting
+	// orders requires a write API key and counts as a mutation. By default,
+	// the SRA server is disabled.
+	EnableSRAServer bool `envvar:"ENABLE_SRA_SERVER" default:"false"`
+	// SRAServerAddr is the interface and port to use for the SRA server.
+	// By default, 0x Mesh will only listen on 127.0.0.1 (localhost) and port
+	// 60560.
+	SRAServerAddr string `envvar:"SRA_SERVER_ADDR" default:"127.0.0.1:60560"`
 	// EnablePrometheusMoniitoring determines whether or not to enable
 	// prometheus monitoring. The metrics are accessed by scraping
 	// {PrometheusMonitoringServerAddr}/metrics, prometheus is disabled.
@@ -49,6 +96,13 @@ type standaloneConfig struct {
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "db" {
+		if err := runDBCommand(os.Args[2:]); err != nil {
+			log.WithField("error", err.Error()).Fatal("db command failed")
+		}
+		return
+	}
+
 	// Parse env vars
 	var coreConfig core.Config
 	if err := envvar.Parse(&coreConfig); err != nil {
@@ -81,6 +135,10 @@ func main() {
 		}
 	}()
 
+	// The GraphQL and SRA servers share the same API keys and rate limits.
+	auth := newAuthenticator(&config)
+	rateLimiter := newRateLimiter(&config)
+
 	graphQLErrChan := make(chan error, 1)
 	if config.EnableGraphQLServer {
 		// Start GraphQL server.
@@ -88,12 +146,25 @@ func main() {
 		go func() {
 			defer wg.Done()
 			log.WithField("graphql_server_addr", config.GraphQLServerAddr).Info("starting GraphQL server")
-			if err := serveGraphQL(ctx, app, &config); err != nil {
+			if err := serveGraphQL(ctx, app, &config, auth, rateLimiter); err != nil {
 				graphQLErrChan <- err
 			}
 		}()
 	}
 
+	sraErrChan := make(chan error, 1)
+	if config.EnableSRAServer {
+		// Start SRA server.
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			log.WithField("sra_server_addr", config.SRAServerAddr).Info("starting SRA server")
+			if err := serveSRA(ctx, app, &config, auth, rateLimiter); err != nil {
+				sraErrChan <- err
+			}
+		}()
+	}
+
 	// NOTE: Prometehus is not an essential service to run.
 	if config.EnablePrometheusMonitoring {
 		wg.Add(1)
@@ -119,6 +190,9 @@ func main() {
 	case err := <-graphQLErrChan:
 		cancel()
 		log.WithField("error", err.Error()).Error("GraphQL server returned error")
+	case err := <-sraErrChan:
+		cancel()
+		log.WithField("error", err.Error()).Error("SRA server returned error")
 	}
 
 	// If we reached here it means there was an error. Wait for all goroutines
@@ -0,0 +1,26 @@
+// +build !js
+
+package main
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/0xProject/0x-mesh/core"
+	"github.com/0xProject/0x-mesh/graphql"
+	"github.com/0xProject/0x-mesh/sra"
+)
+
+// serveSRA serves the SRA API. It uses the same API keys and rate limits as
+// the GraphQL API.
+func serveSRA(ctx context.Context, app *core.App, config *standaloneConfig, auth *graphql.Authenticator, rateLimiter *graphql.RateLimiter) error {
+	handler := auth.RESTMiddleware(rateLimiter.RESTMiddleware(sra.NewHandler(app)))
+	server := &http.Server{Addr: config.SRAServerAddr, Handler: handler}
+	go func() {
+		<-ctx.Done()
+		shutdownContext, cancel := context.WithTimeout(context.Background(), gracefulShutdownTimeout)
+		defer cancel()
+		_ = server.Shutdown(shutdownContext)
+	}()
+	return server.ListenAndServe()
+}
@@ -35,6 +35,22 @@ type Stats struct {
 	StartOfCurrentUTCDay              time.Time   `json:"startOfCurrentUTCDay"`
 	EthRPCRequestsSentInCurrentUTCDay int         `json:"ethRPCRequestsSentInCurrentUTCDay"`
 	EthRPCRateLimitExpiredRequests    int64       `json:"ethRPCRateLimitExpiredRequests"`
+	// MaxOrdersPerMaker and MaxOrdersPerPeer are the configured storage quotas.
+	// A value of 0 means that there is no quota.
+	MaxOrdersPerMaker int `json:"maxOrdersPerMaker"`
+	MaxOrdersPerPeer  int `json:"maxOrdersPerPeer"`
+	// MakerQuotaUsage and PeerQuotaUsage contain the makers and peers with the
+	// most stored orders, sorted by the number of orders in descending order.
+	MakerQuotaUsage []*QuotaUsage `json:"makerQuotaUsage"`
+	PeerQuotaUsage  []*QuotaUsage `json:"peerQuotaUsage"`
+}
+
+// QuotaUsage is the number of orders that count towards the storage quota of a
+// single maker or peer. Pinned and removed orders are not counted.
+type QuotaUsage struct {
+	// ID is the address of the maker or the ID of the peer.
+	ID        string `json:"id"`
+	NumOrders int    `json:"numOrders"`
 }
 
 // LatestBlock is the latest block processed by the Mesh node.
@@ -43,6 +59,52 @@ type LatestBlock struct {
 	Hash   common.Hash `json:"hash"`
 }
 
+// Orderbook is the return value for core.GetOrderbook. It contains the
+// aggregated bids and asks for a single base/quote token pair.
+type Orderbook struct {
+	BaseToken  common.Address `json:"baseToken"`
+	QuoteToken common.Address `json:"quoteToken"`
+	// Bids are orders which buy the base token in exchange for the quote token,
+	// sorted by price in descending order.
+	Bids []*OrderbookLevel `json:"bids"`
+	// Asks are orders which sell the base token in exchange for the quote token,
+	// sorted by price in ascending order.
+	Asks []*OrderbookLevel `json:"asks"`
+}
+
+// OrderbookLevel is a single price level in an Orderbook.
+type OrderbookLevel struct {
+	// Price is the amount of quote token per unit of base token, expressed in
+	// base units of each token (i.e. not adjusted for token decimals).
+	Price *big.Rat `json:"price"`
+	// BaseAmount is the total remaining fillable amount of the base token across
+	// all orders at this price level.
+	BaseAmount *big.Int `json:"baseAmount"`
+	// QuoteAmount is the total remaining fillable amount of the quote token
+	// across all orders at this price level.
+	QuoteAmount *big.Int `json:"quoteAmount"`
+	// OrderCount is the number of orders at this price level.
+	OrderCount int `json:"orderCount"`
+}
+
+// OrderStats is the return value for core.GetOrderStats. It contains aggregate
+// values computed over all orders that match a query.
+type OrderStats struct {
+	// OrderCount is the number of matching orders.
+	OrderCount int `json:"orderCount"`
+	// TotalFillableTakerAssetAmount is the sum of the remaining fillable taker
+	// asset amounts of all matching orders.
+	TotalFillableTakerAssetAmount *big.Int `json:"totalFillableTakerAssetAmount"`
+	// MakerCount is the number of distinct makers of the matching orders.
+	MakerCount int `json:"makerCount"`
+	// MinExpirationTimeSeconds is the earliest expiration time of the matching
+	// orders, or nil if there are none.
+	MinExpirationTimeSeconds *big.Int `json:"minExpirationTimeSeconds"`
+	// MaxExpirationTimeSeconds is the latest expiration time of the matching
+	// orders, or nil if there are none.
+	MaxExpirationTimeSeconds *big.Int `json:"maxExpirationTimeSeconds"`
+}
+
 // GetOrdersResponse is the return value for core.GetOrders. Also used in the
 // browser interface.
 type GetOrdersResponse struct {
@@ -70,6 +132,10 @@ type AddOrdersOpts struct {
 	// KeepUnfunded signals that this order should not be deleted
 	// even if it becomes unfunded.
 	KeepUnfunded bool `json:"keepUnfunded"`
+	// SourcePeerID is the ID of the peer that the orders were received from.
+	// It is empty for orders which were not received from a peer. It is used
+	// to enforce the per-peer storage quota and is never sent over the wire.
+	SourcePeerID string `json:"-"`
 }
 
 // OrderInfo represents an fillable order and how much it could be filled for.
@@ -139,6 +205,10 @@ type OrderWithMetadata struct {
 	ParsedMakerAssetData []*SingleAssetData `json:"parsedMakerAssetData"`
 	// Same as ParsedMakerAssetData but for MakerFeeAssetData instead of MakerAssetData.
 	ParsedMakerFeeAssetData []*SingleAssetData `json:"parsedMakerFeeAssetData"`
+	// Same as ParsedMakerAssetData but for TakerAssetData instead of MakerAssetData.
+	ParsedTakerAssetData []*SingleAssetData `json:"parsedTakerAssetData"`
+	// Same as ParsedMakerAssetData but for TakerFeeAssetData instead of MakerAssetData.
+	ParsedTakerFeeAssetData []*SingleAssetData `json:"parsedTakerFeeAssetData"`
 	// LastValidatedBlockNumber is the block number at which the order was
 	// last validated.
 	LastValidatedBlockNumber *big.Int `json:"lastValidatedBlockNumber"`
@@ -157,6 +227,13 @@ type OrderWithMetadata struct {
 	// KeepUnfunded signals that this order should not be deleted
 	// if it becomes unfunded.
 	KeepUnfunded bool `json:"keepUnfunded"`
+	// SourcePeerID is the ID of the peer that the order was received from, or
+	// an empty string if it wasn't received from a peer.
+	SourcePeerID string `json:"sourcePeerID"`
+	// EndState is the end state of the order event that was emitted when the
+	// order was marked as removed. It is empty for orders which are still
+	// being watched.
+	EndState zeroex.OrderEventEndState `json:"endState"`
 }
 
 func (order OrderWithMetadata) SignedOrder() *zeroex.SignedOrder {
@@ -196,6 +273,31 @@ func (order OrderWithMetadata) SignedOrderV4() *zeroex.SignedOrderV4 {
 	}
 }
 
+// Price returns the price of the maker asset in units of the taker asset (i.e.
+// the taker amount divided by the maker amount) for both v3 and v4 orders. It
+// returns 0 if either amount is 0. The price is stored in the database so that
+// orders can be sorted by price.
+func (order OrderWithMetadata) Price() float64 {
+	var makerAmount, takerAmount *big.Int
+	switch {
+	case order.OrderV3 != nil:
+		makerAmount, takerAmount = order.OrderV3.MakerAssetAmount, order.OrderV3.TakerAssetAmount
+	case order.OrderV4 != nil:
+		makerAmount, takerAmount = order.OrderV4.MakerAmount, order.OrderV4.TakerAmount
+	}
+	return PriceFromAmounts(makerAmount, takerAmount)
+}
+
+// PriceFromAmounts returns the taker amount divided by the maker amount,
+// rounded to the nearest float64. It returns 0 if either amount is nil or 0.
+func PriceFromAmounts(makerAmount, takerAmount *big.Int) float64 {
+	if makerAmount == nil || takerAmount == nil || makerAmount.Sign() == 0 || takerAmount.Sign() == 0 {
+		return 0
+	}
+	price, _ := new(big.Rat).SetFrac(takerAmount, makerAmount).Float64()
+	return price
+}
+
 type SingleAssetData struct {
 	Address common.Address `json:"address"`
 	TokenID *big.Int       `json:"tokenID"`
@@ -215,6 +317,48 @@ type Metadata struct {
 	StartOfCurrentUTCDay              time.Time
 }
 
+// WebhookDelivery is a payload which is waiting to be delivered to a webhook
+// endpoint.
+type WebhookDelivery struct {
+	ID            uint64
+	URL           string
+	Payload       []byte
+	Attempts      int
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+}
+
+// ArchivedOrder is an order that was permanently deleted from the database,
+// together with the final state it was in. Exactly one of SignedOrder and
+// SignedOrderV4 is set.
+type ArchivedOrder struct {
+	Hash          common.Hash
+	SignedOrder   *zeroex.SignedOrder
+	SignedOrderV4 *zeroex.SignedOrderV4
+	// EndState is the end state of the last order event for the order, i.e.
+	// the reason why Mesh stopped watching it.
+	EndState zeroex.OrderEventEndState
+	// FillableTakerAssetAmount is the remaining fillable amount at the time
+	// the order was deleted.
+	FillableTakerAssetAmount *big.Int
+	// BlockNumber and BlockHash identify the block at which the order was last
+	// validated.
+	BlockNumber *big.Int
+	BlockHash   common.Hash
+	// Timestamp is the time at which the order reached its end state.
+	Timestamp  time.Time
+	IsPinned   bool
+	ArchivedAt time.Time
+}
+
+// MakerAddress returns the maker of the archived v3 or v4 order.
+func (order *ArchivedOrder) MakerAddress() common.Address {
+	if order.SignedOrderV4 != nil {
+		return order.SignedOrderV4.Maker
+	}
+	return order.SignedOrder.MakerAddress
+}
+
 // HexToBytes converts the the given hex string (with or without the "0x" prefix)
 // to a slice of bytes. If the string is "0x" it returns nil.
 func HexToBytes(s string) []byte {
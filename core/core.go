@@ -18,6 +18,7 @@ import (
 	"github.com/0xProject/0x-mesh/constants"
 	"github.com/0xProject/0x-mesh/core/ordersync"
 	"github.com/0xProject/0x-mesh/core/ordersync_v4"
+	"github.com/0xProject/0x-mesh/core/webhook"
 	"github.com/0xProject/0x-mesh/db"
 	"github.com/0xProject/0x-mesh/encoding"
 	"github.com/0xProject/0x-mesh/ethereum"
@@ -66,6 +67,9 @@ const (
 	// run of the ordersync protocol (as a requester). We always request orders
 	// immediately on startup. This delay only applies to subsequent runs.
 	ordersyncApproxDelay = 1 * time.Hour
+	// numQuotaUsageStats is the number of makers and peers with the most orders
+	// that are included in the stats.
+	numQuotaUsageStats = 10
 )
 
 // privateConfig contains some configuration options that can only be changed from
@@ -96,6 +100,13 @@ type Config struct {
 	// DataDir is the directory to use for persisting all data, including the
 	// database and private key files.
 	DataDir string `envvar:"DATA_DIR" default:"0x_mesh"`
+	// DatabaseDriver is the storage backend for the database in DataDir. It can
+	// be "sqlite3" or "leveldb". The LevelDB backend keeps secondary indexes for
+	// the order lookups made by the order watcher, which makes it a better fit
+	// for nodes that store a large number of orders. Existing data is not
+	// migrated when the driver is changed (see `mesh db export`). Ignored in the
+	// browser.
+	DatabaseDriver string `envvar:"DATABASE_DRIVER" default:"sqlite3"`
 	// P2PTCPPort is the port on which to listen for new TCP connections from
 	// peers in the network. Set to 60558 by default.
 	P2PTCPPort int `envvar:"P2P_TCP_PORT" default:"60558"`
@@ -168,6 +179,30 @@ type Config struct {
 	// enforcing a limit on maximum expiration time for incoming orders and remove
 	// any orders with an expiration time too far in the future.
 	MaxOrdersInStorage int `envvar:"MAX_ORDERS_IN_STORAGE" default:"100000"`
+	// MaxOrdersPerMaker is the maximum number of orders that Mesh will keep in
+	// storage for a single maker, counting v3 and v4 orders together. Once a
+	// maker has reached the quota, new orders from the maker are only accepted
+	// if they expire sooner than one of the maker's stored orders, which is then
+	// removed to make room. Pinned orders don't count towards the quota. A
+	// value of 0 means that there is no quota.
+	MaxOrdersPerMaker int `envvar:"MAX_ORDERS_PER_MAKER" default:"0"`
+	// MaxOrdersPerPeer is like MaxOrdersPerMaker but limits the number of orders
+	// that Mesh will keep in storage which were received from a single peer.
+	MaxOrdersPerPeer int `envvar:"MAX_ORDERS_PER_PEER" default:"0"`
+	// MaxOrderEventsInStorage is the maximum number of order events that Mesh
+	// will keep in the order event log. Clients can use the order event log to
+	// catch up on events they missed while disconnected, as long as those events
+	// have not been pruned yet.
+	MaxOrderEventsInStorage int `envvar:"MAX_ORDER_EVENTS_IN_STORAGE" default:"100000"`
+	// ArchivedOrdersRetention is how long orders are kept in the order archive
+	// after they have been permanently deleted. The archive records the final
+	// state of each deleted order and can be queried through the GraphQL API.
+	// A value of 0 disables the archive.
+	ArchivedOrdersRetention time.Duration `envvar:"ARCHIVED_ORDERS_RETENTION" default:"720h"`
+	// CheckDatabaseOnStartup enables checking the database for inconsistencies
+	// when Mesh starts (see `mesh db check`). The inconsistencies that are found
+	// are repaired before Mesh starts watching orders.
+	CheckDatabaseOnStartup bool `envvar:"CHECK_DATABASE_ON_STARTUP" default:"false"`
 	// CustomOrderFilter is a stringified JSON Schema which will be used for
 	// validating incoming orders. If provided, Mesh will only receive orders from
 	// other peers in the network with the same filter.
@@ -192,6 +227,10 @@ type Config struct {
 	// settable in browsers and cannot be set via environment variable. If
 	// provided, EthereumRPCURL will be ignored.
 	EthereumRPCClient ethclient.RPCClient `envvar:"-"`
+	// OrderEventSinks receive all order events emitted by Mesh. They are only
+	// settable when using Mesh as a library and cannot be set via environment
+	// variable.
+	OrderEventSinks []OrderEventSink `envvar:"-" json:"-"`
 	// MaxBytesPerSecond is the maximum number of bytes per second that a peer is
 	// allowed to send before failing the bandwidth check. Defaults to 5 MiB.
 	MaxBytesPerSecond float64 `envvar:"MAX_BYTES_PER_SECOND" default:"5242880"`
@@ -202,6 +241,21 @@ type Config struct {
 	// It expects a comma delimited list of external sources for example:
 	// ADDITIONAL_PUBLIC_IP_SOURCES="https://ifconfig.me/ip,http://192.168.5.10:1337/ip"
 	AdditionalPublicIPSources string `envvar:"ADDITIONAL_PUBLIC_IP_SOURCES" default:""`
+	// WebhookURLs is a comma-separated list of HTTP endpoints which all order
+	// events will be sent to in batches via POST requests. Pending deliveries
+	// are stored in the database, so they survive restarts, and failed
+	// deliveries are retried with exponential backoff. By default no webhooks
+	// are used.
+	WebhookURLs string `envvar:"WEBHOOK_URLS" default:""`
+	// WebhookSecret is the key used to sign each webhook payload. The signature
+	// is an HMAC-SHA256 of the request body and is sent in the X-Mesh-Signature
+	// header. Required if WebhookURLs is set.
+	WebhookSecret string `envvar:"WEBHOOK_SECRET" default:"" json:"-"`
+	// WebhookMaxPendingDeliveries is the maximum number of pending deliveries
+	// that are stored for each webhook endpoint. Once it is reached, new order
+	// events for the endpoint are dropped until some of the pending deliveries
+	// have succeeded or have been given up on.
+	WebhookMaxPendingDeliveries int `envvar:"WEBHOOK_MAX_PENDING_DELIVERIES" default:"10000"`
 }
 
 type App struct {
@@ -221,7 +275,9 @@ type App struct {
 	db                 *db.DB
 	ordersyncService   *ordersync.Service
 	ordersyncServiceV4 *ordersync_v4.Service
+	webhookService     *webhook.Service
 	contractAddresses  *ethereum.ContractAddresses
+	assetDataDecoder   *zeroex.AssetDataDecoder
 
 	// started is closed to signal that the App has been started. Some methods
 	// will block until after the App is started.
@@ -243,14 +299,7 @@ func newWithPrivateConfig(ctx context.Context, config Config, pConfig privateCon
 		log.AddHook(loghooks.NewKeySuffixHook())
 	})
 
-	// Add custom contract addresses if needed.
-	var contractAddresses ethereum.ContractAddresses
-	var err error
-	if config.CustomContractAddresses != "" {
-		contractAddresses, err = parseAndValidateCustomContractAddresses(config.EthereumChainID, config.CustomContractAddresses)
-	} else {
-		contractAddresses, err = ethereum.NewContractAddressesForChainID(config.EthereumChainID)
-	}
+	contractAddresses, err := contractAddressesForConfig(config)
 	if err != nil {
 		return nil, err
 	}
@@ -287,7 +336,7 @@ func newWithPrivateConfig(ctx context.Context, config Config, pConfig privateCon
 	}
 
 	// Initialize db
-	database, err := newDB(ctx, config)
+	database, err := NewDB(ctx, config)
 	if err != nil {
 		return nil, err
 	}
@@ -298,6 +347,14 @@ func newWithPrivateConfig(ctx context.Context, config Config, pConfig privateCon
 		return nil, err
 	}
 
+	// Orphaned mini headers have to be removed before the block watcher loads
+	// the stored chain.
+	if config.CheckDatabaseOnStartup {
+		if _, err := checkMiniHeaderChain(database, true); err != nil {
+			return nil, err
+		}
+	}
+
 	// Initialize ETH JSON-RPC RateLimiter
 	var ethRPCRateLimiter ratelimit.RateLimiter
 	if !config.EnableEthereumRPCRateLimiting {
@@ -367,10 +424,33 @@ func newWithPrivateConfig(ctx context.Context, config Config, pConfig privateCon
 		ChainID:           config.EthereumChainID,
 		ContractAddresses: contractAddresses,
 		MaxOrders:         config.MaxOrdersInStorage,
+		MaxOrdersPerMaker: config.MaxOrdersPerMaker,
+		MaxOrdersPerPeer:  config.MaxOrdersPerPeer,
+		ArchiveRetention:  config.ArchivedOrdersRetention,
 	})
 	if err != nil {
 		return nil, err
 	}
+	if config.CheckDatabaseOnStartup {
+		if _, err := orderWatcher.CheckIntegrity(true); err != nil {
+			return nil, err
+		}
+	}
+
+	// Initialize the webhook service (but don't start it yet).
+	var webhookService *webhook.Service
+	if config.WebhookURLs != "" {
+		webhookService, err = webhook.New(webhook.Config{
+			DB:                   database,
+			OrderWatcher:         orderWatcher,
+			URLs:                 parseWebhookURLs(config.WebhookURLs),
+			Secret:               config.WebhookSecret,
+			MaxPendingDeliveries: config.WebhookMaxPendingDeliveries,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// Initialize the order filter
 	orderFilter, err := orderfilter.New(config.EthereumChainID, config.CustomOrderFilter, contractAddresses)
@@ -393,7 +473,9 @@ func newWithPrivateConfig(ctx context.Context, config Config, pConfig privateCon
 		ethRPCRateLimiter: ethRPCRateLimiter,
 		ethRPCClient:      ethClient,
 		db:                database,
+		webhookService:    webhookService,
 		contractAddresses: &contractAddresses,
+		assetDataDecoder:  zeroex.NewAssetDataDecoder(),
 	}
 
 	log.WithFields(map[string]interface{}{
@@ -415,6 +497,20 @@ func unquoteConfig(config Config) Config {
 	return config
 }
 
+// parseWebhookURLs splits the comma-separated list of webhook URLs, trimming
+// whitespace around each URL and skipping empty entries.
+func parseWebhookURLs(webhookURLs string) []string {
+	urls := []string{}
+	for _, url := range strings.Split(webhookURLs, ",") {
+		url = strings.TrimSpace(url)
+		if url == "" {
+			continue
+		}
+		urls = append(urls, url)
+	}
+	return urls
+}
+
 func getPublishTopics(chainID int, contractAddresses ethereum.ContractAddresses, customFilter *orderfilter.Filter) ([]string, error) {
 	defaultTopic, err := orderfilter.GetDefaultTopic(chainID, contractAddresses)
 	if err != nil {
@@ -547,6 +643,36 @@ func (app *App) Start() error {
 		ethRPCRateLimiterErrChan <- app.ethRPCRateLimiter.Start(innerCtx, rateLimiterCheckpointInterval)
 	}()
 
+	// Start publishing to the order event sinks. Each sink is subscribed before
+	// the order watcher is started so that no order events are missed.
+	for _, sink := range app.config.OrderEventSinks {
+		publish := app.subscribeOrderEventSink(sink)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				log.Debug("closing order event sink")
+			}()
+			publish(innerCtx)
+		}()
+	}
+
+	// Start the webhook service if any webhooks are configured. Like the order
+	// event sinks, it is subscribed before the order watcher is started.
+	webhookErrChan := make(chan error, 1)
+	if app.webhookService != nil {
+		webhookSubscription, webhookOrderEventsChan := app.webhookService.Subscribe()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				log.Debug("closing webhook service")
+			}()
+			log.WithField("urls", app.config.WebhookURLs).Info("starting webhook service")
+			webhookErrChan <- app.webhookService.Run(innerCtx, webhookSubscription, webhookOrderEventsChan)
+		}()
+	}
+
 	// Start the order watcher.
 	orderWatcherErrChan := make(chan error, 1)
 	wg.Add(1)
@@ -795,6 +921,12 @@ func (app *App) Start() error {
 				cancel()
 				return err
 			}
+		case err := <-webhookErrChan:
+			if err != nil {
+				log.WithError(err).Error("webhook service exited with error")
+				cancel()
+				return err
+			}
 		case err := <-chainIDMismatchErrChan:
 			if err != nil {
 				log.WithError(err).Error("ETH chain id matcher exited with error")
@@ -850,6 +982,12 @@ func (app *App) ChainID() int {
 	return app.chainID
 }
 
+// ParseAssetData returns the tokens contained in the given asset data, in the
+// same form as they are stored in the parsed asset data fields of v3 orders.
+func (app *App) ParseAssetData(assetData []byte) ([]*types.SingleAssetData, error) {
+	return db.ParseContractAddressesAndTokenIdsFromAssetData(app.assetDataDecoder, assetData, *app.contractAddresses)
+}
+
 func (app *App) GetOrder(hash common.Hash) (*types.OrderWithMetadata, error) {
 	<-app.started
 	return app.db.GetOrder(hash)
@@ -870,6 +1008,67 @@ func (app *App) FindOrdersV4(query *db.OrderQueryV4) ([]*types.OrderWithMetadata
 	return app.db.FindOrdersV4(query)
 }
 
+// FindArchivedOrders returns the orders in the order archive that match the
+// given query. See db.FindArchivedOrders for details.
+func (app *App) FindArchivedOrders(query *db.ArchivedOrderQuery) ([]*types.ArchivedOrder, error) {
+	<-app.started
+	return app.db.FindArchivedOrders(query)
+}
+
+// GetOrderStats returns aggregate values for the v3 orders that match the
+// filters of the given query. See db.GetOrderStats for details.
+func (app *App) GetOrderStats(query *db.OrderQuery) (*types.OrderStats, error) {
+	<-app.started
+	return app.db.GetOrderStats(query)
+}
+
+// GetOrderStatsV4 returns aggregate values for the v4 orders that match the
+// filters of the given query. See db.GetOrderStatsV4 for details.
+func (app *App) GetOrderStatsV4(query *db.OrderQueryV4) (*types.OrderStats, error) {
+	<-app.started
+	return app.db.GetOrderStatsV4(query)
+}
+
+// RemoveOrders stops watching the v3 orders with the given hashes and
+// permanently deletes them. See orderwatch.Watcher.RemoveOrders for details.
+func (app *App) RemoveOrders(hashes []common.Hash) ([]common.Hash, error) {
+	<-app.started
+	return app.orderWatcher.RemoveOrders(hashes)
+}
+
+// RemoveOrdersV4 is the v4 equivalent of RemoveOrders.
+func (app *App) RemoveOrdersV4(hashes []common.Hash) ([]common.Hash, error) {
+	<-app.started
+	return app.orderWatcher.RemoveOrdersV4(hashes)
+}
+
+// SetOrdersPinned pins or unpins the v3 orders with the given hashes. See
+// orderwatch.Watcher.SetOrdersPinned for details.
+func (app *App) SetOrdersPinned(hashes []common.Hash, pinned bool) ([]common.Hash, error) {
+	<-app.started
+	return app.orderWatcher.SetOrdersPinned(hashes, pinned)
+}
+
+// SetOrdersPinnedV4 is the v4 equivalent of SetOrdersPinned.
+func (app *App) SetOrdersPinnedV4(hashes []common.Hash, pinned bool) ([]common.Hash, error) {
+	<-app.started
+	return app.orderWatcher.SetOrdersPinnedV4(hashes, pinned)
+}
+
+// FindOrdersAfter returns the v3 orders that match the given query and come
+// after the given sort values. See db.FindOrdersAfter for details.
+func (app *App) FindOrdersAfter(query *db.OrderQuery, after []interface{}) ([]*types.OrderWithMetadata, error) {
+	<-app.started
+	return app.db.FindOrdersAfter(query, after)
+}
+
+// FindOrdersAfterV4 returns the v4 orders that match the given query and come
+// after the given sort values. See db.FindOrdersAfterV4 for details.
+func (app *App) FindOrdersAfterV4(query *db.OrderQueryV4, after []interface{}) ([]*types.OrderWithMetadata, error) {
+	<-app.started
+	return app.db.FindOrdersAfterV4(query, after)
+}
+
 // ErrPerPageZero is the error returned when a GetOrders request specifies perPage to 0
 type ErrPerPageZero struct{}
 
@@ -974,6 +1173,143 @@ func (app *App) AddOrdersV4(ctx context.Context, signedOrders []*zeroex.SignedOr
 func (app *App) AddOrdersRaw(ctx context.Context, signedOrdersRaw []*json.RawMessage, pinned bool, opts *types.AddOrdersOpts) (*ordervalidator.ValidationResults, error) {
 	<-app.started
 
+	allValidationResults, schemaValidOrders, err := app.validateOrderSchemas(signedOrdersRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	validationResults, err := app.orderWatcher.ValidateAndStoreValidOrders(ctx, schemaValidOrders, app.chainID, pinned, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	allValidationResults.Accepted = append(allValidationResults.Accepted, validationResults.Accepted...)
+	allValidationResults.Rejected = append(allValidationResults.Rejected, validationResults.Rejected...)
+
+	for _, acceptedOrderInfo := range allValidationResults.Accepted {
+		// If the order isn't new, we don't add to OrderWatcher, log it's receipt
+		// or share the order with peers.
+		if !acceptedOrderInfo.IsNew {
+			continue
+		}
+
+		log.WithFields(log.Fields{
+			"orderHash": acceptedOrderInfo.OrderHash.String(),
+		}).Debug("added new valid order via GraphQL or browser callback")
+
+		// Share the order with our peers.
+		if err := app.shareOrder(acceptedOrderInfo.SignedOrder); err != nil {
+			return nil, err
+		}
+	}
+
+	return allValidationResults, nil
+}
+
+// AddOrdersRawV4 is like AddOrdersRaw but accepts for V4 orders.
+func (app *App) AddOrdersRawV4(ctx context.Context, signedOrdersRaw []*json.RawMessage, pinned bool, opts *types.AddOrdersOpts) (*ordervalidator.ValidationResults, error) {
+	<-app.started
+
+	allValidationResults, schemaValidOrders, err := app.validateOrderSchemasV4(signedOrdersRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	validationResults, err := app.orderWatcher.ValidateAndStoreValidOrdersV4(ctx, schemaValidOrders, app.chainID, pinned, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	allValidationResults.Accepted = append(allValidationResults.Accepted, validationResults.Accepted...)
+	allValidationResults.Rejected = append(allValidationResults.Rejected, validationResults.Rejected...)
+
+	for _, acceptedOrderInfo := range allValidationResults.Accepted {
+		// If the order isn't new, we don't add to OrderWatcher, log it's receipt
+		// or share the order with peers.
+		if !acceptedOrderInfo.IsNew {
+			continue
+		}
+
+		log.WithFields(log.Fields{
+			"orderHash": acceptedOrderInfo.OrderHash.String(),
+		}).Debug("added new valid order via GraphQL or browser callback")
+
+		if err := app.shareOrderV4(acceptedOrderInfo.SignedOrderV4); err != nil {
+			return nil, err
+		}
+	}
+
+	return allValidationResults, nil
+}
+
+// ValidateOrders validates the given orders in the same way as AddOrders, but
+// does not store, pin or share any of them. It can be used to check whether
+// orders would be accepted before actually adding them. pinned affects
+// validation in the same way as it does for AddOrders.
+func (app *App) ValidateOrders(ctx context.Context, signedOrders []*zeroex.SignedOrder, pinned bool) (*ordervalidator.ValidationResults, error) {
+	signedOrdersRaw := []*json.RawMessage{}
+	buf := &bytes.Buffer{}
+	if err := json.NewEncoder(buf).Encode(signedOrders); err != nil {
+		return nil, err
+	}
+	if err := json.NewDecoder(buf).Decode(&signedOrdersRaw); err != nil {
+		return nil, err
+	}
+	return app.ValidateOrdersRaw(ctx, signedOrdersRaw, pinned)
+}
+
+// ValidateOrdersV4 is the v4 equivalent of ValidateOrders.
+func (app *App) ValidateOrdersV4(ctx context.Context, signedOrders []*zeroex.SignedOrderV4, pinned bool) (*ordervalidator.ValidationResults, error) {
+	signedOrdersRaw := []*json.RawMessage{}
+	buf := &bytes.Buffer{}
+	if err := json.NewEncoder(buf).Encode(signedOrders); err != nil {
+		return nil, err
+	}
+	if err := json.NewDecoder(buf).Decode(&signedOrdersRaw); err != nil {
+		return nil, err
+	}
+	return app.ValidateOrdersRawV4(ctx, signedOrdersRaw, pinned)
+}
+
+// ValidateOrdersRaw is like ValidateOrders but accepts raw JSON messages.
+func (app *App) ValidateOrdersRaw(ctx context.Context, signedOrdersRaw []*json.RawMessage, pinned bool) (*ordervalidator.ValidationResults, error) {
+	<-app.started
+
+	allValidationResults, schemaValidOrders, err := app.validateOrderSchemas(signedOrdersRaw)
+	if err != nil {
+		return nil, err
+	}
+	validationResults, err := app.orderWatcher.ValidateOrders(ctx, schemaValidOrders, app.chainID, pinned)
+	if err != nil {
+		return nil, err
+	}
+	allValidationResults.Accepted = append(allValidationResults.Accepted, validationResults.Accepted...)
+	allValidationResults.Rejected = append(allValidationResults.Rejected, validationResults.Rejected...)
+	return allValidationResults, nil
+}
+
+// ValidateOrdersRawV4 is like ValidateOrdersRaw but accepts V4 orders.
+func (app *App) ValidateOrdersRawV4(ctx context.Context, signedOrdersRaw []*json.RawMessage, pinned bool) (*ordervalidator.ValidationResults, error) {
+	<-app.started
+
+	allValidationResults, schemaValidOrders, err := app.validateOrderSchemasV4(signedOrdersRaw)
+	if err != nil {
+		return nil, err
+	}
+	validationResults, err := app.orderWatcher.ValidateOrdersV4(ctx, schemaValidOrders, app.chainID, pinned)
+	if err != nil {
+		return nil, err
+	}
+	allValidationResults.Accepted = append(allValidationResults.Accepted, validationResults.Accepted...)
+	allValidationResults.Rejected = append(allValidationResults.Rejected, validationResults.Rejected...)
+	return allValidationResults, nil
+}
+
+// validateOrderSchemas decodes the given raw orders and validates them against
+// the JSON schema of the order filter. Orders which fail validation are
+// included in the returned validation results and all other orders are
+// returned without duplicates.
+func (app *App) validateOrderSchemas(signedOrdersRaw []*json.RawMessage) (*ordervalidator.ValidationResults, []*zeroex.SignedOrder, error) {
 	allValidationResults := &ordervalidator.ValidationResults{
 		Accepted: []*ordervalidator.AcceptedOrderInfo{},
 		Rejected: []*ordervalidator.RejectedOrderInfo{},
@@ -1021,12 +1357,12 @@ func (app *App) AddOrdersRaw(ctx context.Context, signedOrdersRaw []*json.RawMes
 		if err := signedOrder.UnmarshalJSON(signedOrderBytes); err != nil {
 			// This error should never happen since the signedOrder already passed the JSON schema validation above
 			log.WithField("signedOrderRaw", string(signedOrderBytes)).Error("Failed to unmarshal SignedOrder")
-			return nil, err
+			return nil, nil, err
 		}
 
 		orderHash, err := signedOrder.ComputeOrderHash()
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		if _, alreadySeen := orderHashesSeen[orderHash]; alreadySeen {
 			continue
@@ -1036,38 +1372,11 @@ func (app *App) AddOrdersRaw(ctx context.Context, signedOrdersRaw []*json.RawMes
 		orderHashesSeen[orderHash] = struct{}{}
 	}
 
-	validationResults, err := app.orderWatcher.ValidateAndStoreValidOrders(ctx, schemaValidOrders, app.chainID, pinned, opts)
-	if err != nil {
-		return nil, err
-	}
-
-	allValidationResults.Accepted = append(allValidationResults.Accepted, validationResults.Accepted...)
-	allValidationResults.Rejected = append(allValidationResults.Rejected, validationResults.Rejected...)
-
-	for _, acceptedOrderInfo := range allValidationResults.Accepted {
-		// If the order isn't new, we don't add to OrderWatcher, log it's receipt
-		// or share the order with peers.
-		if !acceptedOrderInfo.IsNew {
-			continue
-		}
-
-		log.WithFields(log.Fields{
-			"orderHash": acceptedOrderInfo.OrderHash.String(),
-		}).Debug("added new valid order via GraphQL or browser callback")
-
-		// Share the order with our peers.
-		if err := app.shareOrder(acceptedOrderInfo.SignedOrder); err != nil {
-			return nil, err
-		}
-	}
-
-	return allValidationResults, nil
+	return allValidationResults, schemaValidOrders, nil
 }
 
-// AddOrdersRawV4 is like AddOrdersRaw but accepts for V4 orders.
-func (app *App) AddOrdersRawV4(ctx context.Context, signedOrdersRaw []*json.RawMessage, pinned bool, opts *types.AddOrdersOpts) (*ordervalidator.ValidationResults, error) {
-	<-app.started
-
+// validateOrderSchemasV4 is the v4 equivalent of validateOrderSchemas.
+func (app *App) validateOrderSchemasV4(signedOrdersRaw []*json.RawMessage) (*ordervalidator.ValidationResults, []*zeroex.SignedOrderV4, error) {
 	allValidationResults := &ordervalidator.ValidationResults{
 		Accepted: []*ordervalidator.AcceptedOrderInfo{},
 		Rejected: []*ordervalidator.RejectedOrderInfo{},
@@ -1115,12 +1424,12 @@ func (app *App) AddOrdersRawV4(ctx context.Context, signedOrdersRaw []*json.RawM
 		if err := signedOrder.UnmarshalJSON(signedOrderBytes); err != nil {
 			// This error should never happen since the signedOrder already passed the JSON schema validation above
 			log.WithField("signedOrderRaw", string(signedOrderBytes)).Error("Failed to unmarshal SignedOrder")
-			return nil, err
+			return nil, nil, err
 		}
 
 		orderHash, err := signedOrder.ComputeOrderHash()
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		if _, alreadySeen := orderHashesSeen[orderHash]; alreadySeen {
 			continue
@@ -1130,31 +1439,7 @@ func (app *App) AddOrdersRawV4(ctx context.Context, signedOrdersRaw []*json.RawM
 		orderHashesSeen[orderHash] = struct{}{}
 	}
 
-	validationResults, err := app.orderWatcher.ValidateAndStoreValidOrdersV4(ctx, schemaValidOrders, app.chainID, pinned, opts)
-	if err != nil {
-		return nil, err
-	}
-
-	allValidationResults.Accepted = append(allValidationResults.Accepted, validationResults.Accepted...)
-	allValidationResults.Rejected = append(allValidationResults.Rejected, validationResults.Rejected...)
-
-	for _, acceptedOrderInfo := range allValidationResults.Accepted {
-		// If the order isn't new, we don't add to OrderWatcher, log it's receipt
-		// or share the order with peers.
-		if !acceptedOrderInfo.IsNew {
-			continue
-		}
-
-		log.WithFields(log.Fields{
-			"orderHash": acceptedOrderInfo.OrderHash.String(),
-		}).Debug("added new valid order via GraphQL or browser callback")
-
-		if err := app.shareOrderV4(acceptedOrderInfo.SignedOrderV4); err != nil {
-			return nil, err
-		}
-	}
-
-	return allValidationResults, nil
+	return allValidationResults, schemaValidOrders, nil
 }
 
 // shareOrder immediately shares the given order on the GossipSub network.
@@ -1188,6 +1473,36 @@ func (app *App) AddPeer(peerInfo peer.AddrInfo) error {
 	return app.node.Connect(peerInfo, peerConnectTimeout)
 }
 
+// GetPeers returns information about each peer that Mesh is currently
+// connected to.
+func (app *App) GetPeers() []*p2p.PeerInfo {
+	<-app.started
+
+	return app.node.Peers()
+}
+
+// DisconnectPeer closes all connections to the peer with the given ID.
+func (app *App) DisconnectPeer(id peer.ID) error {
+	<-app.started
+
+	return app.node.DisconnectPeer(id)
+}
+
+// BanPeer bans the IP address of the given multiaddress and closes any
+// connections to it.
+func (app *App) BanPeer(maddr ma.Multiaddr) error {
+	<-app.started
+
+	return app.node.BanIP(maddr)
+}
+
+// UnbanPeer removes the ban on the IP address of the given multiaddress.
+func (app *App) UnbanPeer(maddr ma.Multiaddr) error {
+	<-app.started
+
+	return app.node.UnbanIP(maddr)
+}
+
 // GetStats retrieves stats about the Mesh node
 func (app *App) GetStats() (*types.Stats, error) {
 	<-app.started
@@ -1274,6 +1589,14 @@ func (app *App) GetStats() (*types.Stats, error) {
 	if err != nil {
 		return nil, err
 	}
+	makerQuotaUsage, err := app.db.FindMakerQuotaUsage(numQuotaUsageStats)
+	if err != nil {
+		return nil, err
+	}
+	peerQuotaUsage, err := app.db.FindPeerQuotaUsage(numQuotaUsageStats)
+	if err != nil {
+		return nil, err
+	}
 
 	response := &types.Stats{
 		Version:                           version,
@@ -1294,6 +1617,10 @@ func (app *App) GetStats() (*types.Stats, error) {
 		StartOfCurrentUTCDay:              metadata.StartOfCurrentUTCDay,
 		EthRPCRequestsSentInCurrentUTCDay: metadata.EthRPCRequestsSentInCurrentUTCDay,
 		EthRPCRateLimitExpiredRequests:    app.ethRPCClient.GetRateLimitDroppedRequests(),
+		MaxOrdersPerMaker:                 app.config.MaxOrdersPerMaker,
+		MaxOrdersPerPeer:                  app.config.MaxOrdersPerPeer,
+		MakerQuotaUsage:                   makerQuotaUsage,
+		PeerQuotaUsage:                    peerQuotaUsage,
 	}
 	return response, nil
 }
@@ -1369,6 +1696,15 @@ func (app *App) IsCaughtUpToLatestBlock(ctx context.Context) bool {
 	return latestRPCBlock.Number.Cmp(latestStoredBlock.Number) == 0
 }
 
+// contractAddressesForConfig returns the custom contract addresses if there
+// are any and the default contract addresses for the chain ID otherwise.
+func contractAddressesForConfig(config Config) (ethereum.ContractAddresses, error) {
+	if config.CustomContractAddresses != "" {
+		return parseAndValidateCustomContractAddresses(config.EthereumChainID, config.CustomContractAddresses)
+	}
+	return ethereum.NewContractAddressesForChainID(config.EthereumChainID)
+}
+
 func parseAndValidateCustomContractAddresses(chainID int, encodedContractAddresses string) (ethereum.ContractAddresses, error) {
 	customAddresses := ethereum.ContractAddresses{}
 	if err := json.Unmarshal([]byte(encodedContractAddresses), &customAddresses); err != nil {
@@ -173,6 +173,15 @@ func TestRepeatedAppInitialization(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestParseWebhookURLs(t *testing.T) {
+	assert.Equal(t, []string{}, parseWebhookURLs(""))
+	assert.Equal(t, []string{"https://example.com/a"}, parseWebhookURLs("https://example.com/a"))
+	assert.Equal(t,
+		[]string{"https://example.com/a", "https://example.com/b"},
+		parseWebhookURLs(" https://example.com/a , https://example.com/b,,"),
+	)
+}
+
 func TestOrderSync(t *testing.T) {
 	if !serialTestsEnabled {
 		t.Skip("Serial tests (tests which cannot run in parallel) are disabled. You can enable them with the --serial flag")
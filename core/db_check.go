@@ -0,0 +1,91 @@
+package core
+
+import (
+	"context"
+
+	"github.com/0xProject/0x-mesh/db"
+	"github.com/0xProject/0x-mesh/zeroex/orderwatch"
+	"github.com/ethereum/go-ethereum/common"
+	log "github.com/sirupsen/logrus"
+)
+
+// DBCheckResult describes the inconsistencies that were found by CheckDB.
+type DBCheckResult struct {
+	*orderwatch.IntegrityCheckResult
+	// OrphanedMiniHeaders are the hashes of the stored mini headers which are
+	// not part of the stored chain (see db.FindOrphanedMiniHeaders). They are
+	// deleted if the inconsistencies were repaired.
+	OrphanedMiniHeaders []common.Hash
+}
+
+// NumProblems returns the number of inconsistencies that were found.
+func (r *DBCheckResult) NumProblems() int {
+	return r.IntegrityCheckResult.NumProblems() + len(r.OrphanedMiniHeaders)
+}
+
+// CheckDB checks the database in config.DataDir for inconsistencies between
+// the stored orders, their parsed asset data, the contracts that the order
+// watcher registers with its event decoder when it starts and the stored chain
+// of mini headers. If repair is true, the inconsistencies are repaired. Mesh
+// must not be running while the database is checked.
+//
+// The order watcher registers the contracts of the stored orders when it is
+// created, so this only finds orders whose contracts could not be registered.
+// Contracts that are dropped while Mesh is running are found by the order
+// watcher itself, which runs the same check after each cleanup.
+func CheckDB(ctx context.Context, config Config, repair bool) (*DBCheckResult, error) {
+	contractAddresses, err := contractAddressesForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	database, err := NewDB(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	orphanedMiniHeaders, err := checkMiniHeaderChain(database, repair)
+	if err != nil {
+		return nil, err
+	}
+	// The order watcher registers the contracts of all stored orders with its
+	// event decoder when it is created, like it does when Mesh starts.
+	orderWatcher, err := orderwatch.New(orderwatch.Config{
+		DB:                database,
+		ChainID:           config.EthereumChainID,
+		ContractAddresses: contractAddresses,
+		MaxOrders:         config.MaxOrdersInStorage,
+	})
+	if err != nil {
+		return nil, err
+	}
+	orderResult, err := orderWatcher.CheckIntegrity(repair)
+	if err != nil {
+		return nil, err
+	}
+	return &DBCheckResult{
+		IntegrityCheckResult: orderResult,
+		OrphanedMiniHeaders:  orphanedMiniHeaders,
+	}, nil
+}
+
+// checkMiniHeaderChain returns the hashes of the orphaned mini headers in the
+// database. If repair is true, they are deleted.
+func checkMiniHeaderChain(database *db.DB, repair bool) ([]common.Hash, error) {
+	orphanedMiniHeaders, err := database.FindOrphanedMiniHeaders()
+	if err != nil {
+		return nil, err
+	}
+	hashes := []common.Hash{}
+	for _, miniHeader := range orphanedMiniHeaders {
+		log.WithFields(log.Fields{
+			"hash":   miniHeader.Hash.Hex(),
+			"number": miniHeader.Number,
+		}).Warn("stored mini header is not part of the stored chain")
+		if repair {
+			if err := database.DeleteMiniHeader(miniHeader.Hash); err != nil {
+				return nil, err
+			}
+		}
+		hashes = append(hashes, miniHeader.Hash)
+	}
+	return hashes, nil
+}
@@ -0,0 +1,199 @@
+// Package filesink contains an order event sink which archives order events to
+// JSON lines files on disk. It can be registered via core.Config.OrderEventSinks.
+package filesink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xProject/0x-mesh/zeroex"
+)
+
+const (
+	// currentFileName is the name of the file that order events are currently
+	// being written to.
+	currentFileName = "order_events.jsonl"
+	// rotatedFilePrefix and rotatedFileSuffix are the prefix and suffix of the
+	// names of rotated files. In between is the time of rotation, formatted
+	// with rotatedFileTimeFormat so that files sort chronologically by name.
+	rotatedFilePrefix     = "order_events-"
+	rotatedFileSuffix     = ".jsonl"
+	rotatedFileTimeFormat = "20060102T150405.000000000Z"
+
+	defaultMaxFileSize = 100 * 1024 * 1024 // 100 MiB
+)
+
+// ErrClosed is returned by Publish if the sink has been closed.
+var ErrClosed = errors.New("filesink: sink is closed")
+
+// Config is the configuration for a Sink.
+type Config struct {
+	// Dir is the directory that files are written to. It is created if it does
+	// not exist.
+	Dir string
+	// MaxFileSize is the size in bytes after which the current file is
+	// rotated. Defaults to 100 MiB.
+	MaxFileSize int64
+	// MaxFiles is the maximum number of rotated files to keep. When there are
+	// more, the oldest ones are deleted. If 0, rotated files are never deleted.
+	MaxFiles int
+}
+
+// Sink writes order events to a file in JSON lines format, i.e. each order
+// event is encoded as JSON on a separate line. When the file grows larger than
+// the configured maximum size, it is renamed to include the time of rotation
+// and a new file is started.
+type Sink struct {
+	mu          sync.Mutex
+	dir         string
+	maxFileSize int64
+	maxFiles    int
+	file        *os.File
+	size        int64
+}
+
+// New creates a new Sink with the given config. If there is an existing
+// current file in the directory, new order events are appended to it.
+func New(config Config) (*Sink, error) {
+	if config.Dir == "" {
+		return nil, errors.New("filesink: Dir is required")
+	}
+	maxFileSize := config.MaxFileSize
+	if maxFileSize == 0 {
+		maxFileSize = defaultMaxFileSize
+	}
+	if err := os.MkdirAll(config.Dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	sink := &Sink{
+		dir:         config.Dir,
+		maxFileSize: maxFileSize,
+		maxFiles:    config.MaxFiles,
+	}
+	if err := sink.openCurrentFile(); err != nil {
+		return nil, err
+	}
+	return sink, nil
+}
+
+// Publish appends the given order events to the current file, rotating it
+// first if it has grown too large. It satisfies the core.OrderEventSink
+// interface.
+func (s *Sink) Publish(ctx context.Context, orderEvents []*zeroex.OrderEvent) error {
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	for _, orderEvent := range orderEvents {
+		// Encode adds a newline after each order event.
+		if err := encoder.Encode(orderEvent); err != nil {
+			return err
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.file == nil {
+		return ErrClosed
+	}
+	if s.size > 0 && s.size+int64(buf.Len()) > s.maxFileSize {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := s.file.Write(buf.Bytes())
+	s.size += int64(n)
+	return err
+}
+
+// Close closes the current file. Publish returns ErrClosed after Close has been
+// called.
+func (s *Sink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
+
+func (s *Sink) openCurrentFile() error {
+	file, err := os.OpenFile(filepath.Join(s.dir, currentFileName), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	s.file = file
+	s.size = info.Size()
+	return nil
+}
+
+// rotate renames the current file, starts a new one, and removes the oldest
+// rotated files if there are too many. s.mu must be held.
+func (s *Sink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return err
+	}
+	s.file = nil
+	rotatedName := rotatedFilePrefix + time.Now().UTC().Format(rotatedFileTimeFormat) + rotatedFileSuffix
+	if err := os.Rename(filepath.Join(s.dir, currentFileName), filepath.Join(s.dir, rotatedName)); err != nil {
+		// Keep appending to the current file so that no order events are lost.
+		if openErr := s.openCurrentFile(); openErr != nil {
+			return openErr
+		}
+		return err
+	}
+	if err := s.openCurrentFile(); err != nil {
+		return err
+	}
+	return s.removeOldFiles()
+}
+
+func (s *Sink) removeOldFiles() error {
+	if s.maxFiles == 0 {
+		return nil
+	}
+	rotatedFiles, err := RotatedFiles(s.dir)
+	if err != nil {
+		return err
+	}
+	for len(rotatedFiles) > s.maxFiles {
+		if err := os.Remove(rotatedFiles[0]); err != nil {
+			return fmt.Errorf("filesink: could not remove old file: %s", err)
+		}
+		rotatedFiles = rotatedFiles[1:]
+	}
+	return nil
+}
+
+// RotatedFiles returns the paths of all rotated files in the given directory,
+// from oldest to newest. The current file is not included.
+func RotatedFiles(dir string) ([]string, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	rotatedFiles := []string{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() && strings.HasPrefix(name, rotatedFilePrefix) && strings.HasSuffix(name, rotatedFileSuffix) {
+			rotatedFiles = append(rotatedFiles, filepath.Join(dir, name))
+		}
+	}
+	sort.Strings(rotatedFiles)
+	return rotatedFiles, nil
+}
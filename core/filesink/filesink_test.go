@@ -0,0 +1,119 @@
+package filesink
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/0xProject/0x-mesh/zeroex"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSinkPublish(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filesink")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	sink, err := New(Config{Dir: dir})
+	require.NoError(t, err)
+	ctx := context.Background()
+	orderEvents := []*zeroex.OrderEvent{newTestOrderEvent(1), newTestOrderEvent(2)}
+	require.NoError(t, sink.Publish(ctx, orderEvents[:1]))
+	require.NoError(t, sink.Publish(ctx, orderEvents[1:]))
+	require.NoError(t, sink.Close())
+	assert.Equal(t, ErrClosed, sink.Publish(ctx, orderEvents))
+
+	assertFileContainsOrderEvents(t, filepath.Join(dir, currentFileName), orderEvents)
+
+	// Re-opening the sink should append to the existing file.
+	sink, err = New(Config{Dir: dir})
+	require.NoError(t, err)
+	moreOrderEvents := []*zeroex.OrderEvent{newTestOrderEvent(3)}
+	require.NoError(t, sink.Publish(ctx, moreOrderEvents))
+	require.NoError(t, sink.Close())
+
+	assertFileContainsOrderEvents(t, filepath.Join(dir, currentFileName), append(orderEvents, moreOrderEvents...))
+}
+
+func TestSinkRotatesFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filesink")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// Use a max file size which is small enough that every batch causes the
+	// current file to be rotated.
+	sink, err := New(Config{
+		Dir:         dir,
+		MaxFileSize: 1,
+		MaxFiles:    2,
+	})
+	require.NoError(t, err)
+	defer sink.Close()
+
+	ctx := context.Background()
+	orderEvents := []*zeroex.OrderEvent{}
+	for i := 0; i < 5; i++ {
+		orderEvent := newTestOrderEvent(int64(i))
+		orderEvents = append(orderEvents, orderEvent)
+		require.NoError(t, sink.Publish(ctx, []*zeroex.OrderEvent{orderEvent}))
+		// Make sure that each rotated file gets a distinct name.
+		time.Sleep(time.Millisecond)
+	}
+
+	// Only the latest 2 rotated files should be kept, plus the current file.
+	rotatedFiles, err := RotatedFiles(dir)
+	require.NoError(t, err)
+	require.Len(t, rotatedFiles, 2)
+	assertFileContainsOrderEvents(t, rotatedFiles[0], orderEvents[2:3])
+	assertFileContainsOrderEvents(t, rotatedFiles[1], orderEvents[3:4])
+	assertFileContainsOrderEvents(t, filepath.Join(dir, currentFileName), orderEvents[4:])
+}
+
+func assertFileContainsOrderEvents(t *testing.T, path string, expected []*zeroex.OrderEvent) {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	actual := []*zeroex.OrderEvent{}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		var orderEvent zeroex.OrderEvent
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &orderEvent))
+		actual = append(actual, &orderEvent)
+	}
+	require.NoError(t, scanner.Err())
+	require.Len(t, actual, len(expected), "wrong number of order events in %s", path)
+	for i, orderEvent := range actual {
+		assert.Equal(t, expected[i].OrderHash, orderEvent.OrderHash)
+		assert.Equal(t, expected[i].EndState, orderEvent.EndState)
+	}
+}
+
+func newTestOrderEvent(n int64) *zeroex.OrderEvent {
+	return &zeroex.OrderEvent{
+		Timestamp: time.Now().UTC(),
+		OrderHash: common.BigToHash(big.NewInt(n)),
+		SignedOrderV4: &zeroex.SignedOrderV4{
+			OrderV4: zeroex.OrderV4{
+				ChainID:             big.NewInt(1337),
+				MakerAmount:         big.NewInt(100),
+				TakerAmount:         big.NewInt(42),
+				TakerTokenFeeAmount: big.NewInt(0),
+				Expiry:              big.NewInt(time.Now().Add(time.Hour).Unix()),
+				Salt:                big.NewInt(n),
+			},
+		},
+		EndState:                 zeroex.ESOrderAdded,
+		FillableTakerAssetAmount: big.NewInt(42),
+		ContractEvents:           []*zeroex.ContractEvent{},
+	}
+}
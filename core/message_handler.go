@@ -11,15 +11,39 @@ import (
 	"github.com/0xProject/0x-mesh/zeroex"
 	"github.com/0xProject/0x-mesh/zeroex/ordervalidator"
 	"github.com/ethereum/go-ethereum/common"
+	peer "github.com/libp2p/go-libp2p-core/peer"
 	log "github.com/sirupsen/logrus"
 )
 
 // Ensure that App implements p2p.MessageHandler.
 var _ p2p.MessageHandler = &App{}
 
+// gossipBatchPeerID returns the peer that a gossiped order which was received
+// from the given peer is attributed to. Orders are only attributed to the
+// peer they were received from if the per-peer storage quota is enabled.
+// Otherwise all of the orders in a batch of messages are validated together.
+func (app *App) gossipBatchPeerID(from peer.ID) peer.ID {
+	if app.config.MaxOrdersPerPeer == 0 {
+		return ""
+	}
+	return from
+}
+
+// gossipAddOrdersOpts returns the options for adding the gossiped orders that
+// are attributed to the given peer (see gossipBatchPeerID).
+func gossipAddOrdersOpts(peerID peer.ID) *types.AddOrdersOpts {
+	if peerID == "" {
+		return &types.AddOrdersOpts{}
+	}
+	return &types.AddOrdersOpts{SourcePeerID: peerID.String()}
+}
+
 func (app *App) HandleMessages(ctx context.Context, messages []*p2p.Message) error {
 	// First we validate the messages and decode them into orders.
-	orders := []*zeroex.SignedOrder{}
+	// Orders are grouped by the peer they were received from if the per-peer
+	// storage quota is enabled (see gossipBatchPeerID).
+	peerIDs := []peer.ID{}
+	ordersByPeer := map[peer.ID][]*zeroex.SignedOrder{}
 	orderHashToMessage := map[common.Hash]*p2p.Message{}
 
 	for _, msg := range messages {
@@ -52,15 +76,26 @@ func (app *App) HandleMessages(ctx context.Context, messages []*p2p.Message) err
 		if _, alreadySeen := orderHashToMessage[orderHash]; alreadySeen {
 			continue
 		}
-		orders = append(orders, order)
+		batchPeerID := app.gossipBatchPeerID(msg.From)
+		if _, found := ordersByPeer[batchPeerID]; !found {
+			peerIDs = append(peerIDs, batchPeerID)
+		}
+		ordersByPeer[batchPeerID] = append(ordersByPeer[batchPeerID], order)
 		orderHashToMessage[orderHash] = msg
 		app.handlePeerScoreEvent(msg.From, psValidMessage)
 	}
 
-	// Next, we validate the orders.
-	validationResults, err := app.orderWatcher.ValidateAndStoreValidOrders(ctx, orders, app.chainID, false, &types.AddOrdersOpts{})
-	if err != nil {
-		return err
+	// Next, we validate the orders. The orders from each peer are validated
+	// separately so that they count towards the storage quota of that peer.
+	// Without a per-peer quota, all of the orders are in a single batch.
+	validationResults := &ordervalidator.ValidationResults{}
+	for _, peerID := range peerIDs {
+		peerValidationResults, err := app.orderWatcher.ValidateAndStoreValidOrders(ctx, ordersByPeer[peerID], app.chainID, false, gossipAddOrdersOpts(peerID))
+		if err != nil {
+			return err
+		}
+		validationResults.Accepted = append(validationResults.Accepted, peerValidationResults.Accepted...)
+		validationResults.Rejected = append(validationResults.Rejected, peerValidationResults.Rejected...)
 	}
 
 	// Store any valid orders and update the peer scores.
@@ -96,7 +131,8 @@ func (app *App) HandleMessages(ctx context.Context, messages []*p2p.Message) err
 			"from":              msg.From.String(),
 		}).Trace("not storing rejected order received from peer")
 		switch rejectedOrderInfo.Status {
-		case ordervalidator.ROInternalError, ordervalidator.ROEthRPCRequestFailed, ordervalidator.RODatabaseFullOfOrders:
+		case ordervalidator.ROInternalError, ordervalidator.ROEthRPCRequestFailed, ordervalidator.RODatabaseFullOfOrders,
+			ordervalidator.ROMakerQuotaExceeded, ordervalidator.ROPeerQuotaExceeded:
 			// Don't incur a negative score for these status types
 			// (it might not be their fault).
 		default:
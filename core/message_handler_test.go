@@ -0,0 +1,25 @@
+// +build !js
+
+package core
+
+import (
+	"testing"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	peer "github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGossipBatchPeerID(t *testing.T) {
+	from := peer.ID("peer")
+
+	// Without a per-peer quota, the orders from all peers are validated in a
+	// single batch which isn't attributed to any peer.
+	app := &App{config: Config{MaxOrdersPerPeer: 0}}
+	assert.Equal(t, peer.ID(""), app.gossipBatchPeerID(from))
+	assert.Equal(t, &types.AddOrdersOpts{}, gossipAddOrdersOpts(app.gossipBatchPeerID(from)))
+
+	app = &App{config: Config{MaxOrdersPerPeer: 10}}
+	assert.Equal(t, from, app.gossipBatchPeerID(from))
+	assert.Equal(t, &types.AddOrdersOpts{SourcePeerID: from.String()}, gossipAddOrdersOpts(app.gossipBatchPeerID(from)))
+}
@@ -4,18 +4,21 @@ import (
 	"context"
 	"encoding/json"
 
-	"github.com/0xProject/0x-mesh/common/types"
 	"github.com/0xProject/0x-mesh/metrics"
 	"github.com/0xProject/0x-mesh/p2p"
 	"github.com/0xProject/0x-mesh/zeroex"
 	"github.com/0xProject/0x-mesh/zeroex/ordervalidator"
 	"github.com/ethereum/go-ethereum/common"
+	peer "github.com/libp2p/go-libp2p-core/peer"
 	log "github.com/sirupsen/logrus"
 )
 
 func (app *App) HandleMessagesV4(ctx context.Context, messages []*p2p.Message) error {
 	// First we validate the messages and decode them into orders.
-	orders := []*zeroex.SignedOrderV4{}
+	// Orders are grouped by the peer they were received from if the per-peer
+	// storage quota is enabled (see gossipBatchPeerID).
+	peerIDs := []peer.ID{}
+	ordersByPeer := map[peer.ID][]*zeroex.SignedOrderV4{}
 	orderHashToMessage := map[common.Hash]*p2p.Message{}
 
 	for _, msg := range messages {
@@ -39,15 +42,26 @@ func (app *App) HandleMessagesV4(ctx context.Context, messages []*p2p.Message) e
 		if _, alreadySeen := orderHashToMessage[orderHash]; alreadySeen {
 			continue
 		}
-		orders = append(orders, &order)
+		batchPeerID := app.gossipBatchPeerID(msg.From)
+		if _, found := ordersByPeer[batchPeerID]; !found {
+			peerIDs = append(peerIDs, batchPeerID)
+		}
+		ordersByPeer[batchPeerID] = append(ordersByPeer[batchPeerID], &order)
 		orderHashToMessage[orderHash] = msg
 		app.handlePeerScoreEvent(msg.From, psValidMessage)
 	}
 
-	// Next, we validate the orders.
-	validationResults, err := app.orderWatcher.ValidateAndStoreValidOrdersV4(ctx, orders, app.chainID, false, &types.AddOrdersOpts{})
-	if err != nil {
-		return err
+	// Next, we validate the orders. The orders from each peer are validated
+	// separately so that they count towards the storage quota of that peer.
+	// Without a per-peer quota, all of the orders are in a single batch.
+	validationResults := &ordervalidator.ValidationResults{}
+	for _, peerID := range peerIDs {
+		peerValidationResults, err := app.orderWatcher.ValidateAndStoreValidOrdersV4(ctx, ordersByPeer[peerID], app.chainID, false, gossipAddOrdersOpts(peerID))
+		if err != nil {
+			return err
+		}
+		validationResults.Accepted = append(validationResults.Accepted, peerValidationResults.Accepted...)
+		validationResults.Rejected = append(validationResults.Rejected, peerValidationResults.Rejected...)
 	}
 
 	// Store any valid orders and update the peer scores.
@@ -83,7 +97,8 @@ func (app *App) HandleMessagesV4(ctx context.Context, messages []*p2p.Message) e
 			"from":              msg.From.String(),
 		}).Trace("not storing rejected order received from peer")
 		switch rejectedOrderInfo.Status {
-		case ordervalidator.ROInternalError, ordervalidator.ROEthRPCRequestFailed, ordervalidator.RODatabaseFullOfOrders:
+		case ordervalidator.ROInternalError, ordervalidator.ROEthRPCRequestFailed, ordervalidator.RODatabaseFullOfOrders,
+			ordervalidator.ROMakerQuotaExceeded, ordervalidator.ROPeerQuotaExceeded:
 			// Don't incur a negative score for these status types
 			// (it might not be their fault).
 		default:
@@ -9,7 +9,18 @@ import (
 	"github.com/0xProject/0x-mesh/db"
 )
 
-func newDB(ctx context.Context, config Config) (*db.DB, error) {
+// NewDB opens the database in config.DataDir which is used by the App. It can
+// be used to access the database without starting an App.
+func NewDB(ctx context.Context, config Config) (*db.DB, error) {
+	if config.DatabaseDriver == db.LevelDBDriverName {
+		return db.New(ctx, &db.Options{
+			DriverName:     db.LevelDBDriverName,
+			DataSourceName: filepath.Join(config.DataDir, "db", "leveldb"),
+			MaxOrders:      config.MaxOrdersInStorage,
+			MaxOrderEvents: config.MaxOrderEventsInStorage,
+		})
+	}
+
 	meshDatabasePath := filepath.Join(config.DataDir, "db", "db.sqlite?_journal=WAL")
 	peerStoreDatabasePath := filepath.Join(config.DataDir, "db", "peerstore.sqlite?_journal=WAL")
 	dhtDatabasePath := filepath.Join(config.DataDir, "db", "dht.sqlite?_journal=WAL")
@@ -20,5 +31,6 @@ func newDB(ctx context.Context, config Config) (*db.DB, error) {
 		DataSourcePeerStoreName: peerStoreDatabasePath,
 		DataSourceDHTName:       dhtDatabasePath,
 		MaxOrders:               config.MaxOrdersInStorage,
+		MaxOrderEvents:          config.MaxOrderEventsInStorage,
 	})
 }
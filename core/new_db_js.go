@@ -9,11 +9,14 @@ import (
 	"github.com/0xProject/0x-mesh/db"
 )
 
-func newDB(ctx context.Context, config Config) (*db.DB, error) {
+// NewDB opens the database in config.DataDir which is used by the App. It can
+// be used to access the database without starting an App.
+func NewDB(ctx context.Context, config Config) (*db.DB, error) {
 	databasePath := filepath.Join(config.DataDir, "mesh_dexie_db")
 	return db.New(ctx, &db.Options{
 		DriverName:     "dexie",
 		DataSourceName: databasePath,
 		MaxOrders:      config.MaxOrdersInStorage,
+		MaxOrderEvents: config.MaxOrderEventsInStorage,
 	})
 }
@@ -0,0 +1,59 @@
+package core
+
+import (
+	"context"
+
+	"github.com/0xProject/0x-mesh/zeroex"
+	"github.com/ethereum/go-ethereum/event"
+	log "github.com/sirupsen/logrus"
+)
+
+// orderEventSinkBufferSize is the number of batches of order events that can be
+// queued for each OrderEventSink.
+const orderEventSinkBufferSize = 100
+
+// OrderEventSink receives all order events emitted by an App. Sinks can be
+// registered via Config.OrderEventSinks, e.g. to archive order events or to
+// forward them to another system.
+//
+// Publish is called with each batch of order events in the order that they
+// were emitted, and is never called concurrently for the same sink. A sink
+// which is slow to return will eventually block the order watcher, so sinks
+// should do as little work as possible in Publish. If Publish returns an
+// error, it is logged and the events are not retried.
+type OrderEventSink interface {
+	Publish(ctx context.Context, orderEvents []*zeroex.OrderEvent) error
+}
+
+// subscribeOrderEventSink subscribes the given sink to order events. The
+// returned function publishes events to the sink until the given context is
+// canceled.
+func (app *App) subscribeOrderEventSink(sink OrderEventSink) func(ctx context.Context) {
+	orderEventsChan := make(chan []*zeroex.OrderEvent, orderEventSinkBufferSize)
+	subscription := app.orderWatcher.Subscribe(orderEventsChan)
+	return func(ctx context.Context) {
+		publishToOrderEventSink(ctx, sink, subscription, orderEventsChan)
+	}
+}
+
+func publishToOrderEventSink(ctx context.Context, sink OrderEventSink, subscription event.Subscription, orderEventsChan <-chan []*zeroex.OrderEvent) {
+	defer subscription.Unsubscribe()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case err := <-subscription.Err():
+			if err != nil {
+				log.WithError(err).Error("order event sink subscription exited with error")
+			}
+			return
+		case orderEvents := <-orderEventsChan:
+			if len(orderEvents) == 0 {
+				continue
+			}
+			if err := sink.Publish(ctx, orderEvents); err != nil {
+				log.WithError(err).WithField("numOrderEvents", len(orderEvents)).Error("could not publish order events to sink")
+			}
+		}
+	}
+}
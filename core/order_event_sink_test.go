@@ -0,0 +1,74 @@
+// +build !js
+
+package core
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/0xProject/0x-mesh/zeroex"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testOrderEventSink struct {
+	mu        sync.Mutex
+	published [][]*zeroex.OrderEvent
+	err       error
+}
+
+func (s *testOrderEventSink) Publish(ctx context.Context, orderEvents []*zeroex.OrderEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.published = append(s.published, orderEvents)
+	return s.err
+}
+
+func (s *testOrderEventSink) numPublished() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.published)
+}
+
+func TestPublishToOrderEventSink(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The sink returns an error, which should be logged without stopping
+	// subsequent events from being published.
+	sink := &testOrderEventSink{err: errors.New("sink error")}
+	feed := &event.Feed{}
+	orderEventsChan := make(chan []*zeroex.OrderEvent, orderEventSinkBufferSize)
+	subscription := feed.Subscribe(orderEventsChan)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		publishToOrderEventSink(ctx, sink, subscription, orderEventsChan)
+	}()
+
+	firstBatch := []*zeroex.OrderEvent{{OrderHash: common.HexToHash("0x1")}}
+	secondBatch := []*zeroex.OrderEvent{{OrderHash: common.HexToHash("0x2")}, {OrderHash: common.HexToHash("0x3")}}
+	feed.Send(firstBatch)
+	// Empty batches should not be published.
+	feed.Send([]*zeroex.OrderEvent{})
+	feed.Send(secondBatch)
+
+	require.Eventually(t, func() bool {
+		return sink.numPublished() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, firstBatch, sink.published[0])
+	assert.Equal(t, secondBatch, sink.published[1])
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for publishToOrderEventSink to return")
+	}
+	assert.Equal(t, 0, feed.Send(firstBatch), "expected subscription to be unsubscribed")
+}
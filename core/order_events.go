@@ -0,0 +1,51 @@
+package core
+
+import (
+	"errors"
+
+	"github.com/0xProject/0x-mesh/db"
+	"github.com/0xProject/0x-mesh/zeroex"
+)
+
+// ErrOrderEventsPruned is returned by FindOrderEventsSince when some of the
+// requested order events have already been removed from the order event log.
+// Clients that receive this error can't catch up on missed events and should
+// re-fetch the orders they are interested in instead.
+var ErrOrderEventsPruned = errors.New("some of the requested order events have been pruned from the order event log")
+
+// ErrSequenceNumberTooHigh is returned by FindOrderEventsSince when the given
+// sequence number is greater than the sequence number of the latest order
+// event in the log. This happens when the database has been reset since the
+// client received the event with that sequence number. Like
+// ErrOrderEventsPruned, clients that receive this error should re-fetch the
+// orders they are interested in.
+var ErrSequenceNumberTooHigh = errors.New("the sequence number is greater than the sequence number of the latest order event in the order event log")
+
+// FindOrderEventsSince returns up to limit order events from the order event
+// log with a sequence number greater than sequenceNumber, sorted by sequence
+// number in ascending order. If limit is 0, all such events are returned. If
+// sequenceNumber is 0, events are returned starting from the oldest event that
+// is still in the log. Otherwise ErrOrderEventsPruned is returned if the event
+// immediately following sequenceNumber is no longer in the log and
+// ErrSequenceNumberTooHigh is returned if sequenceNumber is greater than the
+// sequence number of the latest event in the log.
+func (app *App) FindOrderEventsSince(sequenceNumber uint64, limit uint) ([]*zeroex.OrderEvent, error) {
+	<-app.started
+
+	if sequenceNumber != 0 {
+		oldest, latest, err := app.db.GetOrderEventSequenceNumberRange()
+		if err != nil {
+			return nil, err
+		}
+		if sequenceNumber > latest {
+			return nil, ErrSequenceNumberTooHigh
+		}
+		if sequenceNumber+1 < oldest {
+			return nil, ErrOrderEventsPruned
+		}
+	}
+	return app.db.FindOrderEvents(&db.OrderEventQuery{
+		AfterSequenceNumber: sequenceNumber,
+		Limit:               limit,
+	})
+}
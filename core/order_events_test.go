@@ -0,0 +1,88 @@
+// +build !js
+
+package core
+
+import (
+	"context"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/0xProject/0x-mesh/db"
+	"github.com/0xProject/0x-mesh/zeroex"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestAppWithDB returns an App which has already been started and which
+// only has a database and an asset data decoder. It can be used to test
+// methods which only read from the database without connecting to an Ethereum
+// node.
+func newTestAppWithDB(t *testing.T, ctx context.Context, opts *db.Options) *App {
+	database, err := db.New(ctx, opts)
+	require.NoError(t, err)
+	started := make(chan struct{})
+	close(started)
+	return &App{
+		db:               database,
+		assetDataDecoder: zeroex.NewAssetDataDecoder(),
+		started:          started,
+	}
+}
+
+func TestFindOrderEventsSince(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	opts := db.TestOptions()
+	opts.MaxOrderEvents = 3
+	app := newTestAppWithDB(t, ctx, opts)
+
+	// The order event log is empty, e.g. because the database has been reset.
+	_, err := app.FindOrderEventsSince(5, 0)
+	assert.Equal(t, ErrSequenceNumberTooHigh, err)
+	orderEvents, err := app.FindOrderEventsSince(0, 0)
+	require.NoError(t, err)
+	assert.Empty(t, orderEvents)
+
+	// Events 1 and 2 are pruned, so the log contains events 3 to 5.
+	for i := 0; i < 5; i++ {
+		require.NoError(t, app.db.AddOrderEvents([]*zeroex.OrderEvent{newTestOrderEvent()}))
+	}
+
+	testCases := []struct {
+		sequenceNumber          uint64
+		expectedSequenceNumbers []uint64
+		expectedErr             error
+	}{
+		{sequenceNumber: 0, expectedSequenceNumbers: []uint64{3, 4, 5}},
+		{sequenceNumber: 1, expectedErr: ErrOrderEventsPruned},
+		{sequenceNumber: 2, expectedSequenceNumbers: []uint64{3, 4, 5}},
+		{sequenceNumber: 4, expectedSequenceNumbers: []uint64{5}},
+		{sequenceNumber: 5, expectedSequenceNumbers: []uint64{}},
+		{sequenceNumber: 6, expectedErr: ErrSequenceNumberTooHigh},
+	}
+	for _, testCase := range testCases {
+		orderEvents, err := app.FindOrderEventsSince(testCase.sequenceNumber, 0)
+		if testCase.expectedErr != nil {
+			assert.Equal(t, testCase.expectedErr, err, "sequence number %d", testCase.sequenceNumber)
+			continue
+		}
+		require.NoError(t, err, "sequence number %d", testCase.sequenceNumber)
+		actualSequenceNumbers := []uint64{}
+		for _, orderEvent := range orderEvents {
+			actualSequenceNumbers = append(actualSequenceNumbers, orderEvent.SequenceNumber)
+		}
+		assert.Equal(t, testCase.expectedSequenceNumbers, actualSequenceNumbers, "sequence number %d", testCase.sequenceNumber)
+	}
+}
+
+func newTestOrderEvent() *zeroex.OrderEvent {
+	return &zeroex.OrderEvent{
+		Timestamp:                time.Now().UTC(),
+		OrderHash:                common.BigToHash(big.NewInt(rand.Int63())),
+		EndState:                 zeroex.ESOrderAdded,
+		FillableTakerAssetAmount: big.NewInt(0),
+	}
+}
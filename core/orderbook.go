@@ -0,0 +1,324 @@
+package core
+
+import (
+	"errors"
+	"math/big"
+	"sort"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/db"
+	"github.com/0xProject/0x-mesh/zeroex"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrInvalidOrderbookDepth is returned by GetOrderbook when depth is not a
+// positive number.
+var ErrInvalidOrderbookDepth = errors.New("orderbook depth must be greater than zero")
+
+// orderbookEntry is the contribution of a single order to one side of the
+// orderbook.
+type orderbookEntry struct {
+	price       *big.Rat
+	baseAmount  *big.Int
+	quoteAmount *big.Int
+}
+
+// GetOrderbook returns the bids and asks for the given base/quote token pair.
+// Both v3 and v4 orders are included. v3 orders are only included if their
+// maker and taker asset data are both plain ERC20 asset data. The remaining
+// fillable amounts of all orders with the same price are aggregated into a
+// single level and at most depth levels are returned for each side. Fees are
+// not taken into account when computing prices.
+func (app *App) GetOrderbook(baseToken, quoteToken common.Address, depth int) (*types.Orderbook, error) {
+	<-app.started
+
+	if depth <= 0 {
+		return nil, ErrInvalidOrderbookDepth
+	}
+
+	// Asks are orders where the maker is selling the base token and bids are
+	// orders where the maker is selling the quote token.
+	askEntries, err := app.findOrderbookEntries(baseToken, quoteToken, false, depth)
+	if err != nil {
+		return nil, err
+	}
+	bidEntries, err := app.findOrderbookEntries(quoteToken, baseToken, true, depth)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.Orderbook{
+		BaseToken:  baseToken,
+		QuoteToken: quoteToken,
+		Bids:       aggregateOrderbookEntries(bidEntries, depth, true),
+		Asks:       aggregateOrderbookEntries(askEntries, depth, false),
+	}, nil
+}
+
+// orderbookPageSize is the number of orders which are loaded from the
+// database at a time by findOrderbookEntries.
+const orderbookPageSize = 500
+
+// findOrderbookEntries finds the fillable v3 and v4 orders which sell
+// makerToken in exchange for takerToken and converts them to orderbook
+// entries. If isBid is true, the taker token is treated as the base token.
+// Otherwise the maker token is treated as the base token.
+//
+// Orders are loaded in pages, sorted by their stored price (the taker amount
+// divided by the maker amount) in ascending order. This puts the best asks and
+// the best bids first, so loading stops as soon as the entries for the first
+// depth levels have been found.
+func (app *App) findOrderbookEntries(makerToken, takerToken common.Address, isBid bool, depth int) ([]*orderbookEntry, error) {
+	v3Entries, err := app.findOrderbookEntriesV3(makerToken, takerToken, isBid, depth)
+	if err != nil {
+		return nil, err
+	}
+	v4Entries, err := app.findOrderbookEntriesV4(makerToken, takerToken, isBid, depth)
+	if err != nil {
+		return nil, err
+	}
+	return append(v3Entries, v4Entries...), nil
+}
+
+func (app *App) findOrderbookEntriesV3(makerToken, takerToken common.Address, isBid bool, depth int) ([]*orderbookEntry, error) {
+	query := &db.OrderQuery{
+		Filters: []db.OrderFilter{
+			{
+				Field: db.OFIsRemoved,
+				Kind:  db.Equal,
+				Value: false,
+			},
+			{
+				Field: db.OFFillableTakerAssetAmount,
+				Kind:  db.Greater,
+				Value: big.NewInt(0),
+			},
+			db.MakerAssetIncludesTokenAddress(makerToken),
+			db.TakerAssetIncludesTokenAddress(takerToken),
+		},
+		Sort: []db.OrderSort{
+			{
+				Field:     db.OFPrice,
+				Direction: db.Ascending,
+			},
+			{
+				Field:     db.OFHash,
+				Direction: db.Ascending,
+			},
+		},
+		Limit: orderbookPageSize,
+	}
+	collector := &orderbookEntryCollector{depth: depth}
+	var after []interface{}
+	for {
+		var (
+			orders []*types.OrderWithMetadata
+			err    error
+		)
+		if after == nil {
+			orders, err = app.db.FindOrders(query)
+		} else {
+			orders, err = app.db.FindOrdersAfter(query, after)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, order := range orders {
+			// The asset filters also match MultiAsset and non-ERC20 asset
+			// data which include the tokens, so we need to check both sides
+			// of the order here.
+			if !app.isERC20AssetData(order.OrderV3.MakerAssetData, makerToken) ||
+				!app.isERC20AssetData(order.OrderV3.TakerAssetData, takerToken) {
+				continue
+			}
+			entry := newOrderbookEntry(order.OrderV3.MakerAssetAmount, order.OrderV3.TakerAssetAmount, order.FillableTakerAssetAmount, isBid)
+			if !collector.add(order.Price(), entry) {
+				return collector.entries, nil
+			}
+		}
+		if len(orders) < orderbookPageSize {
+			return collector.entries, nil
+		}
+		after, err = db.OrderSortValues(orders[len(orders)-1], query.Sort)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (app *App) findOrderbookEntriesV4(makerToken, takerToken common.Address, isBid bool, depth int) ([]*orderbookEntry, error) {
+	query := &db.OrderQueryV4{
+		Filters: []db.OrderFilterV4{
+			{
+				Field: db.OV4FIsRemoved,
+				Kind:  db.Equal,
+				Value: false,
+			},
+			{
+				Field: db.OV4FFillableTakerAssetAmount,
+				Kind:  db.Greater,
+				Value: big.NewInt(0),
+			},
+			{
+				Field: db.OV4FMakerToken,
+				Kind:  db.Equal,
+				Value: makerToken,
+			},
+			{
+				Field: db.OV4FTakerToken,
+				Kind:  db.Equal,
+				Value: takerToken,
+			},
+		},
+		Sort: []db.OrderSortV4{
+			{
+				Field:     db.OV4FPrice,
+				Direction: db.Ascending,
+			},
+			{
+				Field:     db.OV4FHash,
+				Direction: db.Ascending,
+			},
+		},
+		Limit: orderbookPageSize,
+	}
+	collector := &orderbookEntryCollector{depth: depth}
+	var after []interface{}
+	for {
+		var (
+			orders []*types.OrderWithMetadata
+			err    error
+		)
+		if after == nil {
+			orders, err = app.db.FindOrdersV4(query)
+		} else {
+			orders, err = app.db.FindOrdersAfterV4(query, after)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, order := range orders {
+			entry := newOrderbookEntry(order.OrderV4.MakerAmount, order.OrderV4.TakerAmount, order.FillableTakerAssetAmount, isBid)
+			if !collector.add(order.Price(), entry) {
+				return collector.entries, nil
+			}
+		}
+		if len(orders) < orderbookPageSize {
+			return collector.entries, nil
+		}
+		after, err = db.OrderSortValuesV4(orders[len(orders)-1], query.Sort)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// orderbookEntryCollector collects the orderbook entries of orders which are
+// sorted by their stored price in ascending order until the entries for depth
+// levels have been collected.
+//
+// Stored prices are float64s, so orders with distinct prices can have the same
+// stored price (see db.OFPrice). Rounding never changes the order of two
+// prices though, so once depth distinct stored prices have been seen and the
+// next order has a higher stored price, all orders that belong to the first
+// depth levels have been collected.
+type orderbookEntryCollector struct {
+	depth     int
+	entries   []*orderbookEntry
+	numPrices int
+	lastPrice float64
+}
+
+// add adds the given entry, which belongs to an order with the given stored
+// price. Entries which are nil are ignored. It returns false if the entry was
+// not added because enough entries have already been collected.
+func (c *orderbookEntryCollector) add(storedPrice float64, entry *orderbookEntry) bool {
+	if entry == nil {
+		return true
+	}
+	if c.numPrices == 0 || storedPrice != c.lastPrice {
+		if c.numPrices == c.depth {
+			return false
+		}
+		c.numPrices++
+		c.lastPrice = storedPrice
+	}
+	c.entries = append(c.entries, entry)
+	return true
+}
+
+// isERC20AssetData returns true if assetData is ERC20 asset data for the given
+// token address.
+func (app *App) isERC20AssetData(assetData []byte, tokenAddress common.Address) bool {
+	assetDataName, err := app.assetDataDecoder.GetName(assetData)
+	if err != nil || assetDataName != "ERC20Token" {
+		return false
+	}
+	var decodedAssetData zeroex.ERC20AssetData
+	if err := app.assetDataDecoder.Decode(assetData, &decodedAssetData); err != nil {
+		return false
+	}
+	return decodedAssetData.Address == tokenAddress
+}
+
+// newOrderbookEntry computes the price and remaining fillable amounts for an
+// order with the given amounts. It returns nil if the order does not have a
+// well-defined price.
+func newOrderbookEntry(makerAmount, takerAmount, fillableTakerAmount *big.Int, isBid bool) *orderbookEntry {
+	if makerAmount == nil || takerAmount == nil || fillableTakerAmount == nil ||
+		makerAmount.Sign() <= 0 || takerAmount.Sign() <= 0 {
+		return nil
+	}
+	// fillableMakerAmount = fillableTakerAmount * makerAmount / takerAmount
+	fillableMakerAmount := new(big.Int).Mul(fillableTakerAmount, makerAmount)
+	fillableMakerAmount.Div(fillableMakerAmount, takerAmount)
+	if isBid {
+		// The maker is selling the quote token and buying the base token.
+		return &orderbookEntry{
+			price:       new(big.Rat).SetFrac(makerAmount, takerAmount),
+			baseAmount:  new(big.Int).Set(fillableTakerAmount),
+			quoteAmount: fillableMakerAmount,
+		}
+	}
+	// The maker is selling the base token and buying the quote token.
+	return &orderbookEntry{
+		price:       new(big.Rat).SetFrac(takerAmount, makerAmount),
+		baseAmount:  fillableMakerAmount,
+		quoteAmount: new(big.Int).Set(fillableTakerAmount),
+	}
+}
+
+// aggregateOrderbookEntries groups entries with the same price into a single
+// level, sorts the levels by price and returns at most depth levels. Levels
+// are sorted in descending order if descending is true and in ascending order
+// otherwise.
+func aggregateOrderbookEntries(entries []*orderbookEntry, depth int, descending bool) []*types.OrderbookLevel {
+	levelsByPrice := map[string]*types.OrderbookLevel{}
+	levels := []*types.OrderbookLevel{}
+	for _, entry := range entries {
+		key := entry.price.RatString()
+		level, found := levelsByPrice[key]
+		if !found {
+			level = &types.OrderbookLevel{
+				Price:       entry.price,
+				BaseAmount:  big.NewInt(0),
+				QuoteAmount: big.NewInt(0),
+			}
+			levelsByPrice[key] = level
+			levels = append(levels, level)
+		}
+		level.BaseAmount.Add(level.BaseAmount, entry.baseAmount)
+		level.QuoteAmount.Add(level.QuoteAmount, entry.quoteAmount)
+		level.OrderCount++
+	}
+	sort.Slice(levels, func(i, j int) bool {
+		if descending {
+			return levels[i].Price.Cmp(levels[j].Price) > 0
+		}
+		return levels[i].Price.Cmp(levels[j].Price) < 0
+	})
+	if len(levels) > depth {
+		levels = levels[:depth]
+	}
+	return levels
+}
@@ -0,0 +1,208 @@
+// +build !js
+
+package core
+
+import (
+	"context"
+	"math/big"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/constants"
+	"github.com/0xProject/0x-mesh/db"
+	"github.com/0xProject/0x-mesh/zeroex"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOrderbookEntry(t *testing.T) {
+	// An ask selling 100 base for 200 quote with 50 quote still fillable.
+	ask := newOrderbookEntry(big.NewInt(100), big.NewInt(200), big.NewInt(50), false)
+	require.NotNil(t, ask)
+	assert.Equal(t, "2", ask.price.RatString())
+	assert.Equal(t, big.NewInt(25), ask.baseAmount)
+	assert.Equal(t, big.NewInt(50), ask.quoteAmount)
+
+	// A bid selling 300 quote for 100 base with 40 base still fillable.
+	bid := newOrderbookEntry(big.NewInt(300), big.NewInt(100), big.NewInt(40), true)
+	require.NotNil(t, bid)
+	assert.Equal(t, "3", bid.price.RatString())
+	assert.Equal(t, big.NewInt(40), bid.baseAmount)
+	assert.Equal(t, big.NewInt(120), bid.quoteAmount)
+
+	assert.Nil(t, newOrderbookEntry(big.NewInt(0), big.NewInt(100), big.NewInt(1), false))
+	assert.Nil(t, newOrderbookEntry(big.NewInt(100), big.NewInt(0), big.NewInt(1), true))
+}
+
+func TestAggregateOrderbookEntries(t *testing.T) {
+	entries := []*orderbookEntry{
+		newOrderbookEntry(big.NewInt(100), big.NewInt(200), big.NewInt(200), false),
+		newOrderbookEntry(big.NewInt(100), big.NewInt(100), big.NewInt(100), false),
+		newOrderbookEntry(big.NewInt(50), big.NewInt(100), big.NewInt(100), false),
+		newOrderbookEntry(big.NewInt(100), big.NewInt(300), big.NewInt(300), false),
+	}
+
+	asks := aggregateOrderbookEntries(entries, 10, false)
+	require.Len(t, asks, 3)
+	assert.Equal(t, "1", asks[0].Price.RatString())
+	assert.Equal(t, 1, asks[0].OrderCount)
+	assert.Equal(t, "2", asks[1].Price.RatString())
+	assert.Equal(t, 2, asks[1].OrderCount)
+	assert.Equal(t, big.NewInt(150), asks[1].BaseAmount)
+	assert.Equal(t, big.NewInt(300), asks[1].QuoteAmount)
+	assert.Equal(t, "3", asks[2].Price.RatString())
+
+	descending := aggregateOrderbookEntries(entries, 2, true)
+	require.Len(t, descending, 2)
+	assert.Equal(t, "3", descending[0].Price.RatString())
+	assert.Equal(t, "2", descending[1].Price.RatString())
+
+	assert.Empty(t, aggregateOrderbookEntries(nil, 10, false))
+}
+
+var (
+	testBaseToken  = common.HexToAddress("0x871dd7c2b4b25e1aa18728e9d5f2af4c4e431f5c")
+	testQuoteToken = common.HexToAddress("0x0b1ba0af832d7c05fd64161e0db78e85978e8082")
+	testOtherToken = common.HexToAddress("0x1dc4c1cefef38a777b15aa20260a54e584b16c48")
+)
+
+// testAssetDataABI is used to encode ERC1155 and MultiAsset asset data.
+const testAssetDataABI = `[
+	{"name": "ERC1155Assets", "type": "function", "inputs": [{"name": "address", "type": "address"}, {"name": "ids", "type": "uint256[]"}, {"name": "values", "type": "uint256[]"}, {"name": "callbackData", "type": "bytes"}]},
+	{"name": "MultiAsset", "type": "function", "inputs": [{"name": "amounts", "type": "uint256[]"}, {"name": "nestedAssetData", "type": "bytes[]"}]}
+]`
+
+func TestGetOrderbook(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	app := newTestAppWithDB(t, ctx, db.TestOptions())
+
+	assetDataABI, err := abi.JSON(strings.NewReader(testAssetDataABI))
+	require.NoError(t, err)
+	baseAssetData := erc20AssetData(testBaseToken)
+	quoteAssetData := erc20AssetData(testQuoteToken)
+	erc1155AssetData, err := assetDataABI.Pack("ERC1155Assets", testBaseToken, []*big.Int{big.NewInt(1)}, []*big.Int{big.NewInt(1)}, []byte{})
+	require.NoError(t, err)
+	multiAssetData, err := assetDataABI.Pack("MultiAsset", []*big.Int{big.NewInt(1), big.NewInt(1)}, [][]byte{baseAssetData, erc20AssetData(testOtherToken)})
+	require.NoError(t, err)
+	base := []*types.SingleAssetData{{Address: testBaseToken}}
+	quote := []*types.SingleAssetData{{Address: testQuoteToken}}
+
+	removedOrder := newTestOrderbookOrderV4(testBaseToken, testQuoteToken, 100, 50, 50)
+	removedOrder.IsRemoved = true
+	_, _, _, err = app.db.AddOrders([]*types.OrderWithMetadata{
+		// Asks at prices 2, 2 and 3.
+		newTestOrderbookOrder(baseAssetData, quoteAssetData, base, quote, 100, 200, 200),
+		newTestOrderbookOrder(baseAssetData, quoteAssetData, base, quote, 50, 100, 50),
+		newTestOrderbookOrder(baseAssetData, quoteAssetData, base, quote, 100, 300, 300),
+		// A bid at price 3.
+		newTestOrderbookOrder(quoteAssetData, baseAssetData, quote, base, 300, 100, 100),
+		// Orders for the same tokens with ERC1155 or MultiAsset asset data
+		// are excluded, even though their prices would be the best asks.
+		newTestOrderbookOrder(erc1155AssetData, quoteAssetData, []*types.SingleAssetData{{Address: testBaseToken, TokenID: big.NewInt(1)}}, quote, 100, 100, 100),
+		newTestOrderbookOrder(multiAssetData, quoteAssetData, []*types.SingleAssetData{{Address: testBaseToken}, {Address: testOtherToken}}, quote, 100, 100, 100),
+		// Orders for other tokens and unfillable orders are excluded.
+		newTestOrderbookOrder(baseAssetData, erc20AssetData(testOtherToken), base, []*types.SingleAssetData{{Address: testOtherToken}}, 100, 100, 100),
+		newTestOrderbookOrder(baseAssetData, quoteAssetData, base, quote, 100, 50, 0),
+	})
+	require.NoError(t, err)
+	_, _, _, err = app.db.AddOrdersV4([]*types.OrderWithMetadata{
+		// An ask at price 1 and a bid at price 2.
+		newTestOrderbookOrderV4(testBaseToken, testQuoteToken, 100, 100, 100),
+		newTestOrderbookOrderV4(testQuoteToken, testBaseToken, 200, 100, 50),
+		newTestOrderbookOrderV4(testBaseToken, testOtherToken, 100, 50, 50),
+		removedOrder,
+	})
+	require.NoError(t, err)
+
+	orderbook, err := app.GetOrderbook(testBaseToken, testQuoteToken, 10)
+	require.NoError(t, err)
+	assert.Equal(t, testBaseToken, orderbook.BaseToken)
+	assert.Equal(t, testQuoteToken, orderbook.QuoteToken)
+	assertOrderbookLevels(t, [][4]int64{
+		// price, base amount, quote amount, order count
+		{1, 100, 100, 1},
+		{2, 125, 250, 2},
+		{3, 100, 300, 1},
+	}, orderbook.Asks)
+	assertOrderbookLevels(t, [][4]int64{
+		{3, 100, 300, 1},
+		{2, 50, 100, 1},
+	}, orderbook.Bids)
+
+	orderbook, err = app.GetOrderbook(testBaseToken, testQuoteToken, 2)
+	require.NoError(t, err)
+	assertOrderbookLevels(t, [][4]int64{
+		{1, 100, 100, 1},
+		{2, 125, 250, 2},
+	}, orderbook.Asks)
+	assert.Len(t, orderbook.Bids, 2)
+
+	_, err = app.GetOrderbook(testBaseToken, testQuoteToken, 0)
+	assert.Equal(t, ErrInvalidOrderbookDepth, err)
+}
+
+func assertOrderbookLevels(t *testing.T, expected [][4]int64, actual []*types.OrderbookLevel) {
+	require.Len(t, actual, len(expected))
+	for i, level := range actual {
+		assert.Equal(t, big.NewRat(expected[i][0], 1).RatString(), level.Price.RatString(), "level %d", i)
+		assert.Equal(t, big.NewInt(expected[i][1]), level.BaseAmount, "level %d", i)
+		assert.Equal(t, big.NewInt(expected[i][2]), level.QuoteAmount, "level %d", i)
+		assert.Equal(t, int(expected[i][3]), level.OrderCount, "level %d", i)
+	}
+}
+
+func erc20AssetData(tokenAddress common.Address) []byte {
+	return common.Hex2Bytes(zeroex.ERC20AssetDataID + common.Bytes2Hex(common.LeftPadBytes(tokenAddress.Bytes(), 32)))
+}
+
+func newTestOrderbookOrder(makerAssetData, takerAssetData []byte, parsedMakerAssetData, parsedTakerAssetData []*types.SingleAssetData, makerAmount, takerAmount, fillableTakerAmount int64) *types.OrderWithMetadata {
+	return &types.OrderWithMetadata{
+		Hash: common.BigToHash(big.NewInt(rand.Int63())),
+		OrderV3: &zeroex.Order{
+			ChainID:               big.NewInt(constants.TestChainID),
+			MakerAddress:          constants.GanacheAccount1,
+			MakerAssetData:        makerAssetData,
+			MakerFeeAssetData:     constants.NullBytes,
+			TakerAssetData:        takerAssetData,
+			TakerFeeAssetData:     constants.NullBytes,
+			Salt:                  big.NewInt(rand.Int63()),
+			MakerFee:              big.NewInt(0),
+			TakerFee:              big.NewInt(0),
+			MakerAssetAmount:      big.NewInt(makerAmount),
+			TakerAssetAmount:      big.NewInt(takerAmount),
+			ExpirationTimeSeconds: big.NewInt(time.Now().Add(24 * time.Hour).Unix()),
+		},
+		Signature:                []byte{1, 2, 3},
+		LastUpdated:              time.Now(),
+		FillableTakerAssetAmount: big.NewInt(fillableTakerAmount),
+		ParsedMakerAssetData:     parsedMakerAssetData,
+		ParsedTakerAssetData:     parsedTakerAssetData,
+		LastValidatedBlockNumber: big.NewInt(1),
+	}
+}
+
+func newTestOrderbookOrderV4(makerToken, takerToken common.Address, makerAmount, takerAmount, fillableTakerAmount int64) *types.OrderWithMetadata {
+	return &types.OrderWithMetadata{
+		Hash: common.BigToHash(big.NewInt(rand.Int63())),
+		OrderV4: &zeroex.OrderV4{
+			ChainID:             big.NewInt(constants.TestChainID),
+			MakerToken:          makerToken,
+			TakerToken:          takerToken,
+			MakerAmount:         big.NewInt(makerAmount),
+			TakerAmount:         big.NewInt(takerAmount),
+			TakerTokenFeeAmount: big.NewInt(0),
+			Maker:               constants.GanacheAccount1,
+			Salt:                big.NewInt(rand.Int63()),
+			Expiry:              big.NewInt(time.Now().Add(24 * time.Hour).Unix()),
+		},
+		LastUpdated:              time.Now(),
+		FillableTakerAssetAmount: big.NewInt(fillableTakerAmount),
+		LastValidatedBlockNumber: big.NewInt(1),
+	}
+}
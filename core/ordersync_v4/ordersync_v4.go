@@ -437,7 +437,7 @@ func (s *Service) handleRequest(request *Request, requesterID peer.ID) *Response
 
 // Returns the next request if any, or nil, the number of received orders or err.
 func (s *Service) handleOrderSyncResponse(res *Response, peer peer.ID) (*Request, int, error) {
-	validationResults, err := s.app.OrderWatcher().ValidateAndStoreValidOrdersV4(s.ctx, res.Orders, s.app.ChainID(), false, &types.AddOrdersOpts{})
+	validationResults, err := s.app.OrderWatcher().ValidateAndStoreValidOrdersV4(s.ctx, res.Orders, s.app.ChainID(), false, &types.AddOrdersOpts{SourcePeerID: peer.String()})
 	if err != nil {
 		return nil, len(res.Orders), err
 	}
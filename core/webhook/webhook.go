@@ -0,0 +1,386 @@
+// Package webhook contains a service which delivers order events to HTTP
+// endpoints. Order events are written to an outbox in the database before they
+// are delivered, so that pending deliveries survive restarts. Deliveries which
+// fail are retried with exponential backoff. The number of pending deliveries
+// for each endpoint is capped so that an endpoint which is down for a long time
+// can't fill up the database.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/db"
+	"github.com/0xProject/0x-mesh/zeroex"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/jpillora/backoff"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// SignatureHeader is the HTTP header which contains the HMAC-SHA256
+	// signature of the request body, encoded as "sha256=<hex>".
+	SignatureHeader = "X-Mesh-Signature"
+	// DeliveryIDHeader is the HTTP header which contains the ID of the
+	// delivery. If a delivery is retried, the ID stays the same, so it can be
+	// used by receivers to detect duplicates.
+	DeliveryIDHeader = "X-Mesh-Delivery-ID"
+
+	// maxEventsPerDelivery is the maximum number of order events in a single
+	// payload. Larger batches of order events are split into multiple
+	// deliveries.
+	maxEventsPerDelivery = 500
+	// maxDeliveriesPerCheck is the maximum number of pending deliveries that are
+	// loaded for each endpoint at a time.
+	maxDeliveriesPerCheck = 100
+	// deliveryCheckInterval is how often the outbox is checked for deliveries
+	// that are due to be retried.
+	deliveryCheckInterval = 1 * time.Second
+	defaultMaxAttempts    = 20
+	// defaultMaxPendingDeliveries is the default maximum number of pending
+	// deliveries for each endpoint. With the default of 500 order events per
+	// delivery, this is at least 5 million order events.
+	defaultMaxPendingDeliveries = 10000
+	defaultRequestTimeout       = 10 * time.Second
+	minRetryDelay               = 1 * time.Second
+	maxRetryDelay               = 10 * time.Minute
+	// orderEventsBufferSize is the size of the channel which order events are
+	// received on.
+	orderEventsBufferSize = 100
+)
+
+// OrderEventSubscriber is the source of order events which are delivered to the
+// webhook endpoints. It is typically an *orderwatch.Watcher.
+type OrderEventSubscriber interface {
+	Subscribe(sink chan<- []*zeroex.OrderEvent) event.Subscription
+}
+
+// Config is the configuration for a webhook Service.
+type Config struct {
+	DB           *db.DB
+	OrderWatcher OrderEventSubscriber
+	// URLs are the endpoints which every order event is delivered to.
+	URLs []string
+	// Secret is the key used to sign each payload.
+	Secret string
+	// MaxAttempts is the number of times a delivery is attempted before it is
+	// dropped. Defaults to 20.
+	MaxAttempts int
+	// MaxPendingDeliveries is the maximum number of deliveries for a single
+	// endpoint that are kept in the outbox. New deliveries for an endpoint
+	// whose outbox is full are dropped. Defaults to 10000.
+	MaxPendingDeliveries int
+	// HTTPClient is the client used to make requests. Defaults to a client with
+	// a 10 second timeout.
+	HTTPClient *http.Client
+}
+
+// Payload is the body of each request sent to a webhook endpoint.
+type Payload struct {
+	OrderEvents []*zeroex.OrderEvent `json:"orderEvents"`
+}
+
+// Service delivers order events to webhook endpoints.
+type Service struct {
+	db           *db.DB
+	orderWatcher OrderEventSubscriber
+	urls         []string
+	secret       []byte
+	maxAttempts  int
+	maxPending   int
+	httpClient   *http.Client
+	backoff      *backoff.Backoff
+	// newDeliveries is used to wake up the delivery loop when new deliveries
+	// have been added to the outbox.
+	newDeliveries chan struct{}
+}
+
+// New creates a new webhook Service with the given config.
+func New(config Config) (*Service, error) {
+	if config.DB == nil {
+		return nil, errors.New("webhook: DB is required")
+	}
+	if config.OrderWatcher == nil {
+		return nil, errors.New("webhook: OrderWatcher is required")
+	}
+	if len(config.URLs) == 0 {
+		return nil, errors.New("webhook: at least one URL is required")
+	}
+	for _, rawURL := range config.URLs {
+		parsed, err := url.Parse(rawURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return nil, fmt.Errorf("webhook: invalid URL: %q", rawURL)
+		}
+	}
+	if config.Secret == "" {
+		return nil, errors.New("webhook: Secret is required")
+	}
+	maxAttempts := config.MaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = defaultMaxAttempts
+	}
+	maxPending := config.MaxPendingDeliveries
+	if maxPending == 0 {
+		maxPending = defaultMaxPendingDeliveries
+	}
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultRequestTimeout}
+	}
+	return &Service{
+		db:           config.DB,
+		orderWatcher: config.OrderWatcher,
+		urls:         config.URLs,
+		secret:       []byte(config.Secret),
+		maxAttempts:  maxAttempts,
+		maxPending:   maxPending,
+		httpClient:   httpClient,
+		backoff: &backoff.Backoff{
+			Min:    minRetryDelay,
+			Max:    maxRetryDelay,
+			Factor: 2,
+		},
+		newDeliveries: make(chan struct{}, 1),
+	}, nil
+}
+
+// Subscribe subscribes to order events. It should be called before the order
+// watcher is started so that no order events are missed. The returned
+// subscription and channel are passed to Run.
+func (s *Service) Subscribe() (event.Subscription, <-chan []*zeroex.OrderEvent) {
+	orderEventsChan := make(chan []*zeroex.OrderEvent, orderEventsBufferSize)
+	return s.orderWatcher.Subscribe(orderEventsChan), orderEventsChan
+}
+
+// Run delivers the order events received from the given subscription (see
+// Subscribe) to the webhook endpoints until the given context is canceled or
+// the subscription fails. Any deliveries which are still in the outbox from a
+// previous run are delivered first, except for deliveries to endpoints which
+// are no longer configured. Those are deleted.
+func (s *Service) Run(ctx context.Context, subscription event.Subscription, orderEventsChan <-chan []*zeroex.OrderEvent) error {
+	defer subscription.Unsubscribe()
+	numDeleted, err := s.db.DeleteWebhookDeliveriesExcept(s.urls)
+	if err != nil {
+		log.WithError(err).Error("could not delete webhook deliveries for removed endpoints")
+	} else if numDeleted > 0 {
+		log.WithField("numDeliveries", numDeleted).Info("deleted webhook deliveries for endpoints which are no longer configured")
+	}
+
+	// The delivery loop is stopped with this context if the subscription
+	// fails.
+	innerCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	deliverLoopDone := make(chan struct{})
+	go func() {
+		defer close(deliverLoopDone)
+		s.deliverLoop(innerCtx)
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			<-deliverLoopDone
+			return nil
+		case err := <-subscription.Err():
+			cancel()
+			<-deliverLoopDone
+			return err
+		case orderEvents := <-orderEventsChan:
+			if err := s.enqueue(orderEvents); err != nil {
+				// Failing to deliver order events is not a reason to shut
+				// down Mesh.
+				log.WithError(err).WithField("numOrderEvents", len(orderEvents)).Error("could not add order events to the webhook outbox")
+				continue
+			}
+			select {
+			case s.newDeliveries <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// enqueue adds deliveries for the given order events to the outbox. Deliveries
+// for endpoints which already have the maximum number of pending deliveries
+// are dropped.
+func (s *Service) enqueue(orderEvents []*zeroex.OrderEvent) error {
+	if len(orderEvents) == 0 {
+		return nil
+	}
+	payloads := [][]byte{}
+	for start := 0; start < len(orderEvents); start += maxEventsPerDelivery {
+		end := start + maxEventsPerDelivery
+		if end > len(orderEvents) {
+			end = len(orderEvents)
+		}
+		payload, err := json.Marshal(Payload{OrderEvents: orderEvents[start:end]})
+		if err != nil {
+			return err
+		}
+		payloads = append(payloads, payload)
+	}
+	now := time.Now().UTC()
+	deliveries := []*types.WebhookDelivery{}
+	for _, endpoint := range s.urls {
+		numPending, err := s.db.CountWebhookDeliveries(&db.WebhookDeliveryQuery{URL: endpoint})
+		if err != nil {
+			return err
+		}
+		numAllowed := s.maxPending - numPending
+		if numAllowed < 0 {
+			numAllowed = 0
+		}
+		if numAllowed < len(payloads) {
+			log.WithFields(log.Fields{
+				"url":            endpoint,
+				"numPending":     numPending,
+				"numDropped":     len(payloads) - numAllowed,
+				"numOrderEvents": len(orderEvents),
+			}).Error("dropping webhook deliveries because the outbox for the endpoint is full")
+		}
+		for i := 0; i < len(payloads) && i < numAllowed; i++ {
+			deliveries = append(deliveries, &types.WebhookDelivery{
+				URL:           endpoint,
+				Payload:       payloads[i],
+				NextAttemptAt: now,
+				CreatedAt:     now,
+			})
+		}
+	}
+	return s.db.AddWebhookDeliveries(deliveries)
+}
+
+func (s *Service) deliverLoop(ctx context.Context) {
+	ticker := time.NewTicker(deliveryCheckInterval)
+	defer ticker.Stop()
+	for {
+		for _, endpoint := range s.urls {
+			if err := s.deliverPending(ctx, endpoint); err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				log.WithError(err).WithField("url", endpoint).Error("could not process pending webhook deliveries")
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-s.newDeliveries:
+		}
+	}
+}
+
+// deliverPending attempts all deliveries for the given endpoint which are due,
+// in the order that they were added. Deliveries to the same endpoint are never
+// reordered, so if a delivery fails or is not due yet, none of the subsequent
+// deliveries are attempted until it has succeeded or has been dropped.
+func (s *Service) deliverPending(ctx context.Context, endpoint string) error {
+	for {
+		deliveries, err := s.db.FindWebhookDeliveries(&db.WebhookDeliveryQuery{
+			URL:   endpoint,
+			Limit: maxDeliveriesPerCheck,
+		})
+		if err != nil {
+			return err
+		}
+		if len(deliveries) == 0 {
+			return nil
+		}
+		for _, delivery := range deliveries {
+			if ctx.Err() != nil {
+				return nil
+			}
+			if time.Now().Before(delivery.NextAttemptAt) {
+				return nil
+			}
+			delivered, err := s.attempt(ctx, delivery)
+			if err != nil {
+				return err
+			}
+			if !delivered {
+				return nil
+			}
+		}
+	}
+}
+
+// attempt sends the given delivery and updates the outbox accordingly. It
+// returns true if the delivery was removed from the outbox, either because it
+// succeeded or because it has been attempted too many times.
+func (s *Service) attempt(ctx context.Context, delivery *types.WebhookDelivery) (bool, error) {
+	logger := log.WithFields(log.Fields{
+		"url":        delivery.URL,
+		"deliveryID": delivery.ID,
+		"attempt":    delivery.Attempts + 1,
+	})
+	sendErr := s.send(ctx, delivery)
+	if sendErr == nil {
+		logger.Trace("delivered order events to webhook")
+		if err := s.db.DeleteWebhookDelivery(delivery.ID); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	if ctx.Err() != nil {
+		// Don't count attempts that were interrupted because Mesh is shutting
+		// down.
+		return false, nil
+	}
+
+	delivery.Attempts++
+	if delivery.Attempts >= s.maxAttempts {
+		logger.WithError(sendErr).Error("dropping webhook delivery after too many failed attempts")
+		if err := s.db.DeleteWebhookDelivery(delivery.ID); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	retryDelay := s.backoff.ForAttempt(float64(delivery.Attempts - 1))
+	logger.WithError(sendErr).WithField("retryDelay", retryDelay.String()).Warn("could not deliver order events to webhook")
+	delivery.NextAttemptAt = time.Now().UTC().Add(retryDelay)
+	if err := s.db.UpdateWebhookDelivery(delivery); err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+func (s *Service) send(ctx context.Context, delivery *types.WebhookDelivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(s.secret, delivery.Payload))
+	req.Header.Set(DeliveryIDHeader, strconv.FormatUint(delivery.ID, 10))
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Sign returns the value of the SignatureHeader for the given payload.
+// Receivers can verify a request by computing the signature of the request
+// body with the shared secret and comparing it to the header using
+// hmac.Equal.
+func Sign(secret []byte, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,343 @@
+// +build !js
+
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/constants"
+	"github.com/0xProject/0x-mesh/db"
+	"github.com/0xProject/0x-mesh/zeroex"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "mesh-webhook-test-secret"
+
+type fakeOrderWatcher struct {
+	feed event.Feed
+}
+
+func (w *fakeOrderWatcher) Subscribe(sink chan<- []*zeroex.OrderEvent) event.Subscription {
+	return w.feed.Subscribe(sink)
+}
+
+// testReceiver is a webhook endpoint which fails the first numFailures
+// requests and records the payloads of all successful requests.
+type testReceiver struct {
+	t           *testing.T
+	mu          sync.Mutex
+	numFailures int
+	numRequests int
+	payloads    []*Payload
+}
+
+func (r *testReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, err := ioutil.ReadAll(req.Body)
+	require.NoError(r.t, err)
+	assert.True(r.t, hmac.Equal([]byte(Sign([]byte(testSecret), body)), []byte(req.Header.Get(SignatureHeader))), "invalid signature")
+	assert.NotEmpty(r.t, req.Header.Get(DeliveryIDHeader))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.numRequests++
+	if r.numRequests <= r.numFailures {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	var payload Payload
+	require.NoError(r.t, json.Unmarshal(body, &payload))
+	r.payloads = append(r.payloads, &payload)
+}
+
+func (r *testReceiver) receivedPayloads() []*Payload {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.payloads
+}
+
+func TestServiceRetriesFailedDeliveries(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	database, err := db.New(ctx, db.TestOptions())
+	require.NoError(t, err)
+
+	receiver := &testReceiver{t: t, numFailures: 1}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	orderWatcher := &fakeOrderWatcher{}
+	service, err := New(Config{
+		DB:           database,
+		OrderWatcher: orderWatcher,
+		URLs:         []string{server.URL},
+		Secret:       testSecret,
+	})
+	require.NoError(t, err)
+
+	// Order events which are sent after subscribing but before the service is
+	// running should not be missed.
+	subscription, orderEventsChan := service.Subscribe()
+	orderEvents := []*zeroex.OrderEvent{newTestOrderEvent(), newTestOrderEvent()}
+	orderWatcher.feed.Send(orderEvents)
+	serviceErrChan := make(chan error, 1)
+	go func() {
+		serviceErrChan <- service.Run(ctx, subscription, orderEventsChan)
+	}()
+
+	// The first attempt fails, so the delivery is only received after it has
+	// been retried.
+	require.Eventually(t, func() bool {
+		return len(receiver.receivedPayloads()) == 1
+	}, 5*time.Second, 50*time.Millisecond)
+	payload := receiver.receivedPayloads()[0]
+	require.Len(t, payload.OrderEvents, len(orderEvents))
+	for i, orderEvent := range payload.OrderEvents {
+		assert.Equal(t, orderEvents[i].OrderHash, orderEvent.OrderHash)
+		assert.Equal(t, orderEvents[i].EndState, orderEvent.EndState)
+	}
+
+	// The outbox should be empty once the delivery has succeeded.
+	require.Eventually(t, func() bool {
+		deliveries, err := database.FindWebhookDeliveries(nil)
+		require.NoError(t, err)
+		return len(deliveries) == 0
+	}, 5*time.Second, 50*time.Millisecond)
+
+	cancel()
+	select {
+	case err := <-serviceErrChan:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for webhook service to exit")
+	}
+}
+
+func TestServiceDeliversPendingDeliveriesOnStart(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	database, err := db.New(ctx, db.TestOptions())
+	require.NoError(t, err)
+
+	receiver := &testReceiver{t: t}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	// Simulate a delivery which was left in the outbox by a previous run.
+	payload, err := json.Marshal(Payload{OrderEvents: []*zeroex.OrderEvent{newTestOrderEvent()}})
+	require.NoError(t, err)
+	now := time.Now().UTC()
+	require.NoError(t, database.AddWebhookDeliveries([]*types.WebhookDelivery{
+		{
+			URL:           server.URL,
+			Payload:       payload,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+		},
+	}))
+
+	service, err := New(Config{
+		DB:           database,
+		OrderWatcher: &fakeOrderWatcher{},
+		URLs:         []string{server.URL},
+		Secret:       testSecret,
+	})
+	require.NoError(t, err)
+	subscription, orderEventsChan := service.Subscribe()
+	go func() {
+		_ = service.Run(ctx, subscription, orderEventsChan)
+	}()
+
+	require.Eventually(t, func() bool {
+		return len(receiver.receivedPayloads()) == 1
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestServiceDeletesDeliveriesForRemovedEndpoints(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	database, err := db.New(ctx, db.TestOptions())
+	require.NoError(t, err)
+
+	receiver := &testReceiver{t: t}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	// Simulate a delivery which was left in the outbox by a previous run for
+	// an endpoint which has since been removed from the config.
+	removedURL := "https://example.com/removed"
+	payload, err := json.Marshal(Payload{OrderEvents: []*zeroex.OrderEvent{newTestOrderEvent()}})
+	require.NoError(t, err)
+	now := time.Now().UTC()
+	require.NoError(t, database.AddWebhookDeliveries([]*types.WebhookDelivery{
+		{
+			URL:           removedURL,
+			Payload:       payload,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+		},
+	}))
+
+	service, err := New(Config{
+		DB:           database,
+		OrderWatcher: &fakeOrderWatcher{},
+		URLs:         []string{server.URL},
+		Secret:       testSecret,
+	})
+	require.NoError(t, err)
+	subscription, orderEventsChan := service.Subscribe()
+	go func() {
+		_ = service.Run(ctx, subscription, orderEventsChan)
+	}()
+
+	require.Eventually(t, func() bool {
+		numDeliveries, err := database.CountWebhookDeliveries(&db.WebhookDeliveryQuery{URL: removedURL})
+		require.NoError(t, err)
+		return numDeliveries == 0
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestServiceStopsWhenSubscriptionFails(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	database, err := db.New(ctx, db.TestOptions())
+	require.NoError(t, err)
+
+	receiver := &testReceiver{t: t}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	service, err := New(Config{
+		DB:           database,
+		OrderWatcher: &fakeOrderWatcher{},
+		URLs:         []string{server.URL},
+		Secret:       testSecret,
+	})
+	require.NoError(t, err)
+	subscriptionErr := errors.New("subscription failed")
+	failSubscription := make(chan struct{})
+	subscription := event.NewSubscription(func(quit <-chan struct{}) error {
+		<-failSubscription
+		return subscriptionErr
+	})
+	serviceErrChan := make(chan error, 1)
+	go func() {
+		serviceErrChan <- service.Run(ctx, subscription, make(chan []*zeroex.OrderEvent))
+	}()
+	close(failSubscription)
+	select {
+	case err := <-serviceErrChan:
+		assert.Equal(t, subscriptionErr, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for webhook service to exit")
+	}
+
+	// Deliveries should no longer be attempted once Run has returned.
+	payload, err := json.Marshal(Payload{OrderEvents: []*zeroex.OrderEvent{newTestOrderEvent()}})
+	require.NoError(t, err)
+	now := time.Now().UTC()
+	require.NoError(t, database.AddWebhookDeliveries([]*types.WebhookDelivery{
+		{
+			URL:           server.URL,
+			Payload:       payload,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+		},
+	}))
+	time.Sleep(2 * deliveryCheckInterval)
+	assert.Empty(t, receiver.receivedPayloads())
+}
+
+func TestEnqueueDropsDeliveriesWhenOutboxIsFull(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	database, err := db.New(ctx, db.TestOptions())
+	require.NoError(t, err)
+
+	urls := []string{"https://example.com/a", "https://example.com/b"}
+	service, err := New(Config{
+		DB:                   database,
+		OrderWatcher:         &fakeOrderWatcher{},
+		URLs:                 urls,
+		Secret:               testSecret,
+		MaxPendingDeliveries: 3,
+	})
+	require.NoError(t, err)
+
+	// The service isn't running, so none of the deliveries are attempted and
+	// the outbox for each endpoint fills up.
+	for i := 0; i < 5; i++ {
+		require.NoError(t, service.enqueue([]*zeroex.OrderEvent{newTestOrderEvent()}))
+	}
+	for _, url := range urls {
+		numDeliveries, err := database.CountWebhookDeliveries(&db.WebhookDeliveryQuery{URL: url})
+		require.NoError(t, err)
+		assert.Equal(t, 3, numDeliveries, "wrong number of pending deliveries for %s", url)
+	}
+}
+
+func TestNewValidatesConfig(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	database, err := db.New(ctx, db.TestOptions())
+	require.NoError(t, err)
+
+	_, err = New(Config{
+		DB:           database,
+		OrderWatcher: &fakeOrderWatcher{},
+		URLs:         []string{"not a url"},
+		Secret:       testSecret,
+	})
+	assert.Error(t, err)
+
+	_, err = New(Config{
+		DB:           database,
+		OrderWatcher: &fakeOrderWatcher{},
+		URLs:         []string{"https://example.com/webhook"},
+	})
+	assert.Error(t, err, "expected an error when no secret is given")
+}
+
+func newTestOrderEvent() *zeroex.OrderEvent {
+	return &zeroex.OrderEvent{
+		Timestamp: time.Now().UTC(),
+		OrderHash: common.BigToHash(big.NewInt(rand.Int63())),
+		SignedOrder: &zeroex.SignedOrder{
+			Order: zeroex.Order{
+				ChainID:               big.NewInt(constants.TestChainID),
+				MakerAddress:          constants.GanacheAccount1,
+				TakerAddress:          constants.NullAddress,
+				SenderAddress:         constants.NullAddress,
+				FeeRecipientAddress:   constants.NullAddress,
+				MakerAssetData:        constants.ZRXAssetData,
+				MakerFeeAssetData:     constants.NullBytes,
+				TakerAssetData:        constants.WETHAssetData,
+				TakerFeeAssetData:     constants.NullBytes,
+				Salt:                  big.NewInt(rand.Int63()),
+				MakerFee:              big.NewInt(0),
+				TakerFee:              big.NewInt(0),
+				MakerAssetAmount:      big.NewInt(100),
+				TakerAssetAmount:      big.NewInt(42),
+				ExpirationTimeSeconds: big.NewInt(time.Now().Add(24 * time.Hour).Unix()),
+			},
+			Signature: []byte{1, 2, 255, 255},
+		},
+		EndState:                 zeroex.ESOrderAdded,
+		FillableTakerAssetAmount: big.NewInt(42),
+		ContractEvents:           []*zeroex.ContractEvent{},
+	}
+}
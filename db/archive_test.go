@@ -0,0 +1,153 @@
+// +build !js
+
+package db
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/zeroex"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchivedOrders(t *testing.T) {
+	runWithEachDriver(t, nil, runArchivedOrdersTest)
+}
+
+func runArchivedOrdersTest(t *testing.T, db *DB) {
+	otherMaker := common.HexToAddress("0x1")
+	start := time.Now().Truncate(time.Second)
+	archivedOrders := make([]*types.ArchivedOrder, 4)
+	for i := range archivedOrders {
+		archivedOrder := &types.ArchivedOrder{
+			EndState:                 zeroex.ESOrderExpired,
+			FillableTakerAssetAmount: big.NewInt(int64(i)),
+			BlockNumber:              big.NewInt(int64(100 + i)),
+			BlockHash:                common.BigToHash(big.NewInt(int64(i))),
+			Timestamp:                start.Add(time.Duration(i) * time.Minute),
+			IsPinned:                 i%2 == 0,
+			ArchivedAt:               start.Add(time.Duration(i) * time.Hour),
+		}
+		if i%2 == 0 {
+			order := newTestOrder()
+			archivedOrder.Hash = order.Hash
+			archivedOrder.SignedOrder = order.SignedOrder()
+		} else {
+			order := newTestOrderV4()
+			order.OrderV4.Maker = otherMaker
+			archivedOrder.Hash = order.Hash
+			archivedOrder.SignedOrderV4 = order.SignedOrderV4()
+		}
+		archivedOrders[i] = archivedOrder
+	}
+	archivedOrders[3].EndState = zeroex.ESOrderFullyFilled
+	require.NoError(t, db.AddArchivedOrders(archivedOrders))
+	// Archiving an order a second time should replace it.
+	require.NoError(t, db.AddArchivedOrders(archivedOrders[:1]))
+
+	// Results are sorted by ArchivedAt in descending order.
+	actualOrders, err := db.FindArchivedOrders(nil)
+	require.NoError(t, err)
+	assertArchivedOrdersEqual(t, []*types.ArchivedOrder{archivedOrders[3], archivedOrders[2], archivedOrders[1], archivedOrders[0]}, actualOrders)
+
+	testCases := []struct {
+		name     string
+		query    *ArchivedOrderQuery
+		expected []*types.ArchivedOrder
+	}{
+		{
+			name:     "OrderHash",
+			query:    &ArchivedOrderQuery{OrderHash: archivedOrders[1].Hash},
+			expected: []*types.ArchivedOrder{archivedOrders[1]},
+		},
+		{
+			name:     "MakerAddress",
+			query:    &ArchivedOrderQuery{MakerAddress: otherMaker},
+			expected: []*types.ArchivedOrder{archivedOrders[3], archivedOrders[1]},
+		},
+		{
+			name:     "EndStates",
+			query:    &ArchivedOrderQuery{EndStates: []zeroex.OrderEventEndState{zeroex.ESOrderFullyFilled, zeroex.ESOrderCancelled}},
+			expected: []*types.ArchivedOrder{archivedOrders[3]},
+		},
+		{
+			name: "ArchivedAfter and ArchivedBefore",
+			query: &ArchivedOrderQuery{
+				ArchivedAfter:  archivedOrders[1].ArchivedAt,
+				ArchivedBefore: archivedOrders[3].ArchivedAt,
+			},
+			expected: []*types.ArchivedOrder{archivedOrders[2], archivedOrders[1]},
+		},
+		{
+			name:     "Limit and Offset",
+			query:    &ArchivedOrderQuery{Limit: 2, Offset: 1},
+			expected: []*types.ArchivedOrder{archivedOrders[2], archivedOrders[1]},
+		},
+		{
+			name:     "Offset past the end",
+			query:    &ArchivedOrderQuery{Limit: 2, Offset: 10},
+			expected: []*types.ArchivedOrder{},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			actualOrders, err := db.FindArchivedOrders(testCase.query)
+			require.NoError(t, err)
+			assertArchivedOrdersEqual(t, testCase.expected, actualOrders)
+		})
+	}
+
+	_, err = db.FindArchivedOrders(&ArchivedOrderQuery{Offset: 1})
+	assert.EqualError(t, err, "db.FindArchivedOrders: can't use Offset without Limit")
+
+	numDeleted, err := db.DeleteArchivedOrdersBefore(archivedOrders[2].ArchivedAt)
+	require.NoError(t, err)
+	assert.Equal(t, 2, numDeleted)
+	actualOrders, err = db.FindArchivedOrders(nil)
+	require.NoError(t, err)
+	assertArchivedOrdersEqual(t, []*types.ArchivedOrder{archivedOrders[3], archivedOrders[2]}, actualOrders)
+}
+
+func TestGetLatestOrderEvent(t *testing.T) {
+	runWithEachDriver(t, nil, runGetLatestOrderEventTest)
+}
+
+func runGetLatestOrderEventTest(t *testing.T, db *DB) {
+	orderHash := common.HexToHash("0x1")
+	_, err := db.GetLatestOrderEvent(orderHash)
+	assert.Equal(t, ErrNotFound, err)
+
+	require.NoError(t, db.AddOrderEvents([]*zeroex.OrderEvent{
+		{OrderHash: orderHash, EndState: zeroex.ESOrderAdded, Timestamp: time.Now().UTC(), FillableTakerAssetAmount: big.NewInt(0)},
+		{OrderHash: common.HexToHash("0x2"), EndState: zeroex.ESOrderAdded, Timestamp: time.Now().UTC(), FillableTakerAssetAmount: big.NewInt(0)},
+		{OrderHash: orderHash, EndState: zeroex.ESOrderCancelled, Timestamp: time.Now().UTC(), FillableTakerAssetAmount: big.NewInt(0)},
+		{OrderHash: common.HexToHash("0x2"), EndState: zeroex.ESOrderExpired, Timestamp: time.Now().UTC(), FillableTakerAssetAmount: big.NewInt(0)},
+	}))
+	orderEvent, err := db.GetLatestOrderEvent(orderHash)
+	require.NoError(t, err)
+	assert.Equal(t, zeroex.ESOrderCancelled, orderEvent.EndState)
+}
+
+func assertArchivedOrdersEqual(t *testing.T, expected, actual []*types.ArchivedOrder) {
+	require.Len(t, actual, len(expected))
+	for i, expectedOrder := range expected {
+		actualOrder := actual[i]
+		assert.Equal(t, expectedOrder.Hash, actualOrder.Hash, "wrong order at index %d", i)
+		assert.Equal(t, expectedOrder.EndState, actualOrder.EndState)
+		assert.Equal(t, expectedOrder.FillableTakerAssetAmount, actualOrder.FillableTakerAssetAmount)
+		assert.Equal(t, expectedOrder.BlockNumber, actualOrder.BlockNumber)
+		assert.Equal(t, expectedOrder.BlockHash, actualOrder.BlockHash)
+		assert.True(t, expectedOrder.Timestamp.Equal(actualOrder.Timestamp), "expected timestamp %s but got %s", expectedOrder.Timestamp, actualOrder.Timestamp)
+		assert.True(t, expectedOrder.ArchivedAt.Equal(actualOrder.ArchivedAt), "expected ArchivedAt %s but got %s", expectedOrder.ArchivedAt, actualOrder.ArchivedAt)
+		assert.Equal(t, expectedOrder.IsPinned, actualOrder.IsPinned)
+		assert.Equal(t, expectedOrder.MakerAddress(), actualOrder.MakerAddress())
+		// Signed orders are compared by their JSON encoding because of the
+		// hashes cached by the orders.
+		assertJSONEqual(t, expectedOrder.SignedOrder, actualOrder.SignedOrder)
+		assertJSONEqual(t, expectedOrder.SignedOrderV4, actualOrder.SignedOrderV4)
+	}
+}
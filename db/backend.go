@@ -0,0 +1,134 @@
+// +build !js
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/zeroex"
+	"github.com/ethereum/go-ethereum/common"
+	ds "github.com/ipfs/go-datastore"
+)
+
+var _ Database = (*DB)(nil)
+
+// backend is implemented by each of the storage implementations that can be
+// selected via Options.DriverName. DB adds the methods that are shared by all
+// of them, e.g. AddOrders and UpdateOrder, which handle both v3 and v4 orders.
+type backend interface {
+	AddOrdersV3(orders []*types.OrderWithMetadata) (alreadyStored []common.Hash, added []*types.OrderWithMetadata, removed []*types.OrderWithMetadata, err error)
+	AddOrdersV4(orders []*types.OrderWithMetadata) (alreadyStored []common.Hash, added []*types.OrderWithMetadata, removed []*types.OrderWithMetadata, err error)
+	GetOrder(hash common.Hash) (*types.OrderWithMetadata, error)
+	GetOrderV4(hash common.Hash) (*types.OrderWithMetadata, error)
+	GetOrderStatuses(hashes []common.Hash) ([]*StoredOrderStatus, error)
+	GetOrderStatusesV4(hashes []common.Hash) ([]*StoredOrderStatus, error)
+	FindOrders(query *OrderQuery) ([]*types.OrderWithMetadata, error)
+	FindOrdersV4(query *OrderQueryV4) ([]*types.OrderWithMetadata, error)
+	RemoveOrdersWithLongExpiration() ([]*types.OrderWithMetadata, error)
+	CountOrders(query *OrderQuery) (int, error)
+	CountOrdersV4(query *OrderQueryV4) (int, error)
+	GetOrderStats(query *OrderQuery) (*types.OrderStats, error)
+	GetOrderStatsV4(query *OrderQueryV4) (*types.OrderStats, error)
+	DeleteOrder(hash common.Hash) error
+	DeleteOrderV4(hash common.Hash) error
+	DeleteOrders(query *OrderQuery) ([]*types.OrderWithMetadata, error)
+	DeleteOrdersV4(query *OrderQueryV4) ([]*types.OrderWithMetadata, error)
+	UpdateOrderV3(hash common.Hash, updateFunc func(existingOrder *types.OrderWithMetadata) (updatedOrder *types.OrderWithMetadata, err error)) error
+	UpdateOrderV4(hash common.Hash, updateFunc func(existingOrder *types.OrderWithMetadata) (updatedOrder *types.OrderWithMetadata, err error)) error
+	AddMiniHeaders(miniHeaders []*types.MiniHeader) (added []*types.MiniHeader, removed []*types.MiniHeader, err error)
+	ResetMiniHeaders(miniHeaders []*types.MiniHeader) error
+	GetMiniHeader(hash common.Hash) (*types.MiniHeader, error)
+	FindMiniHeaders(query *MiniHeaderQuery) ([]*types.MiniHeader, error)
+	DeleteMiniHeader(hash common.Hash) error
+	DeleteMiniHeaders(query *MiniHeaderQuery) ([]*types.MiniHeader, error)
+	GetMetadata() (*types.Metadata, error)
+	SaveMetadata(metadata *types.Metadata) error
+	UpdateMetadata(updateFunc func(oldmetadata *types.Metadata) (newMetadata *types.Metadata)) error
+	AddOrderEvents(orderEvents []*zeroex.OrderEvent) error
+	FindOrderEvents(query *OrderEventQuery) ([]*zeroex.OrderEvent, error)
+	GetOrderEventSequenceNumberRange() (oldest uint64, latest uint64, err error)
+	GetLatestOrderEvent(orderHash common.Hash) (*zeroex.OrderEvent, error)
+	AddArchivedOrders(orders []*types.ArchivedOrder) error
+	FindArchivedOrders(query *ArchivedOrderQuery) ([]*types.ArchivedOrder, error)
+	DeleteArchivedOrdersBefore(archivedBefore time.Time) (int, error)
+	FindMakerQuotaUsage(limit int) ([]*types.QuotaUsage, error)
+	FindPeerQuotaUsage(limit int) ([]*types.QuotaUsage, error)
+	AddWebhookDeliveries(deliveries []*types.WebhookDelivery) error
+	FindWebhookDeliveries(query *WebhookDeliveryQuery) ([]*types.WebhookDelivery, error)
+	UpdateWebhookDelivery(delivery *types.WebhookDelivery) error
+	DeleteWebhookDelivery(id uint64) error
+	CountWebhookDeliveries(query *WebhookDeliveryQuery) (int, error)
+	DeleteWebhookDeliveriesExcept(urls []string) (int, error)
+	PeerStore() ds.Batching
+	DHTStore() ds.Batching
+}
+
+// DB instantiates the DB connection and creates all the collections used by the application
+type DB struct {
+	backend
+}
+
+// New creates a new connection to the database. The connection will be automatically closed
+// when the given context is canceled.
+func New(ctx context.Context, opts *Options) (*DB, error) {
+	opts = parseOptions(opts)
+	var (
+		b   backend
+		err error
+	)
+	switch opts.DriverName {
+	case MemoryDriverName:
+		b = newMemoryDB(ctx, opts)
+	case LevelDBDriverName:
+		b, err = newLevelDB(ctx, opts)
+	default:
+		b, err = newSQLDB(ctx, opts)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &DB{backend: b}, nil
+}
+
+func (db *DB) AddOrders(orders []*types.OrderWithMetadata) (alreadyStored []common.Hash, added []*types.OrderWithMetadata, removed []*types.OrderWithMetadata, err error) {
+	alreadyStoredV3, addedV3, removedV3, err := db.AddOrdersV3(orders)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	alreadyStoredV4, addedV4, removedV4, err := db.AddOrdersV4(orders)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	alreadyStored = append(alreadyStoredV3, alreadyStoredV4...)
+	added = append(addedV3, addedV4...)
+	removed = append(removedV3, removedV4...)
+	return alreadyStored, added, removed, nil
+}
+
+func (db *DB) UpdateOrder(hash common.Hash, updateFunc func(existingOrder *types.OrderWithMetadata) (updatedOrder *types.OrderWithMetadata, err error)) (err error) {
+	errV3 := db.UpdateOrderV3(hash, updateFunc)
+	if errV3 != nil && errV3 != ErrNotFound {
+		return errV3
+	}
+	errV4 := db.UpdateOrderV4(hash, updateFunc)
+	if errV4 != nil && errV4 != ErrNotFound {
+		return errV4
+	}
+	if errV3 == ErrNotFound && errV4 == ErrNotFound {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// SchemaVersion returns the current schema version of the database, i.e. the
+// version of the latest migration that has been applied. The schemas of the
+// implementations other than SQL are not versioned, so it always returns the
+// latest version for them.
+func (db *DB) SchemaVersion() (int, error) {
+	if sqlDB, ok := db.backend.(*sqlDB); ok {
+		return sqlDB.SchemaVersion()
+	}
+	return latestSchemaVersion(), nil
+}
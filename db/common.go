@@ -35,6 +35,8 @@ type Database interface {
 	FindOrders(opts *OrderQuery) ([]*types.OrderWithMetadata, error)
 	FindOrdersV4(opts *OrderQueryV4) ([]*types.OrderWithMetadata, error)
 	CountOrders(opts *OrderQuery) (int, error)
+	GetOrderStats(opts *OrderQuery) (*types.OrderStats, error)
+	GetOrderStatsV4(opts *OrderQueryV4) (*types.OrderStats, error)
 	DeleteOrder(hash common.Hash) error
 	DeleteOrders(opts *OrderQuery) ([]*types.OrderWithMetadata, error)
 	UpdateOrder(hash common.Hash, updateFunc func(existingOrder *types.OrderWithMetadata) (updatedOrder *types.OrderWithMetadata, err error)) error
@@ -47,6 +49,21 @@ type Database interface {
 	GetMetadata() (*types.Metadata, error)
 	SaveMetadata(metadata *types.Metadata) error
 	UpdateMetadata(updateFunc func(oldmetadata *types.Metadata) (newMetadata *types.Metadata)) error
+	AddOrderEvents(orderEvents []*zeroex.OrderEvent) error
+	FindOrderEvents(opts *OrderEventQuery) ([]*zeroex.OrderEvent, error)
+	GetOrderEventSequenceNumberRange() (oldest uint64, latest uint64, err error)
+	GetLatestOrderEvent(orderHash common.Hash) (*zeroex.OrderEvent, error)
+	AddArchivedOrders(orders []*types.ArchivedOrder) error
+	FindArchivedOrders(opts *ArchivedOrderQuery) ([]*types.ArchivedOrder, error)
+	DeleteArchivedOrdersBefore(archivedBefore time.Time) (int, error)
+	FindMakerQuotaUsage(limit int) ([]*types.QuotaUsage, error)
+	FindPeerQuotaUsage(limit int) ([]*types.QuotaUsage, error)
+	AddWebhookDeliveries(deliveries []*types.WebhookDelivery) error
+	FindWebhookDeliveries(opts *WebhookDeliveryQuery) ([]*types.WebhookDelivery, error)
+	UpdateWebhookDelivery(delivery *types.WebhookDelivery) error
+	DeleteWebhookDelivery(id uint64) error
+	CountWebhookDeliveries(opts *WebhookDeliveryQuery) (int, error)
+	DeleteWebhookDeliveriesExcept(urls []string) (int, error)
 	PeerStore() ds.Batching
 	DHTStore() ds.Batching
 }
@@ -59,6 +76,7 @@ type Options struct {
 	DataDir                 string `json:"dataDir"`
 	MaxOrders               int    `json:"maxOrders"`
 	MaxMiniHeaders          int    `json:"maxMiniHeaders"`
+	MaxOrderEvents          int    `json:"maxOrderEvents"`
 }
 
 func parseOptions(opts *Options) *Options {
@@ -66,6 +84,9 @@ func parseOptions(opts *Options) *Options {
 	if opts == nil {
 		return finalOpts
 	}
+	if opts.DriverName != "" {
+		finalOpts.DriverName = opts.DriverName
+	}
 	if opts.DataSourceName != "" {
 		finalOpts.DataSourceName = opts.DataSourceName
 	}
@@ -75,6 +96,9 @@ func parseOptions(opts *Options) *Options {
 	if opts.MaxMiniHeaders != 0 {
 		finalOpts.MaxMiniHeaders = opts.MaxMiniHeaders
 	}
+	if opts.MaxOrderEvents != 0 {
+		finalOpts.MaxOrderEvents = opts.MaxOrderEvents
+	}
 	return finalOpts
 }
 
@@ -95,6 +119,13 @@ const (
 	LessOrEqual    FilterKind = "<="
 	GreaterOrEqual FilterKind = ">="
 	Contains       FilterKind = "CONTAINS"
+	// In matches if the field is equal to any of the values in the filter
+	// value, which must be a []interface{}.
+	In FilterKind = "IN"
+	// Or matches if all of the filters in at least one of the filter's Groups
+	// match. The Field and Value of the filter are ignored. Groups may contain
+	// other Or filters.
+	Or FilterKind = "OR"
 )
 
 type OrderField string
@@ -126,13 +157,79 @@ const (
 	OFIsExpired                OrderField = "isExpired"
 	OFParsedMakerAssetData     OrderField = "parsedMakerAssetData"
 	OFParsedMakerFeeAssetData  OrderField = "parsedMakerFeeAssetData"
+	OFParsedTakerAssetData     OrderField = "parsedTakerAssetData"
+	OFParsedTakerFeeAssetData  OrderField = "parsedTakerFeeAssetData"
 	OFLastValidatedBlockNumber OrderField = "lastValidatedBlockNumber"
 	OFKeepCancelled            OrderField = "keepCancelled"
 	OFKeepExpired              OrderField = "keepExpired"
 	OFKeepFullyFilled          OrderField = "keepFullyFilled"
 	OFKeepUnfunded             OrderField = "keepUnfunded"
+	OFSourcePeerID             OrderField = "sourcePeerID"
+	// OFPrice is the taker asset amount divided by the maker asset amount (see
+	// types.OrderWithMetadata.Price). Its values are of type float64.
+	// Prices are rounded to the nearest float64, so distinct prices which
+	// differ by less than about one part in 10^16 are equal. Sorts by price
+	// should include another field (e.g. the hash) to break ties.
+	OFPrice OrderField = "price"
 )
 
+// OrderEventQuery is used to find order events in the order event log.
+type OrderEventQuery struct {
+	// AfterSequenceNumber is used to only include order events with a sequence
+	// number strictly greater than the given value.
+	AfterSequenceNumber uint64 `json:"afterSequenceNumber"`
+	// Limit is the maximum number of order events to return. If 0, there is no
+	// limit.
+	Limit uint `json:"limit"`
+}
+
+// ArchivedOrderQuery is used to find archived orders. Archived orders are
+// always sorted by the time at which they were archived in descending order
+// (i.e. the most recently archived orders come first) and then by hash.
+type ArchivedOrderQuery struct {
+	// OrderHash is used to only include the archived order with the given hash.
+	// If empty, orders with any hash are included.
+	OrderHash common.Hash `json:"orderHash"`
+	// MakerAddress is used to only include orders with the given maker. If
+	// empty, orders with any maker are included.
+	MakerAddress common.Address `json:"makerAddress"`
+	// EndStates is used to only include orders with one of the given end
+	// states. If empty, orders with any end state are included.
+	EndStates []zeroex.OrderEventEndState `json:"endStates"`
+	// ArchivedAfter and ArchivedBefore are used to only include orders which
+	// were archived at or after and strictly before the given times
+	// respectively. Zero values are ignored.
+	ArchivedAfter  time.Time `json:"archivedAfter"`
+	ArchivedBefore time.Time `json:"archivedBefore"`
+	// Limit is the maximum number of archived orders to return. If 0, there is
+	// no limit.
+	Limit uint `json:"limit"`
+	// Offset is the number of archived orders to skip. It can only be used
+	// together with Limit.
+	Offset uint `json:"offset"`
+}
+
+// WebhookDeliveryQuery is used to find pending webhook deliveries. Deliveries
+// are always sorted by ID in ascending order, which is the order in which they
+// were added.
+type WebhookDeliveryQuery struct {
+	// URL is used to only include deliveries for the given webhook endpoint. If
+	// empty, deliveries for all endpoints are included.
+	URL string `json:"url"`
+	// Limit is the maximum number of deliveries to return. If 0, there is no
+	// limit.
+	Limit uint `json:"limit"`
+}
+
+// webhookURLSet returns a set which contains the given webhook endpoint URLs.
+func webhookURLSet(urls []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(urls))
+	for _, url := range urls {
+		set[url] = struct{}{}
+	}
+	return set
+}
+
 type OrderQuery struct {
 	Filters []OrderFilter `json:"filters"`
 	Sort    []OrderSort   `json:"sort"`
@@ -149,6 +246,8 @@ type OrderFilter struct {
 	Field OrderField  `json:"field"`
 	Kind  FilterKind  `json:"kind"`
 	Value interface{} `json:"value"`
+	// Groups is only used by filters with the Or kind.
+	Groups [][]OrderFilter `json:"groups,omitempty"`
 }
 
 type StoredOrderStatus struct {
@@ -182,6 +281,42 @@ func MakerFeeAssetIncludesTokenAddress(tokenAddress common.Address) OrderFilter
 	return assetDataIncludesTokenAddress(OFParsedMakerFeeAssetData, tokenAddress)
 }
 
+// MakerAssetIncludesTokenID is a helper method which returns a filter that will match orders
+// that include the token ID (for any token address) in MakerAssetData.
+func MakerAssetIncludesTokenID(tokenID *big.Int) OrderFilter {
+	return assetDataIncludesTokenID(OFParsedMakerAssetData, tokenID)
+}
+
+// TakerAssetIncludesTokenAddressAndTokenID is a helper method which returns a filter that will match orders
+// that include the token address and token ID in TakerAssetData.
+func TakerAssetIncludesTokenAddressAndTokenID(tokenAddress common.Address, tokenID *big.Int) OrderFilter {
+	return assetDataIncludesTokenAddressAndTokenID(OFParsedTakerAssetData, tokenAddress, tokenID)
+}
+
+// TakerFeeAssetIncludesTokenAddressAndTokenID is a helper method which returns a filter that will match orders
+// that include the token address and token ID in TakerFeeAssetData.
+func TakerFeeAssetIncludesTokenAddressAndTokenID(tokenAddress common.Address, tokenID *big.Int) OrderFilter {
+	return assetDataIncludesTokenAddressAndTokenID(OFParsedTakerFeeAssetData, tokenAddress, tokenID)
+}
+
+// TakerAssetIncludesTokenAddress is a helper method which returns a filter that will match orders
+// that include the token address (and any token id, including null) in TakerAssetData.
+func TakerAssetIncludesTokenAddress(tokenAddress common.Address) OrderFilter {
+	return assetDataIncludesTokenAddress(OFParsedTakerAssetData, tokenAddress)
+}
+
+// TakerFeeAssetIncludesTokenAddress is a helper method which returns a filter that will match orders
+// that include the token address (and any token id, including null) in TakerFeeAssetData.
+func TakerFeeAssetIncludesTokenAddress(tokenAddress common.Address) OrderFilter {
+	return assetDataIncludesTokenAddress(OFParsedTakerFeeAssetData, tokenAddress)
+}
+
+// TakerAssetIncludesTokenID is a helper method which returns a filter that will match orders
+// that include the token ID (for any token address) in TakerAssetData.
+func TakerAssetIncludesTokenID(tokenID *big.Int) OrderFilter {
+	return assetDataIncludesTokenID(OFParsedTakerAssetData, tokenID)
+}
+
 func assetDataIncludesTokenAddress(field OrderField, tokenAddress common.Address) OrderFilter {
 	tokenAddressJSON, err := canonicaljson.Marshal(tokenAddress)
 	if err != nil {
@@ -196,6 +331,20 @@ func assetDataIncludesTokenAddress(field OrderField, tokenAddress common.Address
 	}
 }
 
+func assetDataIncludesTokenID(field OrderField, tokenID *big.Int) OrderFilter {
+	tokenIDJSON, err := canonicaljson.Marshal(tokenID.String())
+	if err != nil {
+		// Strings should never return an error when marshaling to JSON
+		panic(err)
+	}
+	filterValue := fmt.Sprintf(`"tokenID":%s`, tokenIDJSON)
+	return OrderFilter{
+		Field: field,
+		Kind:  Contains,
+		Value: filterValue,
+	}
+}
+
 type MiniHeaderField string
 
 const (
@@ -266,6 +415,54 @@ func (db *DB) GetLatestMiniHeader() (*types.MiniHeader, error) {
 	return latestMiniHeaders[0], nil
 }
 
+// FindOrphanedMiniHeaders returns the stored MiniHeaders which are not part of
+// the chain that ends with the latest MiniHeader, i.e. the ones that can't be
+// reached from the latest MiniHeader by following parent hashes. If there is
+// more than one MiniHeader with the latest block number, the one with the
+// longest chain is used. The returned MiniHeaders are sorted by block number.
+func (db *DB) FindOrphanedMiniHeaders() ([]*types.MiniHeader, error) {
+	miniHeaders, err := db.FindMiniHeaders(&MiniHeaderQuery{
+		Sort: []MiniHeaderSort{
+			{
+				Field:     MFNumber,
+				Direction: Ascending,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(miniHeaders) == 0 {
+		return nil, nil
+	}
+	miniHeadersByHash := map[common.Hash]*types.MiniHeader{}
+	for _, miniHeader := range miniHeaders {
+		miniHeadersByHash[miniHeader.Hash] = miniHeader
+	}
+	var chain map[common.Hash]struct{}
+	latestNumber := miniHeaders[len(miniHeaders)-1].Number
+	for i := len(miniHeaders) - 1; i >= 0 && miniHeaders[i].Number.Cmp(latestNumber) == 0; i-- {
+		candidate := map[common.Hash]struct{}{}
+		for miniHeader := miniHeaders[i]; miniHeader != nil; miniHeader = miniHeadersByHash[miniHeader.Parent] {
+			if _, found := candidate[miniHeader.Hash]; found {
+				// Only possible if the stored parent hashes form a cycle.
+				break
+			}
+			candidate[miniHeader.Hash] = struct{}{}
+		}
+		if len(candidate) > len(chain) {
+			chain = candidate
+		}
+	}
+	orphaned := []*types.MiniHeader{}
+	for _, miniHeader := range miniHeaders {
+		if _, found := chain[miniHeader.Hash]; !found {
+			orphaned = append(orphaned, miniHeader)
+		}
+	}
+	return orphaned, nil
+}
+
 // GetCurrentMaxExpirationTime returns the maximum expiration time for non-pinned orders
 // stored in the database. If there are no non-pinned orders in the database, it returns
 // constants.UnlimitedExpirationTime.
@@ -438,6 +635,41 @@ func checkOrderQuery(query *OrderQuery) error {
 	if query.Offset != 0 && query.Limit == 0 {
 		return errors.New("can't use Offset without Limit")
 	}
+	return checkOrderFilters(query.Filters)
+}
+
+func checkOrderFilters(filters []OrderFilter) error {
+	for _, filter := range filters {
+		if err := checkFilterKind(filter.Kind, filter.Value, len(filter.Groups)); err != nil {
+			return err
+		}
+		for _, group := range filter.Groups {
+			if len(group) == 0 {
+				return errors.New("OR filter groups must not be empty")
+			}
+			if err := checkOrderFilters(group); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
+}
 
+// checkFilterKind checks the parts of a filter which do not depend on whether
+// it is a filter for v3 or v4 orders.
+func checkFilterKind(kind FilterKind, value interface{}, numGroups int) error {
+	switch kind {
+	case In:
+		if _, ok := value.([]interface{}); !ok {
+			return fmt.Errorf("invalid type for IN filter value (expected []interface{} but got %T)", value)
+		}
+	case Or:
+		if numGroups == 0 {
+			return errors.New("OR filters must have at least one group")
+		}
+	}
+	if kind != Or && numGroups != 0 {
+		return fmt.Errorf("only OR filters can have groups (got %s)", kind)
+	}
+	return nil
 }
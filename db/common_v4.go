@@ -6,6 +6,7 @@ const (
 	OV4FHash                     OrderFieldV4 = "hash"
 	OV4FChainID                  OrderFieldV4 = "chainID"
 	OV4FExchangeAddress          OrderFieldV4 = "exchangeAddress"
+	OV4FVerifyingContract        OrderFieldV4 = "verifyingContract"
 	OV4FMakerToken               OrderFieldV4 = "makerToken"
 	OV4FTakerToken               OrderFieldV4 = "takerToken"
 	OV4FMakerAmount              OrderFieldV4 = "makerAmount"
@@ -32,6 +33,13 @@ const (
 	OV4FKeepExpired              OrderFieldV4 = "keepExpired"
 	OV4FKeepFullyFilled          OrderFieldV4 = "keepFullyFilled"
 	OV4FKeepUnfunded             OrderFieldV4 = "keepUnfunded"
+	OV4FSourcePeerID             OrderFieldV4 = "sourcePeerID"
+	// OV4FPrice is the taker amount divided by the maker amount (see
+	// types.OrderWithMetadata.Price). Its values are of type float64.
+	// Prices are rounded to the nearest float64, so distinct prices which
+	// differ by less than about one part in 10^16 are equal. Sorts by price
+	// should include another field (e.g. the hash) to break ties.
+	OV4FPrice OrderFieldV4 = "price"
 )
 
 type OrderQueryV4 struct {
@@ -50,4 +58,6 @@ type OrderFilterV4 struct {
 	Field OrderFieldV4 `json:"field"`
 	Kind  FilterKind   `json:"kind"`
 	Value interface{}  `json:"value"`
+	// Groups is only used by filters with the Or kind.
+	Groups [][]OrderFilterV4 `json:"groups,omitempty"`
 }
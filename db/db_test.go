@@ -46,9 +46,10 @@ func init() {
 }
 
 func TestAddOrders(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runAddOrdersTest)
+}
+
+func runAddOrdersTest(t *testing.T, db *DB) {
 
 	numOrders := 10
 	orderHashes := []common.Hash{}
@@ -188,10 +189,118 @@ func TestAddOrdersMaxExpirationTime(t *testing.T) {
 	// assertOrderSlicesAreUnsortedEqual(t, expectedStoredOrders, actualStoredOrders)
 }
 
+func TestOrdersAreCopied(t *testing.T) {
+	runWithEachDriver(t, nil, runOrdersAreCopiedTest)
+}
+
+func runOrdersAreCopiedTest(t *testing.T, db *DB) {
+	order := newTestOrder()
+	_, _, _, err := db.AddOrders([]*types.OrderWithMetadata{order})
+	require.NoError(t, err)
+	order.IsRemoved = true
+
+	foundOrder, err := db.GetOrder(order.Hash)
+	require.NoError(t, err)
+	assert.False(t, foundOrder.IsRemoved, "modifying the added order should not modify the stored order")
+	foundOrder.IsPinned = !foundOrder.IsPinned
+
+	foundOrderAgain, err := db.GetOrder(order.Hash)
+	require.NoError(t, err)
+	assert.NotEqual(t, foundOrder.IsPinned, foundOrderAgain.IsPinned, "modifying a found order should not modify the stored order")
+
+	require.NoError(t, db.UpdateOrder(order.Hash, func(existingOrder *types.OrderWithMetadata) (*types.OrderWithMetadata, error) {
+		existingOrder.IsRemoved = true
+		return existingOrder, nil
+	}))
+	statuses, err := db.GetOrderStatuses([]common.Hash{order.Hash, common.HexToHash("0x1")})
+	require.NoError(t, err)
+	assert.Equal(t, []*StoredOrderStatus{
+		{
+			IsStored:                 true,
+			IsMarkedRemoved:          true,
+			FillableTakerAssetAmount: order.FillableTakerAssetAmount,
+		},
+		{
+			IsStored: false,
+		},
+	}, statuses)
+	assert.Equal(t, ErrNotFound, db.UpdateOrder(common.HexToHash("0x1"), func(existingOrder *types.OrderWithMetadata) (*types.OrderWithMetadata, error) {
+		return existingOrder, nil
+	}))
+}
+
+func TestAddOrdersV4MaxOrders(t *testing.T) {
+	opts := TestOptions()
+	opts.MaxOrders = 3
+	runWithEachDriver(t, opts, runAddOrdersV4MaxOrdersTest)
+}
+
+func runAddOrdersV4MaxOrdersTest(t *testing.T, db *DB) {
+	// The pinned order is kept even though it has the longest expiration time.
+	orders := []*types.OrderWithMetadata{}
+	for i := 0; i < 3; i++ {
+		order := newTestOrderV4()
+		order.OrderV4.Expiry = big.NewInt(int64(100 + i))
+		order.IsPinned = false
+		orders = append(orders, order)
+	}
+	orders[2].IsPinned = true
+	_, added, removed, err := db.AddOrdersV4(orders)
+	require.NoError(t, err)
+	assert.Len(t, removed, 0)
+	assertOrderSlicesAreUnsortedEqual(t, orders, added)
+
+	newOrder := newTestOrderV4()
+	newOrder.OrderV4.Expiry = big.NewInt(50)
+	newOrder.IsPinned = false
+	longExpirationOrder := newTestOrderV4()
+	longExpirationOrder.OrderV4.Expiry = big.NewInt(1000)
+	longExpirationOrder.IsPinned = false
+	_, added, removed, err = db.AddOrdersV4([]*types.OrderWithMetadata{newOrder, longExpirationOrder})
+	require.NoError(t, err)
+	assertOrderSlicesAreEqual(t, []*types.OrderWithMetadata{newOrder}, added)
+	assertOrderSlicesAreEqual(t, []*types.OrderWithMetadata{orders[1]}, removed)
+
+	count, err := db.CountOrdersV4(nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+}
+
+func TestRemoveOrdersWithLongExpiration(t *testing.T) {
+	opts := TestOptions()
+	opts.MaxOrders = 2
+	runWithEachDriver(t, opts, runRemoveOrdersWithLongExpirationTest)
+}
+
+func runRemoveOrdersWithLongExpirationTest(t *testing.T, db *DB) {
+	orders := []*types.OrderWithMetadata{}
+	for i := 0; i < 4; i++ {
+		order := newTestOrder()
+		order.OrderV3.ExpirationTimeSeconds = big.NewInt(int64(100 + i))
+		order.IsPinned = false
+		orders = append(orders, order)
+	}
+	orders[3].IsPinned = true
+	_, added, _, err := db.AddOrders(orders)
+	require.NoError(t, err)
+	assert.Len(t, added, 4, "MaxOrders should not be enforced when adding v3 orders")
+
+	removed, err := db.RemoveOrdersWithLongExpiration()
+	require.NoError(t, err)
+	require.Len(t, removed, 2)
+	assert.Equal(t, orders[1].Hash, removed[0].Hash)
+	assert.Equal(t, orders[2].Hash, removed[1].Hash)
+
+	remaining, err := db.FindOrders(nil)
+	require.NoError(t, err)
+	assertOrderSlicesAreUnsortedEqual(t, []*types.OrderWithMetadata{orders[0], orders[3]}, remaining)
+}
+
 func TestGetOrder(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runGetOrderTest)
+}
+
+func runGetOrderTest(t *testing.T, db *DB) {
 
 	_, added, _, err := db.AddOrders([]*types.OrderWithMetadata{newTestOrder()})
 	require.NoError(t, err)
@@ -207,9 +316,10 @@ func TestGetOrder(t *testing.T) {
 }
 
 func TestGetOrderStatuses(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runGetOrderStatusesTest)
+}
+
+func runGetOrderStatusesTest(t *testing.T, db *DB) {
 
 	removedOrder := newTestOrder()
 	removedOrder.IsRemoved = true
@@ -249,9 +359,10 @@ func TestGetOrderStatuses(t *testing.T) {
 }
 
 func TestGetCurrentMaxExpirationTime(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runGetCurrentMaxExpirationTimeTest)
+}
+
+func runGetCurrentMaxExpirationTimeTest(t *testing.T, db *DB) {
 
 	// Create some non-pinned orders with expiration times 0, 1, 2, etc.
 	nonPinnedOrders := []*types.OrderWithMetadata{}
@@ -282,9 +393,10 @@ func TestGetCurrentMaxExpirationTime(t *testing.T) {
 }
 
 func TestUpdateOrder(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runUpdateOrderTest)
+}
+
+func runUpdateOrderTest(t *testing.T, db *DB) {
 
 	err := db.UpdateOrder(common.Hash{}, func(existingOrder *types.OrderWithMetadata) (*types.OrderWithMetadata, error) {
 		return existingOrder, nil
@@ -319,9 +431,10 @@ func TestUpdateOrder(t *testing.T) {
 }
 
 func TestFindOrders(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runFindOrdersTest)
+}
+
+func runFindOrdersTest(t *testing.T, db *DB) {
 
 	numOrders := 10
 	originalOrders := []*types.OrderWithMetadata{}
@@ -337,9 +450,10 @@ func TestFindOrders(t *testing.T) {
 }
 
 func TestFindOrdersSort(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runFindOrdersSortTest)
+}
+
+func runFindOrdersSortTest(t *testing.T, db *DB) {
 
 	// Create some test orders with carefully chosen MakerAssetAmount
 	// and TakerAssetAmount values for testing sorting.
@@ -406,6 +520,15 @@ func TestFindOrdersSort(t *testing.T) {
 			},
 			less: lessByTakerAssetAmountDescAndMakerAssetAmountDesc,
 		},
+		{
+			sortOpts: []OrderSort{
+				{
+					Field:     OFPrice,
+					Direction: Ascending,
+				},
+			},
+			less: lessByPriceAsc,
+		},
 	}
 	for i, testCase := range testCases {
 		testCaseName := fmt.Sprintf("test case %d", i)
@@ -433,9 +556,10 @@ func runFindOrdersSortTestCase(db *DB, originalOrders []*types.OrderWithMetadata
 }
 
 func TestFindOrdersLimitAndOffset(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runFindOrdersLimitAndOffsetTest)
+}
+
+func runFindOrdersLimitAndOffsetTest(t *testing.T, db *DB) {
 
 	numOrders := 10
 	originalOrders := []*types.OrderWithMetadata{}
@@ -516,9 +640,10 @@ func runFindOrdersLimitAndOffsetTestCase(db *DB, testCase findOrdersLimitAndOffs
 }
 
 func TestFindOrdersFilter(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runFindOrdersFilterTest)
+}
+
+func runFindOrdersFilterTest(t *testing.T, db *DB) {
 	_, testCases := makeOrderFilterTestCases(t, db)
 
 	for i, testCase := range testCases {
@@ -528,9 +653,10 @@ func TestFindOrdersFilter(t *testing.T) {
 }
 
 func TestFindOrdersFilterSortLimitAndOffset(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runFindOrdersFilterSortLimitAndOffsetTest)
+}
+
+func runFindOrdersFilterSortLimitAndOffsetTest(t *testing.T, db *DB) {
 	storedOrders := createAndStoreOrdersForFilterTests(t, db)
 
 	query := &OrderQuery{
@@ -554,6 +680,91 @@ func TestFindOrdersFilterSortLimitAndOffset(t *testing.T) {
 	actualOrders, err := db.FindOrders(query)
 	require.NoError(t, err)
 	assertOrderSlicesAreEqual(t, expectedOrders, actualOrders)
+
+	_, err = db.FindOrders(&OrderQuery{Offset: 2})
+	require.EqualError(t, err, "can't use Offset without Limit")
+}
+
+func TestFindOrdersAfter(t *testing.T) {
+	runWithEachDriver(t, nil, runFindOrdersAfterTest)
+}
+
+func runFindOrdersAfterTest(t *testing.T, db *DB) {
+
+	// Create orders with duplicate maker asset amounts so that the hash is
+	// needed to break ties.
+	numOrders := 10
+	originalOrders := []*types.OrderWithMetadata{}
+	for i := 0; i < numOrders; i++ {
+		order := newTestOrder()
+		order.OrderV3.MakerAssetAmount = big.NewInt(int64(i % 3))
+		originalOrders = append(originalOrders, order)
+	}
+	_, _, _, err := db.AddOrders(originalOrders)
+	require.NoError(t, err)
+
+	for _, direction := range []SortDirection{Ascending, Descending} {
+		sortOpts := []OrderSort{
+			{
+				Field:     OFMakerAssetAmount,
+				Direction: direction,
+			},
+			{
+				Field:     OFHash,
+				Direction: direction,
+			},
+		}
+		expectedOrders, err := db.FindOrders(&OrderQuery{Sort: sortOpts})
+		require.NoError(t, err)
+		require.Len(t, expectedOrders, numOrders)
+
+		// Walk through all orders three at a time.
+		actualOrders, err := db.FindOrders(&OrderQuery{Sort: sortOpts, Limit: 3})
+		require.NoError(t, err)
+		for page := actualOrders; len(page) > 0; {
+			after, err := OrderSortValues(page[len(page)-1], sortOpts)
+			require.NoError(t, err)
+			page, err = db.FindOrdersAfter(&OrderQuery{Sort: sortOpts, Limit: 3}, after)
+			require.NoError(t, err)
+			actualOrders = append(actualOrders, page...)
+		}
+		assertOrderSlicesAreEqual(t, expectedOrders, actualOrders)
+	}
+}
+
+func TestFindOrdersAfterWithRemovedOrder(t *testing.T) {
+	runWithEachDriver(t, nil, runFindOrdersAfterWithRemovedOrderTest)
+}
+
+func runFindOrdersAfterWithRemovedOrderTest(t *testing.T, db *DB) {
+
+	numOrders := 6
+	originalOrders := []*types.OrderWithMetadata{}
+	for i := 0; i < numOrders; i++ {
+		originalOrders = append(originalOrders, newTestOrder())
+	}
+	_, _, _, err := db.AddOrders(originalOrders)
+	require.NoError(t, err)
+	sortOrdersByHash(originalOrders)
+
+	sortOpts := []OrderSort{
+		{
+			Field:     OFHash,
+			Direction: Ascending,
+		},
+	}
+	firstPage, err := db.FindOrders(&OrderQuery{Sort: sortOpts, Limit: 3})
+	require.NoError(t, err)
+	assertOrderSlicesAreEqual(t, originalOrders[:3], firstPage)
+
+	// Deleting the last order of the previous page should not affect the next
+	// page.
+	require.NoError(t, db.DeleteOrder(firstPage[2].Hash))
+	after, err := OrderSortValues(firstPage[2], sortOpts)
+	require.NoError(t, err)
+	secondPage, err := db.FindOrdersAfter(&OrderQuery{Sort: sortOpts, Limit: 3}, after)
+	require.NoError(t, err)
+	assertOrderSlicesAreEqual(t, originalOrders[3:], secondPage)
 }
 
 func runFindOrdersFilterTestCase(db *DB, testCase orderFilterTestCase) func(t *testing.T) {
@@ -567,10 +778,132 @@ func runFindOrdersFilterTestCase(db *DB, testCase orderFilterTestCase) func(t *t
 	}
 }
 
+func TestOrderMatchesFilters(t *testing.T) {
+	runWithEachDriver(t, nil, runOrderMatchesFiltersTest)
+}
+
+func runOrderMatchesFiltersTest(t *testing.T, db *DB) {
+	storedOrders, testCases := makeOrderFilterTestCases(t, db)
+
+	// OrderMatchesFilters should agree with FindOrders for every filter that
+	// it supports.
+	numCheckedTestCases := 0
+	for i, testCase := range testCases {
+		actualMatchingOrders := []*types.OrderWithMetadata{}
+		supported := true
+		for _, order := range storedOrders {
+			matches, err := OrderMatchesFilters(order, testCase.filters)
+			if err != nil {
+				supported = false
+				break
+			}
+			if matches {
+				actualMatchingOrders = append(actualMatchingOrders, order)
+			}
+		}
+		if !supported {
+			continue
+		}
+		numCheckedTestCases++
+		testCaseName := fmt.Sprintf("%s (test case %d)", testCase.name, i)
+		t.Run(testCaseName, func(t *testing.T) {
+			assertOrderSlicesAreUnsortedEqual(t, testCase.expectedMatchingOrders, actualMatchingOrders)
+		})
+	}
+	assert.NotZero(t, numCheckedTestCases, "expected at least one supported test case")
+
+	_, err := OrderMatchesFilters(storedOrders[0], []OrderFilter{
+		{
+			Field: OrderField("unknownField"),
+			Kind:  Equal,
+			Value: true,
+		},
+	})
+	assert.Error(t, err, "expected an error for an unsupported field")
+}
+
+func TestCheckOrderFilters(t *testing.T) {
+	validFilters := []OrderFilter{
+		MakerAssetIncludesTokenAddress(constants.GanacheDummyERC721TokenAddress),
+		{
+			Kind: Or,
+			Groups: [][]OrderFilter{
+				{{Field: OFMakerAddress, Kind: Equal, Value: constants.GanacheAccount1}},
+				{{Field: OFFillableTakerAssetAmount, Kind: Greater, Value: big.NewInt(0)}},
+			},
+		},
+	}
+	assert.NoError(t, CheckOrderFilters(validFilters))
+
+	invalidFilters := []OrderFilter{
+		{
+			Kind: Or,
+			Groups: [][]OrderFilter{
+				{{Field: OFMakerAddress, Kind: Equal, Value: constants.GanacheAccount1}},
+				{{Field: OrderField("unknownField"), Kind: Equal, Value: true}},
+			},
+		},
+	}
+	assert.Error(t, CheckOrderFilters(invalidFilters), "expected an error for an unsupported field in an OR group")
+
+	assert.NoError(t, CheckOrderFiltersV4([]OrderFilterV4{{Field: OV4FMakerToken, Kind: Equal, Value: constants.GanacheAccount1}}))
+	assert.NoError(t, CheckOrderFiltersV4([]OrderFilterV4{{Field: OV4FSignature, Kind: Equal, Value: []byte{}}}))
+	assert.Error(t, CheckOrderFiltersV4([]OrderFilterV4{{Field: OrderFieldV4("unknownField"), Kind: Equal, Value: true}}))
+}
+
+func TestFindOrdersInvalidInAndOrFilters(t *testing.T) {
+	runWithEachDriver(t, nil, runFindOrdersInvalidInAndOrFiltersTest)
+}
+
+func runFindOrdersInvalidInAndOrFiltersTest(t *testing.T, db *DB) {
+
+	invalidFilters := map[string][]OrderFilter{
+		"IN with a non-list value": {
+			{
+				Field: OFMakerAssetAmount,
+				Kind:  In,
+				Value: big.NewInt(1),
+			},
+		},
+		"OR without groups": {
+			{
+				Kind: Or,
+			},
+		},
+		"OR with an empty group": {
+			{
+				Kind:   Or,
+				Groups: [][]OrderFilter{{}},
+			},
+		},
+		"groups on a non-OR filter": {
+			{
+				Field: OFMakerAssetAmount,
+				Kind:  Equal,
+				Value: big.NewInt(1),
+				Groups: [][]OrderFilter{
+					{
+						{
+							Field: OFMakerAssetAmount,
+							Kind:  Equal,
+							Value: big.NewInt(2),
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, filters := range invalidFilters {
+		_, err := db.FindOrders(&OrderQuery{Filters: filters})
+		assert.Error(t, err, name)
+	}
+}
+
 func TestCountOrdersFilter(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runCountOrdersFilterTest)
+}
+
+func runCountOrdersFilterTest(t *testing.T, db *DB) {
 	_, testCases := makeOrderFilterTestCases(t, db)
 
 	for i, testCase := range testCases {
@@ -591,10 +924,86 @@ func runCountOrdersFilterTestCase(db *DB, testCase orderFilterTestCase) func(t *
 	}
 }
 
+func TestGetOrderStats(t *testing.T) {
+	runWithEachDriver(t, nil, runGetOrderStatsTest)
+}
+
+func runGetOrderStatsTest(t *testing.T, db *DB) {
+
+	// The fillable amounts are large enough that summing them would overflow a
+	// 64-bit integer or lose precision as a float.
+	largeAmount := new(big.Int).Lsh(big.NewInt(1), 255)
+	orders := []*types.OrderWithMetadata{newTestOrder(), newTestOrder(), newTestOrder(), newTestOrder()}
+	orders[0].OrderV3.MakerAddress = constants.GanacheAccount0
+	orders[0].OrderV3.ExpirationTimeSeconds = big.NewInt(200)
+	orders[0].FillableTakerAssetAmount = largeAmount
+	orders[1].OrderV3.MakerAddress = constants.GanacheAccount1
+	orders[1].OrderV3.ExpirationTimeSeconds = big.NewInt(300)
+	orders[1].FillableTakerAssetAmount = largeAmount
+	orders[2].OrderV3.MakerAddress = constants.GanacheAccount0
+	orders[2].OrderV3.ExpirationTimeSeconds = big.NewInt(100)
+	orders[2].FillableTakerAssetAmount, _ = new(big.Int).SetString("123456789012345678901234567890", 10)
+	orders[3].OrderV3.MakerAddress = constants.GanacheAccount2
+	orders[3].OrderV3.ExpirationTimeSeconds = big.NewInt(400)
+	orders[3].FillableTakerAssetAmount = big.NewInt(1)
+	orders[3].IsRemoved = true
+	_, _, _, err := db.AddOrders(orders)
+	require.NoError(t, err)
+
+	expectedTotal := new(big.Int).Add(largeAmount, largeAmount)
+	expectedTotal.Add(expectedTotal, orders[2].FillableTakerAssetAmount)
+	stats, err := db.GetOrderStats(&OrderQuery{
+		Filters: []OrderFilter{
+			{
+				Field: OFIsRemoved,
+				Kind:  Equal,
+				Value: false,
+			},
+		},
+		// Limit should be ignored.
+		Limit: 1,
+	})
+	require.NoError(t, err)
+	assertOrderStatsAreEqual(t, &types.OrderStats{
+		OrderCount:                    3,
+		TotalFillableTakerAssetAmount: expectedTotal,
+		MakerCount:                    2,
+		MinExpirationTimeSeconds:      big.NewInt(100),
+		MaxExpirationTimeSeconds:      big.NewInt(300),
+	}, stats)
+
+	stats, err = db.GetOrderStats(nil)
+	require.NoError(t, err)
+	assertOrderStatsAreEqual(t, &types.OrderStats{
+		OrderCount:                    4,
+		TotalFillableTakerAssetAmount: new(big.Int).Add(expectedTotal, big.NewInt(1)),
+		MakerCount:                    3,
+		MinExpirationTimeSeconds:      big.NewInt(100),
+		MaxExpirationTimeSeconds:      big.NewInt(400),
+	}, stats)
+
+	// Stats for a query which matches no orders.
+	stats, err = db.GetOrderStats(&OrderQuery{
+		Filters: []OrderFilter{
+			{
+				Field: OFMakerAddress,
+				Kind:  Equal,
+				Value: constants.GanacheAccount3,
+			},
+		},
+	})
+	require.NoError(t, err)
+	assertOrderStatsAreEqual(t, &types.OrderStats{
+		OrderCount:                    0,
+		TotalFillableTakerAssetAmount: big.NewInt(0),
+	}, stats)
+}
+
 func TestDeleteOrder(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runDeleteOrderTest)
+}
+
+func runDeleteOrderTest(t *testing.T, db *DB) {
 
 	_, added, _, err := db.AddOrders([]*types.OrderWithMetadata{newTestOrder()})
 	require.NoError(t, err)
@@ -607,9 +1016,10 @@ func TestDeleteOrder(t *testing.T) {
 }
 
 func TestDeleteOrdersLimitAndOffset(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runDeleteOrdersLimitAndOffsetTest)
+}
+
+func runDeleteOrdersLimitAndOffsetTest(t *testing.T, db *DB) {
 
 	// Create orders with increasing makerAssetAmount.
 	// - orders[0].MakerAssetAmount = 0
@@ -651,9 +1061,10 @@ func TestDeleteOrdersLimitAndOffset(t *testing.T) {
 }
 
 func TestDeleteOrdersFilter(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runDeleteOrdersFilterTest)
+}
+
+func runDeleteOrdersFilterTest(t *testing.T, db *DB) {
 
 	storedOrders, testCases := makeOrderFilterTestCases(t, db)
 	for i, testCase := range testCases {
@@ -698,14 +1109,150 @@ func runDeleteOrdersFilterTestCase(db *DB, originalOrders []*types.OrderWithMeta
 	}
 }
 
-func TestAddMiniHeaders(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	dbOpts := TestOptions()
-	db, err := New(ctx, dbOpts)
+func TestAddAndFindOrderEvents(t *testing.T) {
+	runWithEachDriver(t, nil, runAddAndFindOrderEventsTest)
+}
+
+func runAddAndFindOrderEventsTest(t *testing.T, db *DB) {
+
+	oldest, latest, err := db.GetOrderEventSequenceNumberRange()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), oldest)
+	assert.Equal(t, uint64(0), latest)
+
+	numOrderEvents := 5
+	orderEvents := []*zeroex.OrderEvent{}
+	for i := 0; i < numOrderEvents; i++ {
+		orderEvents = append(orderEvents, newTestOrderEvent())
+	}
+	require.NoError(t, db.AddOrderEvents(orderEvents[:2]))
+	require.NoError(t, db.AddOrderEvents(orderEvents[2:]))
+	for i, orderEvent := range orderEvents {
+		assert.Equal(t, uint64(i+1), orderEvent.SequenceNumber, "wrong sequence number")
+	}
+
+	oldest, latest, err = db.GetOrderEventSequenceNumberRange()
 	require.NoError(t, err)
+	assert.Equal(t, uint64(1), oldest)
+	assert.Equal(t, uint64(numOrderEvents), latest)
+
+	foundOrderEvents, err := db.FindOrderEvents(nil)
+	require.NoError(t, err)
+	assertOrderEventSlicesAreEqual(t, orderEvents, foundOrderEvents)
+
+	foundOrderEvents, err = db.FindOrderEvents(&OrderEventQuery{
+		AfterSequenceNumber: 2,
+		Limit:               2,
+	})
+	require.NoError(t, err)
+	assertOrderEventSlicesAreEqual(t, orderEvents[2:4], foundOrderEvents)
+}
+
+func TestAddOrderEventsPrunesOldestEvents(t *testing.T) {
+	opts := TestOptions()
+	opts.MaxOrderEvents = 3
+	runWithEachDriver(t, opts, runAddOrderEventsPrunesOldestEventsTest)
+}
+
+func runAddOrderEventsPrunesOldestEventsTest(t *testing.T, db *DB) {
+
+	orderEvents := []*zeroex.OrderEvent{}
+	for i := 0; i < 5; i++ {
+		orderEvents = append(orderEvents, newTestOrderEvent())
+	}
+	require.NoError(t, db.AddOrderEvents(orderEvents))
+	for i, orderEvent := range orderEvents {
+		assert.Equal(t, uint64(i+1), orderEvent.SequenceNumber, "wrong sequence number")
+	}
+
+	oldest, latest, err := db.GetOrderEventSequenceNumberRange()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), oldest)
+	assert.Equal(t, uint64(5), latest)
+
+	foundOrderEvents, err := db.FindOrderEvents(nil)
+	require.NoError(t, err)
+	assertOrderEventSlicesAreEqual(t, orderEvents[2:], foundOrderEvents)
+
+	foundOrderEvents, err = db.FindOrderEvents(&OrderEventQuery{
+		AfterSequenceNumber: 3,
+		Limit:               1,
+	})
+	require.NoError(t, err)
+	assertOrderEventSlicesAreEqual(t, orderEvents[3:4], foundOrderEvents)
+}
+
+func TestWebhookDeliveries(t *testing.T) {
+	runWithEachDriver(t, nil, runWebhookDeliveriesTest)
+}
 
-	numMiniHeaders := dbOpts.MaxMiniHeaders
+func runWebhookDeliveriesTest(t *testing.T, db *DB) {
+
+	now := time.Now().UTC().Truncate(time.Second)
+	deliveries := []*types.WebhookDelivery{
+		{URL: "http://localhost:8080/a", Payload: []byte(`{"n":1}`), NextAttemptAt: now, CreatedAt: now},
+		{URL: "http://localhost:8080/b", Payload: []byte(`{"n":2}`), NextAttemptAt: now, CreatedAt: now},
+		{URL: "http://localhost:8080/a", Payload: []byte(`{"n":3}`), NextAttemptAt: now, CreatedAt: now},
+	}
+	require.NoError(t, db.AddWebhookDeliveries(deliveries))
+	for i, delivery := range deliveries {
+		assert.Equal(t, uint64(i+1), delivery.ID)
+	}
+
+	foundDeliveries, err := db.FindWebhookDeliveries(&WebhookDeliveryQuery{URL: "http://localhost:8080/a"})
+	require.NoError(t, err)
+	require.Len(t, foundDeliveries, 2)
+	assertWebhookDeliveriesAreEqual(t, deliveries[0], foundDeliveries[0])
+	assertWebhookDeliveriesAreEqual(t, deliveries[2], foundDeliveries[1])
+
+	foundDeliveries, err = db.FindWebhookDeliveries(&WebhookDeliveryQuery{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, foundDeliveries, 1)
+	assertWebhookDeliveriesAreEqual(t, deliveries[0], foundDeliveries[0])
+
+	// Update the first delivery.
+	deliveries[0].Attempts = 1
+	deliveries[0].NextAttemptAt = now.Add(time.Minute)
+	require.NoError(t, db.UpdateWebhookDelivery(deliveries[0]))
+
+	// Delete the second delivery.
+	require.NoError(t, db.DeleteWebhookDelivery(deliveries[1].ID))
+
+	foundDeliveries, err = db.FindWebhookDeliveries(nil)
+	require.NoError(t, err)
+	require.Len(t, foundDeliveries, 2)
+	assertWebhookDeliveriesAreEqual(t, deliveries[0], foundDeliveries[0])
+	assertWebhookDeliveriesAreEqual(t, deliveries[2], foundDeliveries[1])
+
+	// Updating a delivery which was deleted should return ErrNotFound.
+	assert.Equal(t, ErrNotFound, db.UpdateWebhookDelivery(deliveries[1]))
+
+	count, err := db.CountWebhookDeliveries(&WebhookDeliveryQuery{URL: "http://localhost:8080/a"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	count, err = db.CountWebhookDeliveries(&WebhookDeliveryQuery{URL: "http://localhost:8080/b"})
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	// Deliveries for endpoints which are not kept are deleted.
+	require.NoError(t, db.AddWebhookDeliveries([]*types.WebhookDelivery{
+		{URL: "http://localhost:8080/c", Payload: []byte(`{"n":4}`), NextAttemptAt: now, CreatedAt: now},
+	}))
+	numDeleted, err := db.DeleteWebhookDeliveriesExcept([]string{"http://localhost:8080/c"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, numDeleted)
+	count, err = db.CountWebhookDeliveries(nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestAddMiniHeaders(t *testing.T) {
+	runWithEachDriver(t, nil, runAddMiniHeadersTest)
+}
+
+func runAddMiniHeadersTest(t *testing.T, db *DB) {
+	maxMiniHeaders := TestOptions().MaxMiniHeaders
+	numMiniHeaders := maxMiniHeaders
 	miniHeaders := []*types.MiniHeader{}
 	for i := 0; i < numMiniHeaders; i++ {
 		// It's important to note that each miniHeader has a increasing
@@ -729,7 +1276,7 @@ func TestAddMiniHeaders(t *testing.T) {
 
 	// Create 10 more mini headers with higher block numbers.
 	miniHeadersWithHigherBlockNumbers := []*types.MiniHeader{}
-	for i := dbOpts.MaxMiniHeaders; i < dbOpts.MaxMiniHeaders+10; i++ {
+	for i := maxMiniHeaders; i < maxMiniHeaders+10; i++ {
 		// It's important to note that each miniHeader has a increasing
 		// blockNumber. Later will add more miniHeaders with higher numbers.
 		miniHeader := newTestMiniHeader()
@@ -745,9 +1292,10 @@ func TestAddMiniHeaders(t *testing.T) {
 }
 
 func TestGetMiniHeader(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runGetMiniHeaderTest)
+}
+
+func runGetMiniHeaderTest(t *testing.T, db *DB) {
 
 	added, _, err := db.AddMiniHeaders([]*types.MiniHeader{newTestMiniHeader()})
 	require.NoError(t, err)
@@ -762,9 +1310,10 @@ func TestGetMiniHeader(t *testing.T) {
 }
 
 func TestGetLatestMiniHeader(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runGetLatestMiniHeaderTest)
+}
+
+func runGetLatestMiniHeaderTest(t *testing.T, db *DB) {
 
 	numMiniHeaders := 3
 	storedMiniHeaders := []*types.MiniHeader{}
@@ -781,10 +1330,40 @@ func TestGetLatestMiniHeader(t *testing.T) {
 	assertMiniHeadersAreEqual(t, storedMiniHeaders[2], foundMiniHeader)
 }
 
+func TestFindOrphanedMiniHeaders(t *testing.T) {
+	runWithEachDriver(t, nil, runFindOrphanedMiniHeadersTest)
+}
+
+func runFindOrphanedMiniHeadersTest(t *testing.T, db *DB) {
+
+	orphaned, err := db.FindOrphanedMiniHeaders()
+	require.NoError(t, err)
+	assert.Len(t, orphaned, 0)
+
+	// The stored chain is 2 <- 3 <- 4. 0 <- 1 are orphaned because 2 points to
+	// a parent which isn't stored.
+	miniHeaders := []*types.MiniHeader{}
+	for i := 0; i < 5; i++ {
+		miniHeader := newTestMiniHeader()
+		miniHeader.Number = big.NewInt(int64(i))
+		if i > 0 && i != 2 {
+			miniHeader.Parent = miniHeaders[i-1].Hash
+		}
+		miniHeaders = append(miniHeaders, miniHeader)
+	}
+	_, _, err = db.AddMiniHeaders(miniHeaders)
+	require.NoError(t, err)
+
+	orphaned, err = db.FindOrphanedMiniHeaders()
+	require.NoError(t, err)
+	assertMiniHeaderSlicesAreEqual(t, miniHeaders[:2], orphaned)
+}
+
 func TestFindMiniHeaders(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runFindMiniHeadersTest)
+}
+
+func runFindMiniHeadersTest(t *testing.T, db *DB) {
 
 	numMiniHeaders := 10
 	originalMiniHeaders := []*types.MiniHeader{}
@@ -800,9 +1379,10 @@ func TestFindMiniHeaders(t *testing.T) {
 }
 
 func TestFindMiniHeadersSort(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runFindMiniHeadersSortTest)
+}
+
+func runFindMiniHeadersSortTest(t *testing.T, db *DB) {
 
 	// Create some test miniHeaders with carefully chosen Number and Timestamp
 	// values for testing sorting.
@@ -896,9 +1476,10 @@ func runFindMiniHeadersSortTestCase(db *DB, originalMiniHeaders []*types.MiniHea
 }
 
 func TestFindMiniHeadersLimitAndOffset(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runFindMiniHeadersLimitAndOffsetTest)
+}
+
+func runFindMiniHeadersLimitAndOffsetTest(t *testing.T, db *DB) {
 
 	numMiniHeaders := 10
 	originalMiniHeaders := []*types.MiniHeader{}
@@ -979,9 +1560,10 @@ func runFindMiniHeadersLimitAndOffsetTestCase(db *DB, testCase findMiniHeadersLi
 }
 
 func TestFindMiniHeadersFilter(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runFindMiniHeadersFilterTest)
+}
+
+func runFindMiniHeadersFilterTest(t *testing.T, db *DB) {
 
 	_, testCases := makeMiniHeaderFilterTestCases(t, db)
 	for i, testCase := range testCases {
@@ -1003,9 +1585,10 @@ func runFindMiniHeadersFilterTestCase(db *DB, testCase miniHeaderFilterTestCase)
 }
 
 func TestDeleteMiniHeader(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runDeleteMiniHeaderTest)
+}
+
+func runDeleteMiniHeaderTest(t *testing.T, db *DB) {
 
 	added, _, err := db.AddMiniHeaders([]*types.MiniHeader{newTestMiniHeader()})
 	require.NoError(t, err)
@@ -1018,9 +1601,10 @@ func TestDeleteMiniHeader(t *testing.T) {
 }
 
 func TestDeleteMiniHeadersLimitAndOffset(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runDeleteMiniHeadersLimitAndOffsetTest)
+}
+
+func runDeleteMiniHeadersLimitAndOffsetTest(t *testing.T, db *DB) {
 
 	// Create miniHeaders with increasing Number.
 	// - miniHeaders[0].Number = 0
@@ -1062,9 +1646,10 @@ func TestDeleteMiniHeadersLimitAndOffset(t *testing.T) {
 }
 
 func TestDeleteMiniHeadersFilter(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runDeleteMiniHeadersFilterTest)
+}
+
+func runDeleteMiniHeadersFilterTest(t *testing.T, db *DB) {
 	storedMiniHeaders, testCases := makeMiniHeaderFilterTestCases(t, db)
 
 	for i, testCase := range testCases {
@@ -1111,9 +1696,10 @@ func runDeleteMiniHeadersFilterTestCase(db *DB, storedMiniHeaders []*types.MiniH
 }
 
 func TestSaveMetadata(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runSaveMetadataTest)
+}
+
+func runSaveMetadataTest(t *testing.T, db *DB) {
 
 	err := db.SaveMetadata(newTestMetadata())
 	require.NoError(t, err)
@@ -1124,9 +1710,10 @@ func TestSaveMetadata(t *testing.T) {
 }
 
 func TestGetMetadata(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runGetMetadataTest)
+}
+
+func runGetMetadataTest(t *testing.T, db *DB) {
 
 	_, err := db.GetMetadata()
 	assert.EqualError(t, err, ErrNotFound.Error(), "calling GetMetadata when it hasn't been saved yet should return ErrNotFound")
@@ -1142,9 +1729,10 @@ func TestGetMetadata(t *testing.T) {
 }
 
 func TestUpdateMetadata(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runUpdateMetadataTest)
+}
+
+func runUpdateMetadataTest(t *testing.T, db *DB) {
 
 	err := db.UpdateMetadata(func(existingMetadata *types.Metadata) *types.Metadata {
 		return existingMetadata
@@ -1222,6 +1810,25 @@ func newTestDB(t testing.TB, ctx context.Context) *DB {
 	return db
 }
 
+// runWithEachDriver runs test against a new database for each of the
+// implementations in testDrivers. Each database is created with a copy of
+// opts, or with TestOptions if opts is nil.
+func runWithEachDriver(t *testing.T, opts *Options, test func(t *testing.T, db *DB)) {
+	for driverName, newDB := range testDrivers {
+		newDB := newDB
+		t.Run(driverName, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			var driverOpts *Options
+			if opts != nil {
+				optsCopy := *opts
+				driverOpts = &optsCopy
+			}
+			test(t, newDB(t, ctx, driverOpts))
+		})
+	}
+}
+
 // newTestOrder returns a new order with a random hash that is ready to insert
 // into the database. Some computed fields (e.g. hash, signature) may not be
 // correct, so the order will not pass 0x validation.
@@ -1273,6 +1880,12 @@ func newTestOrder() *types.OrderWithMetadata {
 				TokenID: big.NewInt(567),
 			},
 		},
+		ParsedTakerAssetData: []*types.SingleAssetData{
+			{
+				Address: constants.GanacheDummyERC1155MintableAddress,
+				TokenID: big.NewInt(42),
+			},
+		},
 		LastValidatedBlockNumber: big.NewInt(int64(rand.Int())),
 		LastValidatedBlockHash:   common.BigToHash(big.NewInt(int64(rand.Int()))),
 	}
@@ -1379,6 +1992,9 @@ func createAndStoreOrdersForFilterTests(t *testing.T, db *DB) []*types.OrderWith
 	// - MakerAssetData will be 'a', 'b', 'c', etc.
 	// - ParsedMakerAssetData will always be for the ERC721Dummy contract, and each will contain
 	//   two token ids: (0, 1), (0, 11), (0, 21), (0, 31) etc.
+	// - ParsedTakerAssetData will contain the ERC1155Mintable token with ids 0, 1, 2, etc. and,
+	//   for the last five orders, the ZRX token.
+	// - ParsedTakerFeeAssetData will contain the ZRX token for orders with an even index.
 	numOrders := 10
 	storedOrders := []*types.OrderWithMetadata{}
 	for i := 0; i < numOrders; i++ {
@@ -1397,6 +2013,25 @@ func createAndStoreOrdersForFilterTests(t *testing.T, db *DB) []*types.OrderWith
 			},
 		}
 		order.ParsedMakerAssetData = parsedMakerAssetData
+		order.ParsedTakerAssetData = []*types.SingleAssetData{
+			{
+				Address: constants.GanacheDummyERC1155MintableAddress,
+				TokenID: big.NewInt(int64(i)),
+			},
+		}
+		if i >= 5 {
+			order.ParsedTakerAssetData = append(order.ParsedTakerAssetData, &types.SingleAssetData{
+				Address: contractAddresses.ZRXToken,
+			})
+		}
+		order.ParsedTakerFeeAssetData = nil
+		if i%2 == 0 {
+			order.ParsedTakerFeeAssetData = []*types.SingleAssetData{
+				{
+					Address: contractAddresses.ZRXToken,
+				},
+			}
+		}
 		storedOrders = append(storedOrders, order)
 	}
 	_, _, _, err := db.AddOrders(storedOrders)
@@ -1680,6 +2315,57 @@ func makeOrderFilterTestCases(t *testing.T, db *DB) ([]*types.OrderWithMetadata,
 			},
 			expectedMatchingOrders: storedOrders,
 		},
+		{
+			name: "ParsedMakerAssetData CONTAINS token ID with helper method query that matches one",
+			filters: []OrderFilter{
+				MakerAssetIncludesTokenID(big.NewInt(11)),
+			},
+			expectedMatchingOrders: storedOrders[1:2],
+		},
+
+		// Filter on ParsedTakerAssetData and ParsedTakerFeeAssetData (type ParsedAssetData/TEXT)
+		{
+			name: "ParsedTakerAssetData CONTAINS token address with helper method query that matches all",
+			filters: []OrderFilter{
+				TakerAssetIncludesTokenAddress(constants.GanacheDummyERC1155MintableAddress),
+			},
+			expectedMatchingOrders: storedOrders,
+		},
+		{
+			name: "ParsedTakerAssetData CONTAINS token address with helper method query that matches some",
+			filters: []OrderFilter{
+				TakerAssetIncludesTokenAddress(contractAddresses.ZRXToken),
+			},
+			expectedMatchingOrders: storedOrders[5:],
+		},
+		{
+			name: "ParsedTakerAssetData CONTAINS token ID with helper method query that matches one",
+			filters: []OrderFilter{
+				TakerAssetIncludesTokenID(big.NewInt(3)),
+			},
+			expectedMatchingOrders: storedOrders[3:4],
+		},
+		{
+			name: "ParsedTakerAssetData CONTAINS token address and token ID with helper method query that matches one",
+			filters: []OrderFilter{
+				TakerAssetIncludesTokenAddressAndTokenID(constants.GanacheDummyERC1155MintableAddress, big.NewInt(7)),
+			},
+			expectedMatchingOrders: storedOrders[7:8],
+		},
+		{
+			name: "ParsedTakerAssetData CONTAINS token address and token ID with helper method query that matches none",
+			filters: []OrderFilter{
+				TakerAssetIncludesTokenAddressAndTokenID(constants.GanacheDummyERC721TokenAddress, big.NewInt(7)),
+			},
+			expectedMatchingOrders: []*types.OrderWithMetadata{},
+		},
+		{
+			name: "ParsedTakerFeeAssetData CONTAINS token address with helper method query that matches some",
+			filters: []OrderFilter{
+				TakerFeeAssetIncludesTokenAddress(contractAddresses.ZRXToken),
+			},
+			expectedMatchingOrders: []*types.OrderWithMetadata{storedOrders[0], storedOrders[2], storedOrders[4], storedOrders[6], storedOrders[8]},
+		},
 
 		// Combining two or more filters
 		{
@@ -1719,6 +2405,145 @@ func makeOrderFilterTestCases(t *testing.T, db *DB) ([]*types.OrderWithMetadata,
 			},
 			expectedMatchingOrders: append(safeSubsliceOrders(storedOrders, 3, 5), safeSubsliceOrders(storedOrders, 6, 7)...),
 		},
+
+		// IN and OR filters
+		{
+			name: "MakerAssetAmount IN (1, 4, 8)",
+			filters: []OrderFilter{
+				{
+					Field: OFMakerAssetAmount,
+					Kind:  In,
+					Value: []interface{}{big.NewInt(1), big.NewInt(4), big.NewInt(8)},
+				},
+			},
+			expectedMatchingOrders: []*types.OrderWithMetadata{storedOrders[1], storedOrders[4], storedOrders[8]},
+		},
+		{
+			name: "MakerAssetData IN (b, c, z)",
+			filters: []OrderFilter{
+				{
+					Field: OFMakerAssetData,
+					Kind:  In,
+					Value: []interface{}{[]byte("b"), []byte("c"), []byte("z")},
+				},
+			},
+			expectedMatchingOrders: storedOrders[1:3],
+		},
+		{
+			name: "MakerAssetAmount IN ()",
+			filters: []OrderFilter{
+				{
+					Field: OFMakerAssetAmount,
+					Kind:  In,
+					Value: []interface{}{},
+				},
+			},
+			expectedMatchingOrders: []*types.OrderWithMetadata{},
+		},
+		{
+			name: "MakerAssetAmount < 2 OR MakerAssetAmount > 7",
+			filters: []OrderFilter{
+				{
+					Kind: Or,
+					Groups: [][]OrderFilter{
+						{
+							{
+								Field: OFMakerAssetAmount,
+								Kind:  Less,
+								Value: big.NewInt(2),
+							},
+						},
+						{
+							{
+								Field: OFMakerAssetAmount,
+								Kind:  Greater,
+								Value: big.NewInt(7),
+							},
+						},
+					},
+				},
+			},
+			expectedMatchingOrders: append(safeSubsliceOrders(storedOrders, 0, 2), safeSubsliceOrders(storedOrders, 8, 10)...),
+		},
+		{
+			name: "TakerAssetAmount != 5 AND ((MakerAssetAmount >= 3 AND MakerAssetData < h) OR MakerAssetData IN (a, j))",
+			filters: []OrderFilter{
+				{
+					Field: OFTakerAssetAmount,
+					Kind:  NotEqual,
+					Value: big.NewInt(5),
+				},
+				{
+					Kind: Or,
+					Groups: [][]OrderFilter{
+						{
+							{
+								Field: OFMakerAssetAmount,
+								Kind:  GreaterOrEqual,
+								Value: big.NewInt(3),
+							},
+							{
+								Field: OFMakerAssetData,
+								Kind:  Less,
+								Value: []byte("h"),
+							},
+						},
+						{
+							{
+								Field: OFMakerAssetData,
+								Kind:  In,
+								Value: []interface{}{[]byte("a"), []byte("j")},
+							},
+						},
+					},
+				},
+			},
+			expectedMatchingOrders: []*types.OrderWithMetadata{storedOrders[0], storedOrders[3], storedOrders[4], storedOrders[6], storedOrders[9]},
+		},
+		{
+			name: "nested OR",
+			filters: []OrderFilter{
+				{
+					Kind: Or,
+					Groups: [][]OrderFilter{
+						{
+							{
+								Field: OFMakerAssetAmount,
+								Kind:  Equal,
+								Value: big.NewInt(0),
+							},
+						},
+						{
+							{
+								Field: OFMakerAssetAmount,
+								Kind:  Greater,
+								Value: big.NewInt(5),
+							},
+							{
+								Kind: Or,
+								Groups: [][]OrderFilter{
+									{
+										{
+											Field: OFMakerAssetData,
+											Kind:  Equal,
+											Value: []byte("g"),
+										},
+									},
+									{
+										{
+											Field: OFTakerAssetAmount,
+											Kind:  Equal,
+											Value: big.NewInt(9),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedMatchingOrders: []*types.OrderWithMetadata{storedOrders[0], storedOrders[6], storedOrders[9]},
+		},
 	}
 
 	return storedOrders, testCases
@@ -2074,6 +2899,58 @@ func lessByTakerAssetAmountDescAndMakerAssetAmountDesc(orders []*types.OrderWith
 	}
 }
 
+func lessByPriceAsc(orders []*types.OrderWithMetadata) func(i, j int) bool {
+	return func(i, j int) bool {
+		return orders[i].Price() < orders[j].Price()
+	}
+}
+
+func newTestOrderEvent() *zeroex.OrderEvent {
+	order := newTestOrder()
+	return &zeroex.OrderEvent{
+		Timestamp: time.Now().UTC(),
+		OrderHash: order.Hash,
+		SignedOrder: &zeroex.SignedOrder{
+			Order:     *order.OrderV3,
+			Signature: order.Signature,
+		},
+		EndState:                 zeroex.ESOrderAdded,
+		FillableTakerAssetAmount: order.FillableTakerAssetAmount,
+		ContractEvents:           []*zeroex.ContractEvent{},
+	}
+}
+
+func assertOrderEventSlicesAreEqual(t *testing.T, expected, actual []*zeroex.OrderEvent) {
+	require.Equal(t, len(expected), len(actual), "wrong number of order events")
+	for i, expectedOrderEvent := range expected {
+		actualOrderEvent := actual[i]
+		assert.Equal(t, expectedOrderEvent.SequenceNumber, actualOrderEvent.SequenceNumber, "wrong sequence number")
+		assert.Equal(t, expectedOrderEvent.OrderHash, actualOrderEvent.OrderHash, "wrong order hash")
+		assert.Equal(t, expectedOrderEvent.EndState, actualOrderEvent.EndState, "wrong end state")
+		assert.True(t, expectedOrderEvent.Timestamp.Equal(actualOrderEvent.Timestamp), "wrong timestamp")
+		assert.Equal(t, expectedOrderEvent.FillableTakerAssetAmount, actualOrderEvent.FillableTakerAssetAmount, "wrong fillable taker asset amount")
+		assert.Equal(t, expectedOrderEvent.SignedOrder, actualOrderEvent.SignedOrder, "wrong signed order")
+	}
+}
+
+func assertOrderStatsAreEqual(t *testing.T, expected, actual *types.OrderStats) {
+	assert.Equal(t, expected.OrderCount, actual.OrderCount, "OrderCount")
+	assert.Equal(t, expected.TotalFillableTakerAssetAmount.String(), actual.TotalFillableTakerAssetAmount.String(), "TotalFillableTakerAssetAmount")
+	assert.Equal(t, expected.MakerCount, actual.MakerCount, "MakerCount")
+	if expected.MinExpirationTimeSeconds == nil {
+		assert.Nil(t, actual.MinExpirationTimeSeconds, "MinExpirationTimeSeconds")
+	} else {
+		require.NotNil(t, actual.MinExpirationTimeSeconds, "MinExpirationTimeSeconds")
+		assert.Equal(t, expected.MinExpirationTimeSeconds.String(), actual.MinExpirationTimeSeconds.String(), "MinExpirationTimeSeconds")
+	}
+	if expected.MaxExpirationTimeSeconds == nil {
+		assert.Nil(t, actual.MaxExpirationTimeSeconds, "MaxExpirationTimeSeconds")
+	} else {
+		require.NotNil(t, actual.MaxExpirationTimeSeconds, "MaxExpirationTimeSeconds")
+		assert.Equal(t, expected.MaxExpirationTimeSeconds.String(), actual.MaxExpirationTimeSeconds.String(), "MaxExpirationTimeSeconds")
+	}
+}
+
 func assertOrderSlicesAreEqual(t *testing.T, expected, actual []*types.OrderWithMetadata) {
 	assert.Equal(t, len(expected), len(actual), "wrong number of orders")
 	for i, expectedOrder := range expected {
@@ -2244,3 +3121,12 @@ func assertMetadatasAreEqual(t *testing.T, expected, actual *types.Metadata) {
 	// We can compare the rest of the fields normally.
 	assert.Equal(t, expected, actual)
 }
+
+func assertWebhookDeliveriesAreEqual(t *testing.T, expected, actual *types.WebhookDelivery) {
+	assert.Equal(t, expected.ID, actual.ID, "ID")
+	assert.Equal(t, expected.URL, actual.URL, "URL")
+	assert.Equal(t, expected.Payload, actual.Payload, "Payload")
+	assert.Equal(t, expected.Attempts, actual.Attempts, "Attempts")
+	assert.True(t, expected.NextAttemptAt.Equal(actual.NextAttemptAt), "NextAttemptAt: expected %s but got %s", expected.NextAttemptAt, actual.NextAttemptAt)
+	assert.True(t, expected.CreatedAt.Equal(actual.CreatedAt), "CreatedAt: expected %s but got %s", expected.CreatedAt, actual.CreatedAt)
+}
@@ -1,20 +1,23 @@
 package db
 
 import (
-	"context"
+	"bytes"
 	"math/big"
 	"testing"
 
 	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/constants"
+	"github.com/0xProject/0x-mesh/zeroex"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func TestAddOrdersV4(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runAddOrdersV4Test)
+}
+
+func runAddOrdersV4Test(t *testing.T, db *DB) {
 
 	numOrders := 10
 	orderHashes := []common.Hash{}
@@ -49,9 +52,10 @@ func TestAddOrdersV4(t *testing.T) {
 }
 
 func TestGetOrderV4(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runGetOrderV4Test)
+}
+
+func runGetOrderV4Test(t *testing.T, db *DB) {
 
 	_, added, _, err := db.AddOrdersV4([]*types.OrderWithMetadata{newTestOrderV4()})
 	require.NoError(t, err)
@@ -67,9 +71,10 @@ func TestGetOrderV4(t *testing.T) {
 }
 
 func TestGetOrderStatusesV4(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runGetOrderStatusesV4Test)
+}
+
+func runGetOrderStatusesV4Test(t *testing.T, db *DB) {
 
 	removedOrder := newTestOrderV4()
 	removedOrder.IsRemoved = true
@@ -109,9 +114,10 @@ func TestGetOrderStatusesV4(t *testing.T) {
 }
 
 func TestUpdateOrderV4(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runUpdateOrderV4Test)
+}
+
+func runUpdateOrderV4Test(t *testing.T, db *DB) {
 
 	err := db.UpdateOrderV4(common.Hash{}, func(existingOrder *types.OrderWithMetadata) (*types.OrderWithMetadata, error) {
 		return existingOrder, nil
@@ -146,9 +152,10 @@ func TestUpdateOrderV4(t *testing.T) {
 }
 
 func TestFindOrdersV4(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runFindOrdersV4Test)
+}
+
+func runFindOrdersV4Test(t *testing.T, db *DB) {
 
 	numOrders := 10
 	originalOrders := []*types.OrderWithMetadata{}
@@ -164,9 +171,10 @@ func TestFindOrdersV4(t *testing.T) {
 }
 
 func TestFindOrdersFilterSortLimitAndOffsetV4(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	db := newTestDB(t, ctx)
+	runWithEachDriver(t, nil, runFindOrdersFilterSortLimitAndOffsetV4Test)
+}
+
+func runFindOrdersFilterSortLimitAndOffsetV4Test(t *testing.T, db *DB) {
 	storedOrders := createAndStoreOrdersForFilterTestsV4(t, db)
 
 	query := &OrderQueryV4{
@@ -191,3 +199,195 @@ func TestFindOrdersFilterSortLimitAndOffsetV4(t *testing.T) {
 	require.NoError(t, err)
 	assertOrderSlicesAreEqual(t, expectedOrders, actualOrders)
 }
+
+func TestFindOrdersAfterV4(t *testing.T) {
+	runWithEachDriver(t, nil, runFindOrdersAfterV4Test)
+}
+
+func runFindOrdersAfterV4Test(t *testing.T, db *DB) {
+
+	numOrders := 10
+	originalOrders := []*types.OrderWithMetadata{}
+	for i := 0; i < numOrders; i++ {
+		order := newTestOrderV4()
+		order.OrderV4.MakerAmount = big.NewInt(int64(i % 3))
+		originalOrders = append(originalOrders, order)
+	}
+	_, _, _, err := db.AddOrdersV4(originalOrders)
+	require.NoError(t, err)
+
+	sortOpts := []OrderSortV4{
+		{
+			Field:     OV4FMakerAmount,
+			Direction: Descending,
+		},
+		{
+			Field:     OV4FHash,
+			Direction: Ascending,
+		},
+	}
+	expectedOrders, err := db.FindOrdersV4(&OrderQueryV4{Sort: sortOpts})
+	require.NoError(t, err)
+	require.Len(t, expectedOrders, numOrders)
+
+	actualOrders, err := db.FindOrdersV4(&OrderQueryV4{Sort: sortOpts, Limit: 4})
+	require.NoError(t, err)
+	for page := actualOrders; len(page) > 0; {
+		after, err := OrderSortValuesV4(page[len(page)-1], sortOpts)
+		require.NoError(t, err)
+		page, err = db.FindOrdersAfterV4(&OrderQueryV4{Sort: sortOpts, Limit: 4}, after)
+		require.NoError(t, err)
+		actualOrders = append(actualOrders, page...)
+	}
+	assertOrderSlicesAreEqual(t, expectedOrders, actualOrders)
+}
+
+func TestFindOrdersAfterV4BySignature(t *testing.T) {
+	runWithEachDriver(t, nil, runFindOrdersAfterV4BySignatureTest)
+}
+
+func runFindOrdersAfterV4BySignatureTest(t *testing.T, db *DB) {
+	numOrders := 10
+	originalOrders := []*types.OrderWithMetadata{}
+	for i := 0; i < numOrders; i++ {
+		order := newTestOrderV4()
+		// Two orders share each signature so that ties are broken by hash.
+		order.SignatureV4.R = zeroex.BigToBytes32(big.NewInt(int64(numOrders - i/2)))
+		originalOrders = append(originalOrders, order)
+	}
+	_, _, _, err := db.AddOrdersV4(originalOrders)
+	require.NoError(t, err)
+
+	sortOpts := []OrderSortV4{
+		{
+			Field:     OV4FSignature,
+			Direction: Ascending,
+		},
+		{
+			Field:     OV4FHash,
+			Direction: Ascending,
+		},
+	}
+	expectedOrders, err := db.FindOrdersV4(&OrderQueryV4{Sort: sortOpts})
+	require.NoError(t, err)
+	require.Len(t, expectedOrders, numOrders)
+	for i := 1; i < numOrders; i++ {
+		assert.True(t, bytes.Compare(expectedOrders[i-1].SignatureV4.Bytes(), expectedOrders[i].SignatureV4.Bytes()) <= 0, "orders should be sorted by signature")
+	}
+
+	actualOrders, err := db.FindOrdersV4(&OrderQueryV4{Sort: sortOpts, Limit: 3})
+	require.NoError(t, err)
+	for page := actualOrders; len(page) > 0; {
+		after, err := OrderSortValuesV4(page[len(page)-1], sortOpts)
+		require.NoError(t, err)
+		page, err = db.FindOrdersAfterV4(&OrderQueryV4{Sort: sortOpts, Limit: 3}, after)
+		require.NoError(t, err)
+		actualOrders = append(actualOrders, page...)
+	}
+	assertOrderSlicesAreEqual(t, expectedOrders, actualOrders)
+
+	filteredOrders, err := db.FindOrdersV4(&OrderQueryV4{
+		Filters: []OrderFilterV4{{Field: OV4FSignature, Kind: Equal, Value: originalOrders[0].SignatureV4.Bytes()}},
+	})
+	require.NoError(t, err)
+	assert.Len(t, filteredOrders, 2)
+}
+
+func TestFindOrdersInAndOrFiltersV4(t *testing.T) {
+	runWithEachDriver(t, nil, runFindOrdersInAndOrFiltersV4Test)
+}
+
+func runFindOrdersInAndOrFiltersV4Test(t *testing.T, db *DB) {
+	storedOrders := createAndStoreOrdersForFilterTestsV4(t, db)
+
+	filters := []OrderFilterV4{
+		{
+			Kind: Or,
+			Groups: [][]OrderFilterV4{
+				{
+					{
+						Field: OV4FMakerAmount,
+						Kind:  In,
+						Value: []interface{}{big.NewInt(1), big.NewInt(2)},
+					},
+				},
+				{
+					{
+						Field: OV4FTakerAmount,
+						Kind:  GreaterOrEqual,
+						Value: big.NewInt(8),
+					},
+				},
+			},
+		},
+	}
+	expectedOrders := []*types.OrderWithMetadata{storedOrders[1], storedOrders[2], storedOrders[8], storedOrders[9]}
+	foundOrders, err := db.FindOrdersV4(&OrderQueryV4{Filters: filters})
+	require.NoError(t, err)
+	assertOrderSlicesAreUnsortedEqual(t, expectedOrders, foundOrders)
+
+	count, err := db.CountOrdersV4(&OrderQueryV4{Filters: filters})
+	require.NoError(t, err)
+	assert.Equal(t, len(expectedOrders), count)
+
+	expectedHashes := map[common.Hash]bool{}
+	for _, order := range expectedOrders {
+		expectedHashes[order.Hash] = true
+	}
+	for _, order := range storedOrders {
+		matches, err := OrderMatchesFiltersV4(order, filters)
+		require.NoError(t, err)
+		assert.Equal(t, expectedHashes[order.Hash], matches)
+	}
+}
+
+func TestGetOrderStatsV4(t *testing.T) {
+	runWithEachDriver(t, nil, runGetOrderStatsV4Test)
+}
+
+func runGetOrderStatsV4Test(t *testing.T, db *DB) {
+
+	largeAmount := new(big.Int).Lsh(big.NewInt(1), 255)
+	orders := []*types.OrderWithMetadata{newTestOrderV4(), newTestOrderV4(), newTestOrderV4()}
+	orders[0].OrderV4.Maker = constants.GanacheAccount0
+	orders[0].OrderV4.Expiry = big.NewInt(200)
+	orders[0].FillableTakerAssetAmount = largeAmount
+	orders[1].OrderV4.Maker = constants.GanacheAccount1
+	orders[1].OrderV4.Expiry = big.NewInt(300)
+	orders[1].FillableTakerAssetAmount = largeAmount
+	orders[2].OrderV4.Maker = constants.GanacheAccount0
+	orders[2].OrderV4.Expiry = big.NewInt(100)
+	orders[2].FillableTakerAssetAmount = big.NewInt(7)
+	_, _, _, err := db.AddOrdersV4(orders)
+	require.NoError(t, err)
+
+	expectedTotal := new(big.Int).Add(largeAmount, largeAmount)
+	expectedTotal.Add(expectedTotal, big.NewInt(7))
+	stats, err := db.GetOrderStatsV4(nil)
+	require.NoError(t, err)
+	assertOrderStatsAreEqual(t, &types.OrderStats{
+		OrderCount:                    3,
+		TotalFillableTakerAssetAmount: expectedTotal,
+		MakerCount:                    2,
+		MinExpirationTimeSeconds:      big.NewInt(100),
+		MaxExpirationTimeSeconds:      big.NewInt(300),
+	}, stats)
+
+	stats, err = db.GetOrderStatsV4(&OrderQueryV4{
+		Filters: []OrderFilterV4{
+			{
+				Field: OV4FMaker,
+				Kind:  Equal,
+				Value: constants.GanacheAccount0,
+			},
+		},
+	})
+	require.NoError(t, err)
+	assertOrderStatsAreEqual(t, &types.OrderStats{
+		OrderCount:                    2,
+		TotalFillableTakerAssetAmount: new(big.Int).Add(largeAmount, big.NewInt(7)),
+		MakerCount:                    1,
+		MinExpirationTimeSeconds:      big.NewInt(100),
+		MaxExpirationTimeSeconds:      big.NewInt(200),
+	}, stats)
+}
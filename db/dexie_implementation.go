@@ -45,6 +45,7 @@ func TestOptions() *Options {
 		DataSourceName: filepath.Join("mesh_testing", uuid.New().String()),
 		MaxOrders:      100,
 		MaxMiniHeaders: 20,
+		MaxOrderEvents: 100,
 	}
 }
 
@@ -54,6 +55,7 @@ func defaultOptions() *Options {
 		DataSourceName: "mesh_dexie_database",
 		MaxOrders:      100000,
 		MaxMiniHeaders: 20,
+		MaxOrderEvents: 100000,
 	}
 }
 
@@ -237,6 +239,39 @@ func (db *DB) CountOrders(query *OrderQuery) (count int, err error) {
 	return jsCount.Int(), nil
 }
 
+// GetOrderStats returns aggregate values for all orders that match the
+// filters of the given query. The sort options, limit and offset of the query
+// are ignored. Dexie.js has no support for aggregates, so the matching orders
+// are loaded and the values are computed in Go.
+func (db *DB) GetOrderStats(query *OrderQuery) (*types.OrderStats, error) {
+	var filters []OrderFilter
+	if query != nil {
+		filters = query.Filters
+	}
+	orders, err := db.FindOrders(&OrderQuery{Filters: filters})
+	if err != nil {
+		return nil, err
+	}
+	stats := &types.OrderStats{
+		OrderCount:                    len(orders),
+		TotalFillableTakerAssetAmount: big.NewInt(0),
+	}
+	makers := map[common.Address]struct{}{}
+	for _, order := range orders {
+		makers[order.OrderV3.MakerAddress] = struct{}{}
+		stats.TotalFillableTakerAssetAmount.Add(stats.TotalFillableTakerAssetAmount, order.FillableTakerAssetAmount)
+		expirationTime := order.OrderV3.ExpirationTimeSeconds
+		if stats.MinExpirationTimeSeconds == nil || expirationTime.Cmp(stats.MinExpirationTimeSeconds) < 0 {
+			stats.MinExpirationTimeSeconds = expirationTime
+		}
+		if stats.MaxExpirationTimeSeconds == nil || expirationTime.Cmp(stats.MaxExpirationTimeSeconds) > 0 {
+			stats.MaxExpirationTimeSeconds = expirationTime
+		}
+	}
+	stats.MakerCount = len(makers)
+	return stats, nil
+}
+
 func (db *DB) DeleteOrder(hash common.Hash) (err error) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -509,12 +544,19 @@ func formatOrderQuery(query *OrderQuery) *OrderQuery {
 	if query == nil {
 		return nil
 	}
-	for i, filter := range query.Filters {
-		query.Filters[i].Value = convertFilterValue(filter.Value)
-	}
+	formatOrderFilters(query.Filters)
 	return query
 }
 
+func formatOrderFilters(filters []OrderFilter) {
+	for i, filter := range filters {
+		filters[i].Value = convertFilterValue(filter.Value)
+		for _, group := range filter.Groups {
+			formatOrderFilters(group)
+		}
+	}
+}
+
 func formatMiniHeaderQuery(query *MiniHeaderQuery) *MiniHeaderQuery {
 	if query == nil {
 		return nil
@@ -531,6 +573,12 @@ func convertFilterValue(value interface{}) interface{} {
 		return dexietypes.NewSortedBigInt(v)
 	case bool:
 		return dexietypes.BoolToUint8(v)
+	case []interface{}:
+		values := make([]interface{}, len(v))
+		for i, value := range v {
+			values[i] = convertFilterValue(value)
+		}
+		return values
 	}
 	return value
 }
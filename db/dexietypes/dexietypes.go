@@ -161,6 +161,8 @@ type Order struct {
 	IsExpired                uint8          `json:"isExpired"`
 	ParsedMakerAssetData     string         `json:"parsedMakerAssetData"`
 	ParsedMakerFeeAssetData  string         `json:"parsedMakerFeeAssetData"`
+	ParsedTakerAssetData     string         `json:"parsedTakerAssetData"`
+	ParsedTakerFeeAssetData  string         `json:"parsedTakerFeeAssetData"`
 	LastValidatedBlockNumber *SortedBigInt  `json:"lastValidatedBlockNumber"`
 	LastValidatedBlockHash   common.Hash    `json:"lastValidatedBlockHash"`
 	KeepCancelled            uint8          `json:"keepCancelled"`
@@ -206,6 +208,8 @@ func OrderToCommonType(order *Order) *types.OrderWithMetadata {
 		IsExpired:                order.IsExpired == 1,
 		ParsedMakerAssetData:     ParsedAssetDataToCommonType(order.ParsedMakerAssetData),
 		ParsedMakerFeeAssetData:  ParsedAssetDataToCommonType(order.ParsedMakerFeeAssetData),
+		ParsedTakerAssetData:     ParsedAssetDataToCommonType(order.ParsedTakerAssetData),
+		ParsedTakerFeeAssetData:  ParsedAssetDataToCommonType(order.ParsedTakerFeeAssetData),
 		LastValidatedBlockNumber: order.LastValidatedBlockNumber.Int,
 		LastValidatedBlockHash:   order.LastValidatedBlockHash,
 		KeepCancelled:            order.KeepCancelled == 1,
@@ -247,6 +251,8 @@ func OrderFromCommonType(order *types.OrderWithMetadata) *Order {
 		IsExpired:                BoolToUint8(order.IsExpired),
 		ParsedMakerAssetData:     ParsedAssetDataFromCommonType(order.ParsedMakerAssetData),
 		ParsedMakerFeeAssetData:  ParsedAssetDataFromCommonType(order.ParsedMakerFeeAssetData),
+		ParsedTakerAssetData:     ParsedAssetDataFromCommonType(order.ParsedTakerAssetData),
+		ParsedTakerFeeAssetData:  ParsedAssetDataFromCommonType(order.ParsedTakerFeeAssetData),
 		LastValidatedBlockNumber: NewSortedBigInt(order.LastValidatedBlockNumber),
 		LastValidatedBlockHash:   order.LastValidatedBlockHash,
 		KeepCancelled:            BoolToUint8(order.KeepCancelled),
@@ -0,0 +1,24 @@
+// +build js,wasm
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testDrivers contains a function which creates a new database for each of
+// the implementations that can be used in the browser. If opts is nil,
+// TestOptions is used.
+var testDrivers = map[string]func(t testing.TB, ctx context.Context, opts *Options) *DB{
+	"dexie": func(t testing.TB, ctx context.Context, opts *Options) *DB {
+		if opts == nil {
+			opts = TestOptions()
+		}
+		db, err := New(ctx, opts)
+		require.NoError(t, err)
+		return db
+	},
+}
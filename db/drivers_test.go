@@ -0,0 +1,30 @@
+// +build !js
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testDrivers contains a function which creates a new database for each of
+// the implementations that can be used outside of the browser. If opts is nil,
+// TestOptions is used.
+var testDrivers = map[string]func(t testing.TB, ctx context.Context, opts *Options) *DB{
+	"sql":     newTestSQLDB,
+	"memory":  newTestMemoryDB,
+	"leveldb": newTestLevelDB,
+}
+
+// newTestSQLDB creates a new SQLite database with the given options (or
+// TestOptions if opts is nil).
+func newTestSQLDB(t testing.TB, ctx context.Context, opts *Options) *DB {
+	if opts == nil {
+		opts = TestOptions()
+	}
+	db, err := New(ctx, opts)
+	require.NoError(t, err)
+	return db
+}
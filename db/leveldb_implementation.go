@@ -0,0 +1,724 @@
+// +build !js
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/zeroex"
+	"github.com/ethereum/go-ethereum/common"
+	ds "github.com/ipfs/go-datastore"
+	leveldbStore "github.com/ipfs/go-ds-leveldb"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// LevelDBDriverName is the value of Options.DriverName which selects the
+// implementation of the database that is backed by LevelDB, an embedded
+// key-value store. Options.DataSourceName is the directory in which the data
+// is stored: orders, mini headers and all other Mesh data are stored in the
+// "mesh" subdirectory and the peer store and DHT are stored in the
+// "peerstore" and "dht" subdirectories.
+//
+// Unlike the SQL implementation, the LevelDB implementation doesn't serialize
+// all access to the database. Reads are answered from a consistent snapshot
+// without acquiring any locks, and only writes to the same kind of data (e.g.
+// v3 orders or mini headers) wait for each other.
+const LevelDBDriverName = "leveldb"
+
+// Key prefixes for all of the data except for orders, which are stored in
+// levelDBOrderTables.
+var (
+	levelDBMetadataKey              = []byte("metadata")
+	levelDBMiniHeadersPrefix        = []byte("miniheaders/")
+	levelDBOrderEventsPrefix        = []byte("orderevents/")
+	levelDBArchivedOrdersPrefix     = []byte("archivedorders/")
+	levelDBWebhookDeliveriesPrefix  = []byte("webhookdeliveries/")
+	levelDBLastWebhookDeliveryIDKey = []byte("lastwebhookdeliveryid")
+)
+
+var _ backend = (*levelDB)(nil)
+
+// levelDB is the implementation of the database that is backed by LevelDB. It
+// is used when LevelDBDriverName is selected.
+//
+// Each kind of data is stored under its own key prefix and values are encoded
+// as JSON. Numbers which are part of a key are encoded in big-endian order so
+// that iterating over the keys returns them in ascending order.
+type levelDB struct {
+	opts      *Options
+	db        *leveldb.DB
+	peerStore *leveldbStore.Datastore
+	dhtStore  *leveldbStore.Datastore
+
+	orders   *levelDBOrderTable
+	ordersV4 *levelDBOrderTable
+
+	// The following mutexes are used to serialize writes which need to read
+	// existing data first. Reads don't acquire any locks.
+	metadataMu          sync.Mutex
+	miniHeadersMu       sync.Mutex
+	orderEventsMu       sync.Mutex
+	archivedOrdersMu    sync.Mutex
+	webhookDeliveriesMu sync.Mutex
+}
+
+// levelDBReader is implemented by both *leveldb.DB and *leveldb.Snapshot.
+type levelDBReader interface {
+	Get(key []byte, ro *opt.ReadOptions) ([]byte, error)
+	NewIterator(slice *util.Range, ro *opt.ReadOptions) iterator.Iterator
+}
+
+func newLevelDB(ctx context.Context, opts *Options) (l *levelDB, err error) {
+	db, err := leveldb.OpenFile(filepath.Join(opts.DataSourceName, "mesh"), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = db.Close()
+		}
+	}()
+	peerStore, err := leveldbStore.NewDatastore(filepath.Join(opts.DataSourceName, "peerstore"), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = peerStore.Close()
+		}
+	}()
+	dhtStore, err := leveldbStore.NewDatastore(filepath.Join(opts.DataSourceName, "dht"), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = dhtStore.Close()
+		}
+	}()
+
+	orders, err := newLevelDBOrderTable(db, levelDBOrderTableV3)
+	if err != nil {
+		return nil, err
+	}
+	ordersV4, err := newLevelDBOrderTable(db, levelDBOrderTableV4)
+	if err != nil {
+		return nil, err
+	}
+
+	// Automatically close the databases when the context is canceled.
+	go func() {
+		<-ctx.Done()
+		_ = db.Close()
+		_ = peerStore.Close()
+		_ = dhtStore.Close()
+	}()
+
+	return &levelDB{
+		opts:      opts,
+		db:        db,
+		peerStore: peerStore,
+		dhtStore:  dhtStore,
+		orders:    orders,
+		ordersV4:  ordersV4,
+	}, nil
+}
+
+// convertLevelDBErr converts the errors returned by LevelDB to the
+// corresponding errors of this package.
+func convertLevelDBErr(err error) error {
+	switch err {
+	case leveldb.ErrNotFound:
+		return ErrNotFound
+	case leveldb.ErrClosed:
+		return ErrClosed
+	default:
+		return err
+	}
+}
+
+// readSnapshot calls f with a snapshot of the database, which is released
+// when f returns.
+func (l *levelDB) readSnapshot(f func(snapshot *leveldb.Snapshot) error) error {
+	snapshot, err := l.db.GetSnapshot()
+	if err != nil {
+		return convertLevelDBErr(err)
+	}
+	defer snapshot.Release()
+	return convertLevelDBErr(f(snapshot))
+}
+
+// write atomically applies the given batch.
+func (l *levelDB) write(batch *leveldb.Batch) error {
+	return convertLevelDBErr(l.db.Write(batch, nil))
+}
+
+// getLevelDBValue decodes the value with the given key into v.
+func getLevelDBValue(r levelDBReader, key []byte, v interface{}) error {
+	data, err := r.Get(key, nil)
+	if err != nil {
+		return convertLevelDBErr(err)
+	}
+	return json.Unmarshal(data, v)
+}
+
+// putLevelDBValue adds a write of the encoded value with the given key to the
+// batch.
+func putLevelDBValue(batch *leveldb.Batch, key []byte, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	batch.Put(key, data)
+	return nil
+}
+
+// forEachLevelDBEntry calls f with the key and value of each entry in the
+// given range, in ascending order of keys. The key and value must not be
+// modified or retained after f returns.
+func forEachLevelDBEntry(r levelDBReader, rng *util.Range, f func(key []byte, value []byte) error) error {
+	iter := r.NewIterator(rng, nil)
+	defer iter.Release()
+	for iter.Next() {
+		if err := f(iter.Key(), iter.Value()); err != nil {
+			return err
+		}
+	}
+	return convertLevelDBErr(iter.Error())
+}
+
+// concatBytes returns a new slice which contains all of the given slices.
+func concatBytes(slices ...[]byte) []byte {
+	length := 0
+	for _, slice := range slices {
+		length += len(slice)
+	}
+	result := make([]byte, 0, length)
+	for _, slice := range slices {
+		result = append(result, slice...)
+	}
+	return result
+}
+
+func encodeLevelDBUint64(n uint64) []byte {
+	encoded := make([]byte, 8)
+	binary.BigEndian.PutUint64(encoded, n)
+	return encoded
+}
+
+// decodeLevelDBKeyUint64 decodes the number at the end of a key which was
+// created with encodeLevelDBUint64.
+func decodeLevelDBKeyUint64(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(key)-8:])
+}
+
+func (l *levelDB) PeerStore() ds.Batching {
+	return l.peerStore
+}
+
+func (l *levelDB) DHTStore() ds.Batching {
+	return l.dhtStore
+}
+
+func (l *levelDB) GetMetadata() (*types.Metadata, error) {
+	var metadata types.Metadata
+	if err := getLevelDBValue(l.db, levelDBMetadataKey, &metadata); err != nil {
+		return nil, err
+	}
+	return &metadata, nil
+}
+
+func (l *levelDB) SaveMetadata(metadata *types.Metadata) error {
+	l.metadataMu.Lock()
+	defer l.metadataMu.Unlock()
+	exists, err := l.db.Has(levelDBMetadataKey, nil)
+	if err != nil {
+		return convertLevelDBErr(err)
+	}
+	if exists {
+		return ErrMetadataAlreadyExists
+	}
+	batch := new(leveldb.Batch)
+	if err := putLevelDBValue(batch, levelDBMetadataKey, metadata); err != nil {
+		return err
+	}
+	return l.write(batch)
+}
+
+func (l *levelDB) UpdateMetadata(updateFunc func(oldmetadata *types.Metadata) (newMetadata *types.Metadata)) error {
+	if updateFunc == nil {
+		return errors.New("db.UpdateMetadata: updateFunc cannot be nil")
+	}
+	l.metadataMu.Lock()
+	defer l.metadataMu.Unlock()
+	existingMetadata, err := l.GetMetadata()
+	if err != nil {
+		return err
+	}
+	batch := new(leveldb.Batch)
+	if err := putLevelDBValue(batch, levelDBMetadataKey, updateFunc(existingMetadata)); err != nil {
+		return err
+	}
+	return l.write(batch)
+}
+
+func levelDBMiniHeaderKey(hash common.Hash) []byte {
+	return concatBytes(levelDBMiniHeadersPrefix, hash.Bytes())
+}
+
+// loadMiniHeaders returns all of the stored mini headers.
+func loadLevelDBMiniHeaders(r levelDBReader) (map[common.Hash]*types.MiniHeader, error) {
+	miniHeaders := map[common.Hash]*types.MiniHeader{}
+	err := forEachLevelDBEntry(r, util.BytesPrefix(levelDBMiniHeadersPrefix), func(key []byte, value []byte) error {
+		var miniHeader types.MiniHeader
+		if err := json.Unmarshal(value, &miniHeader); err != nil {
+			return err
+		}
+		miniHeaders[miniHeader.Hash] = &miniHeader
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return miniHeaders, nil
+}
+
+func (l *levelDB) AddMiniHeaders(miniHeaders []*types.MiniHeader) (added []*types.MiniHeader, removed []*types.MiniHeader, err error) {
+	l.miniHeadersMu.Lock()
+	defer l.miniHeadersMu.Unlock()
+	stored, err := loadLevelDBMiniHeaders(l.db)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	batch := new(leveldb.Batch)
+	addedMap := map[common.Hash]*types.MiniHeader{}
+	for _, miniHeader := range miniHeaders {
+		if _, found := stored[miniHeader.Hash]; found {
+			continue
+		}
+		if err := putLevelDBValue(batch, levelDBMiniHeaderKey(miniHeader.Hash), miniHeader); err != nil {
+			return nil, nil, err
+		}
+		stored[miniHeader.Hash] = miniHeader
+		addedMap[miniHeader.Hash] = miniHeader
+	}
+
+	if len(stored) > l.opts.MaxMiniHeaders {
+		sorted, err := findMemoryMiniHeaders(stored, &MiniHeaderQuery{
+			Sort: []MiniHeaderSort{
+				{
+					Field:     MFNumber,
+					Direction: Descending,
+				},
+			},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, miniHeader := range sorted[l.opts.MaxMiniHeaders:] {
+			// Deleting a mini header which was put earlier in the same batch
+			// means that it is never written.
+			batch.Delete(levelDBMiniHeaderKey(miniHeader.Hash))
+			if _, found := addedMap[miniHeader.Hash]; found {
+				// If the miniHeader was previously added, remove it from
+				// the added set and don't add it to the removed set.
+				delete(addedMap, miniHeader.Hash)
+			} else {
+				removed = append(removed, miniHeader)
+			}
+		}
+	}
+	if err := l.write(batch); err != nil {
+		return nil, nil, err
+	}
+
+	for _, miniHeader := range miniHeaders {
+		if _, found := addedMap[miniHeader.Hash]; found {
+			added = append(added, miniHeader)
+			delete(addedMap, miniHeader.Hash)
+		}
+	}
+	return added, removed, nil
+}
+
+func (l *levelDB) ResetMiniHeaders(newMiniHeaders []*types.MiniHeader) error {
+	l.miniHeadersMu.Lock()
+	defer l.miniHeadersMu.Unlock()
+	batch := new(leveldb.Batch)
+	err := forEachLevelDBEntry(l.db, util.BytesPrefix(levelDBMiniHeadersPrefix), func(key []byte, value []byte) error {
+		batch.Delete(append([]byte{}, key...))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, miniHeader := range newMiniHeaders {
+		if err := putLevelDBValue(batch, levelDBMiniHeaderKey(miniHeader.Hash), miniHeader); err != nil {
+			return err
+		}
+	}
+	return l.write(batch)
+}
+
+func (l *levelDB) GetMiniHeader(hash common.Hash) (*types.MiniHeader, error) {
+	var miniHeader types.MiniHeader
+	if err := getLevelDBValue(l.db, levelDBMiniHeaderKey(hash), &miniHeader); err != nil {
+		return nil, err
+	}
+	return &miniHeader, nil
+}
+
+func (l *levelDB) FindMiniHeaders(query *MiniHeaderQuery) (miniHeaders []*types.MiniHeader, err error) {
+	err = l.readSnapshot(func(snapshot *leveldb.Snapshot) error {
+		stored, err := loadLevelDBMiniHeaders(snapshot)
+		if err != nil {
+			return err
+		}
+		miniHeaders, err = findMemoryMiniHeaders(stored, query)
+		return err
+	})
+	return miniHeaders, err
+}
+
+func (l *levelDB) DeleteMiniHeader(hash common.Hash) error {
+	l.miniHeadersMu.Lock()
+	defer l.miniHeadersMu.Unlock()
+	return convertLevelDBErr(l.db.Delete(levelDBMiniHeaderKey(hash), nil))
+}
+
+func (l *levelDB) DeleteMiniHeaders(query *MiniHeaderQuery) ([]*types.MiniHeader, error) {
+	l.miniHeadersMu.Lock()
+	defer l.miniHeadersMu.Unlock()
+	stored, err := loadLevelDBMiniHeaders(l.db)
+	if err != nil {
+		return nil, err
+	}
+	miniHeadersToDelete, err := findMemoryMiniHeaders(stored, query)
+	if err != nil {
+		return nil, err
+	}
+	batch := new(leveldb.Batch)
+	for _, miniHeader := range miniHeadersToDelete {
+		batch.Delete(levelDBMiniHeaderKey(miniHeader.Hash))
+	}
+	if err := l.write(batch); err != nil {
+		return nil, err
+	}
+	return miniHeadersToDelete, nil
+}
+
+func levelDBOrderEventKey(sequenceNumber uint64) []byte {
+	return concatBytes(levelDBOrderEventsPrefix, encodeLevelDBUint64(sequenceNumber))
+}
+
+// orderEventSequenceNumberRange returns the sequence numbers of the oldest and
+// latest stored order events, or zeros if there are none. Order events are
+// only deleted from the start of the log, so the stored sequence numbers are
+// always contiguous.
+func orderEventSequenceNumberRange(r levelDBReader) (oldest uint64, latest uint64, err error) {
+	iter := r.NewIterator(util.BytesPrefix(levelDBOrderEventsPrefix), nil)
+	defer iter.Release()
+	if iter.First() {
+		oldest = decodeLevelDBKeyUint64(iter.Key())
+	}
+	if iter.Last() {
+		latest = decodeLevelDBKeyUint64(iter.Key())
+	}
+	return oldest, latest, convertLevelDBErr(iter.Error())
+}
+
+func (l *levelDB) AddOrderEvents(orderEvents []*zeroex.OrderEvent) error {
+	if len(orderEvents) == 0 {
+		return nil
+	}
+	l.orderEventsMu.Lock()
+	defer l.orderEventsMu.Unlock()
+	oldest, latest, err := orderEventSequenceNumberRange(l.db)
+	if err != nil {
+		return err
+	}
+	if oldest == 0 {
+		oldest = latest + 1
+	}
+
+	batch := new(leveldb.Batch)
+	sequenceNumbers := make([]uint64, len(orderEvents))
+	for i, orderEvent := range orderEvents {
+		latest++
+		sequenceNumbers[i] = latest
+		orderEventCopy := *orderEvent
+		orderEventCopy.SequenceNumber = latest
+		if err := putLevelDBValue(batch, levelDBOrderEventKey(latest), orderEventCopy); err != nil {
+			return err
+		}
+	}
+
+	// Remove the oldest events if we are over the limit.
+	if numEvents := latest - oldest + 1; numEvents > uint64(l.opts.MaxOrderEvents) {
+		for sequenceNumber := oldest; sequenceNumber <= latest-uint64(l.opts.MaxOrderEvents); sequenceNumber++ {
+			batch.Delete(levelDBOrderEventKey(sequenceNumber))
+		}
+	}
+	if err := l.write(batch); err != nil {
+		return err
+	}
+	for i, orderEvent := range orderEvents {
+		orderEvent.SequenceNumber = sequenceNumbers[i]
+	}
+	return nil
+}
+
+func (l *levelDB) FindOrderEvents(query *OrderEventQuery) ([]*zeroex.OrderEvent, error) {
+	if query == nil {
+		query = &OrderEventQuery{}
+	}
+	rng := util.BytesPrefix(levelDBOrderEventsPrefix)
+	if query.AfterSequenceNumber != 0 {
+		rng.Start = levelDBOrderEventKey(query.AfterSequenceNumber + 1)
+	}
+	orderEvents := []*zeroex.OrderEvent{}
+	errLimitReached := errors.New("limit reached")
+	err := forEachLevelDBEntry(l.db, rng, func(key []byte, value []byte) error {
+		if query.Limit != 0 && uint(len(orderEvents)) >= query.Limit {
+			return errLimitReached
+		}
+		var orderEvent zeroex.OrderEvent
+		if err := json.Unmarshal(value, &orderEvent); err != nil {
+			return err
+		}
+		orderEvents = append(orderEvents, &orderEvent)
+		return nil
+	})
+	if err != nil && err != errLimitReached {
+		return nil, err
+	}
+	return orderEvents, nil
+}
+
+func (l *levelDB) GetOrderEventSequenceNumberRange() (oldest uint64, latest uint64, err error) {
+	return orderEventSequenceNumberRange(l.db)
+}
+
+func (l *levelDB) GetLatestOrderEvent(orderHash common.Hash) (*zeroex.OrderEvent, error) {
+	iter := l.db.NewIterator(util.BytesPrefix(levelDBOrderEventsPrefix), nil)
+	defer iter.Release()
+	for ok := iter.Last(); ok; ok = iter.Prev() {
+		var orderEvent zeroex.OrderEvent
+		if err := json.Unmarshal(iter.Value(), &orderEvent); err != nil {
+			return nil, err
+		}
+		if orderEvent.OrderHash == orderHash {
+			return &orderEvent, nil
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return nil, convertLevelDBErr(err)
+	}
+	return nil, ErrNotFound
+}
+
+func levelDBArchivedOrderKey(hash common.Hash) []byte {
+	return concatBytes(levelDBArchivedOrdersPrefix, hash.Bytes())
+}
+
+func (l *levelDB) AddArchivedOrders(orders []*types.ArchivedOrder) error {
+	l.archivedOrdersMu.Lock()
+	defer l.archivedOrdersMu.Unlock()
+	batch := new(leveldb.Batch)
+	for _, order := range orders {
+		if err := putLevelDBValue(batch, levelDBArchivedOrderKey(order.Hash), order); err != nil {
+			return err
+		}
+	}
+	return l.write(batch)
+}
+
+func (l *levelDB) FindArchivedOrders(query *ArchivedOrderQuery) ([]*types.ArchivedOrder, error) {
+	if err := checkArchivedOrderQuery(query); err != nil {
+		return nil, err
+	}
+	archivedOrders := map[common.Hash]*types.ArchivedOrder{}
+	rng := util.BytesPrefix(levelDBArchivedOrdersPrefix)
+	if query != nil && query.OrderHash != (common.Hash{}) {
+		rng = util.BytesPrefix(levelDBArchivedOrderKey(query.OrderHash))
+	}
+	err := forEachLevelDBEntry(l.db, rng, func(key []byte, value []byte) error {
+		var order types.ArchivedOrder
+		if err := json.Unmarshal(value, &order); err != nil {
+			return err
+		}
+		archivedOrders[order.Hash] = &order
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return findMemoryArchivedOrders(archivedOrders, query), nil
+}
+
+func (l *levelDB) DeleteArchivedOrdersBefore(archivedBefore time.Time) (int, error) {
+	l.archivedOrdersMu.Lock()
+	defer l.archivedOrdersMu.Unlock()
+	batch := new(leveldb.Batch)
+	err := forEachLevelDBEntry(l.db, util.BytesPrefix(levelDBArchivedOrdersPrefix), func(key []byte, value []byte) error {
+		var order types.ArchivedOrder
+		if err := json.Unmarshal(value, &order); err != nil {
+			return err
+		}
+		if order.ArchivedAt.Before(archivedBefore) {
+			batch.Delete(append([]byte{}, key...))
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if err := l.write(batch); err != nil {
+		return 0, err
+	}
+	return batch.Len(), nil
+}
+
+func levelDBWebhookDeliveryKey(id uint64) []byte {
+	return concatBytes(levelDBWebhookDeliveriesPrefix, encodeLevelDBUint64(id))
+}
+
+func (l *levelDB) AddWebhookDeliveries(deliveries []*types.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	l.webhookDeliveriesMu.Lock()
+	defer l.webhookDeliveriesMu.Unlock()
+	// The last ID is stored separately from the deliveries so that IDs are not
+	// reused after the latest delivery has been deleted.
+	var lastID uint64
+	if err := getLevelDBValue(l.db, levelDBLastWebhookDeliveryIDKey, &lastID); err != nil && err != ErrNotFound {
+		return err
+	}
+	batch := new(leveldb.Batch)
+	ids := make([]uint64, len(deliveries))
+	for i, delivery := range deliveries {
+		lastID++
+		ids[i] = lastID
+		deliveryCopy := *delivery
+		deliveryCopy.ID = lastID
+		if err := putLevelDBValue(batch, levelDBWebhookDeliveryKey(lastID), deliveryCopy); err != nil {
+			return err
+		}
+	}
+	if err := putLevelDBValue(batch, levelDBLastWebhookDeliveryIDKey, lastID); err != nil {
+		return err
+	}
+	if err := l.write(batch); err != nil {
+		return err
+	}
+	for i, delivery := range deliveries {
+		delivery.ID = ids[i]
+	}
+	return nil
+}
+
+func (l *levelDB) FindWebhookDeliveries(query *WebhookDeliveryQuery) ([]*types.WebhookDelivery, error) {
+	if query == nil {
+		query = &WebhookDeliveryQuery{}
+	}
+	deliveries := []*types.WebhookDelivery{}
+	errLimitReached := errors.New("limit reached")
+	err := forEachLevelDBEntry(l.db, util.BytesPrefix(levelDBWebhookDeliveriesPrefix), func(key []byte, value []byte) error {
+		if query.Limit != 0 && uint(len(deliveries)) >= query.Limit {
+			return errLimitReached
+		}
+		var delivery types.WebhookDelivery
+		if err := json.Unmarshal(value, &delivery); err != nil {
+			return err
+		}
+		if query.URL != "" && delivery.URL != query.URL {
+			return nil
+		}
+		deliveries = append(deliveries, &delivery)
+		return nil
+	})
+	if err != nil && err != errLimitReached {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func (l *levelDB) UpdateWebhookDelivery(delivery *types.WebhookDelivery) error {
+	l.webhookDeliveriesMu.Lock()
+	defer l.webhookDeliveriesMu.Unlock()
+	key := levelDBWebhookDeliveryKey(delivery.ID)
+	var existing types.WebhookDelivery
+	if err := getLevelDBValue(l.db, key, &existing); err != nil {
+		return err
+	}
+	existing.Attempts = delivery.Attempts
+	existing.NextAttemptAt = delivery.NextAttemptAt
+	batch := new(leveldb.Batch)
+	if err := putLevelDBValue(batch, key, existing); err != nil {
+		return err
+	}
+	return l.write(batch)
+}
+
+func (l *levelDB) DeleteWebhookDelivery(id uint64) error {
+	l.webhookDeliveriesMu.Lock()
+	defer l.webhookDeliveriesMu.Unlock()
+	return convertLevelDBErr(l.db.Delete(levelDBWebhookDeliveryKey(id), nil))
+}
+
+func (l *levelDB) CountWebhookDeliveries(query *WebhookDeliveryQuery) (int, error) {
+	count := 0
+	err := forEachLevelDBEntry(l.db, util.BytesPrefix(levelDBWebhookDeliveriesPrefix), func(key []byte, value []byte) error {
+		if query != nil && query.URL != "" {
+			var delivery types.WebhookDelivery
+			if err := json.Unmarshal(value, &delivery); err != nil {
+				return err
+			}
+			if delivery.URL != query.URL {
+				return nil
+			}
+		}
+		count++
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (l *levelDB) DeleteWebhookDeliveriesExcept(urls []string) (int, error) {
+	l.webhookDeliveriesMu.Lock()
+	defer l.webhookDeliveriesMu.Unlock()
+	keep := webhookURLSet(urls)
+	batch := new(leveldb.Batch)
+	err := forEachLevelDBEntry(l.db, util.BytesPrefix(levelDBWebhookDeliveriesPrefix), func(key []byte, value []byte) error {
+		var delivery types.WebhookDelivery
+		if err := json.Unmarshal(value, &delivery); err != nil {
+			return err
+		}
+		if _, found := keep[delivery.URL]; !found {
+			batch.Delete(key)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if err := l.write(batch); err != nil {
+		return 0, err
+	}
+	return batch.Len(), nil
+}
@@ -0,0 +1,312 @@
+// +build !js
+
+package db
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/zeroex"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLevelDBFindOrdersByIndex(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	db := newTestLevelDB(t, ctx, nil)
+
+	maker := common.HexToAddress("0x1")
+	token := common.HexToAddress("0x2")
+	otherToken := common.HexToAddress("0x3")
+	orders := []*types.OrderWithMetadata{}
+	for i := 0; i < 6; i++ {
+		order := newTestOrder()
+		order.OrderV3.ExpirationTimeSeconds = big.NewInt(int64(100 + i))
+		order.LastValidatedBlockNumber = big.NewInt(int64(10 + i))
+		order.ParsedMakerAssetData = []*types.SingleAssetData{{Address: otherToken}}
+		order.ParsedMakerFeeAssetData = nil
+		orders = append(orders, order)
+	}
+	orders[0].OrderV3.MakerAddress = maker
+	orders[0].ParsedMakerAssetData = []*types.SingleAssetData{{Address: token}, {Address: token}}
+	orders[1].OrderV3.MakerAddress = maker
+	orders[1].ParsedMakerFeeAssetData = []*types.SingleAssetData{{Address: token}}
+	orders[2].OrderV3.MakerAddress = maker
+	orders[3].ParsedMakerAssetData = []*types.SingleAssetData{{Address: token}}
+	_, _, _, err := db.AddOrders(orders)
+	require.NoError(t, err)
+
+	// The same query that is used by the order watcher to find orders by
+	// token address.
+	actualOrders, err := db.FindOrders(&OrderQuery{
+		Filters: []OrderFilter{
+			{
+				Field: OFMakerAddress,
+				Kind:  Equal,
+				Value: maker,
+			},
+			MakerAssetIncludesTokenAddress(token),
+		},
+	})
+	require.NoError(t, err)
+	assertOrderSlicesAreEqual(t, []*types.OrderWithMetadata{orders[0]}, actualOrders)
+
+	actualOrders, err = db.FindOrders(&OrderQuery{
+		Filters: []OrderFilter{
+			{
+				Field: OFMakerAddress,
+				Kind:  Equal,
+				Value: maker,
+			},
+		},
+	})
+	require.NoError(t, err)
+	assertOrderSlicesAreUnsortedEqual(t, orders[:3], actualOrders)
+
+	actualOrders, err = db.FindOrders(&OrderQuery{
+		Filters: []OrderFilter{
+			{
+				Field: OFExpirationTimeSeconds,
+				Kind:  Greater,
+				Value: big.NewInt(101),
+			},
+			{
+				Field: OFExpirationTimeSeconds,
+				Kind:  LessOrEqual,
+				Value: big.NewInt(104),
+			},
+		},
+	})
+	require.NoError(t, err)
+	assertOrderSlicesAreUnsortedEqual(t, orders[2:5], actualOrders)
+
+	actualOrders, err = db.FindOrders(&OrderQuery{
+		Filters: []OrderFilter{
+			{
+				Field: OFExpirationTimeSeconds,
+				Kind:  Less,
+				Value: big.NewInt(0),
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Len(t, actualOrders, 0)
+
+	actualOrders, err = db.FindOrders(&OrderQuery{
+		Filters: []OrderFilter{
+			{
+				Field: OFLastValidatedBlockNumber,
+				Kind:  GreaterOrEqual,
+				Value: big.NewInt(14),
+			},
+		},
+	})
+	require.NoError(t, err)
+	assertOrderSlicesAreUnsortedEqual(t, orders[4:], actualOrders)
+
+	// Updating an order also updates its index entries.
+	require.NoError(t, db.UpdateOrder(orders[5].Hash, func(existingOrder *types.OrderWithMetadata) (*types.OrderWithMetadata, error) {
+		existingOrder.LastValidatedBlockNumber = big.NewInt(1)
+		existingOrder.OrderV3.ExpirationTimeSeconds = big.NewInt(1)
+		return existingOrder, nil
+	}))
+	actualOrders, err = db.FindOrders(&OrderQuery{
+		Filters: []OrderFilter{
+			{
+				Field: OFLastValidatedBlockNumber,
+				Kind:  GreaterOrEqual,
+				Value: big.NewInt(14),
+			},
+		},
+	})
+	require.NoError(t, err)
+	assertOrderSlicesAreEqual(t, orders[4:5], actualOrders)
+	actualOrders, err = db.FindOrders(&OrderQuery{
+		Filters: []OrderFilter{
+			{
+				Field: OFExpirationTimeSeconds,
+				Kind:  Equal,
+				Value: big.NewInt(1),
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, actualOrders, 1)
+	assert.Equal(t, orders[5].Hash, actualOrders[0].Hash)
+}
+
+func TestLevelDBFindOrdersBySourcePeerID(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	db := newTestLevelDB(t, ctx, nil)
+
+	// Peer IDs which are prefixes of each other must not match each other.
+	orders := []*types.OrderWithMetadata{}
+	for _, peerID := range []string{"peerA", "peerAB", "", "peerA"} {
+		order := newTestOrder()
+		order.SourcePeerID = peerID
+		order.IsPinned = false
+		orders = append(orders, order)
+	}
+	orders[3].IsPinned = true
+	_, _, _, err := db.AddOrders(orders)
+	require.NoError(t, err)
+
+	actualOrders, err := db.FindOrders(&OrderQuery{
+		Filters: []OrderFilter{{Field: OFSourcePeerID, Kind: Equal, Value: "peerA"}},
+	})
+	require.NoError(t, err)
+	assertOrderSlicesAreEqual(t, []*types.OrderWithMetadata{orders[0], orders[3]}, actualOrders)
+
+	count, err := db.CountOrders(&OrderQuery{
+		Filters: []OrderFilter{
+			{Field: OFSourcePeerID, Kind: Equal, Value: "peerA"},
+			{Field: OFIsPinned, Kind: Equal, Value: false},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	// The flags in the peer index are updated together with the order.
+	require.NoError(t, db.UpdateOrder(orders[0].Hash, func(existingOrder *types.OrderWithMetadata) (*types.OrderWithMetadata, error) {
+		existingOrder.IsRemoved = true
+		return existingOrder, nil
+	}))
+	count, err = db.CountOrders(&OrderQuery{
+		Filters: []OrderFilter{
+			{Field: OFSourcePeerID, Kind: Equal, Value: "peerA"},
+			{Field: OFIsPinned, Kind: Equal, Value: false},
+			{Field: OFIsRemoved, Kind: Equal, Value: false},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+	count, err = db.CountOrders(&OrderQuery{
+		Filters: []OrderFilter{{Field: OFSourcePeerID, Kind: Equal, Value: ""}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	count, err = db.CountOrders(nil)
+	require.NoError(t, err)
+	assert.Equal(t, len(orders), count)
+}
+
+func TestLevelDBFindOrdersSortedByExpiration(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	db := newTestLevelDB(t, ctx, nil)
+
+	orders := []*types.OrderWithMetadata{}
+	for _, expirationTime := range []int64{100, 102, 101, 101} {
+		order := newTestOrder()
+		order.OrderV3.ExpirationTimeSeconds = big.NewInt(expirationTime)
+		order.IsPinned = false
+		orders = append(orders, order)
+	}
+	orders[1].IsPinned = true
+	_, _, _, err := db.AddOrders(orders)
+	require.NoError(t, err)
+
+	// Orders with the same expiration time are returned in the order in which
+	// they were inserted, no matter where the limit is.
+	unpinned := []OrderFilter{{Field: OFIsPinned, Kind: Equal, Value: false}}
+	actualOrders, err := db.FindOrders(&OrderQuery{
+		Filters: unpinned,
+		Sort:    []OrderSort{{Field: OFExpirationTimeSeconds, Direction: Descending}},
+		Limit:   1,
+	})
+	require.NoError(t, err)
+	assertOrderSlicesAreEqual(t, []*types.OrderWithMetadata{orders[2]}, actualOrders)
+	actualOrders, err = db.FindOrders(&OrderQuery{
+		Filters: unpinned,
+		Sort:    []OrderSort{{Field: OFExpirationTimeSeconds, Direction: Descending}},
+		Limit:   2,
+		Offset:  1,
+	})
+	require.NoError(t, err)
+	assertOrderSlicesAreEqual(t, []*types.OrderWithMetadata{orders[3], orders[0]}, actualOrders)
+	actualOrders, err = db.FindOrders(&OrderQuery{
+		Filters: unpinned,
+		Sort:    []OrderSort{{Field: OFExpirationTimeSeconds, Direction: Ascending}},
+		Limit:   2,
+	})
+	require.NoError(t, err)
+	assertOrderSlicesAreEqual(t, []*types.OrderWithMetadata{orders[0], orders[2]}, actualOrders)
+
+	maxExpirationTime, err := db.GetCurrentMaxExpirationTime()
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(101), maxExpirationTime)
+}
+
+func TestLevelDBReopen(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	opts := TestOptions()
+	db := newTestLevelDB(t, ctx, opts)
+
+	order := newTestOrder()
+	_, _, _, err := db.AddOrders([]*types.OrderWithMetadata{order})
+	require.NoError(t, err)
+	orderV4 := newTestOrderV4()
+	_, _, _, err = db.AddOrdersV4([]*types.OrderWithMetadata{orderV4})
+	require.NoError(t, err)
+	miniHeader := newTestMiniHeader()
+	_, _, err = db.AddMiniHeaders([]*types.MiniHeader{miniHeader})
+	require.NoError(t, err)
+	metadata := newTestMetadata()
+	require.NoError(t, db.SaveMetadata(metadata))
+	require.NoError(t, db.AddOrderEvents([]*zeroex.OrderEvent{newTestOrderEvent(), newTestOrderEvent()}))
+
+	cancel()
+	require.Eventually(t, func() bool {
+		_, err := db.FindOrders(nil)
+		return err == ErrClosed
+	}, time.Second, 10*time.Millisecond)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	db, err = New(ctx, opts)
+	require.NoError(t, err)
+
+	foundOrder, err := db.GetOrder(order.Hash)
+	require.NoError(t, err)
+	assertOrdersAreEqual(t, order, foundOrder)
+	foundOrderV4, err := db.GetOrderV4(orderV4.Hash)
+	require.NoError(t, err)
+	assertOrdersAreEqual(t, orderV4, foundOrderV4)
+	foundMiniHeader, err := db.GetMiniHeader(miniHeader.Hash)
+	require.NoError(t, err)
+	assertMiniHeadersAreEqual(t, miniHeader, foundMiniHeader)
+	foundMetadata, err := db.GetMetadata()
+	require.NoError(t, err)
+	assertMetadatasAreEqual(t, metadata, foundMetadata)
+	oldest, latest, err := db.GetOrderEventSequenceNumberRange()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), oldest)
+	assert.Equal(t, uint64(2), latest)
+
+	// New orders don't reuse the sequence numbers of stored orders.
+	newOrder := newTestOrder()
+	_, _, _, err = db.AddOrders([]*types.OrderWithMetadata{newOrder})
+	require.NoError(t, err)
+	foundOrders, err := db.FindOrders(nil)
+	require.NoError(t, err)
+	assertOrderSlicesAreEqual(t, []*types.OrderWithMetadata{order, newOrder}, foundOrders)
+}
+
+// newTestLevelDB creates a new LevelDB database with the given options (or
+// TestOptions if opts is nil).
+func newTestLevelDB(t testing.TB, ctx context.Context, opts *Options) *DB {
+	if opts == nil {
+		opts = TestOptions()
+	}
+	opts.DriverName = LevelDBDriverName
+	opts.DataSourceName = filepath.Join(filepath.Dir(opts.DataSourceName), "leveldb")
+	db, err := New(ctx, opts)
+	require.NoError(t, err)
+	return db
+}
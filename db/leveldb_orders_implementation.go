@@ -0,0 +1,1039 @@
+// +build !js
+
+package db
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// levelDBOrderTable stores either the v3 or the v4 orders of a levelDB. Each
+// order is stored as a levelDBOrder under the key <name>/order/<hash>. For each
+// order, there is also an empty index entry with each of the following keys:
+//
+//     <name>/maker-token/<maker><token><hash>    for each token in the maker (fee) asset
+//     <name>/expiration/<expiration time><hash>
+//     <name>/block-number/<last validated block number><hash>
+//     <name>/peer/<source peer ID>\x00<flags><hash>
+//
+// These are the fields that are used by the queries of the order watcher and
+// the storage quotas. When a query filters by one of them, only the orders in
+// the corresponding range of the index are decoded instead of all of the stored
+// orders. The expiration index is also used to find the orders with the
+// longest expiration times without decoding all of the stored orders, and the
+// flags in the peer index (see levelDBPeerIndexFlags) are used to count the
+// orders that count towards the storage quota of a peer without decoding any
+// orders.
+type levelDBOrderTable struct {
+	levelDBOrderTableSchema
+	db *leveldb.DB
+
+	// mu is used to serialize writes to the table. Reads don't acquire it.
+	mu sync.Mutex
+	// lastSeq is the sequence number of the order which was inserted last.
+	// Like in memoryDB, sequence numbers are used to return orders in the
+	// order in which they were inserted when they are otherwise equal.
+	lastSeq uint64
+	// numOrders is the number of stored orders.
+	numOrders int
+}
+
+// levelDBOrderTableSchema contains everything that is different between the
+// tables for v3 and v4 orders.
+type levelDBOrderTableSchema struct {
+	name string
+	// makerField, expirationField, blockNumberField and sourcePeerIDField
+	// are the fields that correspond to the maker-token, expiration,
+	// block-number and peer indexes. isPinnedField and isRemovedField
+	// correspond to the flags in the peer index.
+	makerField        string
+	expirationField   string
+	blockNumberField  string
+	sourcePeerIDField string
+	isPinnedField     string
+	isRemovedField    string
+	// includesOrder returns true if the order is stored in the table.
+	includesOrder func(order *types.OrderWithMetadata) bool
+	// makerAndTokens returns the values for the maker-token index.
+	makerAndTokens func(order *types.OrderWithMetadata) (common.Address, []common.Address)
+	// expirationTime returns the value for the expiration index.
+	expirationTime func(order *types.OrderWithMetadata) *big.Int
+	// tokenFromFilter returns the token address that an order must include in
+	// order to match the filter, if there is one.
+	tokenFromFilter func(filter levelDBOrderFilter) (common.Address, bool)
+}
+
+var levelDBOrderTableV3 = levelDBOrderTableSchema{
+	name:              "orders",
+	makerField:        string(OFMakerAddress),
+	expirationField:   string(OFExpirationTimeSeconds),
+	blockNumberField:  string(OFLastValidatedBlockNumber),
+	sourcePeerIDField: string(OFSourcePeerID),
+	isPinnedField:     string(OFIsPinned),
+	isRemovedField:    string(OFIsRemoved),
+	includesOrder: func(order *types.OrderWithMetadata) bool {
+		return order.OrderV3 != nil
+	},
+	makerAndTokens: func(order *types.OrderWithMetadata) (common.Address, []common.Address) {
+		tokens := []common.Address{}
+		for _, parsedAssetData := range [][]*types.SingleAssetData{order.ParsedMakerAssetData, order.ParsedMakerFeeAssetData} {
+			for _, singleAssetData := range parsedAssetData {
+				tokens = append(tokens, singleAssetData.Address)
+			}
+		}
+		return order.OrderV3.MakerAddress, tokens
+	},
+	expirationTime: func(order *types.OrderWithMetadata) *big.Int {
+		return order.OrderV3.ExpirationTimeSeconds
+	},
+	tokenFromFilter: func(filter levelDBOrderFilter) (common.Address, bool) {
+		// Filters on the tokens of v3 orders are CONTAINS filters on the
+		// encoded parsed asset data (see MakerAssetIncludesTokenAddress).
+		if filter.kind != Contains || (filter.field != string(OFParsedMakerAssetData) && filter.field != string(OFParsedMakerFeeAssetData)) {
+			return common.Address{}, false
+		}
+		value, ok := filter.value.(string)
+		if !ok || !strings.HasPrefix(value, `"address":`) {
+			return common.Address{}, false
+		}
+		var token common.Address
+		decoder := json.NewDecoder(strings.NewReader(strings.TrimPrefix(value, `"address":`)))
+		if err := decoder.Decode(&token); err != nil {
+			return common.Address{}, false
+		}
+		return token, true
+	},
+}
+
+var levelDBOrderTableV4 = levelDBOrderTableSchema{
+	name:              "ordersv4",
+	makerField:        string(OV4FMaker),
+	expirationField:   string(OV4FExpiry),
+	blockNumberField:  string(OV4FLastValidatedBlockNumber),
+	sourcePeerIDField: string(OV4FSourcePeerID),
+	isPinnedField:     string(OV4FIsPinned),
+	isRemovedField:    string(OV4FIsRemoved),
+	includesOrder: func(order *types.OrderWithMetadata) bool {
+		return order.OrderV4 != nil
+	},
+	makerAndTokens: func(order *types.OrderWithMetadata) (common.Address, []common.Address) {
+		return order.OrderV4.Maker, []common.Address{order.OrderV4.MakerToken}
+	},
+	expirationTime: func(order *types.OrderWithMetadata) *big.Int {
+		return order.OrderV4.Expiry
+	},
+	tokenFromFilter: func(filter levelDBOrderFilter) (common.Address, bool) {
+		if filter.field != string(OV4FMakerToken) || filter.kind != Equal {
+			return common.Address{}, false
+		}
+		token, ok := filter.value.(common.Address)
+		return token, ok
+	},
+}
+
+// levelDBOrder is the value that is stored for each order.
+type levelDBOrder struct {
+	Seq   uint64                   `json:"seq"`
+	Order *types.OrderWithMetadata `json:"order"`
+}
+
+// levelDBOrderFilter is a top-level filter of a v3 or v4 order query.
+type levelDBOrderFilter struct {
+	field string
+	kind  FilterKind
+	value interface{}
+}
+
+func levelDBOrderFilters(filters []OrderFilter) []levelDBOrderFilter {
+	result := make([]levelDBOrderFilter, len(filters))
+	for i, filter := range filters {
+		result[i] = levelDBOrderFilter{field: string(filter.Field), kind: filter.Kind, value: filter.Value}
+	}
+	return result
+}
+
+func levelDBOrderFiltersV4(filters []OrderFilterV4) []levelDBOrderFilter {
+	result := make([]levelDBOrderFilter, len(filters))
+	for i, filter := range filters {
+		result[i] = levelDBOrderFilter{field: string(filter.Field), kind: filter.Kind, value: filter.Value}
+	}
+	return result
+}
+
+func newLevelDBOrderTable(db *leveldb.DB, schema levelDBOrderTableSchema) (*levelDBOrderTable, error) {
+	t := &levelDBOrderTable{
+		levelDBOrderTableSchema: schema,
+		db:                      db,
+	}
+	if err := getLevelDBValue(db, t.seqKey(), &t.lastSeq); err != nil && err != ErrNotFound {
+		return nil, err
+	}
+	err := forEachLevelDBEntry(db, util.BytesPrefix(t.prefix("order")), func(key []byte, value []byte) error {
+		t.numOrders++
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t *levelDBOrderTable) prefix(kind string) []byte {
+	return []byte(t.name + "/" + kind + "/")
+}
+
+func (t *levelDBOrderTable) seqKey() []byte {
+	return []byte(t.name + "/seq")
+}
+
+func (t *levelDBOrderTable) orderKey(hash common.Hash) []byte {
+	return concatBytes(t.prefix("order"), hash.Bytes())
+}
+
+// encodeLevelDBIndexNumber encodes a non-negative number as a 32 byte
+// big-endian integer. nil is encoded as zero.
+func encodeLevelDBIndexNumber(n *big.Int) []byte {
+	if n == nil {
+		return make([]byte, common.HashLength)
+	}
+	return common.BigToHash(n).Bytes()
+}
+
+// indexKeys returns the keys of all of the index entries for the given order.
+func (t *levelDBOrderTable) indexKeys(order *types.OrderWithMetadata) [][]byte {
+	maker, tokens := t.makerAndTokens(order)
+	if len(tokens) == 0 {
+		// Orders without any tokens (e.g. because their asset data couldn't
+		// be parsed) still need to be found by maker.
+		tokens = []common.Address{{}}
+	}
+	keys := [][]byte{}
+	seenTokens := map[common.Address]struct{}{}
+	for _, token := range tokens {
+		if _, found := seenTokens[token]; found {
+			continue
+		}
+		seenTokens[token] = struct{}{}
+		keys = append(keys, concatBytes(t.prefix("maker-token"), maker.Bytes(), token.Bytes(), order.Hash.Bytes()))
+	}
+	keys = append(keys, concatBytes(t.prefix("expiration"), t.expirationIndexNumber(order), order.Hash.Bytes()))
+	keys = append(keys, concatBytes(t.prefix("block-number"), encodeLevelDBIndexNumber(order.LastValidatedBlockNumber), order.Hash.Bytes()))
+	keys = append(keys, concatBytes(t.peerIndexPrefix(order.SourcePeerID), []byte{levelDBPeerIndexFlags(order)}, order.Hash.Bytes()))
+	return keys
+}
+
+// expirationIndexNumber returns the encoded expiration time of the order as it
+// is stored in the expiration index.
+func (t *levelDBOrderTable) expirationIndexNumber(order *types.OrderWithMetadata) []byte {
+	return encodeLevelDBIndexNumber(t.expirationTime(order))
+}
+
+const (
+	levelDBPeerIndexIsPinned byte = 1 << iota
+	levelDBPeerIndexIsRemoved
+)
+
+// levelDBPeerIndexFlags returns the flags which are stored in the key of the
+// entry of the order in the peer index.
+func levelDBPeerIndexFlags(order *types.OrderWithMetadata) byte {
+	var flags byte
+	if order.IsPinned {
+		flags |= levelDBPeerIndexIsPinned
+	}
+	if order.IsRemoved {
+		flags |= levelDBPeerIndexIsRemoved
+	}
+	return flags
+}
+
+// peerIndexPrefix returns the prefix of the entries in the peer index for the
+// orders from the given peer. Peer IDs don't contain null bytes, so the
+// terminating null byte separates IDs which are prefixes of each other.
+func (t *levelDBOrderTable) peerIndexPrefix(peerID string) []byte {
+	return concatBytes(t.prefix("peer"), []byte(peerID), []byte{0})
+}
+
+// put adds the writes for storing the order and its index entries to the
+// batch.
+func (t *levelDBOrderTable) put(batch *leveldb.Batch, stored *memoryOrder) error {
+	if err := putLevelDBValue(batch, t.orderKey(stored.order.Hash), &levelDBOrder{Seq: stored.seq, Order: stored.order}); err != nil {
+		return err
+	}
+	for _, key := range t.indexKeys(stored.order) {
+		batch.Put(key, nil)
+	}
+	return nil
+}
+
+// delete adds the deletes for removing the order and its index entries to the
+// batch.
+func (t *levelDBOrderTable) delete(batch *leveldb.Batch, order *types.OrderWithMetadata) {
+	batch.Delete(t.orderKey(order.Hash))
+	for _, key := range t.indexKeys(order) {
+		batch.Delete(key)
+	}
+}
+
+func (t *levelDBOrderTable) get(r levelDBReader, hash common.Hash) (*memoryOrder, error) {
+	var stored levelDBOrder
+	if err := getLevelDBValue(r, t.orderKey(hash), &stored); err != nil {
+		return nil, err
+	}
+	return &memoryOrder{seq: stored.Seq, order: stored.Order}, nil
+}
+
+// loadAll returns all of the stored orders.
+func (t *levelDBOrderTable) loadAll(r levelDBReader) (map[common.Hash]*memoryOrder, error) {
+	orders := map[common.Hash]*memoryOrder{}
+	err := forEachLevelDBEntry(r, util.BytesPrefix(t.prefix("order")), func(key []byte, value []byte) error {
+		var stored levelDBOrder
+		if err := json.Unmarshal(value, &stored); err != nil {
+			return err
+		}
+		orders[stored.Order.Hash] = &memoryOrder{seq: stored.Seq, order: stored.Order}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// candidates returns the stored orders which may match the given filters. If
+// possible, the orders are looked up in one of the indexes. Otherwise all of
+// the stored orders are returned. The returned orders still need to be
+// filtered.
+func (t *levelDBOrderTable) candidates(r levelDBReader, filters []levelDBOrderFilter) (map[common.Hash]*memoryOrder, error) {
+	rng := t.indexRange(filters)
+	if rng == nil {
+		return t.loadAll(r)
+	}
+	hashes := []common.Hash{}
+	err := forEachLevelDBEntry(r, rng, func(key []byte, value []byte) error {
+		hashes = append(hashes, common.BytesToHash(key[len(key)-common.HashLength:]))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	orders := map[common.Hash]*memoryOrder{}
+	for _, hash := range hashes {
+		if _, found := orders[hash]; found {
+			// Orders with more than one token have more than one entry in
+			// the maker-token index.
+			continue
+		}
+		stored, err := t.get(r, hash)
+		if err != nil {
+			return nil, err
+		}
+		orders[hash] = stored
+	}
+	return orders, nil
+}
+
+// indexRange returns the range of one of the indexes which contains all of the
+// orders that match the given filters, or nil if none of the indexes can be
+// used. The maker-token and peer indexes are preferred because a maker or
+// peer usually only has a small number of orders.
+func (t *levelDBOrderTable) indexRange(filters []levelDBOrderFilter) *util.Range {
+	var maker, token *common.Address
+	var peerID *string
+	for _, filter := range filters {
+		if filter.field == t.sourcePeerIDField && filter.kind == Equal {
+			if value, ok := filter.value.(string); ok {
+				peerID = &value
+			}
+		}
+		if filter.field == t.makerField && filter.kind == Equal {
+			if value, ok := filter.value.(common.Address); ok {
+				maker = &value
+			}
+		}
+		if value, ok := t.tokenFromFilter(filter); ok {
+			token = &value
+		}
+	}
+	if maker != nil {
+		prefix := concatBytes(t.prefix("maker-token"), maker.Bytes())
+		if token != nil {
+			prefix = concatBytes(prefix, token.Bytes())
+		}
+		return util.BytesPrefix(prefix)
+	}
+	if peerID != nil {
+		return util.BytesPrefix(t.peerIndexPrefix(*peerID))
+	}
+	if rng := numberIndexRange(t.prefix("expiration"), t.expirationField, filters); rng != nil {
+		return rng
+	}
+	return numberIndexRange(t.prefix("block-number"), t.blockNumberField, filters)
+}
+
+// numberIndexRange returns the range of the index with the given prefix which
+// contains all of the orders that match the filters on the given numeric
+// field, or nil if there are no such filters.
+func numberIndexRange(prefix []byte, field string, filters []levelDBOrderFilter) *util.Range {
+	// lower and upper are inclusive bounds.
+	var lower, upper *big.Int
+	for _, filter := range filters {
+		if filter.field != field {
+			continue
+		}
+		value, ok := filter.value.(*big.Int)
+		if !ok || value == nil || value.Sign() < 0 || value.Cmp(math.MaxBig256) > 0 {
+			continue
+		}
+		switch filter.kind {
+		case Equal:
+			lower = maxBigInt(lower, value)
+			upper = minBigInt(upper, value)
+		case Greater:
+			lower = maxBigInt(lower, new(big.Int).Add(value, big.NewInt(1)))
+		case GreaterOrEqual:
+			lower = maxBigInt(lower, value)
+		case Less:
+			upper = minBigInt(upper, new(big.Int).Sub(value, big.NewInt(1)))
+		case LessOrEqual:
+			upper = minBigInt(upper, value)
+		}
+	}
+	if lower == nil && upper == nil {
+		return nil
+	}
+	rng := util.BytesPrefix(prefix)
+	if (lower != nil && lower.Cmp(math.MaxBig256) > 0) || (upper != nil && upper.Sign() < 0) {
+		// No order can match the filters.
+		return &util.Range{Start: rng.Start, Limit: rng.Start}
+	}
+	if lower != nil {
+		rng.Start = concatBytes(prefix, encodeLevelDBIndexNumber(lower))
+	}
+	if upper != nil && upper.Cmp(math.MaxBig256) < 0 {
+		rng.Limit = concatBytes(prefix, encodeLevelDBIndexNumber(new(big.Int).Add(upper, big.NewInt(1))))
+	}
+	return rng
+}
+
+func maxBigInt(a, b *big.Int) *big.Int {
+	if a == nil || b.Cmp(a) > 0 {
+		return b
+	}
+	return a
+}
+
+func minBigInt(a, b *big.Int) *big.Int {
+	if a == nil || b.Cmp(a) < 0 {
+		return b
+	}
+	return a
+}
+
+// add stores the orders which are included in the table and not stored yet.
+// If maxOrders is not 0, the orders which don't fit within maxOrders are
+// removed afterwards (see memoryOrdersBeyondMax).
+func (t *levelDBOrderTable) add(orders []*types.OrderWithMetadata, maxOrders int) (alreadyStored []common.Hash, added []*types.OrderWithMetadata, removed []*types.OrderWithMetadata, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	lastSeq := t.lastSeq
+	newOrders := map[common.Hash]*memoryOrder{}
+	for _, order := range orders {
+		if !t.includesOrder(order) {
+			continue
+		}
+		found, err := t.db.Has(t.orderKey(order.Hash), nil)
+		if err != nil {
+			return nil, nil, nil, convertLevelDBErr(err)
+		}
+		if _, isNew := newOrders[order.Hash]; found || isNew {
+			alreadyStored = append(alreadyStored, order.Hash)
+			continue
+		}
+		lastSeq++
+		newOrders[order.Hash] = &memoryOrder{seq: lastSeq, order: order}
+		added = append(added, order)
+	}
+
+	batch := new(leveldb.Batch)
+	if maxOrders != 0 && t.numOrders+len(newOrders) > maxOrders {
+		// Remove orders with an expiration time too far in the future.
+		toRemove, err := t.ordersBeyondMax(newOrders, maxOrders)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		for _, order := range toRemove {
+			if _, isNew := newOrders[order.Hash]; isNew {
+				// If the order was going to be added, don't add it and
+				// don't add it to the removed set.
+				delete(newOrders, order.Hash)
+				continue
+			}
+			t.delete(batch, order)
+			removed = append(removed, order)
+		}
+	}
+	for _, stored := range newOrders {
+		if err := t.put(batch, stored); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	if err := putLevelDBValue(batch, t.seqKey(), lastSeq); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := convertLevelDBErr(t.db.Write(batch, nil)); err != nil {
+		return nil, nil, nil, err
+	}
+	t.lastSeq = lastSeq
+	t.numOrders += len(newOrders) - len(removed)
+
+	addedAndKept := []*types.OrderWithMetadata{}
+	for _, order := range added {
+		if _, found := newOrders[order.Hash]; found {
+			addedAndKept = append(addedAndKept, order)
+		}
+	}
+	if len(addedAndKept) == 0 {
+		addedAndKept = nil
+	}
+	return alreadyStored, addedAndKept, removed, nil
+}
+
+// removeBeyondMax removes the orders which don't fit within maxOrders (see
+// memoryOrdersBeyondMax).
+func (t *levelDBOrderTable) removeBeyondMax(maxOrders int) ([]*types.OrderWithMetadata, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	toRemove, err := t.ordersBeyondMax(nil, maxOrders)
+	if err != nil {
+		return nil, err
+	}
+	return toRemove, t.deleteOrders(toRemove)
+}
+
+// ordersBeyondMax returns the stored and new orders which don't fit within
+// maxOrders, in the same order as memoryOrdersBeyondMax would. Unpinned orders
+// are removed before pinned orders and orders with a later expiration time
+// (or, if it is equal, a later sequence number) are removed first. Only the
+// stored orders which may need to be removed are read from the expiration
+// index. The caller must hold the lock.
+func (t *levelDBOrderTable) ordersBeyondMax(newOrders map[common.Hash]*memoryOrder, maxOrders int) ([]*types.OrderWithMetadata, error) {
+	numToRemove := t.numOrders + len(newOrders) - maxOrders
+	if numToRemove <= 0 {
+		return nil, nil
+	}
+	toRemove := []*types.OrderWithMetadata{}
+	for _, isPinned := range []bool{false, true} {
+		if len(toRemove) == numToRemove {
+			break
+		}
+		candidates, err := t.ordersByExpiration(t.db, true, numToRemove-len(toRemove), func(order *types.OrderWithMetadata) (bool, error) {
+			return order.IsPinned == isPinned, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		sorted := make([]*memoryOrder, 0, len(candidates)+len(newOrders))
+		for _, stored := range candidates {
+			sorted = append(sorted, stored)
+		}
+		for _, stored := range newOrders {
+			if stored.order.IsPinned == isPinned {
+				sorted = append(sorted, stored)
+			}
+		}
+		sort.Slice(sorted, func(i, j int) bool {
+			cmp := bytes.Compare(t.expirationIndexNumber(sorted[i].order), t.expirationIndexNumber(sorted[j].order))
+			if cmp != 0 {
+				return cmp > 0
+			}
+			return sorted[i].seq > sorted[j].seq
+		})
+		// Like memoryOrdersBeyondMax, the removed orders are returned in
+		// ascending order.
+		numToRemoveFromGroup := numToRemove - len(toRemove)
+		if numToRemoveFromGroup > len(sorted) {
+			numToRemoveFromGroup = len(sorted)
+		}
+		for i := numToRemoveFromGroup - 1; i >= 0; i-- {
+			toRemove = append(toRemove, sorted[i].order)
+		}
+	}
+	return toRemove, nil
+}
+
+// ordersByExpiration walks the expiration index in ascending or descending
+// order and returns the first n stored orders for which matches returns true.
+// Orders which have the same expiration time as the last of them are also
+// returned so that the caller can sort them by their sequence numbers.
+func (t *levelDBOrderTable) ordersByExpiration(r levelDBReader, descending bool, n int, matches func(order *types.OrderWithMetadata) (bool, error)) (map[common.Hash]*memoryOrder, error) {
+	iter := r.NewIterator(util.BytesPrefix(t.prefix("expiration")), nil)
+	defer iter.Release()
+	first, next := iter.First, iter.Next
+	if descending {
+		first, next = iter.Last, iter.Prev
+	}
+	orders := map[common.Hash]*memoryOrder{}
+	var lastExpiration []byte
+	for ok := first(); ok; ok = next() {
+		key := iter.Key()
+		expiration := key[len(key)-2*common.HashLength : len(key)-common.HashLength]
+		if len(orders) >= n && !bytes.Equal(expiration, lastExpiration) {
+			break
+		}
+		stored, err := t.get(r, common.BytesToHash(key[len(key)-common.HashLength:]))
+		if err != nil {
+			return nil, err
+		}
+		matched, err := matches(stored.order)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			orders[stored.order.Hash] = stored
+			lastExpiration = append([]byte{}, expiration...)
+		}
+	}
+	if err := convertLevelDBErr(iter.Error()); err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// countFromPeerIndex counts the orders which match the given filters using
+// only the keys of the peer index. This is possible if the filters consist of
+// an EQUAL filter on the source peer ID and optionally EQUAL filters on the
+// pinned and removed flags, which is the case for the storage quota of a peer.
+// ok is false if the filters can't be answered this way.
+func (t *levelDBOrderTable) countFromPeerIndex(r levelDBReader, filters []levelDBOrderFilter) (count int, ok bool, err error) {
+	var peerID *string
+	var mask, flags byte
+	for _, filter := range filters {
+		if filter.kind != Equal {
+			return 0, false, nil
+		}
+		switch filter.field {
+		case t.sourcePeerIDField:
+			value, isString := filter.value.(string)
+			if !isString || (peerID != nil && *peerID != value) {
+				return 0, false, nil
+			}
+			peerID = &value
+		case t.isPinnedField, t.isRemovedField:
+			value, isBool := filter.value.(bool)
+			if !isBool {
+				return 0, false, nil
+			}
+			flag := levelDBPeerIndexIsPinned
+			if filter.field == t.isRemovedField {
+				flag = levelDBPeerIndexIsRemoved
+			}
+			if mask&flag != 0 && (flags&flag != 0) != value {
+				// Contradicting filters don't match any orders.
+				return 0, true, nil
+			}
+			mask |= flag
+			if value {
+				flags |= flag
+			}
+		default:
+			return 0, false, nil
+		}
+	}
+	if peerID == nil {
+		return 0, false, nil
+	}
+	prefix := t.peerIndexPrefix(*peerID)
+	err = forEachLevelDBEntry(r, util.BytesPrefix(prefix), func(key []byte, value []byte) error {
+		if key[len(prefix)]&mask == flags {
+			count++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	return count, true, nil
+}
+
+// size returns the number of stored orders.
+func (t *levelDBOrderTable) size() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.numOrders
+}
+
+// deleteOrders deletes the given stored orders. The caller must hold the lock.
+func (t *levelDBOrderTable) deleteOrders(orders []*types.OrderWithMetadata) error {
+	batch := new(leveldb.Batch)
+	for _, order := range orders {
+		t.delete(batch, order)
+	}
+	if err := convertLevelDBErr(t.db.Write(batch, nil)); err != nil {
+		return err
+	}
+	t.numOrders -= len(orders)
+	return nil
+}
+
+func (t *levelDBOrderTable) deleteOrder(hash common.Hash) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	stored, err := t.get(t.db, hash)
+	if err == ErrNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return t.deleteOrders([]*types.OrderWithMetadata{stored.order})
+}
+
+func (t *levelDBOrderTable) update(hash common.Hash, updateFunc func(existingOrder *types.OrderWithMetadata) (updatedOrder *types.OrderWithMetadata, err error)) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	stored, err := t.get(t.db, hash)
+	if err != nil {
+		return err
+	}
+	existingOrder := copyOrderWithMetadata(stored.order)
+	updatedOrder, err := updateFunc(stored.order)
+	if err != nil {
+		return fmt.Errorf("db.UpdateOrders: updateFunc returned error")
+	}
+	// Like in the SQL implementation, the hash of an order can't be changed.
+	updatedOrder = copyOrderWithMetadata(updatedOrder)
+	updatedOrder.Hash = hash
+	// The index entries of the existing order are deleted before the entries
+	// of the updated order are written, so entries which didn't change are
+	// kept.
+	batch := new(leveldb.Batch)
+	t.delete(batch, existingOrder)
+	if err := t.put(batch, &memoryOrder{seq: stored.seq, order: updatedOrder}); err != nil {
+		return err
+	}
+	return convertLevelDBErr(t.db.Write(batch, nil))
+}
+
+func (t *levelDBOrderTable) getOrderStatuses(r levelDBReader, hashes []common.Hash) ([]*StoredOrderStatus, error) {
+	orderStatuses := make([]*StoredOrderStatus, len(hashes))
+	for i, hash := range hashes {
+		stored, err := t.get(r, hash)
+		if err == ErrNotFound {
+			orderStatuses[i] = &StoredOrderStatus{
+				IsStored:                 false,
+				IsMarkedRemoved:          false,
+				IsMarkedUnfillable:       false,
+				FillableTakerAssetAmount: nil,
+			}
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		orderStatuses[i] = &StoredOrderStatus{
+			IsStored:                 true,
+			IsMarkedRemoved:          stored.order.IsRemoved,
+			IsMarkedUnfillable:       stored.order.IsUnfillable,
+			FillableTakerAssetAmount: stored.order.FillableTakerAssetAmount,
+		}
+	}
+	return orderStatuses, nil
+}
+
+func (l *levelDB) AddOrdersV3(orders []*types.OrderWithMetadata) (alreadyStored []common.Hash, added []*types.OrderWithMetadata, removed []*types.OrderWithMetadata, err error) {
+	// Like in the other implementations, MaxOrders is only enforced for v3
+	// orders by RemoveOrdersWithLongExpiration.
+	return l.orders.add(orders, 0)
+}
+
+func (l *levelDB) AddOrdersV4(orders []*types.OrderWithMetadata) (alreadyStored []common.Hash, added []*types.OrderWithMetadata, removed []*types.OrderWithMetadata, err error) {
+	return l.ordersV4.add(orders, l.opts.MaxOrders)
+}
+
+func (l *levelDB) GetOrder(hash common.Hash) (*types.OrderWithMetadata, error) {
+	stored, err := l.orders.get(l.db, hash)
+	if err != nil {
+		return nil, err
+	}
+	return stored.order, nil
+}
+
+func (l *levelDB) GetOrderV4(hash common.Hash) (*types.OrderWithMetadata, error) {
+	stored, err := l.ordersV4.get(l.db, hash)
+	if err != nil {
+		return nil, err
+	}
+	return stored.order, nil
+}
+
+func (l *levelDB) GetOrderStatuses(hashes []common.Hash) (statuses []*StoredOrderStatus, err error) {
+	err = l.readSnapshot(func(snapshot *leveldb.Snapshot) error {
+		statuses, err = l.orders.getOrderStatuses(snapshot, hashes)
+		return err
+	})
+	return statuses, err
+}
+
+func (l *levelDB) GetOrderStatusesV4(hashes []common.Hash) (statuses []*StoredOrderStatus, err error) {
+	err = l.readSnapshot(func(snapshot *leveldb.Snapshot) error {
+		statuses, err = l.ordersV4.getOrderStatuses(snapshot, hashes)
+		return err
+	})
+	return statuses, err
+}
+
+// findOrders returns the stored v3 orders that match the given query.
+func (l *levelDB) findOrders(r levelDBReader, query *OrderQuery) ([]*types.OrderWithMetadata, error) {
+	var filters []OrderFilter
+	if query != nil {
+		filters = query.Filters
+	}
+	var candidates map[common.Hash]*memoryOrder
+	var err error
+	if query != nil && query.Limit != 0 && len(query.Sort) == 1 && query.Sort[0].Field == OFExpirationTimeSeconds && l.orders.indexRange(levelDBOrderFilters(filters)) == nil {
+		// E.g. GetCurrentMaxExpirationTime only needs to read the orders
+		// with the longest expiration times.
+		candidates, err = l.orders.ordersByExpiration(r, query.Sort[0].Direction == Descending, int(query.Limit+query.Offset), func(order *types.OrderWithMetadata) (bool, error) {
+			return OrderMatchesFilters(order, filters)
+		})
+	} else {
+		candidates, err = l.orders.candidates(r, levelDBOrderFilters(filters))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return findMemoryOrders(candidates, query)
+}
+
+// findOrdersV4 is the v4 equivalent of findOrders.
+func (l *levelDB) findOrdersV4(r levelDBReader, query *OrderQueryV4) ([]*types.OrderWithMetadata, error) {
+	var filters []OrderFilterV4
+	if query != nil {
+		filters = query.Filters
+	}
+	var candidates map[common.Hash]*memoryOrder
+	var err error
+	if query != nil && query.Limit != 0 && len(query.Sort) == 1 && query.Sort[0].Field == OV4FExpiry && l.ordersV4.indexRange(levelDBOrderFiltersV4(filters)) == nil {
+		candidates, err = l.ordersV4.ordersByExpiration(r, query.Sort[0].Direction == Descending, int(query.Limit+query.Offset), func(order *types.OrderWithMetadata) (bool, error) {
+			return OrderMatchesFiltersV4(order, filters)
+		})
+	} else {
+		candidates, err = l.ordersV4.candidates(r, levelDBOrderFiltersV4(filters))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return findMemoryOrdersV4(candidates, query)
+}
+
+func (l *levelDB) FindOrders(query *OrderQuery) (orders []*types.OrderWithMetadata, err error) {
+	if err := checkOrderQuery(query); err != nil {
+		return nil, err
+	}
+	err = l.readSnapshot(func(snapshot *leveldb.Snapshot) error {
+		orders, err = l.findOrders(snapshot, query)
+		return err
+	})
+	return orders, err
+}
+
+func (l *levelDB) FindOrdersV4(query *OrderQueryV4) (orders []*types.OrderWithMetadata, err error) {
+	if err := checkOrderQueryV4(query); err != nil {
+		return nil, err
+	}
+	err = l.readSnapshot(func(snapshot *leveldb.Snapshot) error {
+		orders, err = l.findOrdersV4(snapshot, query)
+		return err
+	})
+	return orders, err
+}
+
+func (l *levelDB) RemoveOrdersWithLongExpiration() ([]*types.OrderWithMetadata, error) {
+	return l.orders.removeBeyondMax(l.opts.MaxOrders)
+}
+
+func (l *levelDB) CountOrders(query *OrderQuery) (int, error) {
+	if query == nil || (len(query.Filters) == 0 && query.Limit == 0 && query.Offset == 0) {
+		return l.countAll(l.orders)
+	}
+	if query.Limit == 0 && query.Offset == 0 {
+		var count int
+		var ok bool
+		err := l.readSnapshot(func(snapshot *leveldb.Snapshot) (err error) {
+			count, ok, err = l.orders.countFromPeerIndex(snapshot, levelDBOrderFilters(query.Filters))
+			return err
+		})
+		if err != nil || ok {
+			return count, err
+		}
+	}
+	orders, err := l.FindOrders(query)
+	if err != nil {
+		return 0, err
+	}
+	return len(orders), nil
+}
+
+func (l *levelDB) CountOrdersV4(query *OrderQueryV4) (int, error) {
+	if query == nil || (len(query.Filters) == 0 && query.Limit == 0 && query.Offset == 0) {
+		return l.countAll(l.ordersV4)
+	}
+	if query.Limit == 0 && query.Offset == 0 {
+		var count int
+		var ok bool
+		err := l.readSnapshot(func(snapshot *leveldb.Snapshot) (err error) {
+			count, ok, err = l.ordersV4.countFromPeerIndex(snapshot, levelDBOrderFiltersV4(query.Filters))
+			return err
+		})
+		if err != nil || ok {
+			return count, err
+		}
+	}
+	orders, err := l.FindOrdersV4(query)
+	if err != nil {
+		return 0, err
+	}
+	return len(orders), nil
+}
+
+// countAll returns the number of orders stored in the table. It doesn't need
+// to read any orders, but like the other methods it fails after the database
+// has been closed.
+func (l *levelDB) countAll(table *levelDBOrderTable) (count int, err error) {
+	err = l.readSnapshot(func(snapshot *leveldb.Snapshot) error {
+		count = table.size()
+		return nil
+	})
+	return count, err
+}
+
+func (l *levelDB) GetOrderStats(query *OrderQuery) (*types.OrderStats, error) {
+	if err := checkOrderQuery(query); err != nil {
+		return nil, err
+	}
+	var filters []OrderFilter
+	if query != nil {
+		filters = query.Filters
+	}
+	orders, err := l.FindOrders(&OrderQuery{Filters: filters})
+	if err != nil {
+		return nil, err
+	}
+	return computeOrderStats(orders, func(order *types.OrderWithMetadata) (common.Address, *big.Int) {
+		return order.OrderV3.MakerAddress, order.OrderV3.ExpirationTimeSeconds
+	}), nil
+}
+
+func (l *levelDB) GetOrderStatsV4(query *OrderQueryV4) (*types.OrderStats, error) {
+	if err := checkOrderQueryV4(query); err != nil {
+		return nil, err
+	}
+	var filters []OrderFilterV4
+	if query != nil {
+		filters = query.Filters
+	}
+	orders, err := l.FindOrdersV4(&OrderQueryV4{Filters: filters})
+	if err != nil {
+		return nil, err
+	}
+	return computeOrderStats(orders, func(order *types.OrderWithMetadata) (common.Address, *big.Int) {
+		return order.OrderV4.Maker, order.OrderV4.Expiry
+	}), nil
+}
+
+func (l *levelDB) DeleteOrder(hash common.Hash) error {
+	return l.orders.deleteOrder(hash)
+}
+
+func (l *levelDB) DeleteOrderV4(hash common.Hash) error {
+	return l.ordersV4.deleteOrder(hash)
+}
+
+func (l *levelDB) DeleteOrders(query *OrderQuery) ([]*types.OrderWithMetadata, error) {
+	if err := checkOrderQuery(query); err != nil {
+		return nil, err
+	}
+	l.orders.mu.Lock()
+	defer l.orders.mu.Unlock()
+	ordersToDelete, err := l.findOrders(l.db, query)
+	if err != nil {
+		return nil, err
+	}
+	if err := l.orders.deleteOrders(ordersToDelete); err != nil {
+		return nil, err
+	}
+	return ordersToDelete, nil
+}
+
+func (l *levelDB) DeleteOrdersV4(query *OrderQueryV4) ([]*types.OrderWithMetadata, error) {
+	if err := checkOrderQueryV4(query); err != nil {
+		return nil, err
+	}
+	l.ordersV4.mu.Lock()
+	defer l.ordersV4.mu.Unlock()
+	ordersToDelete, err := l.findOrdersV4(l.db, query)
+	if err != nil {
+		return nil, err
+	}
+	if err := l.ordersV4.deleteOrders(ordersToDelete); err != nil {
+		return nil, err
+	}
+	return ordersToDelete, nil
+}
+
+func (l *levelDB) UpdateOrderV3(hash common.Hash, updateFunc func(existingOrder *types.OrderWithMetadata) (updatedOrder *types.OrderWithMetadata, err error)) error {
+	if updateFunc == nil {
+		return errors.New("db.UpdateOrders: updateFunc cannot be nil")
+	}
+	return l.orders.update(hash, updateFunc)
+}
+
+func (l *levelDB) UpdateOrderV4(hash common.Hash, updateFunc func(existingOrder *types.OrderWithMetadata) (updatedOrder *types.OrderWithMetadata, err error)) error {
+	if updateFunc == nil {
+		return errors.New("db.UpdateOrdersV4: updateFunc cannot be nil")
+	}
+	return l.ordersV4.update(hash, updateFunc)
+}
+
+func (l *levelDB) FindMakerQuotaUsage(limit int) ([]*types.QuotaUsage, error) {
+	return l.findQuotaUsage(limit, makerQuotaID)
+}
+
+func (l *levelDB) FindPeerQuotaUsage(limit int) ([]*types.QuotaUsage, error) {
+	return l.findQuotaUsage(limit, peerQuotaID)
+}
+
+// findQuotaUsage is the equivalent of memoryDB.findQuotaUsage.
+func (l *levelDB) findQuotaUsage(limit int, getID func(order *types.OrderWithMetadata) string) (usage []*types.QuotaUsage, err error) {
+	err = l.readSnapshot(func(snapshot *leveldb.Snapshot) error {
+		numOrdersByID := map[string]int{}
+		for _, table := range []*levelDBOrderTable{l.orders, l.ordersV4} {
+			orders, err := table.loadAll(snapshot)
+			if err != nil {
+				return err
+			}
+			for _, stored := range orders {
+				if stored.order.IsPinned || stored.order.IsRemoved {
+					continue
+				}
+				if id := getID(stored.order); id != "" {
+					numOrdersByID[id]++
+				}
+			}
+		}
+		usage = quotaUsageFromCounts(numOrdersByID, limit)
+		return nil
+	})
+	return usage, err
+}
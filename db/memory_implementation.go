@@ -0,0 +1,1262 @@
+// +build !js
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/zeroex"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gibson042/canonicaljson-go"
+	ds "github.com/ipfs/go-datastore"
+	dssync "github.com/ipfs/go-datastore/sync"
+)
+
+// MemoryDriverName is the value of Options.DriverName which selects the
+// in-memory implementation of the database. Nothing is persisted to disk, so
+// all data is lost when the context passed to New is canceled. The in-memory
+// implementation has the same semantics as the SQL implementation and is
+// mostly useful for tests and short-lived nodes.
+const MemoryDriverName = "memory"
+
+var _ backend = (*memoryDB)(nil)
+
+// memoryDB is a pure Go implementation of the database which is used when
+// MemoryDriverName is selected.
+//
+// Stored values are copied on the way in and on the way out so that callers
+// can't modify stored values without going through the database. The copies
+// are shallow: *big.Int and []byte fields are shared and, like everywhere
+// else in Mesh, must be treated as immutable.
+type memoryDB struct {
+	opts *Options
+
+	mu     sync.RWMutex
+	closed bool
+	// nextOrderSeq is used to remember the order in which orders were inserted.
+	// It is used to break ties when sorting so that orders are returned in a
+	// stable order, like they are by SQLite.
+	nextOrderSeq           uint64
+	orders                 map[common.Hash]*memoryOrder
+	ordersV4               map[common.Hash]*memoryOrder
+	miniHeaders            map[common.Hash]*types.MiniHeader
+	metadata               *types.Metadata
+	orderEvents            []*zeroex.OrderEvent
+	lastOrderEventSequence uint64
+	webhookDeliveries      []*types.WebhookDelivery
+	lastWebhookDeliveryID  uint64
+	archivedOrders         map[common.Hash]*types.ArchivedOrder
+	peerStore              ds.Batching
+	dhtStore               ds.Batching
+}
+
+type memoryOrder struct {
+	seq   uint64
+	order *types.OrderWithMetadata
+}
+
+func newMemoryDB(ctx context.Context, opts *Options) *memoryDB {
+	m := &memoryDB{
+		opts:           opts,
+		orders:         map[common.Hash]*memoryOrder{},
+		ordersV4:       map[common.Hash]*memoryOrder{},
+		miniHeaders:    map[common.Hash]*types.MiniHeader{},
+		archivedOrders: map[common.Hash]*types.ArchivedOrder{},
+		peerStore:      dssync.MutexWrap(ds.NewMapDatastore()),
+		dhtStore:       dssync.MutexWrap(ds.NewMapDatastore()),
+	}
+	// Automatically close the database when the context is canceled.
+	go func() {
+		<-ctx.Done()
+		m.mu.Lock()
+		m.closed = true
+		m.mu.Unlock()
+	}()
+	return m
+}
+
+// lock acquires a write lock. It returns ErrClosed (without holding the lock)
+// if the database has been closed.
+func (m *memoryDB) lock() error {
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return ErrClosed
+	}
+	return nil
+}
+
+// rlock acquires a read lock. It returns ErrClosed (without holding the lock)
+// if the database has been closed.
+func (m *memoryDB) rlock() error {
+	m.mu.RLock()
+	if m.closed {
+		m.mu.RUnlock()
+		return ErrClosed
+	}
+	return nil
+}
+
+func (m *memoryDB) PeerStore() ds.Batching {
+	return m.peerStore
+}
+
+func (m *memoryDB) DHTStore() ds.Batching {
+	return m.dhtStore
+}
+
+func (m *memoryDB) AddOrdersV3(orders []*types.OrderWithMetadata) (alreadyStored []common.Hash, added []*types.OrderWithMetadata, removed []*types.OrderWithMetadata, err error) {
+	if err := m.lock(); err != nil {
+		return nil, nil, nil, err
+	}
+	defer m.mu.Unlock()
+
+	for _, order := range orders {
+		if order.OrderV3 == nil {
+			continue
+		}
+		if _, found := m.orders[order.Hash]; found {
+			alreadyStored = append(alreadyStored, order.Hash)
+			continue
+		}
+		m.insertOrder(m.orders, order)
+		added = append(added, order)
+	}
+	return alreadyStored, added, nil, nil
+}
+
+func (m *memoryDB) AddOrdersV4(orders []*types.OrderWithMetadata) (alreadyStored []common.Hash, added []*types.OrderWithMetadata, removed []*types.OrderWithMetadata, err error) {
+	if err := m.lock(); err != nil {
+		return nil, nil, nil, err
+	}
+	defer m.mu.Unlock()
+
+	addedMap := map[common.Hash]*types.OrderWithMetadata{}
+	for _, order := range orders {
+		if order.OrderV4 == nil {
+			continue
+		}
+		if _, found := m.ordersV4[order.Hash]; found {
+			alreadyStored = append(alreadyStored, order.Hash)
+			continue
+		}
+		m.insertOrder(m.ordersV4, order)
+		addedMap[order.Hash] = order
+	}
+
+	// Remove orders with an expiration time too far in the future.
+	toRemove, err := memoryOrdersBeyondMax(m.ordersV4, m.opts.MaxOrders, func(order *types.OrderWithMetadata) *big.Int {
+		return order.OrderV4.Expiry
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for _, order := range toRemove {
+		delete(m.ordersV4, order.Hash)
+		if _, found := addedMap[order.Hash]; found {
+			// If the order was previously added, remove it from
+			// the added set and don't add it to the removed set.
+			delete(addedMap, order.Hash)
+		} else {
+			removed = append(removed, order)
+		}
+	}
+	for _, order := range orders {
+		if _, found := addedMap[order.Hash]; found {
+			added = append(added, order)
+		}
+	}
+	return alreadyStored, added, removed, nil
+}
+
+func (m *memoryDB) insertOrder(orders map[common.Hash]*memoryOrder, order *types.OrderWithMetadata) {
+	m.nextOrderSeq++
+	orders[order.Hash] = &memoryOrder{
+		seq:   m.nextOrderSeq,
+		order: copyOrderWithMetadata(order),
+	}
+}
+
+// memoryOrdersBeyondMax returns the orders which don't fit within maxOrders
+// when pinned orders are kept first, followed by the orders that expire
+// soonest.
+func memoryOrdersBeyondMax(orders map[common.Hash]*memoryOrder, maxOrders int, expirationTime func(*types.OrderWithMetadata) *big.Int) ([]*types.OrderWithMetadata, error) {
+	if len(orders) <= maxOrders {
+		return nil, nil
+	}
+	sorted, err := sortedMemoryOrders(orders, func(a, b *types.OrderWithMetadata) (int, error) {
+		if a.IsPinned != b.IsPinned {
+			if a.IsPinned {
+				return -1, nil
+			}
+			return 1, nil
+		}
+		return compareFilterValues(expirationTime(a), expirationTime(b))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return copyOrdersWithMetadata(sorted[maxOrders:]), nil
+}
+
+func (m *memoryDB) GetOrder(hash common.Hash) (*types.OrderWithMetadata, error) {
+	return m.getOrder(m.orders, hash)
+}
+
+func (m *memoryDB) GetOrderV4(hash common.Hash) (*types.OrderWithMetadata, error) {
+	return m.getOrder(m.ordersV4, hash)
+}
+
+func (m *memoryDB) getOrder(orders map[common.Hash]*memoryOrder, hash common.Hash) (*types.OrderWithMetadata, error) {
+	if err := m.rlock(); err != nil {
+		return nil, err
+	}
+	defer m.mu.RUnlock()
+	stored, found := orders[hash]
+	if !found {
+		return nil, ErrNotFound
+	}
+	return copyOrderWithMetadata(stored.order), nil
+}
+
+func (m *memoryDB) GetOrderStatuses(hashes []common.Hash) ([]*StoredOrderStatus, error) {
+	return m.getOrderStatuses(m.orders, hashes)
+}
+
+func (m *memoryDB) GetOrderStatusesV4(hashes []common.Hash) ([]*StoredOrderStatus, error) {
+	return m.getOrderStatuses(m.ordersV4, hashes)
+}
+
+func (m *memoryDB) getOrderStatuses(orders map[common.Hash]*memoryOrder, hashes []common.Hash) ([]*StoredOrderStatus, error) {
+	if err := m.rlock(); err != nil {
+		return nil, err
+	}
+	defer m.mu.RUnlock()
+	orderStatuses := make([]*StoredOrderStatus, len(hashes))
+	for i, hash := range hashes {
+		stored, found := orders[hash]
+		if !found {
+			orderStatuses[i] = &StoredOrderStatus{
+				IsStored:                 false,
+				IsMarkedRemoved:          false,
+				IsMarkedUnfillable:       false,
+				FillableTakerAssetAmount: nil,
+			}
+			continue
+		}
+		orderStatuses[i] = &StoredOrderStatus{
+			IsStored:                 true,
+			IsMarkedRemoved:          stored.order.IsRemoved,
+			IsMarkedUnfillable:       stored.order.IsUnfillable,
+			FillableTakerAssetAmount: stored.order.FillableTakerAssetAmount,
+		}
+	}
+	return orderStatuses, nil
+}
+
+func (m *memoryDB) FindOrders(query *OrderQuery) ([]*types.OrderWithMetadata, error) {
+	if err := checkOrderQuery(query); err != nil {
+		return nil, err
+	}
+	if err := m.rlock(); err != nil {
+		return nil, err
+	}
+	defer m.mu.RUnlock()
+	orders, err := findMemoryOrders(m.orders, query)
+	if err != nil {
+		return nil, err
+	}
+	return copyOrdersWithMetadata(orders), nil
+}
+
+func (m *memoryDB) FindOrdersV4(query *OrderQueryV4) ([]*types.OrderWithMetadata, error) {
+	if err := checkOrderQueryV4(query); err != nil {
+		return nil, err
+	}
+	if err := m.rlock(); err != nil {
+		return nil, err
+	}
+	defer m.mu.RUnlock()
+	orders, err := findMemoryOrdersV4(m.ordersV4, query)
+	if err != nil {
+		return nil, err
+	}
+	return copyOrdersWithMetadata(orders), nil
+}
+
+// findMemoryOrders returns the v3 orders in orders that match the given query.
+// The returned orders are not copied. If orders are stored in memoryDB, the
+// caller must hold the lock.
+func findMemoryOrders(orders map[common.Hash]*memoryOrder, query *OrderQuery) ([]*types.OrderWithMetadata, error) {
+	if query == nil {
+		query = &OrderQuery{}
+	}
+	matches := map[common.Hash]*memoryOrder{}
+	for hash, stored := range orders {
+		matched, err := OrderMatchesFilters(stored.order, query.Filters)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			matches[hash] = stored
+		}
+	}
+	sorted, err := sortedMemoryOrders(matches, func(a, b *types.OrderWithMetadata) (int, error) {
+		for _, sortOpt := range query.Sort {
+			cmp, err := compareOrderFields(a, b, func(order *types.OrderWithMetadata) (interface{}, error) {
+				return OrderFieldValue(order, sortOpt.Field)
+			})
+			if err != nil {
+				return 0, err
+			}
+			if cmp != 0 {
+				if sortOpt.Direction == Descending {
+					return -cmp, nil
+				}
+				return cmp, nil
+			}
+		}
+		return 0, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return applyLimitAndOffset(sorted, query.Limit, query.Offset), nil
+}
+
+// findMemoryOrdersV4 is the v4 equivalent of findMemoryOrders.
+func findMemoryOrdersV4(orders map[common.Hash]*memoryOrder, query *OrderQueryV4) ([]*types.OrderWithMetadata, error) {
+	if query == nil {
+		query = &OrderQueryV4{}
+	}
+	matches := map[common.Hash]*memoryOrder{}
+	for hash, stored := range orders {
+		matched, err := OrderMatchesFiltersV4(stored.order, query.Filters)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			matches[hash] = stored
+		}
+	}
+	sorted, err := sortedMemoryOrders(matches, func(a, b *types.OrderWithMetadata) (int, error) {
+		for _, sortOpt := range query.Sort {
+			cmp, err := compareOrderFields(a, b, func(order *types.OrderWithMetadata) (interface{}, error) {
+				return OrderFieldValueV4(order, sortOpt.Field)
+			})
+			if err != nil {
+				return 0, err
+			}
+			if cmp != 0 {
+				if sortOpt.Direction == Descending {
+					return -cmp, nil
+				}
+				return cmp, nil
+			}
+		}
+		return 0, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return applyLimitAndOffset(sorted, query.Limit, query.Offset), nil
+}
+
+func compareOrderFields(a, b *types.OrderWithMetadata, fieldValue func(*types.OrderWithMetadata) (interface{}, error)) (int, error) {
+	aValue, err := fieldValue(a)
+	if err != nil {
+		return 0, err
+	}
+	bValue, err := fieldValue(b)
+	if err != nil {
+		return 0, err
+	}
+	return compareFilterValues(aValue, bValue)
+}
+
+// sortedMemoryOrders returns the given orders sorted according to cmp. Orders
+// which are equal according to cmp are sorted in the order in which they were
+// inserted.
+func sortedMemoryOrders(orders map[common.Hash]*memoryOrder, cmp func(a, b *types.OrderWithMetadata) (int, error)) ([]*types.OrderWithMetadata, error) {
+	stored := make([]*memoryOrder, 0, len(orders))
+	for _, order := range orders {
+		stored = append(stored, order)
+	}
+	var sortErr error
+	sort.Slice(stored, func(i, j int) bool {
+		result, err := cmp(stored[i].order, stored[j].order)
+		if err != nil {
+			sortErr = err
+			return false
+		}
+		if result != 0 {
+			return result < 0
+		}
+		return stored[i].seq < stored[j].seq
+	})
+	if sortErr != nil {
+		return nil, sortErr
+	}
+	sorted := make([]*types.OrderWithMetadata, len(stored))
+	for i, order := range stored {
+		sorted[i] = order.order
+	}
+	return sorted, nil
+}
+
+func applyLimitAndOffset(orders []*types.OrderWithMetadata, limit uint, offset uint) []*types.OrderWithMetadata {
+	if offset >= uint(len(orders)) {
+		return []*types.OrderWithMetadata{}
+	}
+	orders = orders[offset:]
+	if limit != 0 && limit < uint(len(orders)) {
+		orders = orders[:limit]
+	}
+	return orders
+}
+
+func (m *memoryDB) RemoveOrdersWithLongExpiration() ([]*types.OrderWithMetadata, error) {
+	if err := m.lock(); err != nil {
+		return nil, err
+	}
+	defer m.mu.Unlock()
+	toRemove, err := memoryOrdersBeyondMax(m.orders, m.opts.MaxOrders, func(order *types.OrderWithMetadata) *big.Int {
+		return order.OrderV3.ExpirationTimeSeconds
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, order := range toRemove {
+		delete(m.orders, order.Hash)
+	}
+	return copyOrdersWithMetadata(toRemove), nil
+}
+
+func (m *memoryDB) CountOrders(query *OrderQuery) (int, error) {
+	if err := checkOrderQuery(query); err != nil {
+		return 0, err
+	}
+	if err := m.rlock(); err != nil {
+		return 0, err
+	}
+	defer m.mu.RUnlock()
+	orders, err := findMemoryOrders(m.orders, query)
+	if err != nil {
+		return 0, err
+	}
+	return len(orders), nil
+}
+
+func (m *memoryDB) CountOrdersV4(query *OrderQueryV4) (int, error) {
+	if err := checkOrderQueryV4(query); err != nil {
+		return 0, err
+	}
+	if err := m.rlock(); err != nil {
+		return 0, err
+	}
+	defer m.mu.RUnlock()
+	orders, err := findMemoryOrdersV4(m.ordersV4, query)
+	if err != nil {
+		return 0, err
+	}
+	return len(orders), nil
+}
+
+func (m *memoryDB) GetOrderStats(query *OrderQuery) (*types.OrderStats, error) {
+	if err := checkOrderQuery(query); err != nil {
+		return nil, err
+	}
+	var filters []OrderFilter
+	if query != nil {
+		filters = query.Filters
+	}
+	if err := m.rlock(); err != nil {
+		return nil, err
+	}
+	defer m.mu.RUnlock()
+	orders, err := findMemoryOrders(m.orders, &OrderQuery{Filters: filters})
+	if err != nil {
+		return nil, err
+	}
+	return computeOrderStats(orders, func(order *types.OrderWithMetadata) (common.Address, *big.Int) {
+		return order.OrderV3.MakerAddress, order.OrderV3.ExpirationTimeSeconds
+	}), nil
+}
+
+func (m *memoryDB) GetOrderStatsV4(query *OrderQueryV4) (*types.OrderStats, error) {
+	if err := checkOrderQueryV4(query); err != nil {
+		return nil, err
+	}
+	var filters []OrderFilterV4
+	if query != nil {
+		filters = query.Filters
+	}
+	if err := m.rlock(); err != nil {
+		return nil, err
+	}
+	defer m.mu.RUnlock()
+	orders, err := findMemoryOrdersV4(m.ordersV4, &OrderQueryV4{Filters: filters})
+	if err != nil {
+		return nil, err
+	}
+	return computeOrderStats(orders, func(order *types.OrderWithMetadata) (common.Address, *big.Int) {
+		return order.OrderV4.Maker, order.OrderV4.Expiry
+	}), nil
+}
+
+// computeOrderStats is the in-memory equivalent of getOrderStats. makerAndExpiration
+// returns the maker address and expiration time of an order.
+func computeOrderStats(orders []*types.OrderWithMetadata, makerAndExpiration func(*types.OrderWithMetadata) (common.Address, *big.Int)) *types.OrderStats {
+	stats := &types.OrderStats{
+		OrderCount:                    len(orders),
+		TotalFillableTakerAssetAmount: big.NewInt(0),
+	}
+	makers := map[common.Address]struct{}{}
+	for _, order := range orders {
+		maker, expirationTime := makerAndExpiration(order)
+		makers[maker] = struct{}{}
+		if stats.MinExpirationTimeSeconds == nil || expirationTime.Cmp(stats.MinExpirationTimeSeconds) < 0 {
+			stats.MinExpirationTimeSeconds = expirationTime
+		}
+		if stats.MaxExpirationTimeSeconds == nil || expirationTime.Cmp(stats.MaxExpirationTimeSeconds) > 0 {
+			stats.MaxExpirationTimeSeconds = expirationTime
+		}
+		if order.FillableTakerAssetAmount != nil {
+			stats.TotalFillableTakerAssetAmount.Add(stats.TotalFillableTakerAssetAmount, order.FillableTakerAssetAmount)
+		}
+	}
+	stats.MakerCount = len(makers)
+	if stats.MinExpirationTimeSeconds != nil {
+		stats.MinExpirationTimeSeconds = new(big.Int).Set(stats.MinExpirationTimeSeconds)
+	}
+	if stats.MaxExpirationTimeSeconds != nil {
+		stats.MaxExpirationTimeSeconds = new(big.Int).Set(stats.MaxExpirationTimeSeconds)
+	}
+	return stats
+}
+
+func (m *memoryDB) DeleteOrder(hash common.Hash) error {
+	return m.deleteOrder(m.orders, hash)
+}
+
+func (m *memoryDB) DeleteOrderV4(hash common.Hash) error {
+	return m.deleteOrder(m.ordersV4, hash)
+}
+
+func (m *memoryDB) deleteOrder(orders map[common.Hash]*memoryOrder, hash common.Hash) error {
+	if err := m.lock(); err != nil {
+		return err
+	}
+	defer m.mu.Unlock()
+	delete(orders, hash)
+	return nil
+}
+
+func (m *memoryDB) DeleteOrders(query *OrderQuery) ([]*types.OrderWithMetadata, error) {
+	if err := checkOrderQuery(query); err != nil {
+		return nil, err
+	}
+	if err := m.lock(); err != nil {
+		return nil, err
+	}
+	defer m.mu.Unlock()
+	ordersToDelete, err := findMemoryOrders(m.orders, query)
+	if err != nil {
+		return nil, err
+	}
+	for _, order := range ordersToDelete {
+		delete(m.orders, order.Hash)
+	}
+	return copyOrdersWithMetadata(ordersToDelete), nil
+}
+
+func (m *memoryDB) DeleteOrdersV4(query *OrderQueryV4) ([]*types.OrderWithMetadata, error) {
+	if err := checkOrderQueryV4(query); err != nil {
+		return nil, err
+	}
+	if err := m.lock(); err != nil {
+		return nil, err
+	}
+	defer m.mu.Unlock()
+	ordersToDelete, err := findMemoryOrdersV4(m.ordersV4, query)
+	if err != nil {
+		return nil, err
+	}
+	for _, order := range ordersToDelete {
+		delete(m.ordersV4, order.Hash)
+	}
+	return copyOrdersWithMetadata(ordersToDelete), nil
+}
+
+func (m *memoryDB) UpdateOrderV3(hash common.Hash, updateFunc func(existingOrder *types.OrderWithMetadata) (updatedOrder *types.OrderWithMetadata, err error)) error {
+	if updateFunc == nil {
+		return errors.New("db.UpdateOrders: updateFunc cannot be nil")
+	}
+	return m.updateOrder(m.orders, hash, updateFunc)
+}
+
+func (m *memoryDB) UpdateOrderV4(hash common.Hash, updateFunc func(existingOrder *types.OrderWithMetadata) (updatedOrder *types.OrderWithMetadata, err error)) error {
+	if updateFunc == nil {
+		return errors.New("db.UpdateOrdersV4: updateFunc cannot be nil")
+	}
+	return m.updateOrder(m.ordersV4, hash, updateFunc)
+}
+
+func (m *memoryDB) updateOrder(orders map[common.Hash]*memoryOrder, hash common.Hash, updateFunc func(existingOrder *types.OrderWithMetadata) (updatedOrder *types.OrderWithMetadata, err error)) error {
+	if err := m.lock(); err != nil {
+		return err
+	}
+	defer m.mu.Unlock()
+	stored, found := orders[hash]
+	if !found {
+		return ErrNotFound
+	}
+	updatedOrder, err := updateFunc(copyOrderWithMetadata(stored.order))
+	if err != nil {
+		return fmt.Errorf("db.UpdateOrders: updateFunc returned error")
+	}
+	// Like in the SQL implementation, the hash of an order can't be changed.
+	stored.order = copyOrderWithMetadata(updatedOrder)
+	stored.order.Hash = hash
+	return nil
+}
+
+func (m *memoryDB) AddMiniHeaders(miniHeaders []*types.MiniHeader) (added []*types.MiniHeader, removed []*types.MiniHeader, err error) {
+	if err := m.lock(); err != nil {
+		return nil, nil, err
+	}
+	defer m.mu.Unlock()
+
+	addedMap := map[common.Hash]*types.MiniHeader{}
+	for _, miniHeader := range miniHeaders {
+		if _, found := m.miniHeaders[miniHeader.Hash]; found {
+			continue
+		}
+		m.miniHeaders[miniHeader.Hash] = copyMiniHeader(miniHeader)
+		addedMap[miniHeader.Hash] = miniHeader
+	}
+
+	if len(m.miniHeaders) > m.opts.MaxMiniHeaders {
+		sorted, err := findMemoryMiniHeaders(m.miniHeaders, &MiniHeaderQuery{
+			Sort: []MiniHeaderSort{
+				{
+					Field:     MFNumber,
+					Direction: Descending,
+				},
+			},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, miniHeader := range sorted[m.opts.MaxMiniHeaders:] {
+			delete(m.miniHeaders, miniHeader.Hash)
+			if _, found := addedMap[miniHeader.Hash]; found {
+				// If the miniHeader was previously added, remove it from
+				// the added set and don't add it to the removed set.
+				delete(addedMap, miniHeader.Hash)
+			} else {
+				removed = append(removed, miniHeader)
+			}
+		}
+	}
+
+	for _, miniHeader := range miniHeaders {
+		if _, found := addedMap[miniHeader.Hash]; found {
+			added = append(added, miniHeader)
+			delete(addedMap, miniHeader.Hash)
+		}
+	}
+	return added, removed, nil
+}
+
+func (m *memoryDB) ResetMiniHeaders(newMiniHeaders []*types.MiniHeader) error {
+	if err := m.lock(); err != nil {
+		return err
+	}
+	defer m.mu.Unlock()
+	m.miniHeaders = map[common.Hash]*types.MiniHeader{}
+	for _, miniHeader := range newMiniHeaders {
+		m.miniHeaders[miniHeader.Hash] = copyMiniHeader(miniHeader)
+	}
+	return nil
+}
+
+func (m *memoryDB) GetMiniHeader(hash common.Hash) (*types.MiniHeader, error) {
+	if err := m.rlock(); err != nil {
+		return nil, err
+	}
+	defer m.mu.RUnlock()
+	miniHeader, found := m.miniHeaders[hash]
+	if !found {
+		return nil, ErrNotFound
+	}
+	return copyMiniHeader(miniHeader), nil
+}
+
+func (m *memoryDB) FindMiniHeaders(query *MiniHeaderQuery) ([]*types.MiniHeader, error) {
+	if err := m.rlock(); err != nil {
+		return nil, err
+	}
+	defer m.mu.RUnlock()
+	miniHeaders, err := findMemoryMiniHeaders(m.miniHeaders, query)
+	if err != nil {
+		return nil, err
+	}
+	return copyMiniHeaders(miniHeaders), nil
+}
+
+// findMemoryMiniHeaders returns the mini headers in miniHeaders that match the
+// given query. The returned mini headers are not copied. If the mini headers
+// are stored in memoryDB, the caller must hold the lock.
+func findMemoryMiniHeaders(miniHeaders map[common.Hash]*types.MiniHeader, query *MiniHeaderQuery) ([]*types.MiniHeader, error) {
+	if query == nil {
+		query = &MiniHeaderQuery{}
+	}
+	if query.Offset != 0 && query.Limit == 0 {
+		return nil, errors.New("db.FindMiniHeaders: can't use Offset without Limit")
+	}
+	matches := []*types.MiniHeader{}
+	for _, miniHeader := range miniHeaders {
+		matched, err := miniHeaderMatchesFilters(miniHeader, query.Filters)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			matches = append(matches, miniHeader)
+		}
+	}
+	var sortErr error
+	sort.Slice(matches, func(i, j int) bool {
+		for _, sortOpt := range query.Sort {
+			cmp, err := compareMiniHeaderFields(matches[i], matches[j], sortOpt.Field)
+			if err != nil {
+				sortErr = err
+				return false
+			}
+			if cmp != 0 {
+				if sortOpt.Direction == Descending {
+					return cmp > 0
+				}
+				return cmp < 0
+			}
+		}
+		// Mini headers don't have an insertion order, so the hash is used to
+		// break ties in order to make the results deterministic.
+		return matches[i].Hash.Hex() < matches[j].Hash.Hex()
+	})
+	if sortErr != nil {
+		return nil, sortErr
+	}
+
+	if query.Offset >= uint(len(matches)) {
+		return []*types.MiniHeader{}, nil
+	}
+	matches = matches[query.Offset:]
+	if query.Limit != 0 && query.Limit < uint(len(matches)) {
+		matches = matches[:query.Limit]
+	}
+	return matches, nil
+}
+
+func miniHeaderFieldValue(miniHeader *types.MiniHeader, field MiniHeaderField) (interface{}, error) {
+	switch field {
+	case MFHash:
+		return miniHeader.Hash, nil
+	case MFParent:
+		return miniHeader.Parent, nil
+	case MFNumber:
+		return miniHeader.Number, nil
+	case MFTimestamp:
+		return miniHeader.Timestamp, nil
+	case MFLogs:
+		// Logs are encoded the same way that they are stored in the database
+		// so that CONTAINS filters behave the same way.
+		return canonicaljson.Marshal(miniHeader.Logs)
+	default:
+		return nil, fmt.Errorf("db.FindMiniHeaders: unsupported field: %q", field)
+	}
+}
+
+func compareMiniHeaderFields(a, b *types.MiniHeader, field MiniHeaderField) (int, error) {
+	aValue, err := miniHeaderFieldValue(a, field)
+	if err != nil {
+		return 0, err
+	}
+	bValue, err := miniHeaderFieldValue(b, field)
+	if err != nil {
+		return 0, err
+	}
+	return compareFilterValues(aValue, bValue)
+}
+
+func miniHeaderMatchesFilters(miniHeader *types.MiniHeader, filters []MiniHeaderFilter) (bool, error) {
+	for _, filter := range filters {
+		switch filter.Kind {
+		case Equal, NotEqual, Less, Greater, LessOrEqual, GreaterOrEqual, Contains:
+		default:
+			return false, fmt.Errorf("db.FindMiniHeaders: unknown FilterOpt.Kind: %s", filter.Kind)
+		}
+		value, err := miniHeaderFieldValue(miniHeader, filter.Field)
+		if err != nil {
+			return false, err
+		}
+		matches, err := filterValueMatches(filter.Kind, value, filter.Value)
+		if err != nil {
+			return false, err
+		}
+		if !matches {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (m *memoryDB) DeleteMiniHeader(hash common.Hash) error {
+	if err := m.lock(); err != nil {
+		return err
+	}
+	defer m.mu.Unlock()
+	delete(m.miniHeaders, hash)
+	return nil
+}
+
+func (m *memoryDB) DeleteMiniHeaders(query *MiniHeaderQuery) ([]*types.MiniHeader, error) {
+	if err := m.lock(); err != nil {
+		return nil, err
+	}
+	defer m.mu.Unlock()
+	miniHeadersToDelete, err := findMemoryMiniHeaders(m.miniHeaders, query)
+	if err != nil {
+		return nil, err
+	}
+	for _, miniHeader := range miniHeadersToDelete {
+		delete(m.miniHeaders, miniHeader.Hash)
+	}
+	return copyMiniHeaders(miniHeadersToDelete), nil
+}
+
+func (m *memoryDB) GetMetadata() (*types.Metadata, error) {
+	if err := m.rlock(); err != nil {
+		return nil, err
+	}
+	defer m.mu.RUnlock()
+	if m.metadata == nil {
+		return nil, ErrNotFound
+	}
+	metadata := *m.metadata
+	return &metadata, nil
+}
+
+func (m *memoryDB) SaveMetadata(metadata *types.Metadata) error {
+	if err := m.lock(); err != nil {
+		return err
+	}
+	defer m.mu.Unlock()
+	if m.metadata != nil {
+		return ErrMetadataAlreadyExists
+	}
+	metadataCopy := *metadata
+	m.metadata = &metadataCopy
+	return nil
+}
+
+func (m *memoryDB) UpdateMetadata(updateFunc func(oldmetadata *types.Metadata) (newMetadata *types.Metadata)) error {
+	if updateFunc == nil {
+		return errors.New("db.UpdateMetadata: updateFunc cannot be nil")
+	}
+	if err := m.lock(); err != nil {
+		return err
+	}
+	defer m.mu.Unlock()
+	if m.metadata == nil {
+		return ErrNotFound
+	}
+	existingMetadata := *m.metadata
+	updatedMetadata := *updateFunc(&existingMetadata)
+	m.metadata = &updatedMetadata
+	return nil
+}
+
+func (m *memoryDB) AddOrderEvents(orderEvents []*zeroex.OrderEvent) error {
+	if len(orderEvents) == 0 {
+		return nil
+	}
+	if err := m.lock(); err != nil {
+		return err
+	}
+	defer m.mu.Unlock()
+
+	for _, orderEvent := range orderEvents {
+		m.lastOrderEventSequence++
+		orderEvent.SequenceNumber = m.lastOrderEventSequence
+		orderEventCopy := *orderEvent
+		m.orderEvents = append(m.orderEvents, &orderEventCopy)
+	}
+
+	// Remove the oldest events if we are over the limit.
+	if len(m.orderEvents) > m.opts.MaxOrderEvents {
+		numToRemove := len(m.orderEvents) - m.opts.MaxOrderEvents
+		m.orderEvents = append([]*zeroex.OrderEvent{}, m.orderEvents[numToRemove:]...)
+	}
+	return nil
+}
+
+func (m *memoryDB) FindOrderEvents(query *OrderEventQuery) ([]*zeroex.OrderEvent, error) {
+	if err := m.rlock(); err != nil {
+		return nil, err
+	}
+	defer m.mu.RUnlock()
+	if query == nil {
+		query = &OrderEventQuery{}
+	}
+	// The order events are already sorted by sequence number.
+	start := sort.Search(len(m.orderEvents), func(i int) bool {
+		return m.orderEvents[i].SequenceNumber > query.AfterSequenceNumber
+	})
+	orderEvents := []*zeroex.OrderEvent{}
+	for _, orderEvent := range m.orderEvents[start:] {
+		if query.Limit != 0 && uint(len(orderEvents)) >= query.Limit {
+			break
+		}
+		orderEventCopy := *orderEvent
+		orderEvents = append(orderEvents, &orderEventCopy)
+	}
+	return orderEvents, nil
+}
+
+func (m *memoryDB) GetOrderEventSequenceNumberRange() (oldest uint64, latest uint64, err error) {
+	if err := m.rlock(); err != nil {
+		return 0, 0, err
+	}
+	defer m.mu.RUnlock()
+	if len(m.orderEvents) == 0 {
+		return 0, 0, nil
+	}
+	return m.orderEvents[0].SequenceNumber, m.orderEvents[len(m.orderEvents)-1].SequenceNumber, nil
+}
+
+func (m *memoryDB) GetLatestOrderEvent(orderHash common.Hash) (*zeroex.OrderEvent, error) {
+	if err := m.rlock(); err != nil {
+		return nil, err
+	}
+	defer m.mu.RUnlock()
+	for i := len(m.orderEvents) - 1; i >= 0; i-- {
+		if m.orderEvents[i].OrderHash == orderHash {
+			orderEventCopy := *m.orderEvents[i]
+			return &orderEventCopy, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (m *memoryDB) AddArchivedOrders(orders []*types.ArchivedOrder) error {
+	if err := m.lock(); err != nil {
+		return err
+	}
+	defer m.mu.Unlock()
+	for _, order := range orders {
+		orderCopy := *order
+		m.archivedOrders[order.Hash] = &orderCopy
+	}
+	return nil
+}
+
+func (m *memoryDB) FindArchivedOrders(query *ArchivedOrderQuery) ([]*types.ArchivedOrder, error) {
+	if err := checkArchivedOrderQuery(query); err != nil {
+		return nil, err
+	}
+	if err := m.rlock(); err != nil {
+		return nil, err
+	}
+	defer m.mu.RUnlock()
+	return findMemoryArchivedOrders(m.archivedOrders, query), nil
+}
+
+// findMemoryArchivedOrders returns copies of the archived orders in
+// archivedOrders that match the given query. If the archived orders are stored
+// in memoryDB, the caller must hold the lock.
+func findMemoryArchivedOrders(archivedOrders map[common.Hash]*types.ArchivedOrder, query *ArchivedOrderQuery) []*types.ArchivedOrder {
+	if query == nil {
+		query = &ArchivedOrderQuery{}
+	}
+	endStates := map[zeroex.OrderEventEndState]struct{}{}
+	for _, endState := range query.EndStates {
+		endStates[endState] = struct{}{}
+	}
+	orders := []*types.ArchivedOrder{}
+	for _, order := range archivedOrders {
+		if query.OrderHash != (common.Hash{}) && order.Hash != query.OrderHash {
+			continue
+		}
+		if query.MakerAddress != (common.Address{}) && order.MakerAddress() != query.MakerAddress {
+			continue
+		}
+		if _, found := endStates[order.EndState]; len(endStates) > 0 && !found {
+			continue
+		}
+		if !query.ArchivedAfter.IsZero() && order.ArchivedAt.Before(query.ArchivedAfter) {
+			continue
+		}
+		if !query.ArchivedBefore.IsZero() && !order.ArchivedAt.Before(query.ArchivedBefore) {
+			continue
+		}
+		orderCopy := *order
+		orders = append(orders, &orderCopy)
+	}
+	sort.Slice(orders, func(i, j int) bool {
+		if !orders[i].ArchivedAt.Equal(orders[j].ArchivedAt) {
+			return orders[i].ArchivedAt.After(orders[j].ArchivedAt)
+		}
+		return bytes.Compare(orders[i].Hash.Bytes(), orders[j].Hash.Bytes()) < 0
+	})
+	if query.Offset >= uint(len(orders)) {
+		return []*types.ArchivedOrder{}
+	}
+	orders = orders[query.Offset:]
+	if query.Limit != 0 && query.Limit < uint(len(orders)) {
+		orders = orders[:query.Limit]
+	}
+	return orders
+}
+
+func (m *memoryDB) DeleteArchivedOrdersBefore(archivedBefore time.Time) (int, error) {
+	if err := m.lock(); err != nil {
+		return 0, err
+	}
+	defer m.mu.Unlock()
+	numDeleted := 0
+	for hash, order := range m.archivedOrders {
+		if order.ArchivedAt.Before(archivedBefore) {
+			delete(m.archivedOrders, hash)
+			numDeleted++
+		}
+	}
+	return numDeleted, nil
+}
+
+func (m *memoryDB) FindMakerQuotaUsage(limit int) ([]*types.QuotaUsage, error) {
+	return m.findQuotaUsage(limit, makerQuotaID)
+}
+
+func (m *memoryDB) FindPeerQuotaUsage(limit int) ([]*types.QuotaUsage, error) {
+	return m.findQuotaUsage(limit, peerQuotaID)
+}
+
+// makerQuotaID returns the ID which is used for the order in the maker quota
+// usage, i.e. the lowercase hex encoding of its maker address.
+func makerQuotaID(order *types.OrderWithMetadata) string {
+	if order.OrderV4 != nil {
+		return strings.ToLower(order.OrderV4.Maker.Hex())
+	}
+	return strings.ToLower(order.OrderV3.MakerAddress.Hex())
+}
+
+// peerQuotaID returns the ID which is used for the order in the peer quota
+// usage, i.e. the ID of the peer it was received from.
+func peerQuotaID(order *types.OrderWithMetadata) string {
+	return order.SourcePeerID
+}
+
+// findQuotaUsage counts the v3 and v4 orders which are neither pinned nor
+// removed by the ID returned by getID. Orders for which getID returns an empty
+// string are not counted.
+func (m *memoryDB) findQuotaUsage(limit int, getID func(order *types.OrderWithMetadata) string) ([]*types.QuotaUsage, error) {
+	if err := m.rlock(); err != nil {
+		return nil, err
+	}
+	defer m.mu.RUnlock()
+	numOrdersByID := map[string]int{}
+	for _, orders := range []map[common.Hash]*memoryOrder{m.orders, m.ordersV4} {
+		for _, stored := range orders {
+			if stored.order.IsPinned || stored.order.IsRemoved {
+				continue
+			}
+			if id := getID(stored.order); id != "" {
+				numOrdersByID[id]++
+			}
+		}
+	}
+	return quotaUsageFromCounts(numOrdersByID, limit), nil
+}
+
+// quotaUsageFromCounts returns the quota usage for the given number of orders
+// by ID, sorted by the number of orders in descending order and then by ID.
+// At most limit results are returned.
+func quotaUsageFromCounts(numOrdersByID map[string]int, limit int) []*types.QuotaUsage {
+	usage := make([]*types.QuotaUsage, 0, len(numOrdersByID))
+	for id, numOrders := range numOrdersByID {
+		usage = append(usage, &types.QuotaUsage{ID: id, NumOrders: numOrders})
+	}
+	sort.Slice(usage, func(i, j int) bool {
+		if usage[i].NumOrders != usage[j].NumOrders {
+			return usage[i].NumOrders > usage[j].NumOrders
+		}
+		return usage[i].ID < usage[j].ID
+	})
+	if len(usage) > limit {
+		usage = usage[:limit]
+	}
+	return usage
+}
+
+func (m *memoryDB) AddWebhookDeliveries(deliveries []*types.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	if err := m.lock(); err != nil {
+		return err
+	}
+	defer m.mu.Unlock()
+	for _, delivery := range deliveries {
+		m.lastWebhookDeliveryID++
+		delivery.ID = m.lastWebhookDeliveryID
+		deliveryCopy := *delivery
+		m.webhookDeliveries = append(m.webhookDeliveries, &deliveryCopy)
+	}
+	return nil
+}
+
+func (m *memoryDB) FindWebhookDeliveries(query *WebhookDeliveryQuery) ([]*types.WebhookDelivery, error) {
+	if err := m.rlock(); err != nil {
+		return nil, err
+	}
+	defer m.mu.RUnlock()
+	if query == nil {
+		query = &WebhookDeliveryQuery{}
+	}
+	// The deliveries are already sorted by ID.
+	deliveries := []*types.WebhookDelivery{}
+	for _, delivery := range m.webhookDeliveries {
+		if query.Limit != 0 && uint(len(deliveries)) >= query.Limit {
+			break
+		}
+		if query.URL != "" && delivery.URL != query.URL {
+			continue
+		}
+		deliveryCopy := *delivery
+		deliveries = append(deliveries, &deliveryCopy)
+	}
+	return deliveries, nil
+}
+
+func (m *memoryDB) UpdateWebhookDelivery(delivery *types.WebhookDelivery) error {
+	if err := m.lock(); err != nil {
+		return err
+	}
+	defer m.mu.Unlock()
+	for _, existing := range m.webhookDeliveries {
+		if existing.ID == delivery.ID {
+			existing.Attempts = delivery.Attempts
+			existing.NextAttemptAt = delivery.NextAttemptAt
+			return nil
+		}
+	}
+	return ErrNotFound
+}
+
+func (m *memoryDB) DeleteWebhookDelivery(id uint64) error {
+	if err := m.lock(); err != nil {
+		return err
+	}
+	defer m.mu.Unlock()
+	for i, existing := range m.webhookDeliveries {
+		if existing.ID == id {
+			m.webhookDeliveries = append(m.webhookDeliveries[:i:i], m.webhookDeliveries[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+func (m *memoryDB) CountWebhookDeliveries(query *WebhookDeliveryQuery) (int, error) {
+	if err := m.rlock(); err != nil {
+		return 0, err
+	}
+	defer m.mu.RUnlock()
+	if query == nil || query.URL == "" {
+		return len(m.webhookDeliveries), nil
+	}
+	count := 0
+	for _, delivery := range m.webhookDeliveries {
+		if delivery.URL == query.URL {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *memoryDB) DeleteWebhookDeliveriesExcept(urls []string) (int, error) {
+	if err := m.lock(); err != nil {
+		return 0, err
+	}
+	defer m.mu.Unlock()
+	keep := webhookURLSet(urls)
+	remaining := []*types.WebhookDelivery{}
+	for _, delivery := range m.webhookDeliveries {
+		if _, found := keep[delivery.URL]; found {
+			remaining = append(remaining, delivery)
+		}
+	}
+	numDeleted := len(m.webhookDeliveries) - len(remaining)
+	m.webhookDeliveries = remaining
+	return numDeleted, nil
+}
+
+func copyOrderWithMetadata(order *types.OrderWithMetadata) *types.OrderWithMetadata {
+	orderCopy := *order
+	if order.OrderV3 != nil {
+		orderV3 := *order.OrderV3
+		orderCopy.OrderV3 = &orderV3
+	}
+	if order.OrderV4 != nil {
+		orderV4 := *order.OrderV4
+		orderCopy.OrderV4 = &orderV4
+	}
+	orderCopy.ParsedMakerAssetData = copyParsedAssetData(order.ParsedMakerAssetData)
+	orderCopy.ParsedMakerFeeAssetData = copyParsedAssetData(order.ParsedMakerFeeAssetData)
+	orderCopy.ParsedTakerAssetData = copyParsedAssetData(order.ParsedTakerAssetData)
+	orderCopy.ParsedTakerFeeAssetData = copyParsedAssetData(order.ParsedTakerFeeAssetData)
+	return &orderCopy
+}
+
+func copyOrdersWithMetadata(orders []*types.OrderWithMetadata) []*types.OrderWithMetadata {
+	result := make([]*types.OrderWithMetadata, len(orders))
+	for i, order := range orders {
+		result[i] = copyOrderWithMetadata(order)
+	}
+	return result
+}
+
+func copyParsedAssetData(parsedAssetData []*types.SingleAssetData) []*types.SingleAssetData {
+	if parsedAssetData == nil {
+		return nil
+	}
+	result := make([]*types.SingleAssetData, len(parsedAssetData))
+	for i, singleAssetData := range parsedAssetData {
+		singleAssetDataCopy := *singleAssetData
+		result[i] = &singleAssetDataCopy
+	}
+	return result
+}
+
+func copyMiniHeader(miniHeader *types.MiniHeader) *types.MiniHeader {
+	miniHeaderCopy := *miniHeader
+	return &miniHeaderCopy
+}
+
+func copyMiniHeaders(miniHeaders []*types.MiniHeader) []*types.MiniHeader {
+	result := make([]*types.MiniHeader, len(miniHeaders))
+	for i, miniHeader := range miniHeaders {
+		result[i] = copyMiniHeader(miniHeader)
+	}
+	return result
+}
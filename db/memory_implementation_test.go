@@ -0,0 +1,38 @@
+// +build !js
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryClosed(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	db := newTestMemoryDB(t, ctx, nil)
+	cancel()
+
+	require.Eventually(t, func() bool {
+		_, err := db.FindOrders(nil)
+		return err == ErrClosed
+	}, time.Second, 10*time.Millisecond)
+	_, _, _, err := db.AddOrders([]*types.OrderWithMetadata{newTestOrder()})
+	assert.Equal(t, ErrClosed, err)
+}
+
+// newTestMemoryDB creates a new in-memory database with the given options (or
+// TestOptions if opts is nil).
+func newTestMemoryDB(t testing.TB, ctx context.Context, opts *Options) *DB {
+	if opts == nil {
+		opts = TestOptions()
+	}
+	opts.DriverName = MemoryDriverName
+	db, err := New(ctx, opts)
+	require.NoError(t, err)
+	return db
+}
@@ -0,0 +1,188 @@
+// +build !js
+
+package db
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/constants"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// benchmarkNumOrders is the number of orders that are stored in the database
+// for the order query benchmarks.
+const benchmarkNumOrders = 10000
+
+func BenchmarkFindOrdersByMakerAddress(b *testing.B) {
+	benchmarkOrderQuery(b, func(db *DB) error {
+		_, err := db.FindOrders(&OrderQuery{
+			Filters: []OrderFilter{
+				{Field: OFMakerAddress, Kind: Equal, Value: constants.GanacheAccount0},
+				{Field: OFIsRemoved, Kind: Equal, Value: false},
+			},
+		})
+		return err
+	})
+}
+
+func BenchmarkFindOrdersByExpirationTime(b *testing.B) {
+	maxExpirationTime := big.NewInt(time.Now().Add(time.Hour).Unix())
+	benchmarkOrderQuery(b, func(db *DB) error {
+		_, err := db.FindOrders(&OrderQuery{
+			Filters: []OrderFilter{
+				{Field: OFExpirationTimeSeconds, Kind: LessOrEqual, Value: maxExpirationTime},
+			},
+		})
+		return err
+	})
+}
+
+func BenchmarkFindOrdersSortedByFillableTakerAssetAmount(b *testing.B) {
+	benchmarkOrderQuery(b, func(db *DB) error {
+		_, err := db.FindOrders(&OrderQuery{
+			Sort:  []OrderSort{{Field: OFFillableTakerAssetAmount, Direction: Descending}},
+			Limit: 20,
+		})
+		return err
+	})
+}
+
+func BenchmarkFindStaleRemovedOrders(b *testing.B) {
+	benchmarkOrderQuery(b, func(db *DB) error {
+		_, err := db.FindOrders(&OrderQuery{
+			Filters: []OrderFilter{
+				{Field: OFIsRemoved, Kind: Equal, Value: true},
+				{Field: OFLastUpdated, Kind: Less, Value: time.Now().Add(-time.Hour)},
+			},
+		})
+		return err
+	})
+}
+
+func BenchmarkGetCurrentMaxExpirationTime(b *testing.B) {
+	benchmarkOrderQuery(b, func(db *DB) error {
+		_, err := db.GetCurrentMaxExpirationTime()
+		return err
+	})
+}
+
+func BenchmarkCountOrdersByMakerAddress(b *testing.B) {
+	benchmarkOrderQuery(b, func(db *DB) error {
+		_, err := db.CountOrders(&OrderQuery{
+			Filters: []OrderFilter{{Field: OFMakerAddress, Kind: Equal, Value: constants.GanacheAccount1}},
+		})
+		return err
+	})
+}
+
+func BenchmarkCountOrders(b *testing.B) {
+	benchmarkOrderQuery(b, func(db *DB) error {
+		_, err := db.CountOrders(nil)
+		return err
+	})
+}
+
+// BenchmarkCountOrdersBySourcePeerID runs the query which is used to check
+// the storage quota of a peer.
+func BenchmarkCountOrdersBySourcePeerID(b *testing.B) {
+	benchmarkOrderQuery(b, func(db *DB) error {
+		_, err := db.CountOrders(&OrderQuery{
+			Filters: []OrderFilter{
+				{Field: OFSourcePeerID, Kind: Equal, Value: benchmarkPeerIDs[0]},
+				{Field: OFIsPinned, Kind: Equal, Value: false},
+				{Field: OFIsRemoved, Kind: Equal, Value: false},
+			},
+		})
+		return err
+	})
+}
+
+// BenchmarkAddOrdersBeyondMaxOrders adds orders to a full database, so that
+// each new order causes another one to be removed.
+func BenchmarkAddOrdersBeyondMaxOrders(b *testing.B) {
+	benchmarkOrderQuery(b, func(db *DB) error {
+		if _, _, _, err := db.AddOrders([]*types.OrderWithMetadata{newTestOrder()}); err != nil {
+			return err
+		}
+		_, err := db.RemoveOrdersWithLongExpiration()
+		return err
+	})
+}
+
+// benchmarkOrderQuery runs the given query against a database which contains
+// benchmarkNumOrders orders for each of the implementations. For SQLite, the
+// query is run a second time without the indexes on the orders tables.
+func benchmarkOrderQuery(b *testing.B, query func(db *DB) error) {
+	for _, driverName := range []string{"sql", "memory", "leveldb"} {
+		newDB := testDrivers[driverName]
+		b.Run(driverName, func(b *testing.B) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			opts := TestOptions()
+			opts.MaxOrders = benchmarkNumOrders
+			db := newDB(b, ctx, opts)
+			_, _, _, err := db.AddOrders(newBenchmarkOrders(benchmarkNumOrders))
+			require.NoError(b, err)
+
+			runQuery := func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					if err := query(db); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+			b.Run("indexed", runQuery)
+			if driverName == "sql" {
+				dropOrderIndexes(b, sqlBackend(b, db))
+				b.Run("unindexed", runQuery)
+			}
+		})
+	}
+}
+
+// benchmarkPeerIDs are the peers that the benchmark orders were received from.
+var benchmarkPeerIDs = []string{
+	"16Uiu2HAmGd949LwaV4KNvK2WDSiMVy7xEmW983VH75CMmefmMpP7",
+	"16Uiu2HAkwRD3dCB3H2t3mBv2R4UuYpGuEsWQuKhYJW1PTTybqTWa",
+	"16Uiu2HAm3p2HwH2E6pSDeJwUtNYw1A5QnSqSK2KSDNV3kcfbYKNt",
+	"16Uiu2HAmDc3CCx6AfU1tLngqjSpr4eqPnMbxs8NXwV2jWK2xTTUh",
+}
+
+// newBenchmarkOrders returns numOrders test orders with a variety of makers,
+// source peers, expiration times and fillable amounts.
+func newBenchmarkOrders(numOrders int) []*types.OrderWithMetadata {
+	makers := []common.Address{
+		constants.GanacheAccount1,
+		constants.GanacheAccount2,
+		constants.GanacheAccount3,
+		constants.GanacheAccount4,
+	}
+	orders := make([]*types.OrderWithMetadata, numOrders)
+	for i := range orders {
+		order := newTestOrder()
+		// One percent of the orders are from GanacheAccount0, so that there is
+		// a selective maker filter to benchmark.
+		order.OrderV3.MakerAddress = makers[i%len(makers)]
+		if i%100 == 0 {
+			order.OrderV3.MakerAddress = constants.GanacheAccount0
+		}
+		order.OrderV3.ExpirationTimeSeconds = big.NewInt(time.Now().Add(time.Duration(i) * time.Minute).Unix())
+		order.FillableTakerAssetAmount = big.NewInt(int64(i))
+		order.IsRemoved = i%10 == 0
+		// Only a few of the removed orders haven't been updated for a long
+		// time, like in a database which is regularly cleaned up.
+		order.LastUpdated = time.Now()
+		if i%100 == 0 {
+			order.LastUpdated = time.Now().Add(-2 * time.Hour)
+		}
+		order.IsPinned = i%3 == 0
+		order.SourcePeerID = benchmarkPeerIDs[i%len(benchmarkPeerIDs)]
+		orders[i] = order
+	}
+	return orders
+}
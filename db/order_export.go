@@ -0,0 +1,175 @@
+package db
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/zeroex"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// exportBatchSize is the number of orders that ExportOrders reads from the
+// database at a time.
+var exportBatchSize uint = 1000
+
+// ExportedOrder is a single order in an order export. Exports are written as
+// JSON lines (one ExportedOrder per line) so that they can be streamed and
+// inspected with standard tools. Orders are encoded in the same format that is
+// used for adding orders so that an export doesn't depend on the layout of
+// the database it was created from.
+//
+// Exactly one of SignedOrder and SignedOrderV4 is set.
+type ExportedOrder struct {
+	Hash          common.Hash           `json:"hash"`
+	SignedOrder   *zeroex.SignedOrder   `json:"signedOrder,omitempty"`
+	SignedOrderV4 *zeroex.SignedOrderV4 `json:"signedOrderV4,omitempty"`
+	// IsPinned and the Keep* fields have the same meaning as the corresponding
+	// fields of types.OrderWithMetadata.
+	IsPinned        bool `json:"isPinned"`
+	KeepCancelled   bool `json:"keepCancelled"`
+	KeepExpired     bool `json:"keepExpired"`
+	KeepFullyFilled bool `json:"keepFullyFilled"`
+	KeepUnfunded    bool `json:"keepUnfunded"`
+}
+
+// AddOrdersOpts returns the options that should be used to add the exported
+// order back to Mesh.
+func (o *ExportedOrder) AddOrdersOpts() types.AddOrdersOpts {
+	return types.AddOrdersOpts{
+		Pinned:          o.IsPinned,
+		KeepCancelled:   o.KeepCancelled,
+		KeepExpired:     o.KeepExpired,
+		KeepFullyFilled: o.KeepFullyFilled,
+		KeepUnfunded:    o.KeepUnfunded,
+	}
+}
+
+func newExportedOrder(order *types.OrderWithMetadata) *ExportedOrder {
+	return &ExportedOrder{
+		Hash:            order.Hash,
+		SignedOrder:     order.SignedOrder(),
+		SignedOrderV4:   order.SignedOrderV4(),
+		IsPinned:        order.IsPinned,
+		KeepCancelled:   order.KeepCancelled,
+		KeepExpired:     order.KeepExpired,
+		KeepFullyFilled: order.KeepFullyFilled,
+		KeepUnfunded:    order.KeepUnfunded,
+	}
+}
+
+// validate checks that exactly one order is set and that the hash matches the
+// order.
+func (o *ExportedOrder) validate() error {
+	var (
+		actualHash common.Hash
+		err        error
+	)
+	switch {
+	case o.SignedOrder != nil && o.SignedOrderV4 != nil:
+		return errors.New("exported order contains both a v3 and a v4 order")
+	case o.SignedOrder != nil:
+		actualHash, err = o.SignedOrder.ComputeOrderHash()
+	case o.SignedOrderV4 != nil:
+		actualHash, err = o.SignedOrderV4.ComputeOrderHash()
+	default:
+		return errors.New("exported order doesn't contain an order")
+	}
+	if err != nil {
+		return err
+	}
+	if actualHash != o.Hash {
+		return fmt.Errorf("exported order hash %s doesn't match the hash of the order (%s)", o.Hash.Hex(), actualHash.Hex())
+	}
+	return nil
+}
+
+// ExportOrders writes all v3 and v4 orders in the database to w, including
+// orders that have been flagged for removal. Orders are read from the
+// database in batches so that the whole database doesn't need to fit in
+// memory. It returns the number of orders that were written.
+func (db *DB) ExportOrders(w io.Writer) (int, error) {
+	encoder := json.NewEncoder(w)
+	numExported := 0
+	encode := func(orders []*types.OrderWithMetadata) error {
+		for _, order := range orders {
+			if err := encoder.Encode(newExportedOrder(order)); err != nil {
+				return err
+			}
+			numExported++
+		}
+		return nil
+	}
+
+	// Orders are sorted by hash so that FindOrdersAfter can be used to get
+	// the next batch.
+	query := &OrderQuery{
+		Sort:  []OrderSort{{Field: OFHash, Direction: Ascending}},
+		Limit: exportBatchSize,
+	}
+	orders, err := db.FindOrders(query)
+	for {
+		if err != nil {
+			return numExported, err
+		}
+		if err := encode(orders); err != nil {
+			return numExported, err
+		}
+		if uint(len(orders)) < exportBatchSize {
+			break
+		}
+		orders, err = db.FindOrdersAfter(query, []interface{}{orders[len(orders)-1].Hash})
+	}
+
+	queryV4 := &OrderQueryV4{
+		Sort:  []OrderSortV4{{Field: OV4FHash, Direction: Ascending}},
+		Limit: exportBatchSize,
+	}
+	orders, err = db.FindOrdersV4(queryV4)
+	for {
+		if err != nil {
+			return numExported, err
+		}
+		if err := encode(orders); err != nil {
+			return numExported, err
+		}
+		if uint(len(orders)) < exportBatchSize {
+			break
+		}
+		orders, err = db.FindOrdersAfterV4(queryV4, []interface{}{orders[len(orders)-1].Hash})
+	}
+	return numExported, nil
+}
+
+// ExportedOrderReader reads orders that were written by ExportOrders.
+type ExportedOrderReader struct {
+	decoder *json.Decoder
+	numRead int
+}
+
+// NewExportedOrderReader returns a reader which reads exported orders from r.
+func NewExportedOrderReader(r io.Reader) *ExportedOrderReader {
+	return &ExportedOrderReader{
+		decoder: json.NewDecoder(r),
+	}
+}
+
+// Next returns the next order. It returns io.EOF if there are no more orders.
+// An error is returned if the order is malformed or its hash doesn't match the
+// order.
+func (r *ExportedOrderReader) Next() (*ExportedOrder, error) {
+	var order ExportedOrder
+	if err := r.decoder.Decode(&order); err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("could not decode exported order %d: %s", r.numRead+1, err)
+	}
+	r.numRead++
+	if err := order.validate(); err != nil {
+		return nil, fmt.Errorf("invalid exported order %d: %s", r.numRead, err)
+	}
+	return &order, nil
+}
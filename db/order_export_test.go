@@ -0,0 +1,145 @@
+package db
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportAndReadOrders(t *testing.T) {
+	runWithEachDriver(t, nil, runExportAndReadOrdersTest)
+}
+
+func runExportAndReadOrdersTest(t *testing.T, db *DB) {
+
+	// Use a small batch size so that the export needs several batches.
+	originalBatchSize := exportBatchSize
+	defer func() {
+		exportBatchSize = originalBatchSize
+	}()
+	exportBatchSize = 2
+
+	numOrders := 5
+	expectedOrders := map[common.Hash]*types.OrderWithMetadata{}
+	ordersToAdd := []*types.OrderWithMetadata{}
+	for i := 0; i < numOrders; i++ {
+		order := newTestOrder()
+		orderV4 := newTestOrderV4()
+		// The maker amount of v4 orders must fit in a uint128 in order to
+		// compute the hash.
+		orderV4.OrderV4.MakerAmount = big.NewInt(100)
+		order.IsPinned = i%2 == 0
+		order.KeepCancelled = i%2 == 1
+		order.KeepFullyFilled = true
+		orderV4.IsPinned = i%2 == 1
+		orderV4.KeepExpired = i%2 == 0
+		orderV4.KeepUnfunded = true
+		// Exported orders are checked against their hashes when they are read.
+		var err error
+		order.Hash, err = order.SignedOrder().ComputeOrderHash()
+		require.NoError(t, err)
+		orderV4.Hash, err = orderV4.SignedOrderV4().ComputeOrderHash()
+		require.NoError(t, err)
+		ordersToAdd = append(ordersToAdd, order, orderV4)
+		expectedOrders[order.Hash] = order
+		expectedOrders[orderV4.Hash] = orderV4
+	}
+	// Orders which have been flagged for removal should be exported too.
+	ordersToAdd[0].IsRemoved = true
+	_, _, _, err := db.AddOrders(ordersToAdd)
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	numExported, err := db.ExportOrders(buf)
+	require.NoError(t, err)
+	assert.Equal(t, 2*numOrders, numExported)
+	assert.Equal(t, 2*numOrders, strings.Count(buf.String(), "\n"), "each order should be written on its own line")
+
+	reader := NewExportedOrderReader(buf)
+	for {
+		exportedOrder, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		expectedOrder, found := expectedOrders[exportedOrder.Hash]
+		require.True(t, found, "unexpected order in export: %s", exportedOrder.Hash.Hex())
+		delete(expectedOrders, exportedOrder.Hash)
+
+		// The orders are compared by their JSON encoding because the hashes
+		// cached by the exported orders cause assert.Equal to fail.
+		if expectedOrder.OrderV3 != nil {
+			assert.Nil(t, exportedOrder.SignedOrderV4)
+			assertJSONEqual(t, expectedOrder.SignedOrder(), exportedOrder.SignedOrder)
+		} else {
+			assert.Nil(t, exportedOrder.SignedOrder)
+			assertJSONEqual(t, expectedOrder.SignedOrderV4(), exportedOrder.SignedOrderV4)
+		}
+		assert.Equal(t, types.AddOrdersOpts{
+			Pinned:          expectedOrder.IsPinned,
+			KeepCancelled:   expectedOrder.KeepCancelled,
+			KeepExpired:     expectedOrder.KeepExpired,
+			KeepFullyFilled: expectedOrder.KeepFullyFilled,
+			KeepUnfunded:    expectedOrder.KeepUnfunded,
+		}, exportedOrder.AddOrdersOpts())
+	}
+	assert.Empty(t, expectedOrders, "not all orders were exported")
+}
+
+func TestExportedOrderReaderRejectsInvalidOrders(t *testing.T) {
+	order := newTestOrder()
+	exportedOrder := newExportedOrder(order)
+
+	testCases := []struct {
+		name          string
+		modify        func(*ExportedOrder)
+		expectedError string
+	}{
+		{
+			name:          "wrong hash",
+			modify:        func(o *ExportedOrder) {},
+			expectedError: "doesn't match the hash of the order",
+		},
+		{
+			name: "missing order",
+			modify: func(o *ExportedOrder) {
+				o.SignedOrder = nil
+			},
+			expectedError: "doesn't contain an order",
+		},
+		{
+			name: "v3 and v4 order",
+			modify: func(o *ExportedOrder) {
+				o.SignedOrderV4 = newTestOrderV4().SignedOrderV4()
+			},
+			expectedError: "contains both a v3 and a v4 order",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			modifiedOrder := *exportedOrder
+			testCase.modify(&modifiedOrder)
+			buf := &bytes.Buffer{}
+			require.NoError(t, json.NewEncoder(buf).Encode(modifiedOrder))
+			_, err := NewExportedOrderReader(buf).Next()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), testCase.expectedError)
+		})
+	}
+}
+
+func assertJSONEqual(t *testing.T, expected, actual interface{}) {
+	expectedJSON, err := json.Marshal(expected)
+	require.NoError(t, err)
+	actualJSON, err := json.Marshal(actual)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(expectedJSON), string(actualJSON))
+}
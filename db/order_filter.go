@@ -0,0 +1,433 @@
+package db
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/zeroex"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gibson042/canonicaljson-go"
+)
+
+// OrderFieldValue returns the value of the given field for the given v3
+// order. The type of the returned value is the same as the type that is
+// expected for the value of an OrderFilter with the same field.
+func OrderFieldValue(order *types.OrderWithMetadata, field OrderField) (interface{}, error) {
+	if order.OrderV3 == nil {
+		return nil, errors.New("db.OrderFieldValue: order is not a v3 order")
+	}
+	switch field {
+	case OFHash:
+		return order.Hash, nil
+	case OFChainID:
+		return order.OrderV3.ChainID, nil
+	case OFExchangeAddress:
+		return order.OrderV3.ExchangeAddress, nil
+	case OFMakerAddress:
+		return order.OrderV3.MakerAddress, nil
+	case OFMakerAssetData:
+		return order.OrderV3.MakerAssetData, nil
+	case OFMakerFeeAssetData:
+		return order.OrderV3.MakerFeeAssetData, nil
+	case OFMakerAssetAmount:
+		return order.OrderV3.MakerAssetAmount, nil
+	case OFMakerFee:
+		return order.OrderV3.MakerFee, nil
+	case OFTakerAddress:
+		return order.OrderV3.TakerAddress, nil
+	case OFTakerAssetData:
+		return order.OrderV3.TakerAssetData, nil
+	case OFTakerFeeAssetData:
+		return order.OrderV3.TakerFeeAssetData, nil
+	case OFTakerAssetAmount:
+		return order.OrderV3.TakerAssetAmount, nil
+	case OFTakerFee:
+		return order.OrderV3.TakerFee, nil
+	case OFSenderAddress:
+		return order.OrderV3.SenderAddress, nil
+	case OFFeeRecipientAddress:
+		return order.OrderV3.FeeRecipientAddress, nil
+	case OFExpirationTimeSeconds:
+		return order.OrderV3.ExpirationTimeSeconds, nil
+	case OFSalt:
+		return order.OrderV3.Salt, nil
+	case OFSignature:
+		return order.Signature, nil
+	case OFLastUpdated:
+		return order.LastUpdated, nil
+	case OFFillableTakerAssetAmount:
+		return order.FillableTakerAssetAmount, nil
+	case OFIsRemoved:
+		return order.IsRemoved, nil
+	case OFIsPinned:
+		return order.IsPinned, nil
+	case OFIsUnfillable:
+		return order.IsUnfillable, nil
+	case OFIsExpired:
+		return order.IsExpired, nil
+	case OFLastValidatedBlockNumber:
+		return order.LastValidatedBlockNumber, nil
+	case OFKeepCancelled:
+		return order.KeepCancelled, nil
+	case OFKeepExpired:
+		return order.KeepExpired, nil
+	case OFKeepFullyFilled:
+		return order.KeepFullyFilled, nil
+	case OFKeepUnfunded:
+		return order.KeepUnfunded, nil
+	case OFSourcePeerID:
+		return order.SourcePeerID, nil
+	case OFPrice:
+		return order.Price(), nil
+	case OFParsedMakerAssetData:
+		return encodeParsedAssetData(order.ParsedMakerAssetData)
+	case OFParsedMakerFeeAssetData:
+		return encodeParsedAssetData(order.ParsedMakerFeeAssetData)
+	case OFParsedTakerAssetData:
+		return encodeParsedAssetData(order.ParsedTakerAssetData)
+	case OFParsedTakerFeeAssetData:
+		return encodeParsedAssetData(order.ParsedTakerFeeAssetData)
+	default:
+		return nil, fmt.Errorf("db.OrderFieldValue: unsupported field: %q", field)
+	}
+}
+
+// encodeParsedAssetData encodes parsed asset data the same way that it is
+// stored in the database, so that CONTAINS filters such as the ones returned by
+// MakerAssetIncludesTokenAddress can be evaluated in memory.
+func encodeParsedAssetData(parsedAssetData []*types.SingleAssetData) ([]byte, error) {
+	type encodedSingleAssetData struct {
+		Address common.Address `json:"address"`
+		TokenID *string        `json:"tokenID"`
+	}
+	encoded := make([]encodedSingleAssetData, len(parsedAssetData))
+	for i, singleAssetData := range parsedAssetData {
+		encoded[i].Address = singleAssetData.Address
+		if singleAssetData.TokenID != nil {
+			tokenID := singleAssetData.TokenID.String()
+			encoded[i].TokenID = &tokenID
+		}
+	}
+	return canonicaljson.Marshal(encoded)
+}
+
+// OrderFieldValueV4 is the v4 equivalent of OrderFieldValue.
+func OrderFieldValueV4(order *types.OrderWithMetadata, field OrderFieldV4) (interface{}, error) {
+	if order.OrderV4 == nil {
+		return nil, errors.New("db.OrderFieldValueV4: order is not a v4 order")
+	}
+	switch field {
+	case OV4FHash:
+		return order.Hash, nil
+	case OV4FChainID:
+		return order.OrderV4.ChainID, nil
+	case OV4FVerifyingContract:
+		return order.OrderV4.VerifyingContract, nil
+	case OV4FMakerToken:
+		return order.OrderV4.MakerToken, nil
+	case OV4FTakerToken:
+		return order.OrderV4.TakerToken, nil
+	case OV4FMakerAmount:
+		return order.OrderV4.MakerAmount, nil
+	case OV4FTakerAmount:
+		return order.OrderV4.TakerAmount, nil
+	case OV4FTakerTokenFeeAmount:
+		return order.OrderV4.TakerTokenFeeAmount, nil
+	case OV4FMaker:
+		return order.OrderV4.Maker, nil
+	case OV4FTaker:
+		return order.OrderV4.Taker, nil
+	case OV4FSender:
+		return order.OrderV4.Sender, nil
+	case OV4FFeeRecipient:
+		return order.OrderV4.FeeRecipient, nil
+	case OV4FPool:
+		return order.OrderV4.Pool.Bytes(), nil
+	case OV4FExpiry:
+		return order.OrderV4.Expiry, nil
+	case OV4FSalt:
+		return order.OrderV4.Salt, nil
+	case OV4FSignature:
+		return order.SignatureV4.Bytes(), nil
+	case OV4FLastUpdated:
+		return order.LastUpdated, nil
+	case OV4FFillableTakerAssetAmount:
+		return order.FillableTakerAssetAmount, nil
+	case OV4FIsRemoved:
+		return order.IsRemoved, nil
+	case OV4FIsPinned:
+		return order.IsPinned, nil
+	case OV4FIsUnfillable:
+		return order.IsUnfillable, nil
+	case OV4FIsExpired:
+		return order.IsExpired, nil
+	case OV4FLastValidatedBlockNumber:
+		return order.LastValidatedBlockNumber, nil
+	case OV4FKeepCancelled:
+		return order.KeepCancelled, nil
+	case OV4FKeepExpired:
+		return order.KeepExpired, nil
+	case OV4FKeepFullyFilled:
+		return order.KeepFullyFilled, nil
+	case OV4FKeepUnfunded:
+		return order.KeepUnfunded, nil
+	case OV4FSourcePeerID:
+		return order.SourcePeerID, nil
+	case OV4FPrice:
+		return order.Price(), nil
+	default:
+		return nil, fmt.Errorf("db.OrderFieldValueV4: unsupported field: %q", field)
+	}
+}
+
+// OrderMatchesFilters returns true if the given v3 order matches all of the
+// given filters. It is an in-memory equivalent of the filtering done by
+// FindOrders and can be used for orders that are not (or no longer) stored in
+// the database.
+func OrderMatchesFilters(order *types.OrderWithMetadata, filters []OrderFilter) (bool, error) {
+	for _, filter := range filters {
+		matches, err := orderMatchesFilter(order, filter)
+		if err != nil {
+			return false, err
+		}
+		if !matches {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func orderMatchesFilter(order *types.OrderWithMetadata, filter OrderFilter) (bool, error) {
+	if filter.Kind == Or {
+		for _, group := range filter.Groups {
+			matches, err := OrderMatchesFilters(order, group)
+			if err != nil {
+				return false, err
+			}
+			if matches {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	value, err := OrderFieldValue(order, filter.Field)
+	if err != nil {
+		return false, err
+	}
+	return filterValueMatches(filter.Kind, value, filter.Value)
+}
+
+// OrderMatchesFiltersV4 is the v4 equivalent of OrderMatchesFilters.
+func OrderMatchesFiltersV4(order *types.OrderWithMetadata, filters []OrderFilterV4) (bool, error) {
+	for _, filter := range filters {
+		matches, err := orderMatchesFilterV4(order, filter)
+		if err != nil {
+			return false, err
+		}
+		if !matches {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func orderMatchesFilterV4(order *types.OrderWithMetadata, filter OrderFilterV4) (bool, error) {
+	if filter.Kind == Or {
+		for _, group := range filter.Groups {
+			matches, err := OrderMatchesFiltersV4(order, group)
+			if err != nil {
+				return false, err
+			}
+			if matches {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	value, err := OrderFieldValueV4(order, filter.Field)
+	if err != nil {
+		return false, err
+	}
+	return filterValueMatches(filter.Kind, value, filter.Value)
+}
+
+// CheckOrderFilters returns an error if any of the given filters, including
+// the filters in OR groups, uses a field which OrderMatchesFilters doesn't
+// support.
+func CheckOrderFilters(filters []OrderFilter) error {
+	emptyOrder := &types.OrderWithMetadata{OrderV3: &zeroex.Order{}}
+	for _, filter := range filters {
+		if filter.Kind == Or {
+			for _, group := range filter.Groups {
+				if err := CheckOrderFilters(group); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if _, err := OrderFieldValue(emptyOrder, filter.Field); err != nil {
+			return fmt.Errorf("unsupported filter field: %q", filter.Field)
+		}
+	}
+	return nil
+}
+
+// CheckOrderFiltersV4 is the v4 equivalent of CheckOrderFilters.
+func CheckOrderFiltersV4(filters []OrderFilterV4) error {
+	emptyOrder := &types.OrderWithMetadata{OrderV4: &zeroex.OrderV4{}}
+	for _, filter := range filters {
+		if filter.Kind == Or {
+			for _, group := range filter.Groups {
+				if err := CheckOrderFiltersV4(group); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if _, err := OrderFieldValueV4(emptyOrder, filter.Field); err != nil {
+			return fmt.Errorf("unsupported filter field: %q", filter.Field)
+		}
+	}
+	return nil
+}
+
+// filterValueMatches returns true if value satisfies the filter with the given
+// kind and filter value.
+func filterValueMatches(kind FilterKind, value interface{}, filterValue interface{}) (bool, error) {
+	if kind == Contains {
+		valueBytes, ok := value.([]byte)
+		if !ok {
+			return false, fmt.Errorf("db: CONTAINS filter is not supported for values of type %T", value)
+		}
+		switch v := filterValue.(type) {
+		case []byte:
+			return bytes.Contains(valueBytes, v), nil
+		case string:
+			return bytes.Contains(valueBytes, []byte(v)), nil
+		default:
+			return false, fmt.Errorf("db: invalid type for CONTAINS filter value: %T", filterValue)
+		}
+	}
+	if kind == In {
+		filterValues, ok := filterValue.([]interface{})
+		if !ok {
+			return false, fmt.Errorf("db: invalid type for IN filter value: %T", filterValue)
+		}
+		for _, v := range filterValues {
+			cmp, err := compareFilterValues(value, v)
+			if err != nil {
+				return false, err
+			}
+			if cmp == 0 {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	cmp, err := compareFilterValues(value, filterValue)
+	if err != nil {
+		return false, err
+	}
+	switch kind {
+	case Equal:
+		return cmp == 0, nil
+	case NotEqual:
+		return cmp != 0, nil
+	case Less:
+		return cmp < 0, nil
+	case Greater:
+		return cmp > 0, nil
+	case LessOrEqual:
+		return cmp <= 0, nil
+	case GreaterOrEqual:
+		return cmp >= 0, nil
+	default:
+		return false, fmt.Errorf("db: unknown filter kind: %q", kind)
+	}
+}
+
+// compareFilterValues compares two values of the same type. It returns -1 if
+// a < b, 0 if a == b, and 1 if a > b.
+func compareFilterValues(a interface{}, b interface{}) (int, error) {
+	switch aValue := a.(type) {
+	case *big.Int:
+		bValue, ok := b.(*big.Int)
+		if !ok {
+			return 0, fmt.Errorf("db: invalid type for filter value (expected *big.Int but got %T)", b)
+		}
+		if aValue == nil || bValue == nil {
+			return 0, errors.New("db: cannot compare nil *big.Int")
+		}
+		return aValue.Cmp(bValue), nil
+	case common.Hash:
+		bValue, ok := b.(common.Hash)
+		if !ok {
+			return 0, fmt.Errorf("db: invalid type for filter value (expected common.Hash but got %T)", b)
+		}
+		return bytes.Compare(aValue.Bytes(), bValue.Bytes()), nil
+	case common.Address:
+		bValue, ok := b.(common.Address)
+		if !ok {
+			return 0, fmt.Errorf("db: invalid type for filter value (expected common.Address but got %T)", b)
+		}
+		return bytes.Compare(aValue.Bytes(), bValue.Bytes()), nil
+	case []byte:
+		bValue, ok := b.([]byte)
+		if !ok {
+			return 0, fmt.Errorf("db: invalid type for filter value (expected []byte but got %T)", b)
+		}
+		return bytes.Compare(aValue, bValue), nil
+	case string:
+		bValue, ok := b.(string)
+		if !ok {
+			return 0, fmt.Errorf("db: invalid type for filter value (expected string but got %T)", b)
+		}
+		return strings.Compare(aValue, bValue), nil
+	case float64:
+		bValue, ok := b.(float64)
+		if !ok {
+			return 0, fmt.Errorf("db: invalid type for filter value (expected float64 but got %T)", b)
+		}
+		switch {
+		case aValue == bValue:
+			return 0, nil
+		case aValue < bValue:
+			return -1, nil
+		default:
+			return 1, nil
+		}
+	case bool:
+		bValue, ok := b.(bool)
+		if !ok {
+			return 0, fmt.Errorf("db: invalid type for filter value (expected bool but got %T)", b)
+		}
+		switch {
+		case aValue == bValue:
+			return 0, nil
+		case !aValue:
+			return -1, nil
+		default:
+			return 1, nil
+		}
+	case time.Time:
+		bValue, ok := b.(time.Time)
+		if !ok {
+			return 0, fmt.Errorf("db: invalid type for filter value (expected time.Time but got %T)", b)
+		}
+		switch {
+		case aValue.Equal(bValue):
+			return 0, nil
+		case aValue.Before(bValue):
+			return -1, nil
+		default:
+			return 1, nil
+		}
+	default:
+		return 0, fmt.Errorf("db: unsupported type for filter value: %T", a)
+	}
+}
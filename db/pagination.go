@@ -0,0 +1,160 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xProject/0x-mesh/common/types"
+)
+
+// FindOrdersAfter returns the orders that match the given query and come
+// strictly after the orders with the given sort values, according to the sort
+// options in the query. after must contain exactly one value for each sort
+// option. Sort values can be obtained from an existing order via
+// OrderSortValues.
+//
+// Unlike using Offset, this method of pagination stays correct while orders
+// are being added and removed: no order will be returned more than once and
+// any order which was present both before and after pagination will be
+// returned. In order for this to hold, the sort options must uniquely identify
+// an order, which can be achieved by sorting by hash last.
+func (db *DB) FindOrdersAfter(query *OrderQuery, after []interface{}) ([]*types.OrderWithMetadata, error) {
+	if query == nil || len(query.Sort) == 0 {
+		return nil, errors.New("db.FindOrdersAfter: query must include at least one sort option")
+	}
+	if len(after) != len(query.Sort) {
+		return nil, fmt.Errorf("db.FindOrdersAfter: expected %d sort values but got %d", len(query.Sort), len(after))
+	}
+	if query.Offset != 0 {
+		return nil, errors.New("db.FindOrdersAfter: can't use Offset")
+	}
+
+	// The orders that come after the given sort values are the union of the
+	// following, in order:
+	//
+	//     1. Orders where the first n-1 sort fields are equal and the nth sort field comes after.
+	//     2. Orders where the first n-2 sort fields are equal and the (n-1)th sort field comes after.
+	//     ...
+	//     n. Orders where the first sort field comes after.
+	//
+	// Each of these can be expressed with a single query using only the filter
+	// kinds supported by all database implementations.
+	results := []*types.OrderWithMetadata{}
+	for i := len(query.Sort) - 1; i >= 0; i-- {
+		var limit uint
+		if query.Limit != 0 {
+			if uint(len(results)) >= query.Limit {
+				break
+			}
+			limit = query.Limit - uint(len(results))
+		}
+		filters := make([]OrderFilter, 0, len(query.Filters)+i+1)
+		filters = append(filters, query.Filters...)
+		for j := 0; j < i; j++ {
+			filters = append(filters, OrderFilter{
+				Field: query.Sort[j].Field,
+				Kind:  Equal,
+				Value: after[j],
+			})
+		}
+		filters = append(filters, OrderFilter{
+			Field: query.Sort[i].Field,
+			Kind:  filterKindAfter(query.Sort[i].Direction),
+			Value: after[i],
+		})
+		orders, err := db.FindOrders(&OrderQuery{
+			Filters: filters,
+			Sort:    query.Sort,
+			Limit:   limit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, orders...)
+	}
+	return results, nil
+}
+
+// FindOrdersAfterV4 is the v4 equivalent of FindOrdersAfter.
+func (db *DB) FindOrdersAfterV4(query *OrderQueryV4, after []interface{}) ([]*types.OrderWithMetadata, error) {
+	if query == nil || len(query.Sort) == 0 {
+		return nil, errors.New("db.FindOrdersAfterV4: query must include at least one sort option")
+	}
+	if len(after) != len(query.Sort) {
+		return nil, fmt.Errorf("db.FindOrdersAfterV4: expected %d sort values but got %d", len(query.Sort), len(after))
+	}
+	if query.Offset != 0 {
+		return nil, errors.New("db.FindOrdersAfterV4: can't use Offset")
+	}
+
+	// See FindOrdersAfter for an explanation of how these queries are built.
+	results := []*types.OrderWithMetadata{}
+	for i := len(query.Sort) - 1; i >= 0; i-- {
+		var limit uint
+		if query.Limit != 0 {
+			if uint(len(results)) >= query.Limit {
+				break
+			}
+			limit = query.Limit - uint(len(results))
+		}
+		filters := make([]OrderFilterV4, 0, len(query.Filters)+i+1)
+		filters = append(filters, query.Filters...)
+		for j := 0; j < i; j++ {
+			filters = append(filters, OrderFilterV4{
+				Field: query.Sort[j].Field,
+				Kind:  Equal,
+				Value: after[j],
+			})
+		}
+		filters = append(filters, OrderFilterV4{
+			Field: query.Sort[i].Field,
+			Kind:  filterKindAfter(query.Sort[i].Direction),
+			Value: after[i],
+		})
+		orders, err := db.FindOrdersV4(&OrderQueryV4{
+			Filters: filters,
+			Sort:    query.Sort,
+			Limit:   limit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, orders...)
+	}
+	return results, nil
+}
+
+func filterKindAfter(direction SortDirection) FilterKind {
+	if direction == Descending {
+		return Less
+	}
+	return Greater
+}
+
+// OrderSortValues returns the values of the given order for each of the given
+// sort options. The result can be used as the after argument to
+// FindOrdersAfter.
+func OrderSortValues(order *types.OrderWithMetadata, sortOpts []OrderSort) ([]interface{}, error) {
+	values := make([]interface{}, len(sortOpts))
+	for i, sortOpt := range sortOpts {
+		value, err := OrderFieldValue(order, sortOpt.Field)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+// OrderSortValuesV4 is the v4 equivalent of OrderSortValues.
+func OrderSortValuesV4(order *types.OrderWithMetadata, sortOpts []OrderSortV4) ([]interface{}, error) {
+	values := make([]interface{}, len(sortOpts))
+	for i, sortOpt := range sortOpts {
+		value, err := OrderFieldValueV4(order, sortOpt.Field)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
@@ -0,0 +1,81 @@
+// +build !js
+
+package db
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/constants"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuotaUsage(t *testing.T) {
+	runWithEachDriver(t, nil, runQuotaUsageTest)
+}
+
+func runQuotaUsageTest(t *testing.T, db *DB) {
+	otherMaker := common.HexToAddress("0x1")
+
+	// GanacheAccount1 has two v3 orders and one v4 order from peer A. The
+	// pinned and removed orders don't count towards any quota.
+	orders := []*types.OrderWithMetadata{}
+	for i := 0; i < 5; i++ {
+		order := newTestOrder()
+		order.IsPinned = false
+		order.SourcePeerID = "peerA"
+		orders = append(orders, order)
+	}
+	orders[2].IsPinned = true
+	orders[3].IsRemoved = true
+	orders[4].OrderV3.MakerAddress = otherMaker
+	orders[4].SourcePeerID = "peerB"
+	_, _, _, err := db.AddOrders(orders)
+	require.NoError(t, err)
+
+	ordersV4 := []*types.OrderWithMetadata{}
+	for i := 0; i < 2; i++ {
+		order := newTestOrderV4()
+		order.IsPinned = false
+		order.OrderV4.Maker = constants.GanacheAccount1
+		ordersV4 = append(ordersV4, order)
+	}
+	ordersV4[0].SourcePeerID = "peerA"
+	_, _, _, err = db.AddOrdersV4(ordersV4)
+	require.NoError(t, err)
+
+	makerUsage, err := db.FindMakerQuotaUsage(10)
+	require.NoError(t, err)
+	assert.Equal(t, []*types.QuotaUsage{
+		{ID: strings.ToLower(constants.GanacheAccount1.Hex()), NumOrders: 4},
+		{ID: strings.ToLower(otherMaker.Hex()), NumOrders: 1},
+	}, makerUsage)
+	makerUsage, err = db.FindMakerQuotaUsage(1)
+	require.NoError(t, err)
+	assert.Len(t, makerUsage, 1)
+
+	peerUsage, err := db.FindPeerQuotaUsage(10)
+	require.NoError(t, err)
+	assert.Equal(t, []*types.QuotaUsage{
+		{ID: "peerA", NumOrders: 3},
+		{ID: "peerB", NumOrders: 1},
+	}, peerUsage)
+
+	// The source peer is stored with the order and can be used in filters.
+	foundOrders, err := db.FindOrders(&OrderQuery{
+		Filters: []OrderFilter{{Field: OFSourcePeerID, Kind: Equal, Value: "peerB"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, foundOrders, 1)
+	assert.Equal(t, orders[4].Hash, foundOrders[0].Hash)
+	assert.Equal(t, "peerB", foundOrders[0].SourcePeerID)
+	foundOrdersV4, err := db.FindOrdersV4(&OrderQueryV4{
+		Filters: []OrderFilterV4{{Field: OV4FSourcePeerID, Kind: Equal, Value: "peerA"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, foundOrdersV4, 1)
+	assert.Equal(t, ordersV4[0].Hash, foundOrdersV4[0].Hash)
+}
@@ -0,0 +1,129 @@
+// +build !js
+
+package db
+
+import (
+	"errors"
+	"time"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/db/sqltypes"
+	"github.com/0xProject/0x-mesh/zeroex"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ido50/sqlz"
+)
+
+// GetLatestOrderEvent returns the latest event in the order event log for the
+// order with the given hash. It returns ErrNotFound if the log doesn't contain
+// any events for the order, e.g. because they have already been pruned.
+func (db *sqlDB) GetLatestOrderEvent(orderHash common.Hash) (orderEvent *zeroex.OrderEvent, err error) {
+	defer func() {
+		err = convertErr(err)
+	}()
+	var sqlOrderEvent sqltypes.OrderEvent
+	db.mu.RLock()
+	err = db.sqldb.GetContext(db.ctx, &sqlOrderEvent, "SELECT * FROM orderEvents WHERE orderHash = $1 ORDER BY sequenceNumber DESC LIMIT 1", orderHash)
+	db.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	return sqltypes.OrderEventToCommonType(&sqlOrderEvent), nil
+}
+
+// AddArchivedOrders adds the given orders to the archive. If an order with the
+// same hash has already been archived, it is replaced.
+func (db *sqlDB) AddArchivedOrders(orders []*types.ArchivedOrder) (err error) {
+	defer func() {
+		err = convertErr(err)
+	}()
+	if len(orders) == 0 {
+		return nil
+	}
+	return db.ReadWriteTransactionalContext(db.ctx, nil, func(txn *sqlz.Tx) error {
+		for _, order := range orders {
+			if _, err := txn.NamedExecContext(db.ctx, insertArchivedOrderQuery, sqltypes.ArchivedOrderFromCommonType(order)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// FindArchivedOrders returns the archived orders that match the given query,
+// sorted by the time at which they were archived in descending order.
+//
+// Times are stored in UTC so that they can be compared as strings by SQLite.
+func (db *sqlDB) FindArchivedOrders(query *ArchivedOrderQuery) (orders []*types.ArchivedOrder, err error) {
+	defer func() {
+		err = convertErr(err)
+	}()
+	if err := checkArchivedOrderQuery(query); err != nil {
+		return nil, err
+	}
+	stmt := db.sqldb.Select("*").From("archivedOrders").OrderBy(sqlz.Desc("archivedAt"), sqlz.Asc("hash"))
+	if query != nil {
+		if query.OrderHash != (common.Hash{}) {
+			stmt.Where(sqlz.Eq("hash", query.OrderHash))
+		}
+		if query.MakerAddress != (common.Address{}) {
+			stmt.Where(sqlz.Eq("makerAddress", query.MakerAddress))
+		}
+		if len(query.EndStates) > 0 {
+			endStates := make([]interface{}, len(query.EndStates))
+			for i, endState := range query.EndStates {
+				endStates[i] = string(endState)
+			}
+			stmt.Where(sqlz.In("endState", endStates...))
+		}
+		if !query.ArchivedAfter.IsZero() {
+			stmt.Where(sqlz.Gte("archivedAt", query.ArchivedAfter.UTC()))
+		}
+		if !query.ArchivedBefore.IsZero() {
+			stmt.Where(sqlz.Lt("archivedAt", query.ArchivedBefore.UTC()))
+		}
+		if query.Limit != 0 {
+			stmt.Limit(int64(query.Limit))
+			if query.Offset != 0 {
+				stmt.Offset(int64(query.Offset))
+			}
+		}
+	}
+	var sqlOrders []*sqltypes.ArchivedOrder
+	db.mu.RLock()
+	err = stmt.GetAllContext(db.ctx, &sqlOrders)
+	db.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	return sqltypes.ArchivedOrdersToCommonType(sqlOrders), nil
+}
+
+// DeleteArchivedOrdersBefore removes all orders from the archive which were
+// archived before the given time. It returns the number of orders that were
+// removed.
+func (db *sqlDB) DeleteArchivedOrdersBefore(archivedBefore time.Time) (numDeleted int, err error) {
+	defer func() {
+		err = convertErr(err)
+	}()
+	db.mu.Lock()
+	result, err := db.sqldb.DeleteFrom("archivedOrders").Where(sqlz.Lt("archivedAt", archivedBefore.UTC())).ExecContext(db.ctx)
+	db.mu.Unlock()
+	if err != nil {
+		return 0, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(rowsAffected), nil
+}
+
+func checkArchivedOrderQuery(query *ArchivedOrderQuery) error {
+	if query == nil {
+		return nil
+	}
+	if query.Offset != 0 && query.Limit == 0 {
+		return errors.New("db.FindArchivedOrders: can't use Offset without Limit")
+	}
+	return nil
+}
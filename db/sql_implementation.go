@@ -29,10 +29,11 @@ import (
 // largeLimit is used as a workaround due to the fact that SQL does not allow limit without offset.
 const largeLimit = math.MaxInt64
 
-var _ Database = (*DB)(nil)
+var _ backend = (*sqlDB)(nil)
 
-// DB instantiates the DB connection and creates all the collections used by the application
-type DB struct {
+// sqlDB is the implementation of the database that is backed by SQLite. It is
+// used unless another implementation is selected via Options.DriverName.
+type sqlDB struct {
 	ctx   context.Context
 	sqldb *sqlz.DB
 	// Additional connection contexts are created to avoid the `database is
@@ -57,6 +58,7 @@ func defaultOptions() *Options {
 		DataSourcePeerStoreName: "0x_mesh/db/peerstore.sqlite",
 		MaxOrders:               100000,
 		MaxMiniHeaders:          20,
+		MaxOrderEvents:          100000,
 	}
 }
 
@@ -70,14 +72,13 @@ func TestOptions() *Options {
 		DataSourcePeerStoreName: filepath.Join(testingDir, "peerstore.sqlite"),
 		MaxOrders:               100,
 		MaxMiniHeaders:          20,
+		MaxOrderEvents:          100,
 	}
 }
 
-// New creates a new connection to the database. The connection will be automatically closed
-// when the given context is canceled.
-func New(ctx context.Context, opts *Options) (*DB, error) {
-	opts = parseOptions(opts)
-
+// newSQLDB connects to the SQL databases and brings their schemas up to date.
+// The connections are closed when the given context is canceled.
+func newSQLDB(ctx context.Context, opts *Options) (*sqlDB, error) {
 	connectCtx, cancel := context.WithTimeout(ctx, connectTimeout)
 	defer cancel()
 
@@ -111,7 +112,7 @@ func New(ctx context.Context, opts *Options) (*DB, error) {
 		_ = peerSQLdb.Close()
 	}()
 
-	db := &DB{
+	db := &sqlDB{
 		ctx:       ctx,
 		sqldb:     sqlz.Newx(sqldb),
 		dhtSQLdb:  sqlz.Newx(dhtSQLdb),
@@ -125,26 +126,40 @@ func New(ctx context.Context, opts *Options) (*DB, error) {
 	return db, nil
 }
 
-func (db *DB) DHTStore() ds.Batching {
+func (db *sqlDB) DHTStore() ds.Batching {
 	return sqlds.NewDatastore(db.dhtSQLdb.DB.DB, NewSqliteQueriesForTable("dhtstore"))
 }
 
-func (db *DB) PeerStore() ds.Batching {
+func (db *sqlDB) PeerStore() ds.Batching {
 	return sqlds.NewDatastore(db.peerSQLdb.DB.DB, NewSqliteQueriesForTable("peerstore"))
 }
 
-func (db *DB) migrate() error {
-	_, err := db.sqldb.ExecContext(db.ctx, schema)
-	if err != nil {
-		return fmt.Errorf("meshdb schema migration failed with err: %s", err)
+// addColumnIfNotExists adds a column to the given table unless a column with
+// the same name already exists.
+func (db *sqlDB) addColumnIfNotExists(txn *sqlz.Tx, table string, column string, definition string) error {
+	var columns []struct {
+		Name string `db:"name"`
 	}
-
-	_, err = db.sqldb.ExecContext(db.ctx, v4OrdersSchema)
-	if err != nil {
-		return fmt.Errorf("meshdb v4 order schema migration failed with err: %s", err)
+	if err := txn.SelectContext(db.ctx, &columns, fmt.Sprintf("SELECT name FROM pragma_table_info('%s')", table)); err != nil {
+		return err
+	}
+	for _, existing := range columns {
+		if existing.Name == column {
+			return nil
+		}
 	}
+	_, err := txn.ExecContext(db.ctx, fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition))
+	return err
+}
 
-	_, err = db.peerSQLdb.ExecContext(db.ctx, peerstoreSchema)
+// migrate brings the schemas of all databases up to date. The schema of the
+// main database is versioned (see runMigrations). The peerstore and DHT
+// schemas are owned by go-ds-sql and never change.
+func (db *sqlDB) migrate() error {
+	if err := db.runMigrations(); err != nil {
+		return err
+	}
+	_, err := db.peerSQLdb.ExecContext(db.ctx, peerstoreSchema)
 	if err != nil {
 		return fmt.Errorf("peerstore schema migration failed with err: %s", err)
 	}
@@ -156,28 +171,13 @@ func (db *DB) migrate() error {
 }
 
 // ReadWriteTransactionalContext acquires a write lock, executes the transaction, then immediately releases the lock.
-func (db *DB) ReadWriteTransactionalContext(ctx context.Context, opts *sql.TxOptions, f func(tx *sqlz.Tx) error) error {
+func (db *sqlDB) ReadWriteTransactionalContext(ctx context.Context, opts *sql.TxOptions, f func(tx *sqlz.Tx) error) error {
 	db.mu.Lock()
 	defer db.mu.Unlock()
 	return db.sqldb.TransactionalContext(ctx, opts, f)
 }
 
-func (db *DB) AddOrders(orders []*types.OrderWithMetadata) (alreadyStored []common.Hash, added []*types.OrderWithMetadata, removed []*types.OrderWithMetadata, err error) {
-	alreadyStoredV3, addedV3, removedV3, err := db.AddOrdersV3(orders)
-	if err != nil {
-		return nil, nil, nil, err
-	}
-	alreadyStoredV4, addedV4, removedV4, err := db.AddOrdersV4(orders)
-	if err != nil {
-		return nil, nil, nil, err
-	}
-	alreadyStored = append(alreadyStoredV3, alreadyStoredV4...)
-	added = append(addedV3, addedV4...)
-	removed = append(removedV3, removedV4...)
-	return alreadyStored, added, removed, nil
-}
-
-func (db *DB) AddOrdersV3(orders []*types.OrderWithMetadata) (alreadyStored []common.Hash, added []*types.OrderWithMetadata, removed []*types.OrderWithMetadata, err error) {
+func (db *sqlDB) AddOrdersV3(orders []*types.OrderWithMetadata) (alreadyStored []common.Hash, added []*types.OrderWithMetadata, removed []*types.OrderWithMetadata, err error) {
 	defer func() {
 		err = convertErr(err)
 	}()
@@ -215,7 +215,7 @@ func (db *DB) AddOrdersV3(orders []*types.OrderWithMetadata) (alreadyStored []co
 	return alreadyStored, added, sqltypes.OrdersToCommonType(sqlRemoved), nil
 }
 
-func (db *DB) GetOrder(hash common.Hash) (order *types.OrderWithMetadata, err error) {
+func (db *sqlDB) GetOrder(hash common.Hash) (order *types.OrderWithMetadata, err error) {
 	defer func() {
 		err = convertErr(err)
 	}()
@@ -229,7 +229,7 @@ func (db *DB) GetOrder(hash common.Hash) (order *types.OrderWithMetadata, err er
 	return sqltypes.OrderToCommonType(&foundOrder), nil
 }
 
-func (db *DB) GetOrderStatuses(hashes []common.Hash) (statuses []*StoredOrderStatus, err error) {
+func (db *sqlDB) GetOrderStatuses(hashes []common.Hash) (statuses []*StoredOrderStatus, err error) {
 	defer func() {
 		err = convertErr(err)
 	}()
@@ -266,7 +266,7 @@ func (db *DB) GetOrderStatuses(hashes []common.Hash) (statuses []*StoredOrderSta
 	return orderStatuses, nil
 }
 
-func (db *DB) FindOrders(query *OrderQuery) (orders []*types.OrderWithMetadata, err error) {
+func (db *sqlDB) FindOrders(query *OrderQuery) (orders []*types.OrderWithMetadata, err error) {
 	defer func() {
 		err = convertErr(err)
 	}()
@@ -288,9 +288,9 @@ func (db *DB) FindOrders(query *OrderQuery) (orders []*types.OrderWithMetadata,
 }
 
 // Remove orders with an expiration time too far in the future.
-func (db *DB) RemoveOrdersWithLongExpiration() ([]*sqltypes.Order, error) {
+func (db *sqlDB) RemoveOrdersWithLongExpiration() ([]*types.OrderWithMetadata, error) {
 	sqlRemoved := []*sqltypes.Order{}
-	return sqlRemoved, db.ReadWriteTransactionalContext(db.ctx, nil, func(txn *sqlz.Tx) error {
+	err := db.ReadWriteTransactionalContext(db.ctx, nil, func(txn *sqlz.Tx) error {
 		// HACK(albrow): sqlz doesn't support ORDER BY, LIMIT, and OFFSET
 		// for DELETE statements. It also doesn't support RETURNING. As a
 		// workaround, we do a SELECT and DELETE inside a transaction.
@@ -301,7 +301,7 @@ func (db *DB) RemoveOrdersWithLongExpiration() ([]*sqltypes.Order, error) {
 			Limit(largeLimit).
 			Offset(int64(db.opts.MaxOrders))
 		var ordersToRemove []*sqltypes.Order
-		err := removeQuery.GetAllContext(db.ctx, &sqlRemoved)
+		err := removeQuery.GetAllContext(db.ctx, &ordersToRemove)
 		if err != nil {
 			return err
 		}
@@ -315,9 +315,13 @@ func (db *DB) RemoveOrdersWithLongExpiration() ([]*sqltypes.Order, error) {
 
 		return nil
 	})
+	if err != nil {
+		return nil, convertErr(err)
+	}
+	return sqltypes.OrdersToCommonType(sqlRemoved), nil
 }
 
-func (db *DB) CountOrders(query *OrderQuery) (count int, err error) {
+func (db *sqlDB) CountOrders(query *OrderQuery) (count int, err error) {
 	defer func() {
 		err = convertErr(err)
 	}()
@@ -337,6 +341,109 @@ func (db *DB) CountOrders(query *OrderQuery) (count int, err error) {
 	return int(gotCount), nil
 }
 
+// GetOrderStats returns aggregate values for all orders that match the
+// filters of the given query. The sort options, limit and offset of the query
+// are ignored.
+func (db *sqlDB) GetOrderStats(query *OrderQuery) (stats *types.OrderStats, err error) {
+	defer func() {
+		err = convertErr(err)
+	}()
+	if err := checkOrderQuery(query); err != nil {
+		return nil, err
+	}
+	var filters []OrderFilter
+	if query != nil {
+		filters = query.Filters
+	}
+	stmt, err := addOptsToSelectOrdersQuery(db.sqldb.Select(orderStatsColumns(OFMakerAddress, OFExpirationTimeSeconds)...).From("orders"), &OrderQuery{Filters: filters})
+	if err != nil {
+		return nil, err
+	}
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	return getOrderStats(db.ctx, stmt)
+}
+
+// sortedBigIntLength is the length of the zero-padded strings that are used
+// to store big integers (see sqltypes.SortedBigInt).
+const sortedBigIntLength = 80
+
+// orderStatsChunkLength is the number of digits of fillableTakerAssetAmount
+// that are summed at a time by the order stats query. Values this short can be
+// summed for billions of orders without overflowing a 64-bit integer.
+const orderStatsChunkLength = 9
+
+// orderStatsColumns returns the aggregates that are selected by the order stats
+// query. Because big integers are stored as zero-padded strings, MIN and MAX
+// work as expected but SUM would lose precision. fillableTakerAssetAmount is
+// instead summed in chunks of orderStatsChunkLength digits, starting with the
+// least significant digits. getOrderStats combines the chunks afterwards.
+func orderStatsColumns(makerField OrderField, expirationTimeField OrderField) []string {
+	columns := []string{
+		"COUNT(*)",
+		fmt.Sprintf("COUNT(DISTINCT %s)", makerField),
+		fmt.Sprintf("MIN(%s)", expirationTimeField),
+		fmt.Sprintf("MAX(%s)", expirationTimeField),
+	}
+	for end := sortedBigIntLength; end > 0; end -= orderStatsChunkLength {
+		start := end - orderStatsChunkLength + 1
+		if start < 1 {
+			start = 1
+		}
+		columns = append(columns, fmt.Sprintf("COALESCE(SUM(CAST(SUBSTR(%s, %d, %d) AS INTEGER)), 0)", OFFillableTakerAssetAmount, start, end-start+1))
+	}
+	return columns
+}
+
+// getOrderStats runs a query which selects the columns returned by
+// orderStatsColumns and converts the result to types.OrderStats.
+func getOrderStats(ctx context.Context, stmt *sqlz.SelectStmt) (*types.OrderStats, error) {
+	rows, err := stmt.GetAllAsRowsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("order stats query did not return any rows")
+	}
+	var (
+		orderCount         int64
+		makerCount         int64
+		minExpirationTime  *sqltypes.SortedBigInt
+		maxExpirationTime  *sqltypes.SortedBigInt
+		numChunks          = (sortedBigIntLength + orderStatsChunkLength - 1) / orderStatsChunkLength
+		fillableAmountSums = make([]int64, numChunks)
+		scanDestinations   = []interface{}{&orderCount, &makerCount, &minExpirationTime, &maxExpirationTime}
+	)
+	for i := range fillableAmountSums {
+		scanDestinations = append(scanDestinations, &fillableAmountSums[i])
+	}
+	if err := rows.Scan(scanDestinations...); err != nil {
+		return nil, err
+	}
+	totalFillableAmount := big.NewInt(0)
+	chunkBase := new(big.Int).Exp(big.NewInt(10), big.NewInt(orderStatsChunkLength), nil)
+	for i := len(fillableAmountSums) - 1; i >= 0; i-- {
+		totalFillableAmount.Mul(totalFillableAmount, chunkBase)
+		totalFillableAmount.Add(totalFillableAmount, big.NewInt(fillableAmountSums[i]))
+	}
+	stats := &types.OrderStats{
+		OrderCount:                    int(orderCount),
+		TotalFillableTakerAssetAmount: totalFillableAmount,
+		MakerCount:                    int(makerCount),
+	}
+	if minExpirationTime != nil {
+		stats.MinExpirationTimeSeconds = minExpirationTime.Int
+	}
+	if maxExpirationTime != nil {
+		stats.MaxExpirationTimeSeconds = maxExpirationTime.Int
+	}
+	return stats, nil
+}
+
 type Selector interface {
 	Select(cols ...string) *sqlz.SelectStmt
 }
@@ -400,6 +507,22 @@ func whereConditionsFromOrderFilterOpts(filterOpts []OrderFilter) ([]sqlz.WhereC
 			// Note(albrow): If needed, we can optimize this so it is easier to index.
 			// LIKE queries are notoriously slow.
 			whereConditions[i] = sqlz.Like(string(filterOpt.Field), fmt.Sprintf("%%%s%%", value))
+		case In:
+			values, ok := value.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("db.FindOrder: invalid type for IN filter value: %T", filterOpt.Value)
+			}
+			whereConditions[i] = sqlz.In(string(filterOpt.Field), values...)
+		case Or:
+			groupConditions := make([]sqlz.WhereCondition, len(filterOpt.Groups))
+			for j, group := range filterOpt.Groups {
+				conditions, err := whereConditionsFromOrderFilterOpts(group)
+				if err != nil {
+					return nil, err
+				}
+				groupConditions[j] = sqlz.And(conditions...)
+			}
+			whereConditions[i] = sqlz.Or(groupConditions...)
 		default:
 			return nil, fmt.Errorf("db.FindOrder: unknown FilterOpt.Kind: %s", filterOpt.Kind)
 		}
@@ -407,7 +530,7 @@ func whereConditionsFromOrderFilterOpts(filterOpts []OrderFilter) ([]sqlz.WhereC
 	return whereConditions, nil
 }
 
-func (db *DB) DeleteOrder(hash common.Hash) error {
+func (db *sqlDB) DeleteOrder(hash common.Hash) error {
 	db.mu.Lock()
 	_, err := db.sqldb.ExecContext(db.ctx, "DELETE FROM orders WHERE hash = $1", hash)
 	db.mu.Unlock()
@@ -417,7 +540,7 @@ func (db *DB) DeleteOrder(hash common.Hash) error {
 	return nil
 }
 
-func (db *DB) DeleteOrders(query *OrderQuery) (deleted []*types.OrderWithMetadata, err error) {
+func (db *sqlDB) DeleteOrders(query *OrderQuery) (deleted []*types.OrderWithMetadata, err error) {
 	defer func() {
 		err = convertErr(err)
 	}()
@@ -450,22 +573,7 @@ func (db *DB) DeleteOrders(query *OrderQuery) (deleted []*types.OrderWithMetadat
 	return sqltypes.OrdersToCommonType(ordersToDelete), nil
 }
 
-func (db *DB) UpdateOrder(hash common.Hash, updateFunc func(existingOrder *types.OrderWithMetadata) (updatedOrder *types.OrderWithMetadata, err error)) (err error) {
-	errV3 := db.UpdateOrderV3(hash, updateFunc)
-	if errV3 != nil && errV3 != ErrNotFound {
-		return errV3
-	}
-	errV4 := db.UpdateOrderV4(hash, updateFunc)
-	if errV4 != nil && errV4 != ErrNotFound {
-		return errV4
-	}
-	if errV3 == ErrNotFound && errV4 == ErrNotFound {
-		return ErrNotFound
-	}
-	return nil
-}
-
-func (db *DB) UpdateOrderV3(hash common.Hash, updateFunc func(existingOrder *types.OrderWithMetadata) (updatedOrder *types.OrderWithMetadata, err error)) (err error) {
+func (db *sqlDB) UpdateOrderV3(hash common.Hash, updateFunc func(existingOrder *types.OrderWithMetadata) (updatedOrder *types.OrderWithMetadata, err error)) (err error) {
 	defer func() {
 		err = convertErr(err)
 	}()
@@ -496,7 +604,7 @@ func (db *DB) UpdateOrderV3(hash common.Hash, updateFunc func(existingOrder *typ
 	})
 }
 
-func (db *DB) AddMiniHeaders(miniHeaders []*types.MiniHeader) (added []*types.MiniHeader, removed []*types.MiniHeader, err error) {
+func (db *sqlDB) AddMiniHeaders(miniHeaders []*types.MiniHeader) (added []*types.MiniHeader, removed []*types.MiniHeader, err error) {
 	defer func() {
 		err = convertErr(err)
 	}()
@@ -557,7 +665,7 @@ func (db *DB) AddMiniHeaders(miniHeaders []*types.MiniHeader) (added []*types.Mi
 
 // ResetMiniHeaders deletes all of the existing miniheaders and then stores new
 // miniheaders in the database.
-func (db *DB) ResetMiniHeaders(newMiniHeaders []*types.MiniHeader) (err error) {
+func (db *sqlDB) ResetMiniHeaders(newMiniHeaders []*types.MiniHeader) (err error) {
 	defer func() {
 		err = convertErr(err)
 	}()
@@ -579,7 +687,7 @@ func (db *DB) ResetMiniHeaders(newMiniHeaders []*types.MiniHeader) (err error) {
 	return nil
 }
 
-func (db *DB) GetMiniHeader(hash common.Hash) (miniHeader *types.MiniHeader, err error) {
+func (db *sqlDB) GetMiniHeader(hash common.Hash) (miniHeader *types.MiniHeader, err error) {
 	defer func() {
 		err = convertErr(err)
 	}()
@@ -596,7 +704,7 @@ func (db *DB) GetMiniHeader(hash common.Hash) (miniHeader *types.MiniHeader, err
 	return sqltypes.MiniHeaderToCommonType(&sqlMiniHeader), nil
 }
 
-func (db *DB) FindMiniHeaders(query *MiniHeaderQuery) (miniHeaders []*types.MiniHeader, err error) {
+func (db *sqlDB) FindMiniHeaders(query *MiniHeaderQuery) (miniHeaders []*types.MiniHeader, err error) {
 	defer func() {
 		err = convertErr(err)
 	}()
@@ -684,7 +792,7 @@ func whereConditionsFromMiniHeaderFilterOpts(filterOpts []MiniHeaderFilter) ([]s
 	return whereConditions, nil
 }
 
-func (db *DB) DeleteMiniHeader(hash common.Hash) error {
+func (db *sqlDB) DeleteMiniHeader(hash common.Hash) error {
 	db.mu.Lock()
 	_, err := db.sqldb.ExecContext(db.ctx, "DELETE FROM miniHeaders WHERE hash = $1", hash)
 	db.mu.Unlock()
@@ -694,7 +802,7 @@ func (db *DB) DeleteMiniHeader(hash common.Hash) error {
 	return nil
 }
 
-func (db *DB) DeleteMiniHeaders(query *MiniHeaderQuery) (deleted []*types.MiniHeader, err error) {
+func (db *sqlDB) DeleteMiniHeaders(query *MiniHeaderQuery) (deleted []*types.MiniHeader, err error) {
 	defer func() {
 		err = convertErr(err)
 	}()
@@ -725,7 +833,7 @@ func (db *DB) DeleteMiniHeaders(query *MiniHeaderQuery) (deleted []*types.MiniHe
 }
 
 // GetMetadata returns the metadata (or db.ErrNotFound if no metadata has been saved).
-func (db *DB) GetMetadata() (*types.Metadata, error) {
+func (db *sqlDB) GetMetadata() (*types.Metadata, error) {
 	var metadata sqltypes.Metadata
 	db.mu.RLock()
 	err := db.sqldb.GetContext(db.ctx, &metadata, "SELECT * FROM metadata LIMIT 1")
@@ -739,7 +847,7 @@ func (db *DB) GetMetadata() (*types.Metadata, error) {
 // SaveMetadata inserts the metadata into the database, overwriting any existing
 // metadata. It returns ErrMetadataAlreadyExists if the metadata has already been
 // saved in the database.
-func (db *DB) SaveMetadata(metadata *types.Metadata) (err error) {
+func (db *sqlDB) SaveMetadata(metadata *types.Metadata) (err error) {
 	defer func() {
 		err = convertErr(err)
 	}()
@@ -762,7 +870,7 @@ func (db *DB) SaveMetadata(metadata *types.Metadata) (err error) {
 // UpdateMetadata updates the metadata in the database via a transaction. It
 // accepts a callback function which will be provided with the old metadata and
 // should return the new metadata to save.
-func (db *DB) UpdateMetadata(updateFunc func(oldmetadata *types.Metadata) (newMetadata *types.Metadata)) (err error) {
+func (db *sqlDB) UpdateMetadata(updateFunc func(oldmetadata *types.Metadata) (newMetadata *types.Metadata)) (err error) {
 	defer func() {
 		err = convertErr(err)
 	}()
@@ -795,6 +903,12 @@ func convertFilterValue(value interface{}) interface{} {
 	switch v := value.(type) {
 	case *big.Int:
 		return sqltypes.NewSortedBigInt(v)
+	case []interface{}:
+		values := make([]interface{}, len(v))
+		for i, value := range v {
+			values[i] = convertFilterValue(value)
+		}
+		return values
 	}
 	return value
 }
@@ -0,0 +1,220 @@
+// +build !js
+
+package db
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/0xProject/0x-mesh/constants"
+	"github.com/ido50/sqlz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderQueriesUseIndexes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	db := sqlBackend(t, newTestDB(t, ctx))
+
+	testCases := []struct {
+		name  string
+		query *OrderQuery
+	}{
+		{
+			name: "maker address",
+			query: &OrderQuery{
+				Filters: []OrderFilter{{Field: OFMakerAddress, Kind: Equal, Value: constants.GanacheAccount1}},
+			},
+		},
+		{
+			name: "maker and taker asset data",
+			query: &OrderQuery{
+				Filters: []OrderFilter{
+					{Field: OFMakerAssetData, Kind: Equal, Value: constants.ZRXAssetData},
+					{Field: OFTakerAssetData, Kind: Equal, Value: constants.WETHAssetData},
+				},
+			},
+		},
+		{
+			name: "expiration time range",
+			query: &OrderQuery{
+				Filters: []OrderFilter{
+					{Field: OFExpirationTimeSeconds, Kind: LessOrEqual, Value: big.NewInt(time.Now().Unix())},
+					{Field: OFIsRemoved, Kind: Equal, Value: false},
+				},
+			},
+		},
+		{
+			name: "max expiration time",
+			query: &OrderQuery{
+				Filters: []OrderFilter{{Field: OFIsPinned, Kind: Equal, Value: false}},
+				Sort:    []OrderSort{{Field: OFExpirationTimeSeconds, Direction: Descending}},
+				Limit:   1,
+			},
+		},
+		{
+			name: "sorted by fillable amount",
+			query: &OrderQuery{
+				Sort:  []OrderSort{{Field: OFFillableTakerAssetAmount, Direction: Descending}},
+				Limit: 10,
+			},
+		},
+		{
+			name: "fillable amount range",
+			query: &OrderQuery{
+				Filters: []OrderFilter{{Field: OFFillableTakerAssetAmount, Kind: Greater, Value: big.NewInt(1000)}},
+			},
+		},
+		{
+			name: "stale removed orders",
+			query: &OrderQuery{
+				Filters: []OrderFilter{
+					{Field: OFIsRemoved, Kind: Equal, Value: true},
+					{Field: OFLastUpdated, Kind: Less, Value: time.Now().Add(-5 * time.Minute)},
+				},
+			},
+		},
+		{
+			name: "source peer",
+			query: &OrderQuery{
+				Filters: []OrderFilter{{Field: OFSourcePeerID, Kind: Equal, Value: "peer"}},
+				Sort:    []OrderSort{{Field: OFExpirationTimeSeconds, Direction: Descending}},
+				Limit:   1,
+			},
+		},
+		{
+			name: "last validated block number",
+			query: &OrderQuery{
+				Filters: []OrderFilter{{Field: OFLastValidatedBlockNumber, Kind: Greater, Value: big.NewInt(100)}},
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			stmt, err := addOptsToSelectOrdersQuery(db.sqldb.Select("*").From("orders"), testCase.query)
+			require.NoError(t, err)
+			assertQueryUsesIndex(t, db, stmt)
+		})
+	}
+
+	testCasesV4 := []struct {
+		name  string
+		query *OrderQueryV4
+	}{
+		{
+			name: "maker",
+			query: &OrderQueryV4{
+				Filters: []OrderFilterV4{{Field: OV4FMaker, Kind: Equal, Value: constants.GanacheAccount1}},
+			},
+		},
+		{
+			name: "maker and taker token",
+			query: &OrderQueryV4{
+				Filters: []OrderFilterV4{
+					{Field: OV4FMakerToken, Kind: Equal, Value: constants.GanacheDummyERC721TokenAddress},
+					{Field: OV4FTakerToken, Kind: Equal, Value: constants.GanacheDummyERC721TokenAddress},
+				},
+			},
+		},
+		{
+			name: "max expiry",
+			query: &OrderQueryV4{
+				Filters: []OrderFilterV4{{Field: OV4FIsPinned, Kind: Equal, Value: false}},
+				Sort:    []OrderSortV4{{Field: OV4FExpiry, Direction: Descending}},
+				Limit:   1,
+			},
+		},
+		{
+			name: "source peer",
+			query: &OrderQueryV4{
+				Filters: []OrderFilterV4{{Field: OV4FSourcePeerID, Kind: Equal, Value: "peer"}},
+				Sort:    []OrderSortV4{{Field: OV4FExpiry, Direction: Descending}},
+				Limit:   1,
+			},
+		},
+		{
+			name: "stale removed orders",
+			query: &OrderQueryV4{
+				Filters: []OrderFilterV4{
+					{Field: OV4FIsRemoved, Kind: Equal, Value: true},
+					{Field: OV4FLastUpdated, Kind: Less, Value: time.Now().Add(-5 * time.Minute)},
+				},
+			},
+		},
+	}
+	for _, testCase := range testCasesV4 {
+		t.Run("v4 "+testCase.name, func(t *testing.T) {
+			stmt, err := addOptsToSelectOrdersQueryV4(db.sqldb.Select("*").From("ordersv4"), testCase.query)
+			require.NoError(t, err)
+			assertQueryUsesIndex(t, db, stmt)
+		})
+	}
+}
+
+func TestEvictionQueriesUseIndexes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	db := sqlBackend(t, newTestDB(t, ctx))
+
+	// These are the queries which are run by AddOrders and
+	// RemoveOrdersWithLongExpiration to find the orders that need to be
+	// removed when the database is full.
+	stmt := db.sqldb.Select("*").From("orders").
+		OrderBy(sqlz.Desc(string(OFIsPinned)), sqlz.Asc(string(OFExpirationTimeSeconds))).
+		Limit(largeLimit).
+		Offset(int64(db.opts.MaxOrders))
+	assertQueryUsesIndex(t, db, stmt)
+	stmtV4 := db.sqldb.Select("*").From("ordersv4").
+		OrderBy(sqlz.Desc(string(OV4FIsPinned)), sqlz.Asc(string(OV4FExpiry))).
+		Limit(largeLimit).
+		Offset(int64(db.opts.MaxOrders))
+	assertQueryUsesIndex(t, db, stmtV4)
+}
+
+// assertQueryUsesIndex checks the query plan of stmt and asserts that the
+// query is answered using an index instead of scanning and sorting the whole
+// table.
+func assertQueryUsesIndex(t *testing.T, db *sqlDB, stmt *sqlz.SelectStmt) {
+	query, bindings := stmt.ToSQL(true)
+	rows, err := db.sqldb.QueryContext(db.ctx, "EXPLAIN QUERY PLAN "+query, bindings...)
+	require.NoError(t, err)
+	defer rows.Close()
+	details := []string{}
+	for rows.Next() {
+		var id, parent, notUsed int
+		var detail string
+		require.NoError(t, rows.Scan(&id, &parent, &notUsed, &detail))
+		details = append(details, detail)
+	}
+	require.NoError(t, rows.Err())
+	plan := strings.Join(details, "\n")
+	assert.Contains(t, plan, "USING INDEX", "query should use an index: %s\nquery plan:\n%s", query, plan)
+	assert.NotContains(t, plan, "USE TEMP B-TREE", "query should not need to sort: %s\nquery plan:\n%s", query, plan)
+}
+
+// dropOrderIndexes removes all of the indexes that were added to the orders
+// and ordersv4 tables so that queries can be benchmarked without them.
+func dropOrderIndexes(t testing.TB, db *sqlDB) {
+	var indexes []string
+	// Indexes which SQLite creates automatically for UNIQUE constraints have
+	// no SQL and can't be dropped.
+	err := db.sqldb.SelectContext(db.ctx, &indexes, "SELECT name FROM sqlite_master WHERE type = 'index' AND tbl_name IN ('orders', 'ordersv4') AND sql IS NOT NULL")
+	require.NoError(t, err)
+	require.NotEmpty(t, indexes)
+	for _, index := range indexes {
+		_, err := db.sqldb.ExecContext(db.ctx, "DROP INDEX "+index)
+		require.NoError(t, err)
+	}
+}
+
+// sqlBackend returns the SQL implementation behind db so that tests can
+// inspect the underlying tables directly.
+func sqlBackend(t testing.TB, db *DB) *sqlDB {
+	sqlDB, ok := db.backend.(*sqlDB)
+	require.True(t, ok, "expected the database to be backed by SQLite")
+	return sqlDB
+}
@@ -0,0 +1,263 @@
+// +build !js
+
+package db
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/db/sqltypes"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ido50/sqlz"
+)
+
+// ErrSchemaTooNew is returned by New if the database was last used by a newer
+// version of Mesh with a schema version that this version doesn't know about.
+// Mesh refuses to start in this case because it would not be able to read or
+// write the data correctly.
+var ErrSchemaTooNew = errors.New("the database schema is newer than the latest schema supported by this version of Mesh")
+
+// schemaVersionSchema is the schema for the table which records the migrations
+// that have been applied to the database. The current schema version is the
+// highest version in the table.
+const schemaVersionSchema = `
+CREATE TABLE IF NOT EXISTS schema_version (
+	version   INTEGER PRIMARY KEY NOT NULL,
+	appliedAt DATETIME NOT NULL
+);
+`
+
+// migration is a single, ordered change to the schema of the main database.
+// Migrations are only ever run in the "up" direction. Once a migration has
+// been released it must never be changed; any further changes to the schema
+// require a new migration.
+type migration struct {
+	version     int
+	description string
+	up          func(db *sqlDB, txn *sqlz.Tx) error
+}
+
+// migrations is the list of all migrations, ordered by version. Versions must
+// start at 1 and increase by exactly 1.
+//
+// Databases that were created before schema versioning was introduced don't
+// have a schema_version table, but may already contain some of the tables
+// created by the first few migrations. Those migrations are written so that
+// they can safely be applied to such databases.
+var migrations = []*migration{
+	{
+		version:     1,
+		description: "create the orders, miniHeaders and metadata tables",
+		up:          execMigration(schema),
+	},
+	{
+		version:     2,
+		description: "add the parsed taker asset data columns to the orders table",
+		up: func(db *sqlDB, txn *sqlz.Tx) error {
+			// The JSON value null is used as the default so that orders which
+			// still need to be backfilled can be told apart from orders whose
+			// asset data doesn't contain any tokens (which is stored as []).
+			for _, column := range []string{string(OFParsedTakerAssetData), string(OFParsedTakerFeeAssetData)} {
+				if err := db.addColumnIfNotExists(txn, "orders", column, "TEXT NOT NULL DEFAULT 'null'"); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	{
+		version:     3,
+		description: "create the ordersv4 table",
+		up:          execMigration(v4OrdersSchema),
+	},
+	{
+		version:     4,
+		description: "create the orderEvents table",
+		up:          execMigration(orderEventsSchema),
+	},
+	{
+		version:     5,
+		description: "create the webhookDeliveries table",
+		up:          execMigration(webhookDeliveriesSchema),
+	},
+	{
+		version:     6,
+		description: "create the archivedOrders table",
+		up:          execMigration(archivedOrdersSchema),
+	},
+	{
+		version:     7,
+		description: "index the orderEvents table by order hash",
+		up:          execMigration("CREATE INDEX IF NOT EXISTS orderEvents_orderHash ON orderEvents (orderHash, sequenceNumber)"),
+	},
+	{
+		version:     8,
+		description: "index the orders table",
+		up:          execMigration(orderIndexesSchema),
+	},
+	{
+		version:     9,
+		description: "index the ordersv4 table",
+		up:          execMigration(v4OrderIndexesSchema),
+	},
+	{
+		version:     10,
+		description: "add the sourcePeerID column to the orders and ordersv4 tables",
+		up: func(db *sqlDB, txn *sqlz.Tx) error {
+			// Orders which were stored before this migration don't count
+			// towards the storage quota of any peer.
+			for _, table := range []string{"orders", "ordersv4"} {
+				if err := db.addColumnIfNotExists(txn, table, "sourcePeerID", "TEXT NOT NULL DEFAULT ''"); err != nil {
+					return err
+				}
+			}
+			_, err := txn.ExecContext(db.ctx, sourcePeerIDIndexesSchema)
+			return err
+		},
+	},
+	{
+		version:     11,
+		description: "add the endState column to the orders and ordersv4 tables",
+		up: func(db *sqlDB, txn *sqlz.Tx) error {
+			// Orders which were marked as removed before this migration have
+			// no end state. Their end state is looked up in the order event
+			// log when they are archived.
+			for _, table := range []string{"orders", "ordersv4"} {
+				if err := db.addColumnIfNotExists(txn, table, "endState", "TEXT NOT NULL DEFAULT ''"); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	{
+		version:     12,
+		description: "add the price column to the orders and ordersv4 tables",
+		up: func(db *sqlDB, txn *sqlz.Tx) error {
+			for _, table := range []string{"orders", "ordersv4"} {
+				if err := db.addColumnIfNotExists(txn, table, "price", "REAL NOT NULL DEFAULT 0"); err != nil {
+					return err
+				}
+			}
+			// Prices are backfilled in Go rather than in SQL so that they are
+			// rounded in exactly the same way as the prices of orders which
+			// are added or updated after this migration.
+			if err := db.backfillPrices(txn, "orders", "makerAssetAmount", "takerAssetAmount"); err != nil {
+				return err
+			}
+			if err := db.backfillPrices(txn, "ordersv4", "makerAmount", "takerAmount"); err != nil {
+				return err
+			}
+			_, err := txn.ExecContext(db.ctx, priceIndexesSchema)
+			return err
+		},
+	},
+	{
+		version:     13,
+		description: "add the signature column to the ordersv4 table",
+		up: func(db *sqlDB, txn *sqlz.Tx) error {
+			if err := db.addColumnIfNotExists(txn, "ordersv4", "signature", "BLOB NOT NULL DEFAULT x''"); err != nil {
+				return err
+			}
+			// The signature column is derived from the signatureType,
+			// signatureV, signatureR and signatureS columns, which can't be
+			// concatenated in SQL because r and s are stored as hex strings.
+			var orders []*sqltypes.OrderV4
+			if err := txn.SelectContext(db.ctx, &orders, "SELECT * FROM ordersv4"); err != nil {
+				return err
+			}
+			for _, order := range orders {
+				signature := sqltypes.OrderToCommonTypeV4(order).SignatureV4.Bytes()
+				if _, err := txn.ExecContext(db.ctx, "UPDATE ordersv4 SET signature = $1 WHERE hash = $2", signature, order.Hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+}
+
+// latestSchemaVersion is the version of the schema after all migrations have
+// been applied.
+func latestSchemaVersion() int {
+	return migrations[len(migrations)-1].version
+}
+
+func execMigration(query string) func(db *sqlDB, txn *sqlz.Tx) error {
+	return func(db *sqlDB, txn *sqlz.Tx) error {
+		_, err := txn.ExecContext(db.ctx, query)
+		return err
+	}
+}
+
+// backfillPrices sets the price column of every order in the given table to
+// the price computed from the given amount columns (see
+// types.PriceFromAmounts).
+func (db *sqlDB) backfillPrices(txn *sqlz.Tx, table string, makerAmountColumn string, takerAmountColumn string) error {
+	var orders []struct {
+		Hash        common.Hash            `db:"hash"`
+		MakerAmount *sqltypes.SortedBigInt `db:"makerAmount"`
+		TakerAmount *sqltypes.SortedBigInt `db:"takerAmount"`
+	}
+	query := fmt.Sprintf("SELECT hash, %s AS makerAmount, %s AS takerAmount FROM %s", makerAmountColumn, takerAmountColumn, table)
+	if err := txn.SelectContext(db.ctx, &orders, query); err != nil {
+		return err
+	}
+	for _, order := range orders {
+		price := types.PriceFromAmounts(order.MakerAmount.Int, order.TakerAmount.Int)
+		if _, err := txn.ExecContext(db.ctx, fmt.Sprintf("UPDATE %s SET price = $1 WHERE hash = $2", table), price, order.Hash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SchemaVersion returns the current schema version of the database, i.e. the
+// version of the latest migration that has been applied. It returns 0 if no
+// migrations have been applied.
+func (db *sqlDB) SchemaVersion() (int, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	var version int
+	if err := db.sqldb.GetContext(db.ctx, &version, "SELECT COALESCE(MAX(version), 0) FROM schema_version"); err != nil {
+		return 0, convertErr(err)
+	}
+	return version, nil
+}
+
+// runMigrations applies all of the migrations which have not yet been applied
+// to the main database, in order. Each migration is applied in its own
+// transaction together with the update of the schema version, so a migration
+// that fails leaves the database at the previous version. It returns
+// ErrSchemaTooNew if the database has a newer schema version than the latest
+// known migration.
+func (db *sqlDB) runMigrations() error {
+	if _, err := db.sqldb.ExecContext(db.ctx, schemaVersionSchema); err != nil {
+		return fmt.Errorf("could not create schema_version table: %s", err)
+	}
+	currentVersion, err := db.SchemaVersion()
+	if err != nil {
+		return err
+	}
+	if currentVersion > latestSchemaVersion() {
+		return fmt.Errorf("%w (database version: %d, latest supported version: %d)", ErrSchemaTooNew, currentVersion, latestSchemaVersion())
+	}
+	for _, m := range migrations {
+		if m.version <= currentVersion {
+			continue
+		}
+		err := db.ReadWriteTransactionalContext(db.ctx, nil, func(txn *sqlz.Tx) error {
+			if err := m.up(db, txn); err != nil {
+				return err
+			}
+			_, err := txn.ExecContext(db.ctx, "INSERT INTO schema_version (version, appliedAt) VALUES ($1, $2)", m.version, time.Now().UTC())
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("meshdb schema migration %d (%s) failed with err: %s", m.version, m.description, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,321 @@
+// +build !js
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/db/sqltypes"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ido50/sqlz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrationVersions(t *testing.T) {
+	for i, m := range migrations {
+		assert.Equal(t, i+1, m.version, "migration versions must start at 1 and increase by exactly 1")
+	}
+}
+
+func TestNewAppliesAllMigrations(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	opts := TestOptions()
+	db, err := New(ctx, opts)
+	require.NoError(t, err)
+
+	version, err := db.SchemaVersion()
+	require.NoError(t, err)
+	assert.Equal(t, latestSchemaVersion(), version)
+
+	order := newTestOrder()
+	_, _, _, err = db.AddOrders([]*types.OrderWithMetadata{order})
+	require.NoError(t, err)
+
+	// Opening the same database again should not apply any migrations or
+	// affect the stored data.
+	db, err = New(ctx, opts)
+	require.NoError(t, err)
+	version, err = db.SchemaVersion()
+	require.NoError(t, err)
+	assert.Equal(t, latestSchemaVersion(), version)
+	foundOrder, err := db.GetOrder(order.Hash)
+	require.NoError(t, err)
+	assertOrdersAreEqual(t, order, foundOrder)
+}
+
+func TestNewMigratesUnversionedDatabase(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	opts := TestOptions()
+
+	// Create a database the way older versions of Mesh did, i.e. without the
+	// schema_version table, the parsed taker asset data columns and any of the
+	// tables that were added later.
+	{
+		db, err := New(ctx, opts)
+		require.NoError(t, err)
+		for _, table := range []string{"schema_version", "orders", "ordersv4", "orderEvents", "webhookDeliveries"} {
+			_, err := sqlBackend(t, db).sqldb.ExecContext(ctx, "DROP TABLE "+table)
+			require.NoError(t, err)
+		}
+		_, err = sqlBackend(t, db).sqldb.ExecContext(ctx, `CREATE TABLE orders (
+			hash TEXT UNIQUE NOT NULL,
+			chainID TEXT NOT NULL,
+			exchangeAddress TEXT NOT NULL,
+			makerAddress TEXT NOT NULL,
+			makerAssetData TEXT NOT NULL,
+			makerFeeAssetData TEXT NOT NULL,
+			makerAssetAmount TEXT NOT NULL,
+			makerFee TEXT NOT NULL,
+			takerAddress TEXT NOT NULL,
+			takerAssetData TEXT NOT NULL,
+			takerFeeAssetData TEXT NOT NULL,
+			takerAssetAmount TEXT NOT NULL,
+			takerFee TEXT NOT NULL,
+			senderAddress TEXT NOT NULL,
+			feeRecipientAddress TEXT NOT NULL,
+			expirationTimeSeconds TEXT NOT NULL,
+			salt TEXT NOT NULL,
+			signature TEXT NOT NULL,
+			lastUpdated DATETIME NOT NULL,
+			fillableTakerAssetAmount TEXT NOT NULL,
+			isRemoved BOOLEAN NOT NULL,
+			isPinned BOOLEAN NOT NULL,
+			isUnfillable BOOLEAN NOT NULL,
+			isExpired BOOLEAN NOT NULL,
+			parsedMakerAssetData TEXT NOT NULL,
+			parsedMakerFeeAssetData TEXT NOT NULL,
+			lastValidatedBlockNumber TEXT NOT NULL,
+			lastValidatedBlockHash TEXT NOT NULL,
+			keepCancelled BOOLEAN NOT NULL,
+			keepExpired BOOLEAN NOT NULL,
+			keepFullyFilled BOOLEAN NOT NULL,
+			keepUnfunded BOOLEAN NOT NULL
+		)`)
+		require.NoError(t, err)
+	}
+
+	db, err := New(ctx, opts)
+	require.NoError(t, err)
+	version, err := db.SchemaVersion()
+	require.NoError(t, err)
+	assert.Equal(t, latestSchemaVersion(), version)
+
+	// All tables and columns should exist now.
+	order := newTestOrder()
+	orderV4 := newTestOrderV4()
+	_, added, _, err := db.AddOrders([]*types.OrderWithMetadata{order, orderV4})
+	require.NoError(t, err)
+	assert.Len(t, added, 2)
+	foundOrder, err := db.GetOrder(order.Hash)
+	require.NoError(t, err)
+	assertOrdersAreEqual(t, order, foundOrder)
+	require.NoError(t, db.AddOrderEvents(nil))
+	_, err = db.FindWebhookDeliveries(nil)
+	require.NoError(t, err)
+}
+
+func TestNewRefusesNewerSchemaVersion(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	opts := TestOptions()
+	db, err := New(ctx, opts)
+	require.NoError(t, err)
+
+	_, err = sqlBackend(t, db).sqldb.ExecContext(ctx, "INSERT INTO schema_version (version, appliedAt) VALUES ($1, CURRENT_TIMESTAMP)", latestSchemaVersion()+1)
+	require.NoError(t, err)
+
+	_, err = New(ctx, opts)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrSchemaTooNew), "expected ErrSchemaTooNew but got: %s", err)
+}
+
+func TestFailedMigrationIsRolledBack(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	opts := TestOptions()
+	db, err := New(ctx, opts)
+	require.NoError(t, err)
+
+	originalMigrations := migrations
+	defer func() {
+		migrations = originalMigrations
+	}()
+	migrations = append(migrations[:len(migrations):len(migrations)], &migration{
+		version:     latestSchemaVersion() + 1,
+		description: "a migration which fails halfway through",
+		up: func(db *sqlDB, txn *sqlz.Tx) error {
+			if _, err := txn.ExecContext(db.ctx, "CREATE TABLE migrationTest (id INTEGER)"); err != nil {
+				return err
+			}
+			return errors.New("something went wrong")
+		},
+	})
+
+	_, err = New(ctx, opts)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "something went wrong")
+
+	version, err := db.SchemaVersion()
+	require.NoError(t, err)
+	assert.Equal(t, latestSchemaVersion()-1, version)
+	var count int
+	require.NoError(t, sqlBackend(t, db).sqldb.GetContext(ctx, &count, "SELECT COUNT(*) FROM sqlite_master WHERE name = 'migrationTest'"))
+	assert.Equal(t, 0, count, "the table created by the failed migration should not exist")
+}
+
+// v11InsertOrderQuery and v11InsertOrderQueryV4 insert orders into a database
+// with schema version 11, i.e. before the price and signature columns were
+// added.
+var (
+	v11InsertOrderQuery = strings.Replace(strings.Replace(insertOrderQuery,
+		",\n\tprice\n)", "\n)", 1),
+		",\n\t:price\n)", "\n)", 1)
+	v11InsertOrderQueryV4 = strings.Replace(strings.Replace(insertOrderQueryV4,
+		",\n\tprice,\n\tsignature\n)", "\n)", 1),
+		",\n\t:price,\n\t:signature\n)", "\n)", 1)
+)
+
+// closePrices are pairs of maker and taker amounts with prices that are equal,
+// close to each other or only differ beyond the precision of a float64.
+var closePrices = [][2]*big.Int{
+	{big.NewInt(3), big.NewInt(1)},
+	{big.NewInt(6), big.NewInt(2)},
+	{big.NewInt(1000000), big.NewInt(1000001)},
+	{big.NewInt(1000000), big.NewInt(1000002)},
+	{new(big.Int).Exp(big.NewInt(10), big.NewInt(30), nil), new(big.Int).Add(new(big.Int).Exp(big.NewInt(10), big.NewInt(30), nil), big.NewInt(1))},
+	{new(big.Int).Exp(big.NewInt(10), big.NewInt(30), nil), new(big.Int).Add(new(big.Int).Exp(big.NewInt(10), big.NewInt(30), nil), big.NewInt(2))},
+	{big.NewInt(7), big.NewInt(7)},
+}
+
+func TestPriceMigrationUpgradesV11Database(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	opts := TestOptions()
+	allMigrations := migrations
+	migrations = migrations[:11]
+	db, err := New(ctx, opts)
+	migrations = allMigrations
+	require.NoError(t, err)
+
+	// Every other pair of amounts is used for an order which is stored before
+	// the upgrade. The others are used for orders which are added afterwards.
+	var backfilledOrders, backfilledOrdersV4, addedOrders, addedOrdersV4 []*types.OrderWithMetadata
+	for i, amounts := range closePrices {
+		order := newTestOrder()
+		order.OrderV3.MakerAssetAmount, order.OrderV3.TakerAssetAmount = amounts[0], amounts[1]
+		orderV4 := newTestOrderV4()
+		orderV4.OrderV4.MakerAmount, orderV4.OrderV4.TakerAmount = amounts[0], amounts[1]
+		if i%2 == 0 {
+			backfilledOrders = append(backfilledOrders, order)
+			backfilledOrdersV4 = append(backfilledOrdersV4, orderV4)
+		} else {
+			addedOrders = append(addedOrders, order)
+			addedOrdersV4 = append(addedOrdersV4, orderV4)
+		}
+	}
+	sqldb := sqlBackend(t, db).sqldb
+	for _, order := range backfilledOrders {
+		_, err := sqldb.NamedExecContext(ctx, v11InsertOrderQuery, sqltypes.OrderFromCommonType(order))
+		require.NoError(t, err)
+	}
+	for _, order := range backfilledOrdersV4 {
+		_, err := sqldb.NamedExecContext(ctx, v11InsertOrderQueryV4, sqltypes.OrderFromCommonTypeV4(order))
+		require.NoError(t, err)
+	}
+
+	db, err = New(ctx, opts)
+	require.NoError(t, err)
+	version, err := db.SchemaVersion()
+	require.NoError(t, err)
+	assert.Equal(t, latestSchemaVersion(), version)
+	_, _, _, err = db.AddOrders(addedOrders)
+	require.NoError(t, err)
+	_, _, _, err = db.AddOrdersV4(addedOrdersV4)
+	require.NoError(t, err)
+
+	// Backfilled prices are rounded in the same way as the prices of orders
+	// which are added after the upgrade.
+	sqldb = sqlBackend(t, db).sqldb
+	for _, order := range append(backfilledOrders, addedOrders...) {
+		var price float64
+		require.NoError(t, sqldb.GetContext(ctx, &price, "SELECT price FROM orders WHERE hash = $1", order.Hash))
+		assert.Equal(t, order.Price(), price)
+	}
+	for _, order := range append(backfilledOrdersV4, addedOrdersV4...) {
+		var price float64
+		require.NoError(t, sqldb.GetContext(ctx, &price, "SELECT price FROM ordersv4 WHERE hash = $1", order.Hash))
+		assert.Equal(t, order.Price(), price)
+	}
+
+	// Orders with equal prices, including prices which only differ beyond the
+	// precision of a float64, are sorted by hash.
+	expectedHashes := hashesSortedByPrice(append(backfilledOrders, addedOrders...))
+	foundOrders, err := db.FindOrders(&OrderQuery{
+		Sort: []OrderSort{{Field: OFPrice, Direction: Ascending}, {Field: OFHash, Direction: Ascending}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, expectedHashes, orderHashes(foundOrders))
+	expectedHashes = hashesSortedByPrice(append(backfilledOrdersV4, addedOrdersV4...))
+	foundOrders, err = db.FindOrdersV4(&OrderQueryV4{
+		Sort: []OrderSortV4{{Field: OV4FPrice, Direction: Ascending}, {Field: OV4FHash, Direction: Ascending}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, expectedHashes, orderHashes(foundOrders))
+}
+
+func hashesSortedByPrice(orders []*types.OrderWithMetadata) []common.Hash {
+	sorted := append([]*types.OrderWithMetadata{}, orders...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Price() != sorted[j].Price() {
+			return sorted[i].Price() < sorted[j].Price()
+		}
+		return bytes.Compare(sorted[i].Hash.Bytes(), sorted[j].Hash.Bytes()) < 0
+	})
+	return orderHashes(sorted)
+}
+
+func orderHashes(orders []*types.OrderWithMetadata) []common.Hash {
+	hashes := make([]common.Hash, len(orders))
+	for i, order := range orders {
+		hashes[i] = order.Hash
+	}
+	return hashes
+}
+
+func TestSignatureMigrationBackfillsSignatures(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	opts := TestOptions()
+	db, err := New(ctx, opts)
+	require.NoError(t, err)
+
+	order := newTestOrderV4()
+	_, _, _, err = db.AddOrdersV4([]*types.OrderWithMetadata{order})
+	require.NoError(t, err)
+
+	// Simulate orders which were stored before the signature column was added.
+	sqldb := sqlBackend(t, db).sqldb
+	for _, query := range []string{
+		"UPDATE ordersv4 SET signature = x''",
+		"DELETE FROM schema_version WHERE version = 13",
+	} {
+		_, err := sqldb.ExecContext(ctx, query)
+		require.NoError(t, err)
+	}
+
+	db, err = New(ctx, opts)
+	require.NoError(t, err)
+	var signature []byte
+	require.NoError(t, sqlBackend(t, db).sqldb.GetContext(ctx, &signature, "SELECT signature FROM ordersv4 WHERE hash = $1", order.Hash))
+	assert.Equal(t, order.SignatureV4.Bytes(), signature)
+}
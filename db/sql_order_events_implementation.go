@@ -0,0 +1,106 @@
+// +build !js
+
+package db
+
+import (
+	"database/sql"
+
+	"github.com/0xProject/0x-mesh/db/sqltypes"
+	"github.com/0xProject/0x-mesh/zeroex"
+	"github.com/ido50/sqlz"
+)
+
+// AddOrderEvents appends the given order events to the order event log. Each
+// event is assigned the next sequence number, which is set on the given order
+// events. If the number of events in the log exceeds MaxOrderEvents, the oldest
+// events are removed.
+func (db *sqlDB) AddOrderEvents(orderEvents []*zeroex.OrderEvent) (err error) {
+	defer func() {
+		err = convertErr(err)
+	}()
+	if len(orderEvents) == 0 {
+		return nil
+	}
+
+	sequenceNumbers := make([]uint64, len(orderEvents))
+	err = db.ReadWriteTransactionalContext(db.ctx, nil, func(txn *sqlz.Tx) error {
+		for i, orderEvent := range orderEvents {
+			result, err := txn.NamedExecContext(db.ctx, insertOrderEventQuery, sqltypes.OrderEventFromCommonType(orderEvent))
+			if err != nil {
+				return err
+			}
+			sequenceNumber, err := result.LastInsertId()
+			if err != nil {
+				return err
+			}
+			sequenceNumbers[i] = uint64(sequenceNumber)
+		}
+
+		// Remove the oldest events if we are over the limit. Since sequence numbers
+		// are strictly increasing, we only need to compare against the latest one.
+		latest := sequenceNumbers[len(sequenceNumbers)-1]
+		if latest > uint64(db.opts.MaxOrderEvents) {
+			_, err := txn.DeleteFrom("orderEvents").
+				Where(sqlz.Lte("sequenceNumber", latest-uint64(db.opts.MaxOrderEvents))).
+				ExecContext(db.ctx)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Only set the sequence numbers once the transaction has been committed.
+	for i, orderEvent := range orderEvents {
+		orderEvent.SequenceNumber = sequenceNumbers[i]
+	}
+	return nil
+}
+
+// FindOrderEvents returns the order events in the order event log that match
+// the given query, sorted by sequence number in ascending order.
+func (db *sqlDB) FindOrderEvents(query *OrderEventQuery) (orderEvents []*zeroex.OrderEvent, err error) {
+	defer func() {
+		err = convertErr(err)
+	}()
+	stmt := db.sqldb.Select("*").From("orderEvents").OrderBy(sqlz.Asc("sequenceNumber"))
+	if query != nil {
+		if query.AfterSequenceNumber != 0 {
+			stmt.Where(sqlz.Gt("sequenceNumber", query.AfterSequenceNumber))
+		}
+		if query.Limit != 0 {
+			stmt.Limit(int64(query.Limit))
+		}
+	}
+	var sqlOrderEvents []*sqltypes.OrderEvent
+	db.mu.RLock()
+	err = stmt.GetAllContext(db.ctx, &sqlOrderEvents)
+	db.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	return sqltypes.OrderEventsToCommonType(sqlOrderEvents), nil
+}
+
+// GetOrderEventSequenceNumberRange returns the sequence numbers of the oldest
+// and latest events that are currently stored in the order event log. Both are
+// 0 if the log is empty.
+func (db *sqlDB) GetOrderEventSequenceNumberRange() (oldest uint64, latest uint64, err error) {
+	defer func() {
+		err = convertErr(err)
+	}()
+	var result struct {
+		Oldest sql.NullInt64 `db:"oldest"`
+		Latest sql.NullInt64 `db:"latest"`
+	}
+	db.mu.RLock()
+	err = db.sqldb.GetContext(db.ctx, &result, "SELECT MIN(sequenceNumber) AS oldest, MAX(sequenceNumber) AS latest FROM orderEvents")
+	db.mu.RUnlock()
+	if err != nil {
+		return 0, 0, err
+	}
+	return uint64(result.Oldest.Int64), uint64(result.Latest.Int64), nil
+}
@@ -2,10 +2,10 @@
 
 package db
 
-// TODO(albrow): Use a proper migration tool. We don't technically need this
-// now but it will be necessary if we ever change the database schema.
-// Note(albrow): If needed, we can optimize this by adding indexes to the
-// orders and miniHeaders tables.
+// schema is the initial schema of the main database. It must not be changed.
+// Changes to the schema are made by adding a migration (see sql_migrations.go).
+// Indexes for the orders table are added by a later migration (see
+// orderIndexesSchema).
 const schema = `
 CREATE TABLE IF NOT EXISTS orders (
 	hash                     TEXT UNIQUE NOT NULL,
@@ -34,6 +34,8 @@ CREATE TABLE IF NOT EXISTS orders (
 	isExpired                BOOLEAN NOT NULL,
 	parsedMakerAssetData     TEXT NOT NULL,
 	parsedMakerFeeAssetData  TEXT NOT NULL,
+	parsedTakerAssetData     TEXT NOT NULL,
+	parsedTakerFeeAssetData  TEXT NOT NULL,
 	lastValidatedBlockNumber TEXT NOT NULL,
 	lastValidatedBlockHash   TEXT NOT NULL,
 	keepCancelled            BOOLEAN NOT NULL,
@@ -56,6 +58,90 @@ CREATE TABLE IF NOT EXISTS metadata (
 	startOfCurrentUTCDay              DATETIME NOT NULL
 );
 `
+
+// orderIndexesSchema adds indexes for the fields that orders are most commonly
+// filtered and sorted by. Big integers are stored as zero-padded strings (see
+// sqltypes.SortedBigInt), so they are compared numerically by these indexes.
+//
+// The index on isPinned and expirationTimeSeconds matches the sort order that
+// is used to find the orders which are removed when the database is full, as
+// well as the query for the current max expiration time. The other indexes
+// serve the filters used by the order watcher (expiration, lastUpdated and
+// lastValidatedBlockNumber) and by the GraphQL API (maker, asset data and
+// fillable amount).
+const orderIndexesSchema = `
+CREATE INDEX IF NOT EXISTS orders_makerAddress ON orders (makerAddress, expirationTimeSeconds);
+CREATE INDEX IF NOT EXISTS orders_makerAssetData ON orders (makerAssetData, takerAssetData);
+CREATE INDEX IF NOT EXISTS orders_expirationTimeSeconds ON orders (expirationTimeSeconds);
+CREATE INDEX IF NOT EXISTS orders_isPinned_expirationTimeSeconds ON orders (isPinned DESC, expirationTimeSeconds);
+CREATE INDEX IF NOT EXISTS orders_fillableTakerAssetAmount ON orders (fillableTakerAssetAmount);
+CREATE INDEX IF NOT EXISTS orders_lastUpdated ON orders (lastUpdated);
+CREATE INDEX IF NOT EXISTS orders_lastValidatedBlockNumber ON orders (lastValidatedBlockNumber);
+`
+
+// sourcePeerIDIndexesSchema adds the indexes which are used to count and evict
+// the orders that were received from a single peer when enforcing the per-peer
+// storage quota.
+const sourcePeerIDIndexesSchema = `
+CREATE INDEX IF NOT EXISTS orders_sourcePeerID ON orders (sourcePeerID, expirationTimeSeconds);
+CREATE INDEX IF NOT EXISTS ordersv4_sourcePeerID ON ordersv4 (sourcePeerID, expiry);
+`
+
+// priceIndexesSchema indexes the price column so that the orders for a pair of
+// assets can be sorted by price (e.g. for an orderbook).
+const priceIndexesSchema = `
+CREATE INDEX IF NOT EXISTS orders_price ON orders (makerAssetData, takerAssetData, price);
+CREATE INDEX IF NOT EXISTS ordersv4_price ON ordersv4 (makerToken, takerToken, price);
+`
+
+// orderEventsSchema is the schema for the order event log. AUTOINCREMENT
+// guarantees that sequence numbers are never reused, even after the oldest
+// events have been pruned.
+const orderEventsSchema = `
+CREATE TABLE IF NOT EXISTS orderEvents (
+	sequenceNumber INTEGER PRIMARY KEY AUTOINCREMENT,
+	orderHash      TEXT NOT NULL,
+	timestamp      DATETIME NOT NULL,
+	orderEvent     TEXT NOT NULL
+);
+`
+
+// archivedOrdersSchema is the schema for the archive of orders that have been
+// permanently deleted. Only one of signedOrder and signedOrderV4 is set.
+const archivedOrdersSchema = `
+CREATE TABLE IF NOT EXISTS archivedOrders (
+	hash                     TEXT UNIQUE NOT NULL,
+	makerAddress             TEXT NOT NULL,
+	signedOrder              TEXT,
+	signedOrderV4            TEXT,
+	endState                 TEXT NOT NULL,
+	fillableTakerAssetAmount TEXT NOT NULL,
+	blockNumber              TEXT NOT NULL,
+	blockHash                TEXT NOT NULL,
+	timestamp                DATETIME NOT NULL,
+	isPinned                 BOOLEAN NOT NULL,
+	archivedAt               DATETIME NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS archivedOrders_archivedAt ON archivedOrders (archivedAt);
+CREATE INDEX IF NOT EXISTS archivedOrders_makerAddress ON archivedOrders (makerAddress);
+`
+
+// webhookDeliveriesSchema is the schema for the outbox of payloads that are
+// waiting to be delivered to webhook endpoints.
+const webhookDeliveriesSchema = `
+CREATE TABLE IF NOT EXISTS webhookDeliveries (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	url           TEXT NOT NULL,
+	payload       BLOB NOT NULL,
+	attempts      INTEGER NOT NULL,
+	nextAttemptAt DATETIME NOT NULL,
+	createdAt     DATETIME NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_url ON webhookDeliveries (url);
+`
+
 const peerstoreSchema = `
 CREATE TABLE IF NOT EXISTS peerstore (
 	key  TEXT NOT NULL UNIQUE,
@@ -98,12 +184,17 @@ const insertOrderQuery = `INSERT INTO orders (
 	isExpired,
 	parsedMakerAssetData,
 	parsedMakerFeeAssetData,
+	parsedTakerAssetData,
+	parsedTakerFeeAssetData,
 	lastValidatedBlockNumber,
 	lastValidatedBlockHash,
 	keepCancelled,
 	keepExpired,
 	keepFullyFilled,
-	keepUnfunded
+	keepUnfunded,
+	sourcePeerID,
+	endState,
+	price
 ) VALUES (
 	:hash,
 	:chainID,
@@ -131,12 +222,17 @@ const insertOrderQuery = `INSERT INTO orders (
 	:isExpired,
 	:parsedMakerAssetData,
 	:parsedMakerFeeAssetData,
+	:parsedTakerAssetData,
+	:parsedTakerFeeAssetData,
 	:lastValidatedBlockNumber,
 	:lastValidatedBlockHash,
 	:keepCancelled,
 	:keepExpired,
 	:keepFullyFilled,
-	:keepUnfunded
+	:keepUnfunded,
+	:sourcePeerID,
+	:endState,
+	:price
 ) ON CONFLICT DO NOTHING
 `
 
@@ -166,12 +262,17 @@ const updateOrderQuery = `UPDATE orders SET
 	isExpired = :isExpired,
 	parsedMakerAssetData = :parsedMakerAssetData,
 	parsedMakerFeeAssetData = :parsedMakerFeeAssetData,
+	parsedTakerAssetData = :parsedTakerAssetData,
+	parsedTakerFeeAssetData = :parsedTakerFeeAssetData,
 	lastValidatedBlockNumber = :lastValidatedBlockNumber,
 	lastValidatedBlockHash = :lastValidatedBlockHash,
 	keepCancelled = :keepCancelled,
 	keepExpired = :keepExpired,
 	keepFullyFilled = :keepFullyFilled,
-	keepUnfunded = :keepUnfunded
+	keepUnfunded = :keepUnfunded,
+	sourcePeerID = :sourcePeerID,
+	endState = :endState,
+	price = :price
 WHERE orders.hash = :hash
 `
 
@@ -204,3 +305,76 @@ const updateMetadataQuery = `UPDATE metadata SET
 	ethRPCRequestsSentInCurrentUTCDay = :ethRPCRequestsSentInCurrentUTCDay,
 	startOfCurrentUTCDay = :startOfCurrentUTCDay
 `
+
+const insertOrderEventQuery = `INSERT INTO orderEvents (
+	orderHash,
+	timestamp,
+	orderEvent
+) VALUES (
+	:orderHash,
+	:timestamp,
+	:orderEvent
+)`
+
+const insertArchivedOrderQuery = `INSERT OR REPLACE INTO archivedOrders (
+	hash,
+	makerAddress,
+	signedOrder,
+	signedOrderV4,
+	endState,
+	fillableTakerAssetAmount,
+	blockNumber,
+	blockHash,
+	timestamp,
+	isPinned,
+	archivedAt
+) VALUES (
+	:hash,
+	:makerAddress,
+	:signedOrder,
+	:signedOrderV4,
+	:endState,
+	:fillableTakerAssetAmount,
+	:blockNumber,
+	:blockHash,
+	:timestamp,
+	:isPinned,
+	:archivedAt
+)`
+
+const insertWebhookDeliveryQuery = `INSERT INTO webhookDeliveries (
+	url,
+	payload,
+	attempts,
+	nextAttemptAt,
+	createdAt
+) VALUES (
+	:url,
+	:payload,
+	:attempts,
+	:nextAttemptAt,
+	:createdAt
+)`
+
+const updateWebhookDeliveryQuery = `UPDATE webhookDeliveries SET
+	attempts = :attempts,
+	nextAttemptAt = :nextAttemptAt
+WHERE id = :id`
+
+// makerQuotaUsageQuery counts the orders which count towards the storage quota
+// of each maker. The v3 and v4 orders of a maker count towards the same quota.
+const makerQuotaUsageQuery = `SELECT id, COUNT(*) AS numOrders FROM (
+	SELECT makerAddress AS id FROM orders WHERE isRemoved = 0 AND isPinned = 0
+	UNION ALL
+	SELECT maker AS id FROM ordersv4 WHERE isRemoved = 0 AND isPinned = 0
+) GROUP BY id ORDER BY numOrders DESC, id ASC LIMIT $1
+`
+
+// peerQuotaUsageQuery is like makerQuotaUsageQuery but counts the orders that
+// were received from each peer.
+const peerQuotaUsageQuery = `SELECT id, COUNT(*) AS numOrders FROM (
+	SELECT sourcePeerID AS id FROM orders WHERE isRemoved = 0 AND isPinned = 0 AND sourcePeerID != ''
+	UNION ALL
+	SELECT sourcePeerID AS id FROM ordersv4 WHERE isRemoved = 0 AND isPinned = 0 AND sourcePeerID != ''
+) GROUP BY id ORDER BY numOrders DESC, id ASC LIMIT $1
+`
@@ -0,0 +1,65 @@
+// +build !js
+
+package db
+
+import (
+	"strings"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type sqlQuotaUsage struct {
+	ID        []byte `db:"id"`
+	NumOrders int    `db:"numOrders"`
+}
+
+// FindMakerQuotaUsage returns the makers with the most orders that count
+// towards the per-maker storage quota, i.e. v3 and v4 orders which are neither
+// pinned nor removed. The result is sorted by the number of orders in
+// descending order and contains at most limit entries. Makers are identified
+// by their lowercase hex address.
+func (db *sqlDB) FindMakerQuotaUsage(limit int) (usage []*types.QuotaUsage, err error) {
+	defer func() {
+		err = convertErr(err)
+	}()
+	var sqlUsage []*sqlQuotaUsage
+	db.mu.RLock()
+	err = db.sqldb.SelectContext(db.ctx, &sqlUsage, makerQuotaUsageQuery, limit)
+	db.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	usage = make([]*types.QuotaUsage, len(sqlUsage))
+	for i, makerUsage := range sqlUsage {
+		usage[i] = &types.QuotaUsage{
+			ID:        strings.ToLower(common.BytesToAddress(makerUsage.ID).Hex()),
+			NumOrders: makerUsage.NumOrders,
+		}
+	}
+	return usage, nil
+}
+
+// FindPeerQuotaUsage is like FindMakerQuotaUsage but returns the peers that
+// the most orders were received from. Orders which were not received from a
+// peer are not counted.
+func (db *sqlDB) FindPeerQuotaUsage(limit int) (usage []*types.QuotaUsage, err error) {
+	defer func() {
+		err = convertErr(err)
+	}()
+	var sqlUsage []*sqlQuotaUsage
+	db.mu.RLock()
+	err = db.sqldb.SelectContext(db.ctx, &sqlUsage, peerQuotaUsageQuery, limit)
+	db.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	usage = make([]*types.QuotaUsage, len(sqlUsage))
+	for i, peerUsage := range sqlUsage {
+		usage[i] = &types.QuotaUsage{
+			ID:        string(peerUsage.ID),
+			NumOrders: peerUsage.NumOrders,
+		}
+	}
+	return usage, nil
+}
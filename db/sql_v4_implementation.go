@@ -13,7 +13,7 @@ import (
 	"github.com/ido50/sqlz"
 )
 
-func (db *DB) AddOrdersV4(orders []*types.OrderWithMetadata) (alreadyStored []common.Hash, added []*types.OrderWithMetadata, removed []*types.OrderWithMetadata, err error) {
+func (db *sqlDB) AddOrdersV4(orders []*types.OrderWithMetadata) (alreadyStored []common.Hash, added []*types.OrderWithMetadata, removed []*types.OrderWithMetadata, err error) {
 	defer func() {
 		err = convertErr(err)
 	}()
@@ -82,7 +82,7 @@ func (db *DB) AddOrdersV4(orders []*types.OrderWithMetadata) (alreadyStored []co
 	return alreadyStored, added, sqltypes.OrdersToCommonTypeV4(sqlRemoved), nil
 }
 
-func (db *DB) GetOrderV4(hash common.Hash) (order *types.OrderWithMetadata, err error) {
+func (db *sqlDB) GetOrderV4(hash common.Hash) (order *types.OrderWithMetadata, err error) {
 	defer func() {
 		err = convertErr(err)
 	}()
@@ -96,7 +96,7 @@ func (db *DB) GetOrderV4(hash common.Hash) (order *types.OrderWithMetadata, err
 	return sqltypes.OrderToCommonTypeV4(&foundOrder), nil
 }
 
-func (db *DB) FindOrdersV4(query *OrderQueryV4) (orders []*types.OrderWithMetadata, err error) {
+func (db *sqlDB) FindOrdersV4(query *OrderQueryV4) (orders []*types.OrderWithMetadata, err error) {
 	defer func() {
 		err = convertErr(err)
 	}()
@@ -117,7 +117,7 @@ func (db *DB) FindOrdersV4(query *OrderQueryV4) (orders []*types.OrderWithMetada
 	return sqltypes.OrdersToCommonTypeV4(foundOrders), nil
 }
 
-func (db *DB) GetOrderStatusesV4(hashes []common.Hash) (statuses []*StoredOrderStatus, err error) {
+func (db *sqlDB) GetOrderStatusesV4(hashes []common.Hash) (statuses []*StoredOrderStatus, err error) {
 	defer func() {
 		err = convertErr(err)
 	}()
@@ -154,7 +154,7 @@ func (db *DB) GetOrderStatusesV4(hashes []common.Hash) (statuses []*StoredOrderS
 	return orderStatuses, nil
 }
 
-func (db *DB) CountOrdersV4(query *OrderQueryV4) (count int, err error) {
+func (db *sqlDB) CountOrdersV4(query *OrderQueryV4) (count int, err error) {
 	defer func() {
 		err = convertErr(err)
 	}()
@@ -174,7 +174,30 @@ func (db *DB) CountOrdersV4(query *OrderQueryV4) (count int, err error) {
 	return int(gotCount), nil
 }
 
-func (db *DB) DeleteOrderV4(hash common.Hash) error {
+// GetOrderStatsV4 returns aggregate values for all v4 orders that match the
+// filters of the given query. The sort options, limit and offset of the query
+// are ignored.
+func (db *sqlDB) GetOrderStatsV4(query *OrderQueryV4) (stats *types.OrderStats, err error) {
+	defer func() {
+		err = convertErr(err)
+	}()
+	if err := checkOrderQueryV4(query); err != nil {
+		return nil, err
+	}
+	var filters []OrderFilterV4
+	if query != nil {
+		filters = query.Filters
+	}
+	stmt, err := addOptsToSelectOrdersQueryV4(db.sqldb.Select(orderStatsColumns(OrderField(OV4FMaker), OrderField(OV4FExpiry))...).From("ordersv4"), &OrderQueryV4{Filters: filters})
+	if err != nil {
+		return nil, err
+	}
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	return getOrderStats(db.ctx, stmt)
+}
+
+func (db *sqlDB) DeleteOrderV4(hash common.Hash) error {
 	db.mu.Lock()
 	_, err := db.sqldb.ExecContext(db.ctx, "DELETE FROM ordersv4 WHERE hash = $1", hash)
 	db.mu.Unlock()
@@ -184,7 +207,7 @@ func (db *DB) DeleteOrderV4(hash common.Hash) error {
 	return nil
 }
 
-func (db *DB) DeleteOrdersV4(query *OrderQueryV4) (deleted []*types.OrderWithMetadata, err error) {
+func (db *sqlDB) DeleteOrdersV4(query *OrderQueryV4) (deleted []*types.OrderWithMetadata, err error) {
 	defer func() {
 		err = convertErr(err)
 	}()
@@ -196,7 +219,7 @@ func (db *DB) DeleteOrdersV4(query *OrderQueryV4) (deleted []*types.OrderWithMet
 	// workaround, we do a SELECT and DELETE inside a transaction.
 	var ordersToDelete []*sqltypes.OrderV4
 	err = db.ReadWriteTransactionalContext(db.ctx, nil, func(txn *sqlz.Tx) error {
-		stmt, err := addOptsToSelectOrdersQueryV4(txn.Select("*").From("orders"), query)
+		stmt, err := addOptsToSelectOrdersQueryV4(txn.Select("*").From("ordersv4"), query)
 		if err != nil {
 			return err
 		}
@@ -217,7 +240,7 @@ func (db *DB) DeleteOrdersV4(query *OrderQueryV4) (deleted []*types.OrderWithMet
 	return sqltypes.OrdersToCommonTypeV4(ordersToDelete), nil
 }
 
-func (db *DB) UpdateOrderV4(hash common.Hash, updateFunc func(existingOrder *types.OrderWithMetadata) (updatedOrder *types.OrderWithMetadata, err error)) (err error) {
+func (db *sqlDB) UpdateOrderV4(hash common.Hash, updateFunc func(existingOrder *types.OrderWithMetadata) (updatedOrder *types.OrderWithMetadata, err error)) (err error) {
 	defer func() {
 		err = convertErr(err)
 	}()
@@ -307,6 +330,22 @@ func whereConditionsFromOrderFilterOptsV4(filterOpts []OrderFilterV4) ([]sqlz.Wh
 			// Note(albrow): If needed, we can optimize this so it is easier to index.
 			// LIKE queries are notoriously slow.
 			whereConditions[i] = sqlz.Like(string(filterOpt.Field), fmt.Sprintf("%%%s%%", value))
+		case In:
+			values, ok := value.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("db.FindOrder: invalid type for IN filter value: %T", filterOpt.Value)
+			}
+			whereConditions[i] = sqlz.In(string(filterOpt.Field), values...)
+		case Or:
+			groupConditions := make([]sqlz.WhereCondition, len(filterOpt.Groups))
+			for j, group := range filterOpt.Groups {
+				conditions, err := whereConditionsFromOrderFilterOptsV4(group)
+				if err != nil {
+					return nil, err
+				}
+				groupConditions[j] = sqlz.And(conditions...)
+			}
+			whereConditions[i] = sqlz.Or(groupConditions...)
 		default:
 			return nil, fmt.Errorf("db.FindOrder: unknown FilterOpt.Kind: %s", filterOpt.Kind)
 		}
@@ -321,6 +360,22 @@ func checkOrderQueryV4(query *OrderQueryV4) error {
 	if query.Offset != 0 && query.Limit == 0 {
 		return errors.New("can't use Offset without Limit")
 	}
-	return nil
+	return checkOrderFiltersV4(query.Filters)
+}
 
+func checkOrderFiltersV4(filters []OrderFilterV4) error {
+	for _, filter := range filters {
+		if err := checkFilterKind(filter.Kind, filter.Value, len(filter.Groups)); err != nil {
+			return err
+		}
+		for _, group := range filter.Groups {
+			if len(group) == 0 {
+				return errors.New("OR filter groups must not be empty")
+			}
+			if err := checkOrderFiltersV4(group); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
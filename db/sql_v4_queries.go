@@ -35,6 +35,19 @@ CREATE TABLE IF NOT EXISTS ordersv4 (
 	keepUnfunded             BOOLEAN NOT NULL
 );
 `
+
+// v4OrderIndexesSchema adds the same indexes to the ordersv4 table that
+// orderIndexesSchema adds to the orders table.
+const v4OrderIndexesSchema = `
+CREATE INDEX IF NOT EXISTS ordersv4_maker ON ordersv4 (maker, expiry);
+CREATE INDEX IF NOT EXISTS ordersv4_makerToken ON ordersv4 (makerToken, takerToken);
+CREATE INDEX IF NOT EXISTS ordersv4_expiry ON ordersv4 (expiry);
+CREATE INDEX IF NOT EXISTS ordersv4_isPinned_expiry ON ordersv4 (isPinned DESC, expiry);
+CREATE INDEX IF NOT EXISTS ordersv4_fillableTakerAssetAmount ON ordersv4 (fillableTakerAssetAmount);
+CREATE INDEX IF NOT EXISTS ordersv4_lastUpdated ON ordersv4 (lastUpdated);
+CREATE INDEX IF NOT EXISTS ordersv4_lastValidatedBlockNumber ON ordersv4 (lastValidatedBlockNumber);
+`
+
 const insertOrderQueryV4 = `INSERT INTO ordersv4 (
 	hash,
 	chainID,
@@ -66,7 +79,11 @@ const insertOrderQueryV4 = `INSERT INTO ordersv4 (
 	keepCancelled,
 	keepExpired,
 	keepFullyFilled,
-	keepUnfunded
+	keepUnfunded,
+	sourcePeerID,
+	endState,
+	price,
+	signature
 ) VALUES (
 	:hash,
 	:chainID,
@@ -98,7 +115,11 @@ const insertOrderQueryV4 = `INSERT INTO ordersv4 (
 	:keepCancelled,
 	:keepExpired,
 	:keepFullyFilled,
-	:keepUnfunded
+	:keepUnfunded,
+	:sourcePeerID,
+	:endState,
+	:price,
+	:signature
 ) ON CONFLICT DO NOTHING
 `
 
@@ -131,6 +152,10 @@ const updateOrderQueryV4 = `UPDATE ordersv4 SET
 	keepCancelled = :keepCancelled,
 	keepExpired = :keepExpired,
 	keepFullyFilled = :keepFullyFilled,
-	keepUnfunded = :keepUnfunded
+	keepUnfunded = :keepUnfunded,
+	sourcePeerID = :sourcePeerID,
+	endState = :endState,
+	price = :price,
+	signature = :signature
 WHERE ordersv4.hash = :hash
 `
@@ -0,0 +1,152 @@
+// +build !js
+
+package db
+
+import (
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/db/sqltypes"
+	"github.com/ido50/sqlz"
+)
+
+// AddWebhookDeliveries adds the given deliveries to the webhook outbox. The ID
+// of each delivery is set to the ID that was assigned by the database.
+func (db *sqlDB) AddWebhookDeliveries(deliveries []*types.WebhookDelivery) (err error) {
+	defer func() {
+		err = convertErr(err)
+	}()
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	ids := make([]uint64, len(deliveries))
+	err = db.ReadWriteTransactionalContext(db.ctx, nil, func(txn *sqlz.Tx) error {
+		for i, delivery := range deliveries {
+			result, err := txn.NamedExecContext(db.ctx, insertWebhookDeliveryQuery, sqltypes.WebhookDeliveryFromCommonType(delivery))
+			if err != nil {
+				return err
+			}
+			id, err := result.LastInsertId()
+			if err != nil {
+				return err
+			}
+			ids[i] = uint64(id)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Only set the IDs once the transaction has been committed.
+	for i, delivery := range deliveries {
+		delivery.ID = ids[i]
+	}
+	return nil
+}
+
+// FindWebhookDeliveries returns the deliveries in the webhook outbox that match
+// the given query, sorted by ID in ascending order.
+func (db *sqlDB) FindWebhookDeliveries(query *WebhookDeliveryQuery) (deliveries []*types.WebhookDelivery, err error) {
+	defer func() {
+		err = convertErr(err)
+	}()
+	stmt := db.sqldb.Select("*").From("webhookDeliveries").OrderBy(sqlz.Asc("id"))
+	if query != nil {
+		if query.URL != "" {
+			stmt.Where(sqlz.Eq("url", query.URL))
+		}
+		if query.Limit != 0 {
+			stmt.Limit(int64(query.Limit))
+		}
+	}
+	var sqlDeliveries []*sqltypes.WebhookDelivery
+	db.mu.RLock()
+	err = stmt.GetAllContext(db.ctx, &sqlDeliveries)
+	db.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	return sqltypes.WebhookDeliveriesToCommonType(sqlDeliveries), nil
+}
+
+// UpdateWebhookDelivery updates the number of attempts and the time of the next
+// attempt for the given delivery. It returns ErrNotFound if the delivery is not
+// in the webhook outbox.
+func (db *sqlDB) UpdateWebhookDelivery(delivery *types.WebhookDelivery) (err error) {
+	defer func() {
+		err = convertErr(err)
+	}()
+	db.mu.Lock()
+	result, err := db.sqldb.NamedExecContext(db.ctx, updateWebhookDeliveryQuery, sqltypes.WebhookDeliveryFromCommonType(delivery))
+	db.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// DeleteWebhookDelivery removes the delivery with the given ID from the webhook
+// outbox.
+func (db *sqlDB) DeleteWebhookDelivery(id uint64) error {
+	db.mu.Lock()
+	_, err := db.sqldb.ExecContext(db.ctx, "DELETE FROM webhookDeliveries WHERE id = $1", id)
+	db.mu.Unlock()
+	if err != nil {
+		return convertErr(err)
+	}
+	return nil
+}
+
+// CountWebhookDeliveries returns the number of deliveries in the webhook outbox
+// that match the given query. The limit of the query is ignored.
+func (db *sqlDB) CountWebhookDeliveries(query *WebhookDeliveryQuery) (count int, err error) {
+	defer func() {
+		err = convertErr(err)
+	}()
+	stmt := db.sqldb.Select("COUNT(*)").From("webhookDeliveries")
+	if query != nil && query.URL != "" {
+		stmt.Where(sqlz.Eq("url", query.URL))
+	}
+	db.mu.RLock()
+	gotCount, err := stmt.GetCountContext(db.ctx)
+	db.mu.RUnlock()
+	if err != nil {
+		return 0, err
+	}
+	return int(gotCount), nil
+}
+
+// DeleteWebhookDeliveriesExcept removes all deliveries for endpoints other than
+// the given URLs from the webhook outbox. It returns the number of deliveries
+// that were removed.
+func (db *sqlDB) DeleteWebhookDeliveriesExcept(urls []string) (numDeleted int, err error) {
+	defer func() {
+		err = convertErr(err)
+	}()
+	stmt := db.sqldb.DeleteFrom("webhookDeliveries")
+	if len(urls) > 0 {
+		values := make([]interface{}, len(urls))
+		for i, url := range urls {
+			values[i] = url
+		}
+		stmt.Where(sqlz.NotIn("url", values...))
+	}
+	db.mu.Lock()
+	result, err := stmt.ExecContext(db.ctx)
+	db.mu.Unlock()
+	if err != nil {
+		return 0, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(rowsAffected), nil
+}
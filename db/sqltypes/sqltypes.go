@@ -220,6 +220,139 @@ func (s *ParsedAssetData) Scan(value interface{}) error {
 	}
 }
 
+// EncodedOrderEvent is a wrapper around *zeroex.OrderEvent that implements the
+// sql.Valuer and sql.Scanner interfaces.
+type EncodedOrderEvent struct {
+	*zeroex.OrderEvent
+}
+
+func NewEncodedOrderEvent(orderEvent *zeroex.OrderEvent) *EncodedOrderEvent {
+	return &EncodedOrderEvent{
+		OrderEvent: orderEvent,
+	}
+}
+
+func (e *EncodedOrderEvent) Value() (driver.Value, error) {
+	if e == nil || e.OrderEvent == nil {
+		return nil, nil
+	}
+	orderEventJSON, err := json.Marshal(e.OrderEvent)
+	if err != nil {
+		return nil, err
+	}
+	return orderEventJSON, err
+}
+
+func (e *EncodedOrderEvent) Scan(value interface{}) error {
+	if value == nil {
+		e.OrderEvent = nil
+		return nil
+	}
+	e.OrderEvent = &zeroex.OrderEvent{}
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, e.OrderEvent)
+	case string:
+		return json.Unmarshal([]byte(v), e.OrderEvent)
+	default:
+		return fmt.Errorf("could not scan type %T into EncodedOrderEvent", value)
+	}
+}
+
+// OrderEvent is the SQL database representation of an entry in the order
+// event log.
+type OrderEvent struct {
+	SequenceNumber uint64             `db:"sequenceNumber"`
+	OrderHash      common.Hash        `db:"orderHash"`
+	Timestamp      time.Time          `db:"timestamp"`
+	OrderEvent     *EncodedOrderEvent `db:"orderEvent"`
+}
+
+// EncodedSignedOrder is a wrapper around *zeroex.SignedOrder that implements
+// the sql.Valuer and sql.Scanner interfaces.
+type EncodedSignedOrder struct {
+	*zeroex.SignedOrder
+}
+
+func (e *EncodedSignedOrder) Value() (driver.Value, error) {
+	if e == nil || e.SignedOrder == nil {
+		return nil, nil
+	}
+	return json.Marshal(e.SignedOrder)
+}
+
+func (e *EncodedSignedOrder) Scan(value interface{}) error {
+	if value == nil {
+		e.SignedOrder = nil
+		return nil
+	}
+	e.SignedOrder = &zeroex.SignedOrder{}
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, e.SignedOrder)
+	case string:
+		return json.Unmarshal([]byte(v), e.SignedOrder)
+	default:
+		return fmt.Errorf("could not scan type %T into EncodedSignedOrder", value)
+	}
+}
+
+// EncodedSignedOrderV4 is a wrapper around *zeroex.SignedOrderV4 that
+// implements the sql.Valuer and sql.Scanner interfaces.
+type EncodedSignedOrderV4 struct {
+	*zeroex.SignedOrderV4
+}
+
+func (e *EncodedSignedOrderV4) Value() (driver.Value, error) {
+	if e == nil || e.SignedOrderV4 == nil {
+		return nil, nil
+	}
+	return json.Marshal(e.SignedOrderV4)
+}
+
+func (e *EncodedSignedOrderV4) Scan(value interface{}) error {
+	if value == nil {
+		e.SignedOrderV4 = nil
+		return nil
+	}
+	e.SignedOrderV4 = &zeroex.SignedOrderV4{}
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, e.SignedOrderV4)
+	case string:
+		return json.Unmarshal([]byte(v), e.SignedOrderV4)
+	default:
+		return fmt.Errorf("could not scan type %T into EncodedSignedOrderV4", value)
+	}
+}
+
+// ArchivedOrder is the SQL database representation of an order that was
+// permanently deleted, together with the final state it was in.
+type ArchivedOrder struct {
+	Hash                     common.Hash           `db:"hash"`
+	MakerAddress             common.Address        `db:"makerAddress"`
+	SignedOrder              *EncodedSignedOrder   `db:"signedOrder"`
+	SignedOrderV4            *EncodedSignedOrderV4 `db:"signedOrderV4"`
+	EndState                 string                `db:"endState"`
+	FillableTakerAssetAmount *BigInt               `db:"fillableTakerAssetAmount"`
+	BlockNumber              *SortedBigInt         `db:"blockNumber"`
+	BlockHash                common.Hash           `db:"blockHash"`
+	Timestamp                time.Time             `db:"timestamp"`
+	IsPinned                 bool                  `db:"isPinned"`
+	ArchivedAt               time.Time             `db:"archivedAt"`
+}
+
+// WebhookDelivery is the SQL database representation of a payload which is
+// waiting to be delivered to a webhook endpoint.
+type WebhookDelivery struct {
+	ID            uint64    `db:"id"`
+	URL           string    `db:"url"`
+	Payload       []byte    `db:"payload"`
+	Attempts      int       `db:"attempts"`
+	NextAttemptAt time.Time `db:"nextAttemptAt"`
+	CreatedAt     time.Time `db:"createdAt"`
+}
+
 // Order is the SQL database representation a 0x order along with some relevant metadata.
 type Order struct {
 	Hash                     common.Hash      `db:"hash"`
@@ -248,12 +381,17 @@ type Order struct {
 	IsExpired                bool             `db:"isExpired"`
 	ParsedMakerAssetData     *ParsedAssetData `db:"parsedMakerAssetData"`
 	ParsedMakerFeeAssetData  *ParsedAssetData `db:"parsedMakerFeeAssetData"`
+	ParsedTakerAssetData     *ParsedAssetData `db:"parsedTakerAssetData"`
+	ParsedTakerFeeAssetData  *ParsedAssetData `db:"parsedTakerFeeAssetData"`
 	LastValidatedBlockNumber *SortedBigInt    `db:"lastValidatedBlockNumber"`
 	LastValidatedBlockHash   common.Hash      `db:"lastValidatedBlockHash"`
 	KeepCancelled            bool             `db:"keepCancelled"`
 	KeepExpired              bool             `db:"keepExpired"`
 	KeepFullyFilled          bool             `db:"keepFullyFilled"`
 	KeepUnfunded             bool             `db:"keepUnfunded"`
+	SourcePeerID             string           `db:"sourcePeerID"`
+	EndState                 string           `db:"endState"`
+	Price                    float64          `db:"price"`
 }
 
 type OrderSignatureV4 struct {
@@ -290,6 +428,10 @@ type OrderV4 struct {
 	SignatureV    uint8                  `db:"signatureV"`
 	SignatureR    string                 `db:"signatureR"`
 	SignatureS    string                 `db:"signatureS"`
+	// Signature is the concatenation of the fields above (see
+	// zeroex.SignatureFieldV4.Bytes). It is only stored so that orders can be
+	// sorted and filtered by signature.
+	Signature []byte `db:"signature"`
 	// metadata
 	LastUpdated              time.Time     `db:"lastUpdated"`
 	FillableTakerAssetAmount *SortedBigInt `db:"fillableTakerAssetAmount"`
@@ -303,6 +445,9 @@ type OrderV4 struct {
 	KeepExpired              bool          `db:"keepExpired"`
 	KeepFullyFilled          bool          `db:"keepFullyFilled"`
 	KeepUnfunded             bool          `db:"keepUnfunded"`
+	SourcePeerID             string        `db:"sourcePeerID"`
+	EndState                 string        `db:"endState"`
+	Price                    float64       `db:"price"`
 }
 
 // EventLogs is a wrapper around []*ethtypes.Log that implements the
@@ -390,12 +535,16 @@ func OrderToCommonType(order *Order) *types.OrderWithMetadata {
 		IsExpired:                order.IsExpired,
 		ParsedMakerAssetData:     ParsedAssetDataToCommonType(order.ParsedMakerAssetData),
 		ParsedMakerFeeAssetData:  ParsedAssetDataToCommonType(order.ParsedMakerFeeAssetData),
+		ParsedTakerAssetData:     ParsedAssetDataToCommonType(order.ParsedTakerAssetData),
+		ParsedTakerFeeAssetData:  ParsedAssetDataToCommonType(order.ParsedTakerFeeAssetData),
 		LastValidatedBlockNumber: order.LastValidatedBlockNumber.Int,
 		LastValidatedBlockHash:   order.LastValidatedBlockHash,
 		KeepCancelled:            order.KeepCancelled,
 		KeepExpired:              order.KeepExpired,
 		KeepFullyFilled:          order.KeepFullyFilled,
 		KeepUnfunded:             order.KeepUnfunded,
+		SourcePeerID:             order.SourcePeerID,
+		EndState:                 zeroex.OrderEventEndState(order.EndState),
 	}
 }
 
@@ -439,6 +588,8 @@ func OrderToCommonTypeV4(order *OrderV4) *types.OrderWithMetadata {
 		KeepExpired:              order.KeepExpired,
 		KeepFullyFilled:          order.KeepFullyFilled,
 		KeepUnfunded:             order.KeepUnfunded,
+		SourcePeerID:             order.SourcePeerID,
+		EndState:                 zeroex.OrderEventEndState(order.EndState),
 	}
 }
 
@@ -473,12 +624,17 @@ func OrderFromCommonType(order *types.OrderWithMetadata) *Order {
 		IsExpired:                order.IsExpired,
 		ParsedMakerAssetData:     ParsedAssetDataFromCommonType(order.ParsedMakerAssetData),
 		ParsedMakerFeeAssetData:  ParsedAssetDataFromCommonType(order.ParsedMakerFeeAssetData),
+		ParsedTakerAssetData:     ParsedAssetDataFromCommonType(order.ParsedTakerAssetData),
+		ParsedTakerFeeAssetData:  ParsedAssetDataFromCommonType(order.ParsedTakerFeeAssetData),
 		LastValidatedBlockNumber: NewSortedBigInt(order.LastValidatedBlockNumber),
 		LastValidatedBlockHash:   order.LastValidatedBlockHash,
 		KeepCancelled:            order.KeepCancelled,
 		KeepExpired:              order.KeepExpired,
 		KeepFullyFilled:          order.KeepFullyFilled,
 		KeepUnfunded:             order.KeepUnfunded,
+		SourcePeerID:             order.SourcePeerID,
+		EndState:                 string(order.EndState),
+		Price:                    order.Price(),
 	}
 }
 
@@ -506,6 +662,7 @@ func OrderFromCommonTypeV4(order *types.OrderWithMetadata) *OrderV4 {
 		SignatureV:               order.SignatureV4.V,
 		SignatureR:               order.SignatureV4.R.Hex(),
 		SignatureS:               order.SignatureV4.S.Hex(),
+		Signature:                order.SignatureV4.Bytes(),
 		LastUpdated:              order.LastUpdated,
 		FillableTakerAssetAmount: NewSortedBigInt(order.FillableTakerAssetAmount),
 		IsRemoved:                order.IsRemoved,
@@ -518,6 +675,9 @@ func OrderFromCommonTypeV4(order *types.OrderWithMetadata) *OrderV4 {
 		KeepExpired:              order.KeepExpired,
 		KeepFullyFilled:          order.KeepFullyFilled,
 		KeepUnfunded:             order.KeepUnfunded,
+		SourcePeerID:             order.SourcePeerID,
+		EndState:                 string(order.EndState),
+		Price:                    order.Price(),
 	}
 }
 
@@ -670,3 +830,120 @@ func MetadataFromCommonType(metadata *types.Metadata) *Metadata {
 		StartOfCurrentUTCDay:              metadata.StartOfCurrentUTCDay,
 	}
 }
+
+func OrderEventToCommonType(orderEvent *OrderEvent) *zeroex.OrderEvent {
+	if orderEvent == nil || orderEvent.OrderEvent == nil {
+		return nil
+	}
+	result := orderEvent.OrderEvent.OrderEvent
+	result.SequenceNumber = orderEvent.SequenceNumber
+	return result
+}
+
+func OrderEventFromCommonType(orderEvent *zeroex.OrderEvent) *OrderEvent {
+	return &OrderEvent{
+		SequenceNumber: orderEvent.SequenceNumber,
+		OrderHash:      orderEvent.OrderHash,
+		Timestamp:      orderEvent.Timestamp,
+		OrderEvent:     NewEncodedOrderEvent(orderEvent),
+	}
+}
+
+func OrderEventsToCommonType(orderEvents []*OrderEvent) []*zeroex.OrderEvent {
+	result := make([]*zeroex.OrderEvent, len(orderEvents))
+	for i, orderEvent := range orderEvents {
+		result[i] = OrderEventToCommonType(orderEvent)
+	}
+	return result
+}
+
+func WebhookDeliveryToCommonType(delivery *WebhookDelivery) *types.WebhookDelivery {
+	if delivery == nil {
+		return nil
+	}
+	return &types.WebhookDelivery{
+		ID:            delivery.ID,
+		URL:           delivery.URL,
+		Payload:       delivery.Payload,
+		Attempts:      delivery.Attempts,
+		NextAttemptAt: delivery.NextAttemptAt,
+		CreatedAt:     delivery.CreatedAt,
+	}
+}
+
+func WebhookDeliveryFromCommonType(delivery *types.WebhookDelivery) *WebhookDelivery {
+	if delivery == nil {
+		return nil
+	}
+	return &WebhookDelivery{
+		ID:            delivery.ID,
+		URL:           delivery.URL,
+		Payload:       delivery.Payload,
+		Attempts:      delivery.Attempts,
+		NextAttemptAt: delivery.NextAttemptAt,
+		CreatedAt:     delivery.CreatedAt,
+	}
+}
+
+func WebhookDeliveriesToCommonType(deliveries []*WebhookDelivery) []*types.WebhookDelivery {
+	result := make([]*types.WebhookDelivery, len(deliveries))
+	for i, delivery := range deliveries {
+		result[i] = WebhookDeliveryToCommonType(delivery)
+	}
+	return result
+}
+
+func ArchivedOrderToCommonType(order *ArchivedOrder) *types.ArchivedOrder {
+	if order == nil {
+		return nil
+	}
+	result := &types.ArchivedOrder{
+		Hash:                     order.Hash,
+		EndState:                 zeroex.OrderEventEndState(order.EndState),
+		FillableTakerAssetAmount: order.FillableTakerAssetAmount.Int,
+		BlockNumber:              order.BlockNumber.Int,
+		BlockHash:                order.BlockHash,
+		Timestamp:                order.Timestamp,
+		IsPinned:                 order.IsPinned,
+		ArchivedAt:               order.ArchivedAt,
+	}
+	if order.SignedOrder != nil {
+		result.SignedOrder = order.SignedOrder.SignedOrder
+	}
+	if order.SignedOrderV4 != nil {
+		result.SignedOrderV4 = order.SignedOrderV4.SignedOrderV4
+	}
+	return result
+}
+
+func ArchivedOrderFromCommonType(order *types.ArchivedOrder) *ArchivedOrder {
+	if order == nil {
+		return nil
+	}
+	result := &ArchivedOrder{
+		Hash:                     order.Hash,
+		MakerAddress:             order.MakerAddress(),
+		EndState:                 string(order.EndState),
+		FillableTakerAssetAmount: NewBigInt(order.FillableTakerAssetAmount),
+		BlockNumber:              NewSortedBigInt(order.BlockNumber),
+		BlockHash:                order.BlockHash,
+		Timestamp:                order.Timestamp.UTC(),
+		IsPinned:                 order.IsPinned,
+		ArchivedAt:               order.ArchivedAt.UTC(),
+	}
+	if order.SignedOrder != nil {
+		result.SignedOrder = &EncodedSignedOrder{SignedOrder: order.SignedOrder}
+	}
+	if order.SignedOrderV4 != nil {
+		result.SignedOrderV4 = &EncodedSignedOrderV4{SignedOrderV4: order.SignedOrderV4}
+	}
+	return result
+}
+
+func ArchivedOrdersToCommonType(orders []*ArchivedOrder) []*types.ArchivedOrder {
+	result := make([]*types.ArchivedOrder, len(orders))
+	for i, order := range orders {
+		result[i] = ArchivedOrderToCommonType(order)
+	}
+	return result
+}
@@ -0,0 +1,282 @@
+package graphql
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/0xProject/0x-mesh/metrics"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Scope determines which operations a client is allowed to perform.
+type Scope int
+
+const (
+	// ScopeNone means that the client has not been authenticated.
+	ScopeNone Scope = iota
+	// ScopeRead allows queries and subscriptions.
+	ScopeRead
+	// ScopeWrite allows queries, subscriptions and mutations (e.g. addOrders).
+	ScopeWrite
+)
+
+// authorizationKey is the name of the HTTP header (and the key in the
+// websocket connection_init payload) that contains the API key.
+const authorizationKey = "Authorization"
+
+const (
+	errCodeUnauthenticated = "UNAUTHENTICATED"
+	errCodeForbidden       = "FORBIDDEN"
+)
+
+var errInvalidAPIKey = errors.New("invalid API key")
+
+type scopeContextKey struct{}
+
+type apiKeyContextKey struct{}
+
+type apiKey struct {
+	key   []byte
+	scope Scope
+}
+
+// Authenticator enforces API key authentication for the GraphQL server. Each
+// API key has either read or write scope. Clients send their API key in the
+// Authorization header (e.g. "Authorization: Bearer <key>"). Since browsers
+// cannot set headers for websocket connections, subscription clients may
+// instead send the key in the payload of the connection_init message (e.g.
+// {"Authorization": "Bearer <key>"}). The Authenticator is a gqlgen extension
+// and should be the first extension added to the server.
+type Authenticator struct {
+	apiKeys []apiKey
+}
+
+// NewAuthenticator returns an Authenticator for the given read and write API
+// keys. Empty keys are ignored. If no keys are given, authentication is
+// disabled and all operations are allowed.
+func NewAuthenticator(readAPIKeys []string, writeAPIKeys []string) *Authenticator {
+	auth := &Authenticator{}
+	for _, key := range readAPIKeys {
+		auth.addAPIKey(key, ScopeRead)
+	}
+	for _, key := range writeAPIKeys {
+		auth.addAPIKey(key, ScopeWrite)
+	}
+	return auth
+}
+
+func (a *Authenticator) addAPIKey(key string, scope Scope) {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return
+	}
+	a.apiKeys = append(a.apiKeys, apiKey{
+		key:   []byte(key),
+		scope: scope,
+	})
+}
+
+// Enabled returns true if any API keys have been configured.
+func (a *Authenticator) Enabled() bool {
+	return len(a.apiKeys) > 0
+}
+
+// Middleware returns an http.Handler which authenticates the API key in the
+// Authorization header (if any) and adds the corresponding scope to the request
+// context. Requests with an invalid API key are rejected. Requests without an
+// API key are passed through so that websocket clients can authenticate via the
+// connection_init message. Operations are not allowed until a valid API key has
+// been provided (see MutateOperationParameters).
+func (a *Authenticator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !a.Enabled() {
+			next.ServeHTTP(w, r)
+			return
+		}
+		authorization := r.Header.Get(authorizationKey)
+		if authorization == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		key := apiKeyFromAuthorization(authorization)
+		scope, err := a.authenticate(key)
+		if err != nil {
+			metrics.GraphqlRequestsRejected.WithLabelValues(metrics.RejectionUnauthorized).Inc()
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(withAPIKey(r.Context(), key, scope)))
+	})
+}
+
+// WebsocketInit authenticates the API key in the payload of the websocket
+// connection_init message (if any). It satisfies transport.WebsocketInitFunc.
+func (a *Authenticator) WebsocketInit(ctx context.Context, initPayload transport.InitPayload) (context.Context, error) {
+	if !a.Enabled() {
+		return ctx, nil
+	}
+	authorization := initPayload.GetString(authorizationKey)
+	if authorization == "" {
+		// The client may have already authenticated via the Authorization header
+		// of the upgrade request.
+		return ctx, nil
+	}
+	key := apiKeyFromAuthorization(authorization)
+	scope, err := a.authenticate(key)
+	if err != nil {
+		metrics.GraphqlRequestsRejected.WithLabelValues(metrics.RejectionUnauthorized).Inc()
+		return nil, err
+	}
+	return withAPIKey(ctx, key, scope), nil
+}
+
+var (
+	_ graphql.HandlerExtension          = &Authenticator{}
+	_ graphql.OperationParameterMutator = &Authenticator{}
+	_ graphql.OperationContextMutator   = &Authenticator{}
+)
+
+// ExtensionName satisfies graphql.HandlerExtension.
+func (a *Authenticator) ExtensionName() string {
+	return "Authenticator"
+}
+
+// Validate satisfies graphql.HandlerExtension.
+func (a *Authenticator) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationParameters rejects any operations from clients which have not
+// provided a valid API key. It runs before the operation is parsed so that
+// unauthenticated clients don't get parse, validation or complexity errors
+// (or cause the server to do the work needed to produce them). It satisfies
+// graphql.OperationParameterMutator.
+func (a *Authenticator) MutateOperationParameters(ctx context.Context, params *graphql.RawParams) *gqlerror.Error {
+	if !a.Enabled() {
+		return nil
+	}
+	scope, _ := ctx.Value(scopeContextKey{}).(Scope)
+	if code, message := checkScope(scope, ScopeNone); code != "" {
+		metrics.GraphqlRequestsRejected.WithLabelValues(metrics.RejectionUnauthorized).Inc()
+		return newError(code, message)
+	}
+	return nil
+}
+
+// MutateOperationContext rejects any operations which are not allowed by the
+// scope of the client. Queries and subscriptions require read scope and
+// mutations require write scope. The Authenticator must be added to the server
+// before the complexity limit so that this check runs first. It satisfies
+// graphql.OperationContextMutator.
+func (a *Authenticator) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	if !a.Enabled() {
+		return nil
+	}
+	scope, _ := ctx.Value(scopeContextKey{}).(Scope)
+	requiredScope := ScopeRead
+	if opCtx.Operation != nil && opCtx.Operation.Operation == ast.Mutation {
+		requiredScope = ScopeWrite
+	}
+	if code, message := checkScope(scope, requiredScope); code != "" {
+		metrics.GraphqlRequestsRejected.WithLabelValues(metrics.RejectionUnauthorized).Inc()
+		return newError(code, message)
+	}
+	return nil
+}
+
+// RESTMiddleware returns an http.Handler which enforces API key authentication
+// for a REST API such as the SRA server. Unlike Middleware, it rejects requests
+// without an API key. GET, HEAD and OPTIONS requests require read scope and
+// all other requests (e.g. POST requests which add orders) require write scope.
+func (a *Authenticator) RESTMiddleware(next http.Handler) http.Handler {
+	return a.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !a.Enabled() {
+			next.ServeHTTP(w, r)
+			return
+		}
+		scope, _ := r.Context().Value(scopeContextKey{}).(Scope)
+		requiredScope := ScopeRead
+		if isWriteRequest(r) {
+			requiredScope = ScopeWrite
+		}
+		if code, message := checkScope(scope, requiredScope); code != "" {
+			metrics.RESTRequestsRejected.WithLabelValues(metrics.RejectionUnauthorized).Inc()
+			status := http.StatusForbidden
+			if code == errCodeUnauthenticated {
+				status = http.StatusUnauthorized
+			}
+			http.Error(w, message, status)
+			return
+		}
+		next.ServeHTTP(w, r)
+	}))
+}
+
+// checkScope returns an error code and message if scope does not include
+// requiredScope. Otherwise, it returns empty strings.
+func checkScope(scope Scope, requiredScope Scope) (code string, message string) {
+	switch {
+	case scope == ScopeNone:
+		return errCodeUnauthenticated, "missing API key"
+	case scope < requiredScope:
+		return errCodeForbidden, "API key does not have write scope"
+	}
+	return "", ""
+}
+
+// isWriteRequest returns true if the given request may modify state, i.e. if
+// its method is not GET, HEAD or OPTIONS.
+func isWriteRequest(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return true
+}
+
+// authenticate returns the scope of the given API key.
+func (a *Authenticator) authenticate(key string) (Scope, error) {
+	scope := ScopeNone
+	// Always compare against every key using a constant time comparison so that
+	// the time taken leaks as little information as possible.
+	for _, apiKey := range a.apiKeys {
+		if subtle.ConstantTimeCompare([]byte(key), apiKey.key) == 1 && apiKey.scope > scope {
+			scope = apiKey.scope
+		}
+	}
+	if scope == ScopeNone {
+		return ScopeNone, errInvalidAPIKey
+	}
+	return scope, nil
+}
+
+// apiKeyFromAuthorization returns the API key in the given Authorization value.
+// Both "Bearer <key>" and "<key>" are accepted.
+func apiKeyFromAuthorization(authorization string) string {
+	return strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(authorization), "Bearer "))
+}
+
+func withAPIKey(ctx context.Context, key string, scope Scope) context.Context {
+	ctx = context.WithValue(ctx, apiKeyContextKey{}, key)
+	return context.WithValue(ctx, scopeContextKey{}, scope)
+}
+
+// newError returns an error with the given code in its extensions.
+func newError(code string, message string) *gqlerror.Error {
+	err := &gqlerror.Error{Message: message}
+	errcode.Set(err, code)
+	return err
+}
+
+// errorResponse returns a response containing a single error with the given
+// code in its extensions.
+func errorResponse(code string, message string) graphql.ResponseHandler {
+	return graphql.OneShot(&graphql.Response{Errors: gqlerror.List{newError(code, message)}})
+}
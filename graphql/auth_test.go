@@ -0,0 +1,322 @@
+// +build !js
+
+package graphql
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const (
+	testReadAPIKey  = "read-key"
+	testWriteAPIKey = "write-key"
+)
+
+func newTestAuthenticator() *Authenticator {
+	return NewAuthenticator([]string{testReadAPIKey, " ", ""}, []string{" " + testWriteAPIKey + " "})
+}
+
+func TestNewAuthenticator(t *testing.T) {
+	assert.False(t, NewAuthenticator(nil, nil).Enabled())
+	assert.False(t, NewAuthenticator([]string{""}, []string{" "}).Enabled(), "empty keys should be ignored")
+
+	auth := newTestAuthenticator()
+	require.True(t, auth.Enabled())
+	require.Len(t, auth.apiKeys, 2)
+
+	testCases := []struct {
+		key           string
+		expectedScope Scope
+		expectedErr   error
+	}{
+		{key: testReadAPIKey, expectedScope: ScopeRead},
+		{key: testWriteAPIKey, expectedScope: ScopeWrite},
+		{key: "", expectedScope: ScopeNone, expectedErr: errInvalidAPIKey},
+		{key: "invalid-key", expectedScope: ScopeNone, expectedErr: errInvalidAPIKey},
+		{key: testReadAPIKey + "x", expectedScope: ScopeNone, expectedErr: errInvalidAPIKey},
+	}
+	for _, tc := range testCases {
+		scope, err := auth.authenticate(tc.key)
+		assert.Equal(t, tc.expectedErr, err, "key: %q", tc.key)
+		assert.Equal(t, tc.expectedScope, scope, "key: %q", tc.key)
+	}
+
+	// A key which is configured with both scopes has write scope.
+	auth = NewAuthenticator([]string{testWriteAPIKey}, []string{testWriteAPIKey})
+	scope, err := auth.authenticate(testWriteAPIKey)
+	require.NoError(t, err)
+	assert.Equal(t, ScopeWrite, scope)
+}
+
+func TestAPIKeyFromAuthorization(t *testing.T) {
+	assert.Equal(t, "key", apiKeyFromAuthorization("Bearer key"))
+	assert.Equal(t, "key", apiKeyFromAuthorization("key"))
+	assert.Equal(t, "key", apiKeyFromAuthorization(" Bearer  key "))
+}
+
+func TestAuthenticatorMiddleware(t *testing.T) {
+	testCases := []struct {
+		name           string
+		auth           *Authenticator
+		authorization  string
+		expectedStatus int
+		expectedScope  Scope
+		expectedKey    string
+	}{
+		{
+			name:           "authentication disabled",
+			auth:           NewAuthenticator(nil, nil),
+			authorization:  "Bearer anything",
+			expectedStatus: http.StatusOK,
+			expectedScope:  ScopeNone,
+		},
+		{
+			name:           "missing API key",
+			auth:           newTestAuthenticator(),
+			expectedStatus: http.StatusOK,
+			expectedScope:  ScopeNone,
+		},
+		{
+			name:           "invalid API key",
+			auth:           newTestAuthenticator(),
+			authorization:  "Bearer invalid-key",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "read API key",
+			auth:           newTestAuthenticator(),
+			authorization:  "Bearer " + testReadAPIKey,
+			expectedStatus: http.StatusOK,
+			expectedScope:  ScopeRead,
+			expectedKey:    testReadAPIKey,
+		},
+		{
+			name:           "write API key without Bearer prefix",
+			auth:           newTestAuthenticator(),
+			authorization:  testWriteAPIKey,
+			expectedStatus: http.StatusOK,
+			expectedScope:  ScopeWrite,
+			expectedKey:    testWriteAPIKey,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				called bool
+				scope  Scope
+				key    string
+			)
+			handler := tc.auth.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				scope, _ = r.Context().Value(scopeContextKey{}).(Scope)
+				key, _ = r.Context().Value(apiKeyContextKey{}).(string)
+			}))
+			req := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+			if tc.authorization != "" {
+				req.Header.Set(authorizationKey, tc.authorization)
+			}
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			assert.Equal(t, tc.expectedStatus == http.StatusOK, called)
+			assert.Equal(t, tc.expectedScope, scope)
+			assert.Equal(t, tc.expectedKey, key)
+		})
+	}
+}
+
+func TestAuthenticatorWebsocketInit(t *testing.T) {
+	auth := newTestAuthenticator()
+
+	// Clients which don't send an API key in the payload may have already
+	// authenticated via the Authorization header of the upgrade request.
+	ctx := withAPIKey(context.Background(), testReadAPIKey, ScopeRead)
+	newCtx, err := auth.WebsocketInit(ctx, transport.InitPayload{})
+	require.NoError(t, err)
+	assert.Equal(t, ScopeRead, newCtx.Value(scopeContextKey{}))
+
+	newCtx, err = auth.WebsocketInit(context.Background(), transport.InitPayload{
+		authorizationKey: "Bearer " + testWriteAPIKey,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ScopeWrite, newCtx.Value(scopeContextKey{}))
+	assert.Equal(t, testWriteAPIKey, newCtx.Value(apiKeyContextKey{}))
+
+	_, err = auth.WebsocketInit(context.Background(), transport.InitPayload{
+		authorizationKey: "Bearer invalid-key",
+	})
+	assert.Equal(t, errInvalidAPIKey, err)
+
+	// The payload is ignored if authentication is disabled.
+	_, err = NewAuthenticator(nil, nil).WebsocketInit(context.Background(), transport.InitPayload{
+		authorizationKey: "Bearer invalid-key",
+	})
+	assert.NoError(t, err)
+}
+
+func TestAuthenticatorMutateOperationParameters(t *testing.T) {
+	auth := newTestAuthenticator()
+
+	err := auth.MutateOperationParameters(context.Background(), &graphql.RawParams{Query: "{"})
+	require.NotNil(t, err)
+	assert.Equal(t, errCodeUnauthenticated, err.Extensions["code"])
+
+	ctx := withAPIKey(context.Background(), testReadAPIKey, ScopeRead)
+	assert.Nil(t, auth.MutateOperationParameters(ctx, &graphql.RawParams{Query: "{"}))
+
+	assert.Nil(t, NewAuthenticator(nil, nil).MutateOperationParameters(context.Background(), &graphql.RawParams{Query: "{"}))
+}
+
+func TestAuthenticatorMutateOperationContext(t *testing.T) {
+	testCases := []struct {
+		name          string
+		auth          *Authenticator
+		scope         Scope
+		operation     ast.Operation
+		expectedError string
+	}{
+		{
+			name:      "authentication disabled",
+			auth:      NewAuthenticator(nil, nil),
+			scope:     ScopeNone,
+			operation: ast.Mutation,
+		},
+		{
+			name:          "missing API key for query",
+			auth:          newTestAuthenticator(),
+			scope:         ScopeNone,
+			operation:     ast.Query,
+			expectedError: errCodeUnauthenticated,
+		},
+		{
+			name:          "missing API key for subscription",
+			auth:          newTestAuthenticator(),
+			scope:         ScopeNone,
+			operation:     ast.Subscription,
+			expectedError: errCodeUnauthenticated,
+		},
+		{
+			name:      "read scope for query",
+			auth:      newTestAuthenticator(),
+			scope:     ScopeRead,
+			operation: ast.Query,
+		},
+		{
+			name:      "read scope for subscription",
+			auth:      newTestAuthenticator(),
+			scope:     ScopeRead,
+			operation: ast.Subscription,
+		},
+		{
+			name:          "read scope for mutation",
+			auth:          newTestAuthenticator(),
+			scope:         ScopeRead,
+			operation:     ast.Mutation,
+			expectedError: errCodeForbidden,
+		},
+		{
+			name:      "write scope for mutation",
+			auth:      newTestAuthenticator(),
+			scope:     ScopeWrite,
+			operation: ast.Mutation,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.scope != ScopeNone {
+				ctx = withAPIKey(ctx, "key", tc.scope)
+			}
+			err := tc.auth.MutateOperationContext(ctx, newTestOperationContext(tc.operation))
+			if tc.expectedError == "" {
+				assert.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Equal(t, tc.expectedError, err.Extensions["code"])
+		})
+	}
+}
+
+func newTestOperationContext(operation ast.Operation) *graphql.OperationContext {
+	return &graphql.OperationContext{
+		Operation: &ast.OperationDefinition{Operation: operation},
+	}
+}
+
+func TestAuthenticatorRESTMiddleware(t *testing.T) {
+	testCases := []struct {
+		name           string
+		auth           *Authenticator
+		method         string
+		authorization  string
+		expectedStatus int
+	}{
+		{
+			name:           "authentication disabled",
+			auth:           NewAuthenticator(nil, nil),
+			method:         http.MethodPost,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "missing API key",
+			auth:           newTestAuthenticator(),
+			method:         http.MethodGet,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid API key",
+			auth:           newTestAuthenticator(),
+			method:         http.MethodGet,
+			authorization:  "Bearer invalid-key",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "read API key for GET request",
+			auth:           newTestAuthenticator(),
+			method:         http.MethodGet,
+			authorization:  "Bearer " + testReadAPIKey,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "read API key for POST request",
+			auth:           newTestAuthenticator(),
+			method:         http.MethodPost,
+			authorization:  "Bearer " + testReadAPIKey,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "write API key for POST request",
+			auth:           newTestAuthenticator(),
+			method:         http.MethodPost,
+			authorization:  "Bearer " + testWriteAPIKey,
+			expectedStatus: http.StatusOK,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			called := false
+			handler := tc.auth.RESTMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+			}))
+			req := httptest.NewRequest(tc.method, "/sra/v4/orders", nil)
+			if tc.authorization != "" {
+				req.Header.Set(authorizationKey, tc.authorization)
+			}
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			assert.Equal(t, tc.expectedStatus == http.StatusOK, called)
+		})
+	}
+}
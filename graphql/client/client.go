@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/0xProject/0x-mesh/graphql/gqltypes"
 	"github.com/0xProject/0x-mesh/zeroex"
@@ -12,6 +13,28 @@ import (
 // Client is a client for the 0x Mesh GraphQL API.
 type Client struct {
 	*graphql.Client
+	url    string
+	header http.Header
+}
+
+// Option is an option for New.
+type Option func(c *Client)
+
+// WithAPIKey sends the given API key in the Authorization header of every
+// request. It is required to connect to Mesh nodes which have API keys
+// configured. Use a key with write scope in order to add or remove orders.
+func WithAPIKey(apiKey string) Option {
+	return WithHeader("Authorization", "Bearer "+apiKey)
+}
+
+// WithHeader sends the given HTTP header with every request. Since headers
+// can't be set for websocket connections from browsers, Mesh also accepts
+// headers in the payload of the connection_init message of a subscription, so
+// the header is sent there as well.
+func WithHeader(key string, value string) Option {
+	return func(c *Client) {
+		c.header.Set(key, value)
+	}
 }
 
 const (
@@ -77,27 +100,29 @@ const (
 		}
 	}`
 
-	ordersQuery = `query Orders($filters: [OrderFilter!] = [], $sort: [OrderSort!] = [{ field: hash, direction: ASC }], $limit: Int = 100) {
-		orders(filters: $filters, sort: $sort, limit: $limit) {
-			hash
-			chainId
-			exchangeAddress
-			makerAddress
-			makerAssetData
-			makerAssetAmount
-			makerFeeAssetData
-			makerFee
-			takerAddress
-			takerAssetData
-			takerAssetAmount
-			takerFeeAssetData
-			takerFee
-			senderAddress
-			feeRecipientAddress
-			expirationTimeSeconds
-			salt
-			signature
-			fillableTakerAssetAmount
+	ordersQuery = `query Orders($filters: [OrderFilter!] = [], $sort: [OrderSort!] = [{ field: hash, direction: ASC }], $first: Int = 100) {
+		orders(filters: $filters, sort: $sort, first: $first) {
+			orders {
+				hash
+				chainId
+				exchangeAddress
+				makerAddress
+				makerAssetData
+				makerAssetAmount
+				makerFeeAssetData
+				makerFee
+				takerAddress
+				takerAssetData
+				takerAssetAmount
+				takerFeeAssetData
+				takerFee
+				senderAddress
+				feeRecipientAddress
+				expirationTimeSeconds
+				salt
+				signature
+				fillableTakerAssetAmount
+			}
 		}
 	}`
 
@@ -147,16 +172,55 @@ const (
 			ethRPCRequestsSentInCurrentUTCDay
 			ethRPCRateLimitExpiredRequests
 			maxExpirationTime
+			maxOrdersPerMaker
+			maxOrdersPerPeer
+			makerQuotaUsage {
+				id
+				numOrders
+			}
+			peerQuotaUsage {
+				id
+				numOrders
+			}
 		}
 	}`
 )
 
 // New creates a new client which points to the given URL.
-func New(url string) *Client {
-	client := graphql.NewClient(url)
-	return &Client{
-		Client: client,
+func New(url string, opts ...Option) *Client {
+	c := &Client{
+		url:    url,
+		header: http.Header{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	var clientOpts []graphql.ClientOption
+	if len(c.header) > 0 {
+		clientOpts = append(clientOpts, graphql.WithHTTPClient(&http.Client{
+			Transport: &headerTransport{
+				header: c.header,
+				base:   http.DefaultTransport,
+			},
+		}))
 	}
+	c.Client = graphql.NewClient(url, clientOpts...)
+	return c
+}
+
+// headerTransport is an http.RoundTripper which adds headers to each request.
+type headerTransport struct {
+	header http.Header
+	base   http.RoundTripper
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTrip must not modify the original request.
+	req = req.Clone(req.Context())
+	for key, values := range t.header {
+		req.Header[key] = values
+	}
+	return t.base.RoundTrip(req)
 }
 
 // AddOrdersOpts is a set of options for the AddOrders method. They can
@@ -239,30 +303,27 @@ func (c *Client) FindOrders(ctx context.Context, opts ...FindOrdersOpts) ([]*Ord
 		opts := opts[0]
 		if len(opts.Filters) > 0 {
 			// Convert each filter value from the native Go type to a JSON-compatible type.
-			for i, filter := range opts.Filters {
-				jsonCompatibleValue, err := gqltypes.FilterValueToJSON(filter)
-				if err != nil {
-					return nil, err
-				}
-				opts.Filters[i].Value = jsonCompatibleValue
+			jsonCompatibleFilters, err := gqltypes.OrderFiltersToJSON(opts.Filters)
+			if err != nil {
+				return nil, err
 			}
-			req.Var("filters", opts.Filters)
+			req.Var("filters", jsonCompatibleFilters)
 		}
 		if len(opts.Sort) > 0 {
 			req.Var("sort", opts.Sort)
 		}
 		if opts.Limit != 0 {
-			req.Var("limit", opts.Limit)
+			req.Var("first", opts.Limit)
 		}
 	}
 
 	var resp struct {
-		Orders []*gqltypes.OrderWithMetadata `json:"orders"`
+		Orders *gqltypes.OrderConnection `json:"orders"`
 	}
 	if err := c.Run(ctx, req, &resp); err != nil {
 		return nil, err
 	}
-	return ordersWithMetadataFromGQLType(resp.Orders), nil
+	return ordersWithMetadataFromGQLType(resp.Orders.Orders), nil
 }
 
 func (c *Client) GetStats(ctx context.Context) (*Stats, error) {
@@ -0,0 +1,33 @@
+// +build !js
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithAPIKey(t *testing.T) {
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {}}`))
+	}))
+	defer server.Close()
+
+	var response struct{}
+	require.NoError(t, New(server.URL, WithAPIKey("test-key")).RawQuery(context.Background(), "{ stats { version } }", &response))
+	assert.Equal(t, "Bearer test-key", authorization)
+
+	require.NoError(t, New(server.URL, WithHeader("Authorization", "test-key")).RawQuery(context.Background(), "{ stats { version } }", &response))
+	assert.Equal(t, "test-key", authorization)
+
+	require.NoError(t, New(server.URL).RawQuery(context.Background(), "{ stats { version } }", &response))
+	assert.Equal(t, "", authorization)
+}
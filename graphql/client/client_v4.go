@@ -34,28 +34,30 @@ const (
 			fillableTakerAssetAmount
 		}
 	}`
-	ordersQueryV4 = `query OrdersV4($filters: [OrderFilterV4!] = [], $sort: [OrderSortV4!] = [{ field: hash, direction: ASC }], $limit: Int = 100) {
-		ordersv4(filters: $filters, sort: $sort, limit: $limit) {
-                        hash
-			chainId
-			verifyingContract
-			makerToken
-			takerToken
-			makerAmount
-			takerAmount
-			takerTokenFeeAmount
-			maker
-			taker
-			sender
-			feeRecipient
-			pool
-			expiry
-			salt
-			signatureType
-			signatureV
-			signatureR
-			signatureS
-			fillableTakerAssetAmount
+	ordersQueryV4 = `query OrdersV4($filters: [OrderFilterV4!] = [], $sort: [OrderSortV4!] = [{ field: hash, direction: ASC }], $first: Int = 100) {
+		ordersv4(filters: $filters, sort: $sort, first: $first) {
+			orders {
+				hash
+				chainId
+				verifyingContract
+				makerToken
+				takerToken
+				makerAmount
+				takerAmount
+				takerTokenFeeAmount
+				maker
+				taker
+				sender
+				feeRecipient
+				pool
+				expiry
+				salt
+				signatureType
+				signatureV
+				signatureR
+				signatureS
+				fillableTakerAssetAmount
+			}
 		}
 	}`
 
@@ -175,28 +177,25 @@ func (c *Client) FindOrdersV4(ctx context.Context, opts ...FindOrdersOpts) ([]*O
 		opts := opts[0]
 		if len(opts.Filters) > 0 {
 			// Convert each filter value from the native Go type to a JSON-compatible type.
-			for i, filter := range opts.Filters {
-				jsonCompatibleValue, err := gqltypes.FilterValueToJSON(filter)
-				if err != nil {
-					return nil, err
-				}
-				opts.Filters[i].Value = jsonCompatibleValue
+			jsonCompatibleFilters, err := gqltypes.OrderFiltersToJSON(opts.Filters)
+			if err != nil {
+				return nil, err
 			}
-			req.Var("filters", opts.Filters)
+			req.Var("filters", jsonCompatibleFilters)
 		}
 		if len(opts.Sort) > 0 {
 			req.Var("sort", opts.Sort)
 		}
 		if opts.Limit != 0 {
-			req.Var("limit", opts.Limit)
+			req.Var("first", opts.Limit)
 		}
 	}
 
 	var resp struct {
-		Orders []*gqltypes.OrderV4WithMetadata `json:"ordersv4"`
+		Orders *gqltypes.OrderV4Connection `json:"ordersv4"`
 	}
 	if err := c.Run(ctx, req, &resp); err != nil {
 		return nil, err
 	}
-	return ordersWithMetadataFromGQLTypeV4(resp.Orders), nil
+	return ordersWithMetadataFromGQLTypeV4(resp.Orders.Orders), nil
 }
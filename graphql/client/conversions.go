@@ -1,6 +1,7 @@
 package client
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/0xProject/0x-mesh/graphql/gqltypes"
@@ -102,6 +103,64 @@ func ordersWithMetadataFromGQLType(orders []*gqltypes.OrderWithMetadata) []*Orde
 	return result
 }
 
+func orderEventFromGQLType(event *gqltypes.OrderEvent) (*OrderEvent, error) {
+	timestamp, err := time.Parse(time.RFC3339, event.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+	result := &OrderEvent{
+		EndState:       event.EndState,
+		Timestamp:      timestamp,
+		ContractEvents: contractEventsFromGQLType(event.ContractEvents),
+	}
+	if event.Order != nil {
+		result.Order = orderWithMetadataFromGQLType(event.Order)
+	}
+	if event.Orderv4 != nil {
+		result.OrderV4 = orderWithMetadataFromGQLTypeV4(event.Orderv4)
+	}
+	if event.SequenceNumber != nil {
+		result.SequenceNumber, err = strconv.ParseUint(*event.SequenceNumber, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func orderEventsFromGQLType(events []*gqltypes.OrderEvent) ([]*OrderEvent, error) {
+	result := make([]*OrderEvent, len(events))
+	for i, event := range events {
+		orderEvent, err := orderEventFromGQLType(event)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = orderEvent
+	}
+	return result, nil
+}
+
+func contractEventFromGQLType(event *gqltypes.ContractEvent) *ContractEvent {
+	return &ContractEvent{
+		BlockHash:  common.HexToHash(event.BlockHash),
+		TxHash:     common.HexToHash(event.TxHash),
+		TxIndex:    event.TxIndex,
+		LogIndex:   event.LogIndex,
+		IsRemoved:  event.IsRemoved,
+		Address:    common.HexToAddress(event.Address),
+		Kind:       event.Kind,
+		Parameters: event.Parameters,
+	}
+}
+
+func contractEventsFromGQLType(events []*gqltypes.ContractEvent) []*ContractEvent {
+	result := make([]*ContractEvent, len(events))
+	for i, event := range events {
+		result[i] = contractEventFromGQLType(event)
+	}
+	return result
+}
+
 func statsFromGQLType(stats *gqltypes.Stats) (*Stats, error) {
 	startOfCurrentUTCDay, err := time.Parse(time.RFC3339, stats.StartOfCurrentUTCDay)
 	if err != nil {
@@ -121,6 +180,10 @@ func statsFromGQLType(stats *gqltypes.Stats) (*Stats, error) {
 		EthRPCRequestsSentInCurrentUTCDay: stats.EthRPCRequestsSentInCurrentUTCDay,
 		EthRPCRateLimitExpiredRequests:    stats.EthRPCRateLimitExpiredRequests,
 		MaxExpirationTime:                 math.MustParseBig256(stats.MaxExpirationTime),
+		MaxOrdersPerMaker:                 stats.MaxOrdersPerMaker,
+		MaxOrdersPerPeer:                  stats.MaxOrdersPerPeer,
+		MakerQuotaUsage:                   stats.MakerQuotaUsage,
+		PeerQuotaUsage:                    stats.PeerQuotaUsage,
 	}, nil
 }
 
@@ -56,6 +56,7 @@ func orderWithMetadataFromGQLTypeV4(order *gqltypes.OrderV4WithMetadata) *OrderW
 			R:             zeroex.HexToBytes32(order.SignatureR),
 			S:             zeroex.HexToBytes32(order.SignatureS),
 		},
+		FillableTakerAssetAmount: math.MustParseBig256(order.FillableTakerAssetAmount),
 	}
 }
 
@@ -0,0 +1,443 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/0xProject/0x-mesh/graphql/gqltypes"
+	"github.com/gorilla/websocket"
+	"github.com/jpillora/backoff"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	orderEventsSubscription = `subscription OrderEvents($filters: [OrderFilter!] = [], $filtersV4: [OrderFilterV4!] = [], $endStates: [OrderEndState!], $since: String) {
+		orderEvents(filters: $filters, filtersV4: $filtersV4, endStates: $endStates, since: $since) {
+			order {
+				hash
+				chainId
+				exchangeAddress
+				makerAddress
+				makerAssetData
+				makerAssetAmount
+				makerFeeAssetData
+				makerFee
+				takerAddress
+				takerAssetData
+				takerAssetAmount
+				takerFeeAssetData
+				takerFee
+				senderAddress
+				feeRecipientAddress
+				expirationTimeSeconds
+				salt
+				signature
+				fillableTakerAssetAmount
+			}
+			orderv4 {
+				hash
+				chainId
+				verifyingContract
+				makerToken
+				takerToken
+				makerAmount
+				takerAmount
+				takerTokenFeeAmount
+				maker
+				taker
+				sender
+				feeRecipient
+				pool
+				expiry
+				salt
+				signatureType
+				signatureV
+				signatureR
+				signatureS
+				fillableTakerAssetAmount
+			}
+			endState
+			timestamp
+			contractEvents {
+				blockHash
+				txHash
+				txIndex
+				logIndex
+				isRemoved
+				address
+				kind
+				parameters
+			}
+			sequenceNumber
+		}
+	}`
+
+	// The message types of the graphql-ws protocol. See
+	// https://github.com/apollographql/subscriptions-transport-ws/blob/master/PROTOCOL.md
+	connectionInitMsg      = "connection_init"
+	startMsg               = "start"
+	connectionAckMsg       = "connection_ack"
+	connectionErrorMsg     = "connection_error"
+	dataMsg                = "data"
+	errorMsg               = "error"
+	completeMsg            = "complete"
+	connectionKeepAliveMsg = "ka"
+
+	// subscriptionID is the ID of the single operation started on each
+	// websocket connection.
+	subscriptionID = "1"
+	// handshakeTimeout is the maximum amount of time to wait for the websocket
+	// handshake to complete.
+	handshakeTimeout = 10 * time.Second
+	// keepAliveTimeout is the maximum amount of time to wait for any message
+	// from the server before the connection is considered dead. Mesh sends a
+	// keep-alive message every 10 seconds.
+	keepAliveTimeout = 30 * time.Second
+	// minReconnectDelay and maxReconnectDelay are the bounds for the
+	// exponential backoff used when reconnecting.
+	minReconnectDelay = 500 * time.Millisecond
+	maxReconnectDelay = 30 * time.Second
+	// errChanBufferSize is the buffer size for the error channel of an
+	// OrderEventSubscription.
+	errChanBufferSize = 10
+	// errCodeRateLimited is the code of errors returned by Mesh when a rate
+	// limit is exceeded.
+	errCodeRateLimited = "RATE_LIMITED"
+)
+
+// errSubscriptionCompleted is returned when the server ends the subscription,
+// e.g. because the subscriber was too slow to receive order events.
+var errSubscriptionCompleted = errors.New("subscription was completed by the server")
+
+// SubscribeToOrderEventsOpts is a set of options for the SubscribeToOrderEvents
+// method. They can be omitted in order to use the defaults.
+type SubscribeToOrderEventsOpts struct {
+	// Filters is a set of filters for events about v3 orders. Only events for v3
+	// orders that match all filters will be received.
+	Filters []OrderFilter
+	// FiltersV4 is a set of filters for events about v4 orders. Only events for
+	// v4 orders that match all filters will be received.
+	FiltersV4 []OrderFilterV4
+	// EndStates is the set of end states to receive events for. If empty,
+	// events with any end state are received.
+	EndStates []OrderEndState
+	// Since is the sequence number of the last order event received before the
+	// subscription was previously closed. If it is not 0, all events in the
+	// order event log after Since are received before any new events.
+	Since uint64
+}
+
+// SubscriptionError is an error encountered by an OrderEventSubscription.
+type SubscriptionError struct {
+	// Err is the underlying error.
+	Err error
+	// Fatal is true if the subscription has been stopped because of the error
+	// (e.g. because the filters are invalid or the order events to resume from
+	// have been pruned). Otherwise the subscription will try to reconnect.
+	Fatal bool
+}
+
+func (e *SubscriptionError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *SubscriptionError) Unwrap() error {
+	return e.Err
+}
+
+// OrderEventSubscription is a subscription to order events created by
+// SubscribeToOrderEvents.
+type OrderEventSubscription struct {
+	cancel    context.CancelFunc
+	url       string
+	header    http.Header
+	variables map[string]interface{}
+	events    chan<- []*OrderEvent
+	errChan   chan *SubscriptionError
+	done      chan struct{}
+	// since is the sequence number of the last order event received. It is only
+	// accessed by the goroutine started in SubscribeToOrderEvents.
+	since uint64
+}
+
+// SubscribeToOrderEvents subscribes to the orderEvents subscription and sends
+// each batch of order events to the given channel, which is never closed.
+// Events are received over a websocket connection using the graphql-ws
+// protocol. If the connection is lost, the subscription reconnects with
+// exponential backoff and resumes from the sequence number of the last event
+// received so that no events are missed (as long as they haven't been pruned
+// from the order event log). The subscription stops when ctx is cancelled,
+// Unsubscribe is called or a fatal error occurs.
+func (c *Client) SubscribeToOrderEvents(ctx context.Context, events chan<- []*OrderEvent, opts ...SubscribeToOrderEventsOpts) (*OrderEventSubscription, error) {
+	wsURL, err := websocketURL(c.url)
+	if err != nil {
+		return nil, err
+	}
+	variables := map[string]interface{}{}
+	var since uint64
+	if len(opts) > 0 {
+		opts := opts[0]
+		if len(opts.Filters) > 0 {
+			// Convert each filter value from the native Go type to a JSON-compatible type.
+			jsonCompatibleFilters, err := gqltypes.OrderFiltersToJSON(opts.Filters)
+			if err != nil {
+				return nil, err
+			}
+			variables["filters"] = jsonCompatibleFilters
+		}
+		if len(opts.FiltersV4) > 0 {
+			jsonCompatibleFilters, err := gqltypes.OrderFiltersV4ToJSON(opts.FiltersV4)
+			if err != nil {
+				return nil, err
+			}
+			variables["filtersV4"] = jsonCompatibleFilters
+		}
+		if len(opts.EndStates) > 0 {
+			variables["endStates"] = opts.EndStates
+		}
+		since = opts.Since
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	sub := &OrderEventSubscription{
+		cancel:    cancel,
+		url:       wsURL,
+		header:    c.header,
+		variables: variables,
+		events:    events,
+		errChan:   make(chan *SubscriptionError, errChanBufferSize),
+		done:      make(chan struct{}),
+		since:     since,
+	}
+	go sub.run(ctx)
+	return sub, nil
+}
+
+// Err returns a channel which receives any errors encountered by the
+// subscription. Errors which are not fatal are dropped if the channel is full.
+// The channel is closed when the subscription stops.
+func (s *OrderEventSubscription) Err() <-chan *SubscriptionError {
+	return s.errChan
+}
+
+// Unsubscribe stops the subscription and waits for it to close the
+// connection.
+func (s *OrderEventSubscription) Unsubscribe() {
+	s.cancel()
+	<-s.done
+}
+
+func (s *OrderEventSubscription) run(ctx context.Context) {
+	defer close(s.done)
+	defer close(s.errChan)
+
+	reconnectBackoff := &backoff.Backoff{
+		Min:    minReconnectDelay,
+		Max:    maxReconnectDelay,
+		Factor: 2,
+		Jitter: true,
+	}
+	for {
+		connected, subErr := s.receive(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if connected {
+			reconnectBackoff.Reset()
+		}
+		if subErr.Fatal {
+			select {
+			case s.errChan <- subErr:
+			case <-ctx.Done():
+			}
+			return
+		}
+		select {
+		case s.errChan <- subErr:
+		default:
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectBackoff.Duration()):
+		}
+	}
+}
+
+// receive connects to the server, starts the subscription and sends order
+// events to s.events until an error occurs or ctx is cancelled. It returns true
+// if the subscription was started successfully.
+func (s *OrderEventSubscription) receive(ctx context.Context) (bool, *SubscriptionError) {
+	dialer := &websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		HandshakeTimeout: handshakeTimeout,
+		Subprotocols:     []string{"graphql-ws"},
+	}
+	conn, resp, err := dialer.DialContext(ctx, s.url, s.header)
+	if err != nil {
+		// Client errors (e.g. 401 for an invalid API key) won't go away by
+		// reconnecting.
+		fatal := resp != nil && resp.StatusCode >= 400 && resp.StatusCode < 500
+		return false, &SubscriptionError{Err: err, Fatal: fatal}
+	}
+	defer conn.Close()
+
+	// Closing the connection unblocks any pending reads when ctx is cancelled.
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = conn.Close()
+		case <-stop:
+		}
+	}()
+
+	if err := conn.WriteJSON(&operationMessage{Type: connectionInitMsg, Payload: s.initPayload()}); err != nil {
+		return false, &SubscriptionError{Err: err}
+	}
+	for acked := false; !acked; {
+		msg, err := readMessage(conn)
+		if err != nil {
+			return false, &SubscriptionError{Err: err}
+		}
+		switch msg.Type {
+		case connectionAckMsg:
+			acked = true
+		case connectionKeepAliveMsg:
+		case connectionErrorMsg:
+			return false, &SubscriptionError{Err: fmt.Errorf("connection error: %s", msg.Payload), Fatal: true}
+		default:
+			return false, &SubscriptionError{Err: fmt.Errorf("unexpected message type before connection_ack: %q", msg.Type)}
+		}
+	}
+
+	if err := conn.WriteJSON(&operationMessage{
+		ID:      subscriptionID,
+		Type:    startMsg,
+		Payload: s.startPayload(),
+	}); err != nil {
+		return false, &SubscriptionError{Err: err}
+	}
+	for {
+		msg, err := readMessage(conn)
+		if err != nil {
+			return true, &SubscriptionError{Err: err}
+		}
+		switch msg.Type {
+		case connectionKeepAliveMsg:
+		case dataMsg:
+			var payload struct {
+				Data struct {
+					OrderEvents []*gqltypes.OrderEvent `json:"orderEvents"`
+				} `json:"data"`
+				Errors gqlerror.List `json:"errors"`
+			}
+			if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+				return true, &SubscriptionError{Err: err, Fatal: true}
+			}
+			if len(payload.Errors) > 0 {
+				return true, &SubscriptionError{Err: payload.Errors, Fatal: !isRateLimited(payload.Errors)}
+			}
+			orderEvents, err := orderEventsFromGQLType(payload.Data.OrderEvents)
+			if err != nil {
+				return true, &SubscriptionError{Err: err, Fatal: true}
+			}
+			for _, orderEvent := range orderEvents {
+				if orderEvent.SequenceNumber != 0 {
+					s.since = orderEvent.SequenceNumber
+				}
+			}
+			select {
+			case s.events <- orderEvents:
+			case <-ctx.Done():
+				return true, nil
+			}
+		case errorMsg:
+			return true, &SubscriptionError{Err: fmt.Errorf("subscription error: %s", msg.Payload), Fatal: true}
+		case completeMsg:
+			return true, &SubscriptionError{Err: errSubscriptionCompleted}
+		default:
+			return true, &SubscriptionError{Err: fmt.Errorf("unexpected message type: %q", msg.Type)}
+		}
+	}
+}
+
+// initPayload returns the payload of the connection_init message, which
+// contains the headers of the client (e.g. the Authorization header).
+func (s *OrderEventSubscription) initPayload() json.RawMessage {
+	payload := make(map[string]string, len(s.header))
+	for key := range s.header {
+		payload[key] = s.header.Get(key)
+	}
+	result, _ := json.Marshal(payload)
+	return result
+}
+
+func (s *OrderEventSubscription) startPayload() json.RawMessage {
+	variables := make(map[string]interface{}, len(s.variables)+1)
+	for key, value := range s.variables {
+		variables[key] = value
+	}
+	if s.since != 0 {
+		variables["since"] = strconv.FormatUint(s.since, 10)
+	}
+	payload, _ := json.Marshal(map[string]interface{}{
+		"query":     orderEventsSubscription,
+		"variables": variables,
+	})
+	return payload
+}
+
+// operationMessage is a message of the graphql-ws protocol.
+type operationMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+func readMessage(conn *websocket.Conn) (*operationMessage, error) {
+	if err := conn.SetReadDeadline(time.Now().Add(keepAliveTimeout)); err != nil {
+		return nil, err
+	}
+	var msg operationMessage
+	if err := conn.ReadJSON(&msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+func isRateLimited(errs gqlerror.List) bool {
+	for _, err := range errs {
+		if err.Extensions["code"] != errCodeRateLimited {
+			return false
+		}
+	}
+	return true
+}
+
+// websocketURL converts the http(s) URL of the GraphQL server to the
+// corresponding ws(s) URL.
+func websocketURL(httpURL string) (string, error) {
+	u, err := url.Parse(httpURL)
+	if err != nil {
+		return "", err
+	}
+	switch u.Scheme {
+	case "http":
+		u.Scheme = "ws"
+	case "https":
+		u.Scheme = "wss"
+	case "ws", "wss":
+	default:
+		return "", fmt.Errorf("unsupported URL scheme for subscriptions: %q", u.Scheme)
+	}
+	return u.String(), nil
+}
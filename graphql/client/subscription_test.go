@@ -0,0 +1,377 @@
+// +build !js
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testTimeout is the maximum amount of time to wait for anything to happen in
+// the tests. It is longer than the first few reconnect delays.
+const testTimeout = 5 * time.Second
+
+// testServer is a graphql-ws server which hands each new connection to the
+// test so that the test can decide how the server responds.
+type testServer struct {
+	*httptest.Server
+	connections chan *testConnection
+}
+
+// testConnection is a single websocket connection to a testServer.
+type testConnection struct {
+	conn        *websocket.Conn
+	header      http.Header
+	connectedAt time.Time
+}
+
+func newTestServer(t *testing.T) *testServer {
+	upgrader := websocket.Upgrader{
+		Subprotocols: []string{"graphql-ws"},
+	}
+	server := &testServer{
+		connections: make(chan *testConnection, 10),
+	}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("could not upgrade connection: %s", err)
+			return
+		}
+		server.connections <- &testConnection{
+			conn:        conn,
+			header:      r.Header,
+			connectedAt: time.Now(),
+		}
+	}))
+	return server
+}
+
+// accept waits for the next connection and returns it together with the
+// payload of its connection_init message.
+func (s *testServer) accept(t *testing.T) (*testConnection, map[string]string) {
+	select {
+	case conn := <-s.connections:
+		msg := conn.read(t)
+		require.Equal(t, connectionInitMsg, msg.Type)
+		var initPayload map[string]string
+		require.NoError(t, json.Unmarshal(msg.Payload, &initPayload))
+		return conn, initPayload
+	case <-time.After(testTimeout):
+		t.Fatal("timed out waiting for connection")
+		return nil, nil
+	}
+}
+
+// acceptSubscription accepts the next connection, acknowledges it and returns
+// the variables of the subscription started by the client.
+func (s *testServer) acceptSubscription(t *testing.T) (*testConnection, map[string]interface{}) {
+	conn, _ := s.accept(t)
+	conn.write(t, connectionAckMsg, nil)
+	msg := conn.read(t)
+	require.Equal(t, startMsg, msg.Type)
+	require.Equal(t, subscriptionID, msg.ID)
+	var payload struct {
+		Variables map[string]interface{} `json:"variables"`
+	}
+	require.NoError(t, json.Unmarshal(msg.Payload, &payload))
+	return conn, payload.Variables
+}
+
+func (c *testConnection) read(t *testing.T) *operationMessage {
+	require.NoError(t, c.conn.SetReadDeadline(time.Now().Add(testTimeout)))
+	var msg operationMessage
+	require.NoError(t, c.conn.ReadJSON(&msg))
+	return &msg
+}
+
+func (c *testConnection) write(t *testing.T, msgType string, payload interface{}) {
+	msg := &operationMessage{Type: msgType}
+	if msgType == dataMsg || msgType == errorMsg || msgType == completeMsg {
+		msg.ID = subscriptionID
+	}
+	if payload != nil {
+		payloadJSON, err := json.Marshal(payload)
+		require.NoError(t, err)
+		msg.Payload = payloadJSON
+	}
+	require.NoError(t, c.conn.WriteJSON(msg))
+}
+
+// sendOrderEvents sends a batch of order events with the given sequence
+// numbers.
+func (c *testConnection) sendOrderEvents(t *testing.T, sequenceNumbers ...uint64) {
+	orderEvents := make([]map[string]interface{}, len(sequenceNumbers))
+	for i, sequenceNumber := range sequenceNumbers {
+		orderEvents[i] = map[string]interface{}{
+			"endState":       "ADDED",
+			"timestamp":      time.Now().UTC().Format(time.RFC3339),
+			"contractEvents": []interface{}{},
+			"sequenceNumber": fmt.Sprint(sequenceNumber),
+		}
+	}
+	c.write(t, dataMsg, map[string]interface{}{
+		"data": map[string]interface{}{
+			"orderEvents": orderEvents,
+		},
+	})
+}
+
+func (c *testConnection) sendErrors(t *testing.T, code string) {
+	c.write(t, dataMsg, map[string]interface{}{
+		"data": nil,
+		"errors": []map[string]interface{}{
+			{
+				"message":    "test error",
+				"extensions": map[string]interface{}{"code": code},
+			},
+		},
+	})
+}
+
+func receiveOrderEvents(t *testing.T, events <-chan []*OrderEvent) []*OrderEvent {
+	select {
+	case orderEvents := <-events:
+		return orderEvents
+	case <-time.After(testTimeout):
+		t.Fatal("timed out waiting for order events")
+		return nil
+	}
+}
+
+func receiveError(t *testing.T, sub *OrderEventSubscription) *SubscriptionError {
+	select {
+	case err, ok := <-sub.Err():
+		require.True(t, ok, "error channel was closed")
+		return err
+	case <-time.After(testTimeout):
+		t.Fatal("timed out waiting for error")
+		return nil
+	}
+}
+
+func assertErrChanClosed(t *testing.T, sub *OrderEventSubscription) {
+	select {
+	case err, ok := <-sub.Err():
+		assert.False(t, ok, "expected error channel to be closed but received: %v", err)
+	case <-time.After(testTimeout):
+		t.Fatal("timed out waiting for error channel to be closed")
+	}
+}
+
+func TestSubscribeToOrderEventsSendsAPIKey(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sub, err := New(server.URL, WithAPIKey("test-key")).SubscribeToOrderEvents(ctx, make(chan []*OrderEvent))
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	conn, initPayload := server.accept(t)
+	assert.Equal(t, "Bearer test-key", conn.header.Get("Authorization"))
+	assert.Equal(t, map[string]string{"Authorization": "Bearer test-key"}, initPayload)
+}
+
+func TestSubscribeToOrderEventsWithoutAPIKey(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sub, err := New(server.URL).SubscribeToOrderEvents(ctx, make(chan []*OrderEvent))
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	conn, initPayload := server.accept(t)
+	assert.Equal(t, "", conn.header.Get("Authorization"))
+	assert.Empty(t, initPayload)
+}
+
+func TestSubscribeToOrderEventsReconnectsAndResumes(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := make(chan []*OrderEvent)
+	sub, err := New(server.URL).SubscribeToOrderEvents(ctx, events, SubscribeToOrderEventsOpts{
+		EndStates: []OrderEndState{"ADDED"},
+		Since:     42,
+	})
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	// The first subscription resumes from the given sequence number.
+	conn, variables := server.acceptSubscription(t)
+	assert.Equal(t, "42", variables["since"])
+	assert.Equal(t, []interface{}{"ADDED"}, variables["endStates"])
+
+	conn.sendOrderEvents(t, 43, 44)
+	orderEvents := receiveOrderEvents(t, events)
+	require.Len(t, orderEvents, 2)
+	assert.Equal(t, uint64(43), orderEvents[0].SequenceNumber)
+	assert.Equal(t, uint64(44), orderEvents[1].SequenceNumber)
+
+	// Closing the connection is not fatal and the subscription reconnects
+	// after a delay, resuming from the last event received.
+	disconnectedAt := time.Now()
+	require.NoError(t, conn.conn.Close())
+	subErr := receiveError(t, sub)
+	assert.False(t, subErr.Fatal)
+
+	conn, variables = server.acceptSubscription(t)
+	assert.True(t, conn.connectedAt.Sub(disconnectedAt) >= minReconnectDelay, "reconnected after %s", conn.connectedAt.Sub(disconnectedAt))
+	assert.Equal(t, "44", variables["since"])
+	assert.Equal(t, []interface{}{"ADDED"}, variables["endStates"])
+
+	conn.sendOrderEvents(t, 45)
+	orderEvents = receiveOrderEvents(t, events)
+	require.Len(t, orderEvents, 1)
+	assert.Equal(t, uint64(45), orderEvents[0].SequenceNumber)
+
+	sub.Unsubscribe()
+	assertErrChanClosed(t, sub)
+}
+
+func TestSubscribeToOrderEventsBackoff(t *testing.T) {
+	var attempts []time.Time
+	attemptChan := make(chan time.Time, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attemptChan <- time.Now()
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sub, err := New(server.URL).SubscribeToOrderEvents(ctx, make(chan []*OrderEvent))
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	for len(attempts) < 3 {
+		select {
+		case attempt := <-attemptChan:
+			attempts = append(attempts, attempt)
+		case <-time.After(testTimeout):
+			t.Fatal("timed out waiting for reconnect")
+		}
+		// Server errors are not fatal.
+		subErr := receiveError(t, sub)
+		assert.False(t, subErr.Fatal, subErr.Error())
+	}
+	for i := 1; i < len(attempts); i++ {
+		delay := attempts[i].Sub(attempts[i-1])
+		assert.True(t, delay >= minReconnectDelay, "reconnect %d happened after %s", i, delay)
+		assert.True(t, delay < maxReconnectDelay, "reconnect %d happened after %s", i, delay)
+	}
+}
+
+func TestSubscribeToOrderEventsRateLimited(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sub, err := New(server.URL).SubscribeToOrderEvents(ctx, make(chan []*OrderEvent))
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	conn, _ := server.acceptSubscription(t)
+	conn.sendErrors(t, errCodeRateLimited)
+	subErr := receiveError(t, sub)
+	assert.False(t, subErr.Fatal, "rate limit errors should not be fatal")
+
+	// The subscription tries again.
+	server.acceptSubscription(t)
+}
+
+func TestSubscribeToOrderEventsFatalErrors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		respond func(t *testing.T, server *testServer)
+	}{
+		{
+			name: "GraphQL error",
+			respond: func(t *testing.T, server *testServer) {
+				conn, _ := server.acceptSubscription(t)
+				conn.sendErrors(t, "INVALID_FILTER")
+			},
+		},
+		{
+			name: "connection error",
+			respond: func(t *testing.T, server *testServer) {
+				conn, _ := server.accept(t)
+				conn.write(t, connectionErrorMsg, map[string]string{"message": "invalid API key"})
+			},
+		},
+		{
+			name: "subscription error",
+			respond: func(t *testing.T, server *testServer) {
+				conn, _ := server.acceptSubscription(t)
+				conn.write(t, errorMsg, []map[string]string{{"message": "test error"}})
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := newTestServer(t)
+			defer server.Close()
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			sub, err := New(server.URL).SubscribeToOrderEvents(ctx, make(chan []*OrderEvent))
+			require.NoError(t, err)
+			defer sub.Unsubscribe()
+
+			tc.respond(t, server)
+			subErr := receiveError(t, sub)
+			assert.True(t, subErr.Fatal, subErr.Error())
+			assertErrChanClosed(t, sub)
+		})
+	}
+}
+
+func TestSubscribeToOrderEventsUnauthorized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "invalid API key", http.StatusUnauthorized)
+	}))
+	defer server.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sub, err := New(server.URL, WithAPIKey("invalid-key")).SubscribeToOrderEvents(ctx, make(chan []*OrderEvent))
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	subErr := receiveError(t, sub)
+	assert.True(t, subErr.Fatal, "client errors should be fatal")
+	assert.Equal(t, subErr.Err, errors.Unwrap(subErr))
+	assertErrChanClosed(t, sub)
+}
+
+func TestWebsocketURL(t *testing.T) {
+	testCases := map[string]string{
+		"http://localhost:60557/graphql": "ws://localhost:60557/graphql",
+		"https://example.com/graphql":    "wss://example.com/graphql",
+		"ws://localhost:60557/graphql":   "ws://localhost:60557/graphql",
+	}
+	for httpURL, expectedURL := range testCases {
+		wsURL, err := websocketURL(httpURL)
+		require.NoError(t, err)
+		assert.Equal(t, expectedURL, wsURL)
+	}
+	_, err := websocketURL("ftp://localhost/graphql")
+	assert.Error(t, err)
+}
@@ -94,8 +94,10 @@ type Order struct {
 }
 
 type OrderEvent struct {
-	// The order that was affected.
+	// The order that was affected. Nil if the event is about a v4 order.
 	Order *OrderWithMetadata `json:"order"`
+	// The v4 order that was affected. Nil if the event is about a v3 order.
+	OrderV4 *OrderWithMetadataV4 `json:"orderv4"`
 	// A way of classifying the effect that the order event had on the order. You can
 	// think of different end states as different "types" of order events.
 	EndState OrderEndState `json:"endState"`
@@ -110,11 +112,18 @@ type OrderEvent struct {
 	// It is guaranteed that at least one of the events included here will have affected
 	// the order's state, but there may also be some false positives.
 	ContractEvents []*ContractEvent `json:"contractEvents"`
+	// The position of the event in the order event log. It can be used to resume
+	// a subscription without missing events. 0 if the event could not be added to
+	// the log.
+	SequenceNumber uint64 `json:"sequenceNumber"`
 }
 
 // A filter on orders. Can be used in queries to only return orders that meet certain criteria.
 type OrderFilter = gqltypes.OrderFilter
 
+// A filter on v4 orders.
+type OrderFilterV4 = gqltypes.OrderFilterV4
+
 // A sort ordering for orders. Can be used in queries to control the order in which results are returned.
 type OrderSort = gqltypes.OrderSort
 
@@ -196,25 +205,33 @@ type RejectedOrderResultV4 struct {
 
 // Contains configuration options and various stats for Mesh.
 type Stats struct {
-	Version                           string       `json:"version"`
-	PubSubTopic                       string       `json:"pubSubTopic"`
-	Rendezvous                        string       `json:"rendezvous"`
-	PeerID                            string       `json:"peerID"`
-	EthereumChainID                   int          `json:"ethereumChainID"`
-	LatestBlock                       *LatestBlock `json:"latestBlock"`
-	NumPeers                          int          `json:"numPeers"`
-	NumOrders                         int          `json:"numOrders"`
-	NumOrdersV4                       int          `json:"numOrdersV4"`
-	NumOrdersIncludingRemoved         int          `json:"numOrdersIncludingRemoved"`
-	NumOrdersIncludingRemovedV4       int          `json:"numOrdersIncludingRemovedV4"`
-	NumPinnedOrders                   int          `json:"numPinnedOrders"`
-	NumPinnedOrdersV4                 int          `json:"numPinnedOrdersV4"`
-	StartOfCurrentUTCDay              time.Time    `json:"startOfCurrentUTCDay"`
-	EthRPCRequestsSentInCurrentUTCDay int          `json:"ethRPCRequestsSentInCurrentUTCDay"`
-	EthRPCRateLimitExpiredRequests    int          `json:"ethRPCRateLimitExpiredRequests"`
-	MaxExpirationTime                 *big.Int     `json:"maxExpirationTime"`
+	Version                           string        `json:"version"`
+	PubSubTopic                       string        `json:"pubSubTopic"`
+	Rendezvous                        string        `json:"rendezvous"`
+	PeerID                            string        `json:"peerID"`
+	EthereumChainID                   int           `json:"ethereumChainID"`
+	LatestBlock                       *LatestBlock  `json:"latestBlock"`
+	NumPeers                          int           `json:"numPeers"`
+	NumOrders                         int           `json:"numOrders"`
+	NumOrdersV4                       int           `json:"numOrdersV4"`
+	NumOrdersIncludingRemoved         int           `json:"numOrdersIncludingRemoved"`
+	NumOrdersIncludingRemovedV4       int           `json:"numOrdersIncludingRemovedV4"`
+	NumPinnedOrders                   int           `json:"numPinnedOrders"`
+	NumPinnedOrdersV4                 int           `json:"numPinnedOrdersV4"`
+	StartOfCurrentUTCDay              time.Time     `json:"startOfCurrentUTCDay"`
+	EthRPCRequestsSentInCurrentUTCDay int           `json:"ethRPCRequestsSentInCurrentUTCDay"`
+	EthRPCRateLimitExpiredRequests    int           `json:"ethRPCRateLimitExpiredRequests"`
+	MaxExpirationTime                 *big.Int      `json:"maxExpirationTime"`
+	MaxOrdersPerMaker                 int           `json:"maxOrdersPerMaker"`
+	MaxOrdersPerPeer                  int           `json:"maxOrdersPerPeer"`
+	MakerQuotaUsage                   []*QuotaUsage `json:"makerQuotaUsage"`
+	PeerQuotaUsage                    []*QuotaUsage `json:"peerQuotaUsage"`
 }
 
+// QuotaUsage is the number of orders that count towards the storage quota of a
+// single maker or peer.
+type QuotaUsage = gqltypes.QuotaUsage
+
 // The kind of comparison to be used in a filter.
 type FilterKind = gqltypes.FilterKind
 
@@ -285,6 +302,31 @@ const (
 
 var AllOrderField = gqltypes.AllOrderField
 
+// An enum containing all the v4 order fields for which filters and/or sorting is supported.
+type OrderFieldV4 = gqltypes.OrderFieldV4
+
+const (
+	OrderFieldV4Hash                     OrderFieldV4 = "hash"
+	OrderFieldV4ChainID                  OrderFieldV4 = "chainId"
+	OrderFieldV4VerifyingContract        OrderFieldV4 = "verifyingContract"
+	OrderFieldV4MakerToken               OrderFieldV4 = "makerToken"
+	OrderFieldV4TakerToken               OrderFieldV4 = "takerToken"
+	OrderFieldV4MakerAmount              OrderFieldV4 = "makerAmount"
+	OrderFieldV4TakerAmount              OrderFieldV4 = "takerAmount"
+	OrderFieldV4TakerTokenFeeAmount      OrderFieldV4 = "takerTokenFeeAmount"
+	OrderFieldV4Maker                    OrderFieldV4 = "maker"
+	OrderFieldV4Taker                    OrderFieldV4 = "taker"
+	OrderFieldV4Sender                   OrderFieldV4 = "sender"
+	OrderFieldV4FeeRecipient             OrderFieldV4 = "feeRecipient"
+	OrderFieldV4Pool                     OrderFieldV4 = "pool"
+	OrderFieldV4Expiry                   OrderFieldV4 = "expiry"
+	OrderFieldV4Salt                     OrderFieldV4 = "salt"
+	OrderFieldV4Signature                OrderFieldV4 = "signature"
+	OrderFieldV4FillableTakerAssetAmount OrderFieldV4 = "fillableTakerAssetAmount"
+)
+
+var AllOrderFieldV4 = gqltypes.AllOrderFieldV4
+
 // A set of all possible codes included in RejectedOrderResult.
 type RejectedOrderCode = gqltypes.RejectedOrderCode
 
@@ -311,6 +353,8 @@ const (
 	RejectedOrderCodeDatabaseFullOfOrders             RejectedOrderCode = "DATABASE_FULL_OF_ORDERS"
 	RejectedOrderCodeTakerAddressNotAllowed           RejectedOrderCode = "TAKER_ADDRESS_NOT_ALLOWED"
 	RejectedOrderCodeInvalidSchema                    RejectedOrderCode = "INVALID_SCHEMA"
+	RejectedOrderCodeMakerQuotaExceeded               RejectedOrderCode = "MAKER_QUOTA_EXCEEDED"
+	RejectedOrderCodePeerQuotaExceeded                RejectedOrderCode = "PEER_QUOTA_EXCEEDED"
 )
 
 var AllRejectedOrderCode = gqltypes.AllRejectedOrderCode
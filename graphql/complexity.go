@@ -0,0 +1,101 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/0xProject/0x-mesh/graphql/generated"
+	"github.com/0xProject/0x-mesh/graphql/gqltypes"
+	"github.com/0xProject/0x-mesh/metrics"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// NewComplexityRoot returns a generated.ComplexityRoot which accounts for the
+// number of items returned by list queries. By default, gqlgen counts each
+// field once regardless of how many items are returned. Here the complexity of
+// a list is the complexity of a single item times the maximum number of items,
+// plus one for each sort field and item. The complexity of mutations which
+// accept a list of orders or hashes grows with the length of the list.
+func NewComplexityRoot() generated.ComplexityRoot {
+	var root generated.ComplexityRoot
+
+	root.Query.Orders = func(childComplexity int, sort []*gqltypes.OrderSort, filters []*gqltypes.OrderFilter, first *int, after *string) int {
+		return listComplexity(childComplexity, first, defaultPageSize, len(sort))
+	}
+	root.Query.Ordersv4 = func(childComplexity int, sort []*gqltypes.OrderSortV4, filters []*gqltypes.OrderFilterV4, first *int, after *string) int {
+		return listComplexity(childComplexity, first, defaultPageSize, len(sort))
+	}
+	root.Query.Orderbook = func(childComplexity int, baseToken string, quoteToken string, depth *int) int {
+		return listComplexity(childComplexity, depth, defaultOrderbookDepth, 0)
+	}
+	root.Query.OrderEventsSince = func(childComplexity int, sequenceNumber string, limit *int) int {
+		return listComplexity(childComplexity, limit, defaultOrderEventsPageSize, 0)
+	}
+	root.Query.ArchivedOrders = func(childComplexity int, hash *string, maker *string, endStates []gqltypes.OrderEndState, archivedAfter *string, archivedBefore *string, limit *int, offset *int) int {
+		return listComplexity(childComplexity, limit, defaultPageSize, 0)
+	}
+	root.Query.ValidateOrders = func(childComplexity int, orders []*gqltypes.NewOrder, pinned *bool) int {
+		return len(orders) * childComplexity
+	}
+	root.Query.ValidateOrdersV4 = func(childComplexity int, orders []*gqltypes.NewOrderV4, pinned *bool) int {
+		return len(orders) * childComplexity
+	}
+
+	root.Mutation.AddOrders = func(childComplexity int, orders []*gqltypes.NewOrder, pinned *bool, opts *gqltypes.AddOrdersOpts) int {
+		return len(orders) * childComplexity
+	}
+	root.Mutation.AddOrdersV4 = func(childComplexity int, orders []*gqltypes.NewOrderV4, pinned *bool, opts *gqltypes.AddOrdersOpts) int {
+		return len(orders) * childComplexity
+	}
+	root.Mutation.RemoveOrders = func(childComplexity int, hashes []string) int {
+		return len(hashes)
+	}
+	root.Mutation.RemoveOrdersV4 = func(childComplexity int, hashes []string) int {
+		return len(hashes)
+	}
+	root.Mutation.SetOrdersPinned = func(childComplexity int, hashes []string, pinned bool) int {
+		return len(hashes)
+	}
+	root.Mutation.SetOrdersPinnedV4 = func(childComplexity int, hashes []string, pinned bool) int {
+		return len(hashes)
+	}
+
+	return root
+}
+
+// listComplexity returns the complexity of a list query. A limit which is
+// explicitly set to null is charged as defaultLimit, since that is what the
+// resolver will return. Zero and negative limits are rejected by the resolver
+// (see parseLimit) and are only charged the complexity of a single item.
+func listComplexity(childComplexity int, limit *int, defaultLimit int, numSortFields int) int {
+	pageSize := defaultLimit
+	if limit != nil {
+		if *limit < 1 {
+			return childComplexity
+		}
+		pageSize = *limit
+	}
+	return pageSize * (childComplexity + numSortFields)
+}
+
+// NewComplexityLimit returns a gqlgen extension which rejects any operation
+// with a complexity greater than limit (see NewComplexityRoot). Rejected
+// operations are counted in metrics.GraphqlRequestsRejected.
+func NewComplexityLimit(limit int) graphql.HandlerExtension {
+	return complexityLimit{extension.FixedComplexityLimit(limit)}
+}
+
+type complexityLimit struct {
+	*extension.ComplexityLimit
+}
+
+var _ graphql.OperationContextMutator = complexityLimit{}
+
+func (c complexityLimit) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	if err := c.ComplexityLimit.MutateOperationContext(ctx, rc); err != nil {
+		metrics.GraphqlRequestsRejected.WithLabelValues(metrics.RejectionComplexity).Inc()
+		return err
+	}
+	return nil
+}
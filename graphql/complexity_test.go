@@ -0,0 +1,164 @@
+// +build !js
+
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/0xProject/0x-mesh/graphql/generated"
+	"github.com/0xProject/0x-mesh/metrics"
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/executor"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const errCodeComplexityLimitExceeded = "COMPLEXITY_LIMIT_EXCEEDED"
+
+func newTestExecutableSchema() graphql.ExecutableSchema {
+	return generated.NewExecutableSchema(generated.Config{
+		Resolvers:  NewResolver(nil, &ResolverConfig{}),
+		Complexity: NewComplexityRoot(),
+	})
+}
+
+func TestComplexityRoot(t *testing.T) {
+	schema := newTestExecutableSchema()
+	exec := executor.New(schema)
+
+	testCases := []struct {
+		query              string
+		expectedComplexity int
+	}{
+		{
+			// The default sort counts as one sort field.
+			query:              `{ orders(first: 10) { orders { hash } } }`,
+			expectedComplexity: 10 * (2 + 1),
+		},
+		{
+			query:              `{ orders(first: 10, sort: []) { orders { hash makerAddress } } }`,
+			expectedComplexity: 10 * 3,
+		},
+		{
+			// The default page size is 20.
+			query:              `{ orders(sort: []) { orders { hash } } }`,
+			expectedComplexity: 20 * 2,
+		},
+		{
+			// A page size of null is charged as the default page size.
+			query:              `{ orders(first: null, sort: []) { orders { hash } } }`,
+			expectedComplexity: 20 * 2,
+		},
+		{
+			query:              `{ ordersv4(first: 5, sort: []) { orders { hash } } }`,
+			expectedComplexity: 5 * 2,
+		},
+		{
+			query:              `mutation { removeOrders(hashes: ["0x1", "0x2", "0x3"]) }`,
+			expectedComplexity: 3,
+		},
+	}
+	for _, tc := range testCases {
+		opCtx, errs := createTestOperationContext(exec, context.Background(), tc.query)
+		require.Empty(t, errs, tc.query)
+		assert.Equal(t, tc.expectedComplexity, complexity.Calculate(schema, opCtx.Operation, opCtx.Variables), tc.query)
+	}
+}
+
+func TestListComplexity(t *testing.T) {
+	limit := func(limit int) *int { return &limit }
+	assert.Equal(t, 100, listComplexity(3, nil, 20, 2))
+	assert.Equal(t, 3, listComplexity(3, limit(0), 20, 2))
+	assert.Equal(t, 3, listComplexity(3, limit(-1), 20, 2))
+	assert.Equal(t, 50, listComplexity(3, limit(10), 20, 2))
+}
+
+func TestComplexityLimit(t *testing.T) {
+	exec := executor.New(newTestExecutableSchema())
+	exec.Use(NewComplexityLimit(100))
+
+	_, errs := createTestOperationContext(exec, context.Background(), `{ orders(first: 10) { orders { hash } } }`)
+	assert.Empty(t, errs)
+
+	numRejected := testutil.ToFloat64(metrics.GraphqlRequestsRejected.WithLabelValues(metrics.RejectionComplexity))
+	_, errs = createTestOperationContext(exec, context.Background(), `{ orders(first: 1000) { orders { hash } } }`)
+	assertErrorCode(t, errCodeComplexityLimitExceeded, errs)
+	assert.Equal(t, numRejected+1, testutil.ToFloat64(metrics.GraphqlRequestsRejected.WithLabelValues(metrics.RejectionComplexity)))
+
+	// A page size of null does not bypass the complexity limit.
+	_, errs = createTestOperationContext(exec, context.Background(), `{ orders(first: null) { orders { hash makerAddress takerAddress salt makerFee } } }`)
+	assertErrorCode(t, errCodeComplexityLimitExceeded, errs)
+}
+
+func TestInvalidLimits(t *testing.T) {
+	exec := executor.New(newTestExecutableSchema())
+	exec.Use(NewComplexityLimit(100))
+
+	// Zero and negative limits pass the complexity limit but are rejected by
+	// the resolvers instead of resulting in unbounded queries.
+	queries := []string{
+		`{ orders(first: 0) { orders { hash } } }`,
+		`{ orders(first: -1) { orders { hash } } }`,
+		`{ ordersv4(first: 0) { orders { hash } } }`,
+		`{ ordersv4(first: -1) { orders { hash } } }`,
+		`{ orderbook(baseToken: "0x0000000000000000000000000000000000000001", quoteToken: "0x0000000000000000000000000000000000000002", depth: -1) { bids { price } } }`,
+		`{ orderEventsSince(sequenceNumber: "0", limit: -1) { hasMore } }`,
+		`{ archivedOrders(limit: 0) { hash } }`,
+	}
+	for _, query := range queries {
+		ctx := graphql.StartOperationTrace(context.Background())
+		opCtx, errs := exec.CreateOperationContext(ctx, &graphql.RawParams{Query: query})
+		require.Empty(t, errs, query)
+		responses, ctx := exec.DispatchOperation(ctx, opCtx)
+		assertErrorCode(t, errCodeInvalidLimit, responses(ctx).Errors)
+	}
+}
+
+func TestAuthenticationBeforeComplexityLimit(t *testing.T) {
+	exec := executor.New(newTestExecutableSchema())
+	exec.Use(newTestAuthenticator())
+	exec.Use(NewComplexityLimit(100))
+
+	numRejectedComplexity := testutil.ToFloat64(metrics.GraphqlRequestsRejected.WithLabelValues(metrics.RejectionComplexity))
+	numRejectedUnauthorized := testutil.ToFloat64(metrics.GraphqlRequestsRejected.WithLabelValues(metrics.RejectionUnauthorized))
+
+	// Unauthenticated clients are rejected before their operations are parsed
+	// or their complexity is calculated.
+	unauthenticatedQueries := []string{
+		`{ orders(first: `,
+		`{ unknownField }`,
+		`{ orders(first: 1000) { orders { hash } } }`,
+	}
+	for _, query := range unauthenticatedQueries {
+		_, errs := createTestOperationContext(exec, context.Background(), query)
+		assertErrorCode(t, errCodeUnauthenticated, errs)
+	}
+
+	// Clients with read scope are not allowed to send mutations, regardless of
+	// their complexity.
+	readCtx := withAPIKey(context.Background(), testReadAPIKey, ScopeRead)
+	_, errs := createTestOperationContext(exec, readCtx, `mutation { removeOrders(hashes: ["0x1"]) }`)
+	assertErrorCode(t, errCodeForbidden, errs)
+
+	assert.Equal(t, numRejectedComplexity, testutil.ToFloat64(metrics.GraphqlRequestsRejected.WithLabelValues(metrics.RejectionComplexity)))
+	assert.Equal(t, numRejectedUnauthorized+float64(len(unauthenticatedQueries)+1), testutil.ToFloat64(metrics.GraphqlRequestsRejected.WithLabelValues(metrics.RejectionUnauthorized)))
+
+	// Authenticated clients are subject to the complexity limit.
+	_, errs = createTestOperationContext(exec, readCtx, `{ orders(first: 1000) { orders { hash } } }`)
+	assertErrorCode(t, errCodeComplexityLimitExceeded, errs)
+	_, errs = createTestOperationContext(exec, readCtx, `{ orders(first: 10) { orders { hash } } }`)
+	assert.Empty(t, errs)
+}
+
+func createTestOperationContext(exec *executor.Executor, ctx context.Context, query string) (*graphql.OperationContext, gqlerror.List) {
+	return exec.CreateOperationContext(graphql.StartOperationTrace(ctx), &graphql.RawParams{Query: query})
+}
+
+func assertErrorCode(t *testing.T, expectedCode string, errs gqlerror.List) {
+	require.Len(t, errs, 1)
+	assert.Equal(t, expectedCode, errs[0].Extensions["code"], errs[0].Message)
+}
@@ -2,6 +2,16 @@ package graphql
 
 const (
 	orderEventBufferSize = 100
+	// defaultOrderbookDepth is the number of price levels returned on each side
+	// of the orderbook when no depth is specified.
+	defaultOrderbookDepth = 20
+	// defaultPageSize is the number of orders returned by the orders, ordersv4
+	// and archivedOrders queries when no limit is specified.
+	defaultPageSize = 20
+	// defaultOrderEventsPageSize is the number of order events returned by the
+	// orderEventsSince query when no limit is specified. It is also the batch
+	// size used when replaying events for a resumed subscription.
+	defaultOrderEventsPageSize = 100
 )
 
 //go:generate gqlgen generate
@@ -65,6 +65,18 @@ type ComplexityRoot struct {
 		Rejected func(childComplexity int) int
 	}
 
+	ArchivedOrder struct {
+		ArchivedAt  func(childComplexity int) int
+		BlockHash   func(childComplexity int) int
+		BlockNumber func(childComplexity int) int
+		EndState    func(childComplexity int) int
+		Hash        func(childComplexity int) int
+		IsPinned    func(childComplexity int) int
+		Order       func(childComplexity int) int
+		Orderv4     func(childComplexity int) int
+		Timestamp   func(childComplexity int) int
+	}
+
 	ContractEvent struct {
 		Address    func(childComplexity int) int
 		BlockHash  func(childComplexity int) int
@@ -82,8 +94,16 @@ type ComplexityRoot struct {
 	}
 
 	Mutation struct {
-		AddOrders   func(childComplexity int, orders []*gqltypes.NewOrder, pinned *bool, opts *gqltypes.AddOrdersOpts) int
-		AddOrdersV4 func(childComplexity int, orders []*gqltypes.NewOrderV4, pinned *bool, opts *gqltypes.AddOrdersOpts) int
+		AddOrders         func(childComplexity int, orders []*gqltypes.NewOrder, pinned *bool, opts *gqltypes.AddOrdersOpts) int
+		AddOrdersV4       func(childComplexity int, orders []*gqltypes.NewOrderV4, pinned *bool, opts *gqltypes.AddOrdersOpts) int
+		BanPeer           func(childComplexity int, multiaddr string) int
+		ConnectPeer       func(childComplexity int, multiaddr string) int
+		DisconnectPeer    func(childComplexity int, id string) int
+		RemoveOrders      func(childComplexity int, hashes []string) int
+		RemoveOrdersV4    func(childComplexity int, hashes []string) int
+		SetOrdersPinned   func(childComplexity int, hashes []string, pinned bool) int
+		SetOrdersPinnedV4 func(childComplexity int, hashes []string, pinned bool) int
+		UnbanPeer         func(childComplexity int, multiaddr string) int
 	}
 
 	Order struct {
@@ -106,14 +126,34 @@ type ComplexityRoot struct {
 		TakerFeeAssetData     func(childComplexity int) int
 	}
 
+	OrderConnection struct {
+		Orders   func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
 	OrderEvent struct {
 		ContractEvents func(childComplexity int) int
 		EndState       func(childComplexity int) int
 		Order          func(childComplexity int) int
 		Orderv4        func(childComplexity int) int
+		SequenceNumber func(childComplexity int) int
 		Timestamp      func(childComplexity int) int
 	}
 
+	OrderEventPage struct {
+		Events             func(childComplexity int) int
+		HasMore            func(childComplexity int) int
+		LastSequenceNumber func(childComplexity int) int
+	}
+
+	OrderStats struct {
+		MakerCount                    func(childComplexity int) int
+		MaxExpirationTimeSeconds      func(childComplexity int) int
+		MinExpirationTimeSeconds      func(childComplexity int) int
+		OrderCount                    func(childComplexity int) int
+		TotalFillableTakerAssetAmount func(childComplexity int) int
+	}
+
 	OrderV4 struct {
 		ChainID             func(childComplexity int) int
 		Expiry              func(childComplexity int) int
@@ -135,6 +175,11 @@ type ComplexityRoot struct {
 		VerifyingContract   func(childComplexity int) int
 	}
 
+	OrderV4Connection struct {
+		Orders   func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
 	OrderV4WithMetadata struct {
 		ChainID                  func(childComplexity int) int
 		Expiry                   func(childComplexity int) int
@@ -180,12 +225,69 @@ type ComplexityRoot struct {
 		TakerFeeAssetData        func(childComplexity int) int
 	}
 
+	Orderbook struct {
+		Asks       func(childComplexity int) int
+		BaseToken  func(childComplexity int) int
+		Bids       func(childComplexity int) int
+		QuoteToken func(childComplexity int) int
+	}
+
+	OrderbookLevel struct {
+		BaseAmount  func(childComplexity int) int
+		OrderCount  func(childComplexity int) int
+		Price       func(childComplexity int) int
+		QuoteAmount func(childComplexity int) int
+	}
+
+	PageInfo struct {
+		EndCursor   func(childComplexity int) int
+		HasNextPage func(childComplexity int) int
+	}
+
+	Peer struct {
+		Bandwidth   func(childComplexity int) int
+		Connections func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Score       func(childComplexity int) int
+		Tags        func(childComplexity int) int
+	}
+
+	PeerBandwidth struct {
+		RateIn   func(childComplexity int) int
+		RateOut  func(childComplexity int) int
+		TotalIn  func(childComplexity int) int
+		TotalOut func(childComplexity int) int
+	}
+
+	PeerConnection struct {
+		Direction func(childComplexity int) int
+		Multiaddr func(childComplexity int) int
+	}
+
+	PeerTag struct {
+		Tag   func(childComplexity int) int
+		Value func(childComplexity int) int
+	}
+
 	Query struct {
-		Order    func(childComplexity int, hash string) int
-		Orders   func(childComplexity int, sort []*gqltypes.OrderSort, filters []*gqltypes.OrderFilter, limit *int) int
-		Ordersv4 func(childComplexity int, sort []*gqltypes.OrderSortV4, filters []*gqltypes.OrderFilterV4, limit *int) int
-		Orderv4  func(childComplexity int, hash string) int
-		Stats    func(childComplexity int) int
+		ArchivedOrders   func(childComplexity int, hash *string, maker *string, endStates []gqltypes.OrderEndState, archivedAfter *string, archivedBefore *string, limit *int, offset *int) int
+		Order            func(childComplexity int, hash string) int
+		OrderEventsSince func(childComplexity int, sequenceNumber string, limit *int) int
+		OrderStats       func(childComplexity int, filters []*gqltypes.OrderFilter) int
+		OrderStatsV4     func(childComplexity int, filters []*gqltypes.OrderFilterV4) int
+		Orderbook        func(childComplexity int, baseToken string, quoteToken string, depth *int) int
+		Orders           func(childComplexity int, sort []*gqltypes.OrderSort, filters []*gqltypes.OrderFilter, first *int, after *string) int
+		Ordersv4         func(childComplexity int, sort []*gqltypes.OrderSortV4, filters []*gqltypes.OrderFilterV4, first *int, after *string) int
+		Orderv4          func(childComplexity int, hash string) int
+		Peers            func(childComplexity int) int
+		Stats            func(childComplexity int) int
+		ValidateOrders   func(childComplexity int, orders []*gqltypes.NewOrder, pinned *bool) int
+		ValidateOrdersV4 func(childComplexity int, orders []*gqltypes.NewOrderV4, pinned *bool) int
+	}
+
+	QuotaUsage struct {
+		ID        func(childComplexity int) int
+		NumOrders func(childComplexity int) int
 	}
 
 	RejectedOrderResult struct {
@@ -207,7 +309,10 @@ type ComplexityRoot struct {
 		EthRPCRequestsSentInCurrentUTCDay func(childComplexity int) int
 		EthereumChainID                   func(childComplexity int) int
 		LatestBlock                       func(childComplexity int) int
+		MakerQuotaUsage                   func(childComplexity int) int
 		MaxExpirationTime                 func(childComplexity int) int
+		MaxOrdersPerMaker                 func(childComplexity int) int
+		MaxOrdersPerPeer                  func(childComplexity int) int
 		NumOrders                         func(childComplexity int) int
 		NumOrdersIncludingRemoved         func(childComplexity int) int
 		NumOrdersIncludingRemovedV4       func(childComplexity int) int
@@ -216,6 +321,7 @@ type ComplexityRoot struct {
 		NumPinnedOrders                   func(childComplexity int) int
 		NumPinnedOrdersV4                 func(childComplexity int) int
 		PeerID                            func(childComplexity int) int
+		PeerQuotaUsage                    func(childComplexity int) int
 		PubSubTopic                       func(childComplexity int) int
 		Rendezvous                        func(childComplexity int) int
 		SecondaryRendezvous               func(childComplexity int) int
@@ -224,23 +330,39 @@ type ComplexityRoot struct {
 	}
 
 	Subscription struct {
-		OrderEvents func(childComplexity int) int
+		OrderEvents func(childComplexity int, filters []*gqltypes.OrderFilter, filtersV4 []*gqltypes.OrderFilterV4, endStates []gqltypes.OrderEndState, since *string) int
 	}
 }
 
 type MutationResolver interface {
 	AddOrders(ctx context.Context, orders []*gqltypes.NewOrder, pinned *bool, opts *gqltypes.AddOrdersOpts) (*gqltypes.AddOrdersResults, error)
 	AddOrdersV4(ctx context.Context, orders []*gqltypes.NewOrderV4, pinned *bool, opts *gqltypes.AddOrdersOpts) (*gqltypes.AddOrdersResultsV4, error)
+	RemoveOrders(ctx context.Context, hashes []string) ([]string, error)
+	RemoveOrdersV4(ctx context.Context, hashes []string) ([]string, error)
+	SetOrdersPinned(ctx context.Context, hashes []string, pinned bool) ([]string, error)
+	SetOrdersPinnedV4(ctx context.Context, hashes []string, pinned bool) ([]string, error)
+	ConnectPeer(ctx context.Context, multiaddr string) (bool, error)
+	DisconnectPeer(ctx context.Context, id string) (bool, error)
+	BanPeer(ctx context.Context, multiaddr string) (bool, error)
+	UnbanPeer(ctx context.Context, multiaddr string) (bool, error)
 }
 type QueryResolver interface {
 	Order(ctx context.Context, hash string) (*gqltypes.OrderWithMetadata, error)
 	Orderv4(ctx context.Context, hash string) (*gqltypes.OrderV4WithMetadata, error)
-	Orders(ctx context.Context, sort []*gqltypes.OrderSort, filters []*gqltypes.OrderFilter, limit *int) ([]*gqltypes.OrderWithMetadata, error)
-	Ordersv4(ctx context.Context, sort []*gqltypes.OrderSortV4, filters []*gqltypes.OrderFilterV4, limit *int) ([]*gqltypes.OrderV4WithMetadata, error)
+	Orders(ctx context.Context, sort []*gqltypes.OrderSort, filters []*gqltypes.OrderFilter, first *int, after *string) (*gqltypes.OrderConnection, error)
+	Ordersv4(ctx context.Context, sort []*gqltypes.OrderSortV4, filters []*gqltypes.OrderFilterV4, first *int, after *string) (*gqltypes.OrderV4Connection, error)
+	OrderStats(ctx context.Context, filters []*gqltypes.OrderFilter) (*gqltypes.OrderStats, error)
+	OrderStatsV4(ctx context.Context, filters []*gqltypes.OrderFilterV4) (*gqltypes.OrderStats, error)
+	Orderbook(ctx context.Context, baseToken string, quoteToken string, depth *int) (*gqltypes.Orderbook, error)
+	OrderEventsSince(ctx context.Context, sequenceNumber string, limit *int) (*gqltypes.OrderEventPage, error)
+	ArchivedOrders(ctx context.Context, hash *string, maker *string, endStates []gqltypes.OrderEndState, archivedAfter *string, archivedBefore *string, limit *int, offset *int) ([]*gqltypes.ArchivedOrder, error)
+	ValidateOrders(ctx context.Context, orders []*gqltypes.NewOrder, pinned *bool) (*gqltypes.AddOrdersResults, error)
+	ValidateOrdersV4(ctx context.Context, orders []*gqltypes.NewOrderV4, pinned *bool) (*gqltypes.AddOrdersResultsV4, error)
 	Stats(ctx context.Context) (*gqltypes.Stats, error)
+	Peers(ctx context.Context) ([]*gqltypes.Peer, error)
 }
 type SubscriptionResolver interface {
-	OrderEvents(ctx context.Context) (<-chan []*gqltypes.OrderEvent, error)
+	OrderEvents(ctx context.Context, filters []*gqltypes.OrderFilter, filtersV4 []*gqltypes.OrderFilterV4, endStates []gqltypes.OrderEndState, since *string) (<-chan []*gqltypes.OrderEvent, error)
 }
 
 type executableSchema struct {
@@ -314,6 +436,69 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AddOrdersResultsV4.Rejected(childComplexity), true
 
+	case "ArchivedOrder.archivedAt":
+		if e.complexity.ArchivedOrder.ArchivedAt == nil {
+			break
+		}
+
+		return e.complexity.ArchivedOrder.ArchivedAt(childComplexity), true
+
+	case "ArchivedOrder.blockHash":
+		if e.complexity.ArchivedOrder.BlockHash == nil {
+			break
+		}
+
+		return e.complexity.ArchivedOrder.BlockHash(childComplexity), true
+
+	case "ArchivedOrder.blockNumber":
+		if e.complexity.ArchivedOrder.BlockNumber == nil {
+			break
+		}
+
+		return e.complexity.ArchivedOrder.BlockNumber(childComplexity), true
+
+	case "ArchivedOrder.endState":
+		if e.complexity.ArchivedOrder.EndState == nil {
+			break
+		}
+
+		return e.complexity.ArchivedOrder.EndState(childComplexity), true
+
+	case "ArchivedOrder.hash":
+		if e.complexity.ArchivedOrder.Hash == nil {
+			break
+		}
+
+		return e.complexity.ArchivedOrder.Hash(childComplexity), true
+
+	case "ArchivedOrder.isPinned":
+		if e.complexity.ArchivedOrder.IsPinned == nil {
+			break
+		}
+
+		return e.complexity.ArchivedOrder.IsPinned(childComplexity), true
+
+	case "ArchivedOrder.order":
+		if e.complexity.ArchivedOrder.Order == nil {
+			break
+		}
+
+		return e.complexity.ArchivedOrder.Order(childComplexity), true
+
+	case "ArchivedOrder.orderv4":
+		if e.complexity.ArchivedOrder.Orderv4 == nil {
+			break
+		}
+
+		return e.complexity.ArchivedOrder.Orderv4(childComplexity), true
+
+	case "ArchivedOrder.timestamp":
+		if e.complexity.ArchivedOrder.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.ArchivedOrder.Timestamp(childComplexity), true
+
 	case "ContractEvent.address":
 		if e.complexity.ContractEvent.Address == nil {
 			break
@@ -408,6 +593,102 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.AddOrdersV4(childComplexity, args["orders"].([]*gqltypes.NewOrderV4), args["pinned"].(*bool), args["opts"].(*gqltypes.AddOrdersOpts)), true
 
+	case "Mutation.banPeer":
+		if e.complexity.Mutation.BanPeer == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_banPeer_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.BanPeer(childComplexity, args["multiaddr"].(string)), true
+
+	case "Mutation.connectPeer":
+		if e.complexity.Mutation.ConnectPeer == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_connectPeer_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ConnectPeer(childComplexity, args["multiaddr"].(string)), true
+
+	case "Mutation.disconnectPeer":
+		if e.complexity.Mutation.DisconnectPeer == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_disconnectPeer_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DisconnectPeer(childComplexity, args["id"].(string)), true
+
+	case "Mutation.removeOrders":
+		if e.complexity.Mutation.RemoveOrders == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_removeOrders_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RemoveOrders(childComplexity, args["hashes"].([]string)), true
+
+	case "Mutation.removeOrdersV4":
+		if e.complexity.Mutation.RemoveOrdersV4 == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_removeOrdersV4_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RemoveOrdersV4(childComplexity, args["hashes"].([]string)), true
+
+	case "Mutation.setOrdersPinned":
+		if e.complexity.Mutation.SetOrdersPinned == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setOrdersPinned_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetOrdersPinned(childComplexity, args["hashes"].([]string), args["pinned"].(bool)), true
+
+	case "Mutation.setOrdersPinnedV4":
+		if e.complexity.Mutation.SetOrdersPinnedV4 == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setOrdersPinnedV4_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetOrdersPinnedV4(childComplexity, args["hashes"].([]string), args["pinned"].(bool)), true
+
+	case "Mutation.unbanPeer":
+		if e.complexity.Mutation.UnbanPeer == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_unbanPeer_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UnbanPeer(childComplexity, args["multiaddr"].(string)), true
+
 	case "Order.chainId":
 		if e.complexity.Order.ChainID == nil {
 			break
@@ -527,6 +808,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Order.TakerFeeAssetData(childComplexity), true
 
+	case "OrderConnection.orders":
+		if e.complexity.OrderConnection.Orders == nil {
+			break
+		}
+
+		return e.complexity.OrderConnection.Orders(childComplexity), true
+
+	case "OrderConnection.pageInfo":
+		if e.complexity.OrderConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.OrderConnection.PageInfo(childComplexity), true
+
 	case "OrderEvent.contractEvents":
 		if e.complexity.OrderEvent.ContractEvents == nil {
 			break
@@ -555,6 +850,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.OrderEvent.Orderv4(childComplexity), true
 
+	case "OrderEvent.sequenceNumber":
+		if e.complexity.OrderEvent.SequenceNumber == nil {
+			break
+		}
+
+		return e.complexity.OrderEvent.SequenceNumber(childComplexity), true
+
 	case "OrderEvent.timestamp":
 		if e.complexity.OrderEvent.Timestamp == nil {
 			break
@@ -562,6 +864,62 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.OrderEvent.Timestamp(childComplexity), true
 
+	case "OrderEventPage.events":
+		if e.complexity.OrderEventPage.Events == nil {
+			break
+		}
+
+		return e.complexity.OrderEventPage.Events(childComplexity), true
+
+	case "OrderEventPage.hasMore":
+		if e.complexity.OrderEventPage.HasMore == nil {
+			break
+		}
+
+		return e.complexity.OrderEventPage.HasMore(childComplexity), true
+
+	case "OrderEventPage.lastSequenceNumber":
+		if e.complexity.OrderEventPage.LastSequenceNumber == nil {
+			break
+		}
+
+		return e.complexity.OrderEventPage.LastSequenceNumber(childComplexity), true
+
+	case "OrderStats.makerCount":
+		if e.complexity.OrderStats.MakerCount == nil {
+			break
+		}
+
+		return e.complexity.OrderStats.MakerCount(childComplexity), true
+
+	case "OrderStats.maxExpirationTimeSeconds":
+		if e.complexity.OrderStats.MaxExpirationTimeSeconds == nil {
+			break
+		}
+
+		return e.complexity.OrderStats.MaxExpirationTimeSeconds(childComplexity), true
+
+	case "OrderStats.minExpirationTimeSeconds":
+		if e.complexity.OrderStats.MinExpirationTimeSeconds == nil {
+			break
+		}
+
+		return e.complexity.OrderStats.MinExpirationTimeSeconds(childComplexity), true
+
+	case "OrderStats.orderCount":
+		if e.complexity.OrderStats.OrderCount == nil {
+			break
+		}
+
+		return e.complexity.OrderStats.OrderCount(childComplexity), true
+
+	case "OrderStats.totalFillableTakerAssetAmount":
+		if e.complexity.OrderStats.TotalFillableTakerAssetAmount == nil {
+			break
+		}
+
+		return e.complexity.OrderStats.TotalFillableTakerAssetAmount(childComplexity), true
+
 	case "OrderV4.chainId":
 		if e.complexity.OrderV4.ChainID == nil {
 			break
@@ -688,6 +1046,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.OrderV4.VerifyingContract(childComplexity), true
 
+	case "OrderV4Connection.orders":
+		if e.complexity.OrderV4Connection.Orders == nil {
+			break
+		}
+
+		return e.complexity.OrderV4Connection.Orders(childComplexity), true
+
+	case "OrderV4Connection.pageInfo":
+		if e.complexity.OrderV4Connection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.OrderV4Connection.PageInfo(childComplexity), true
+
 	case "OrderV4WithMetadata.chainId":
 		if e.complexity.OrderV4WithMetadata.ChainID == nil {
 			break
@@ -961,168 +1333,455 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.OrderWithMetadata.TakerFeeAssetData(childComplexity), true
 
-	case "Query.order":
-		if e.complexity.Query.Order == nil {
+	case "Orderbook.asks":
+		if e.complexity.Orderbook.Asks == nil {
 			break
 		}
 
-		args, err := ec.field_Query_order_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
-		}
-
-		return e.complexity.Query.Order(childComplexity, args["hash"].(string)), true
+		return e.complexity.Orderbook.Asks(childComplexity), true
 
-	case "Query.orders":
-		if e.complexity.Query.Orders == nil {
+	case "Orderbook.baseToken":
+		if e.complexity.Orderbook.BaseToken == nil {
 			break
 		}
 
-		args, err := ec.field_Query_orders_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Orderbook.BaseToken(childComplexity), true
+
+	case "Orderbook.bids":
+		if e.complexity.Orderbook.Bids == nil {
+			break
 		}
 
-		return e.complexity.Query.Orders(childComplexity, args["sort"].([]*gqltypes.OrderSort), args["filters"].([]*gqltypes.OrderFilter), args["limit"].(*int)), true
+		return e.complexity.Orderbook.Bids(childComplexity), true
 
-	case "Query.ordersv4":
-		if e.complexity.Query.Ordersv4 == nil {
+	case "Orderbook.quoteToken":
+		if e.complexity.Orderbook.QuoteToken == nil {
 			break
 		}
 
-		args, err := ec.field_Query_ordersv4_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Orderbook.QuoteToken(childComplexity), true
+
+	case "OrderbookLevel.baseAmount":
+		if e.complexity.OrderbookLevel.BaseAmount == nil {
+			break
 		}
 
-		return e.complexity.Query.Ordersv4(childComplexity, args["sort"].([]*gqltypes.OrderSortV4), args["filters"].([]*gqltypes.OrderFilterV4), args["limit"].(*int)), true
+		return e.complexity.OrderbookLevel.BaseAmount(childComplexity), true
 
-	case "Query.orderv4":
-		if e.complexity.Query.Orderv4 == nil {
+	case "OrderbookLevel.orderCount":
+		if e.complexity.OrderbookLevel.OrderCount == nil {
 			break
 		}
 
-		args, err := ec.field_Query_orderv4_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.OrderbookLevel.OrderCount(childComplexity), true
+
+	case "OrderbookLevel.price":
+		if e.complexity.OrderbookLevel.Price == nil {
+			break
 		}
 
-		return e.complexity.Query.Orderv4(childComplexity, args["hash"].(string)), true
+		return e.complexity.OrderbookLevel.Price(childComplexity), true
 
-	case "Query.stats":
-		if e.complexity.Query.Stats == nil {
+	case "OrderbookLevel.quoteAmount":
+		if e.complexity.OrderbookLevel.QuoteAmount == nil {
 			break
 		}
 
-		return e.complexity.Query.Stats(childComplexity), true
+		return e.complexity.OrderbookLevel.QuoteAmount(childComplexity), true
 
-	case "RejectedOrderResult.code":
-		if e.complexity.RejectedOrderResult.Code == nil {
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
 			break
 		}
 
-		return e.complexity.RejectedOrderResult.Code(childComplexity), true
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
 
-	case "RejectedOrderResult.hash":
-		if e.complexity.RejectedOrderResult.Hash == nil {
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
 			break
 		}
 
-		return e.complexity.RejectedOrderResult.Hash(childComplexity), true
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
 
-	case "RejectedOrderResult.message":
-		if e.complexity.RejectedOrderResult.Message == nil {
+	case "Peer.bandwidth":
+		if e.complexity.Peer.Bandwidth == nil {
 			break
 		}
 
-		return e.complexity.RejectedOrderResult.Message(childComplexity), true
+		return e.complexity.Peer.Bandwidth(childComplexity), true
 
-	case "RejectedOrderResult.order":
-		if e.complexity.RejectedOrderResult.Order == nil {
+	case "Peer.connections":
+		if e.complexity.Peer.Connections == nil {
 			break
 		}
 
-		return e.complexity.RejectedOrderResult.Order(childComplexity), true
+		return e.complexity.Peer.Connections(childComplexity), true
 
-	case "RejectedOrderResultV4.code":
-		if e.complexity.RejectedOrderResultV4.Code == nil {
+	case "Peer.id":
+		if e.complexity.Peer.ID == nil {
 			break
 		}
 
-		return e.complexity.RejectedOrderResultV4.Code(childComplexity), true
+		return e.complexity.Peer.ID(childComplexity), true
 
-	case "RejectedOrderResultV4.hash":
-		if e.complexity.RejectedOrderResultV4.Hash == nil {
+	case "Peer.score":
+		if e.complexity.Peer.Score == nil {
 			break
 		}
 
-		return e.complexity.RejectedOrderResultV4.Hash(childComplexity), true
+		return e.complexity.Peer.Score(childComplexity), true
 
-	case "RejectedOrderResultV4.message":
-		if e.complexity.RejectedOrderResultV4.Message == nil {
+	case "Peer.tags":
+		if e.complexity.Peer.Tags == nil {
 			break
 		}
 
-		return e.complexity.RejectedOrderResultV4.Message(childComplexity), true
+		return e.complexity.Peer.Tags(childComplexity), true
 
-	case "RejectedOrderResultV4.order":
-		if e.complexity.RejectedOrderResultV4.Order == nil {
+	case "PeerBandwidth.rateIn":
+		if e.complexity.PeerBandwidth.RateIn == nil {
 			break
 		}
 
-		return e.complexity.RejectedOrderResultV4.Order(childComplexity), true
+		return e.complexity.PeerBandwidth.RateIn(childComplexity), true
 
-	case "Stats.ethRPCRateLimitExpiredRequests":
-		if e.complexity.Stats.EthRPCRateLimitExpiredRequests == nil {
+	case "PeerBandwidth.rateOut":
+		if e.complexity.PeerBandwidth.RateOut == nil {
 			break
 		}
 
-		return e.complexity.Stats.EthRPCRateLimitExpiredRequests(childComplexity), true
+		return e.complexity.PeerBandwidth.RateOut(childComplexity), true
 
-	case "Stats.ethRPCRequestsSentInCurrentUTCDay":
-		if e.complexity.Stats.EthRPCRequestsSentInCurrentUTCDay == nil {
+	case "PeerBandwidth.totalIn":
+		if e.complexity.PeerBandwidth.TotalIn == nil {
 			break
 		}
 
-		return e.complexity.Stats.EthRPCRequestsSentInCurrentUTCDay(childComplexity), true
+		return e.complexity.PeerBandwidth.TotalIn(childComplexity), true
 
-	case "Stats.ethereumChainID":
-		if e.complexity.Stats.EthereumChainID == nil {
+	case "PeerBandwidth.totalOut":
+		if e.complexity.PeerBandwidth.TotalOut == nil {
 			break
 		}
 
-		return e.complexity.Stats.EthereumChainID(childComplexity), true
+		return e.complexity.PeerBandwidth.TotalOut(childComplexity), true
 
-	case "Stats.latestBlock":
-		if e.complexity.Stats.LatestBlock == nil {
+	case "PeerConnection.direction":
+		if e.complexity.PeerConnection.Direction == nil {
 			break
 		}
 
-		return e.complexity.Stats.LatestBlock(childComplexity), true
+		return e.complexity.PeerConnection.Direction(childComplexity), true
 
-	case "Stats.maxExpirationTime":
-		if e.complexity.Stats.MaxExpirationTime == nil {
+	case "PeerConnection.multiaddr":
+		if e.complexity.PeerConnection.Multiaddr == nil {
 			break
 		}
 
-		return e.complexity.Stats.MaxExpirationTime(childComplexity), true
+		return e.complexity.PeerConnection.Multiaddr(childComplexity), true
 
-	case "Stats.numOrders":
-		if e.complexity.Stats.NumOrders == nil {
+	case "PeerTag.tag":
+		if e.complexity.PeerTag.Tag == nil {
 			break
 		}
 
-		return e.complexity.Stats.NumOrders(childComplexity), true
+		return e.complexity.PeerTag.Tag(childComplexity), true
 
-	case "Stats.numOrdersIncludingRemoved":
-		if e.complexity.Stats.NumOrdersIncludingRemoved == nil {
+	case "PeerTag.value":
+		if e.complexity.PeerTag.Value == nil {
 			break
 		}
 
-		return e.complexity.Stats.NumOrdersIncludingRemoved(childComplexity), true
+		return e.complexity.PeerTag.Value(childComplexity), true
 
-	case "Stats.numOrdersIncludingRemovedV4":
-		if e.complexity.Stats.NumOrdersIncludingRemovedV4 == nil {
+	case "Query.archivedOrders":
+		if e.complexity.Query.ArchivedOrders == nil {
+			break
+		}
+
+		args, err := ec.field_Query_archivedOrders_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ArchivedOrders(childComplexity, args["hash"].(*string), args["maker"].(*string), args["endStates"].([]gqltypes.OrderEndState), args["archivedAfter"].(*string), args["archivedBefore"].(*string), args["limit"].(*int), args["offset"].(*int)), true
+
+	case "Query.order":
+		if e.complexity.Query.Order == nil {
+			break
+		}
+
+		args, err := ec.field_Query_order_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Order(childComplexity, args["hash"].(string)), true
+
+	case "Query.orderEventsSince":
+		if e.complexity.Query.OrderEventsSince == nil {
+			break
+		}
+
+		args, err := ec.field_Query_orderEventsSince_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.OrderEventsSince(childComplexity, args["sequenceNumber"].(string), args["limit"].(*int)), true
+
+	case "Query.orderStats":
+		if e.complexity.Query.OrderStats == nil {
+			break
+		}
+
+		args, err := ec.field_Query_orderStats_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.OrderStats(childComplexity, args["filters"].([]*gqltypes.OrderFilter)), true
+
+	case "Query.orderStatsV4":
+		if e.complexity.Query.OrderStatsV4 == nil {
+			break
+		}
+
+		args, err := ec.field_Query_orderStatsV4_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.OrderStatsV4(childComplexity, args["filters"].([]*gqltypes.OrderFilterV4)), true
+
+	case "Query.orderbook":
+		if e.complexity.Query.Orderbook == nil {
+			break
+		}
+
+		args, err := ec.field_Query_orderbook_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Orderbook(childComplexity, args["baseToken"].(string), args["quoteToken"].(string), args["depth"].(*int)), true
+
+	case "Query.orders":
+		if e.complexity.Query.Orders == nil {
+			break
+		}
+
+		args, err := ec.field_Query_orders_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Orders(childComplexity, args["sort"].([]*gqltypes.OrderSort), args["filters"].([]*gqltypes.OrderFilter), args["first"].(*int), args["after"].(*string)), true
+
+	case "Query.ordersv4":
+		if e.complexity.Query.Ordersv4 == nil {
+			break
+		}
+
+		args, err := ec.field_Query_ordersv4_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Ordersv4(childComplexity, args["sort"].([]*gqltypes.OrderSortV4), args["filters"].([]*gqltypes.OrderFilterV4), args["first"].(*int), args["after"].(*string)), true
+
+	case "Query.orderv4":
+		if e.complexity.Query.Orderv4 == nil {
+			break
+		}
+
+		args, err := ec.field_Query_orderv4_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Orderv4(childComplexity, args["hash"].(string)), true
+
+	case "Query.peers":
+		if e.complexity.Query.Peers == nil {
+			break
+		}
+
+		return e.complexity.Query.Peers(childComplexity), true
+
+	case "Query.stats":
+		if e.complexity.Query.Stats == nil {
+			break
+		}
+
+		return e.complexity.Query.Stats(childComplexity), true
+
+	case "Query.validateOrders":
+		if e.complexity.Query.ValidateOrders == nil {
+			break
+		}
+
+		args, err := ec.field_Query_validateOrders_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ValidateOrders(childComplexity, args["orders"].([]*gqltypes.NewOrder), args["pinned"].(*bool)), true
+
+	case "Query.validateOrdersV4":
+		if e.complexity.Query.ValidateOrdersV4 == nil {
+			break
+		}
+
+		args, err := ec.field_Query_validateOrdersV4_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ValidateOrdersV4(childComplexity, args["orders"].([]*gqltypes.NewOrderV4), args["pinned"].(*bool)), true
+
+	case "QuotaUsage.id":
+		if e.complexity.QuotaUsage.ID == nil {
+			break
+		}
+
+		return e.complexity.QuotaUsage.ID(childComplexity), true
+
+	case "QuotaUsage.numOrders":
+		if e.complexity.QuotaUsage.NumOrders == nil {
+			break
+		}
+
+		return e.complexity.QuotaUsage.NumOrders(childComplexity), true
+
+	case "RejectedOrderResult.code":
+		if e.complexity.RejectedOrderResult.Code == nil {
+			break
+		}
+
+		return e.complexity.RejectedOrderResult.Code(childComplexity), true
+
+	case "RejectedOrderResult.hash":
+		if e.complexity.RejectedOrderResult.Hash == nil {
+			break
+		}
+
+		return e.complexity.RejectedOrderResult.Hash(childComplexity), true
+
+	case "RejectedOrderResult.message":
+		if e.complexity.RejectedOrderResult.Message == nil {
+			break
+		}
+
+		return e.complexity.RejectedOrderResult.Message(childComplexity), true
+
+	case "RejectedOrderResult.order":
+		if e.complexity.RejectedOrderResult.Order == nil {
+			break
+		}
+
+		return e.complexity.RejectedOrderResult.Order(childComplexity), true
+
+	case "RejectedOrderResultV4.code":
+		if e.complexity.RejectedOrderResultV4.Code == nil {
+			break
+		}
+
+		return e.complexity.RejectedOrderResultV4.Code(childComplexity), true
+
+	case "RejectedOrderResultV4.hash":
+		if e.complexity.RejectedOrderResultV4.Hash == nil {
+			break
+		}
+
+		return e.complexity.RejectedOrderResultV4.Hash(childComplexity), true
+
+	case "RejectedOrderResultV4.message":
+		if e.complexity.RejectedOrderResultV4.Message == nil {
+			break
+		}
+
+		return e.complexity.RejectedOrderResultV4.Message(childComplexity), true
+
+	case "RejectedOrderResultV4.order":
+		if e.complexity.RejectedOrderResultV4.Order == nil {
+			break
+		}
+
+		return e.complexity.RejectedOrderResultV4.Order(childComplexity), true
+
+	case "Stats.ethRPCRateLimitExpiredRequests":
+		if e.complexity.Stats.EthRPCRateLimitExpiredRequests == nil {
+			break
+		}
+
+		return e.complexity.Stats.EthRPCRateLimitExpiredRequests(childComplexity), true
+
+	case "Stats.ethRPCRequestsSentInCurrentUTCDay":
+		if e.complexity.Stats.EthRPCRequestsSentInCurrentUTCDay == nil {
+			break
+		}
+
+		return e.complexity.Stats.EthRPCRequestsSentInCurrentUTCDay(childComplexity), true
+
+	case "Stats.ethereumChainID":
+		if e.complexity.Stats.EthereumChainID == nil {
+			break
+		}
+
+		return e.complexity.Stats.EthereumChainID(childComplexity), true
+
+	case "Stats.latestBlock":
+		if e.complexity.Stats.LatestBlock == nil {
+			break
+		}
+
+		return e.complexity.Stats.LatestBlock(childComplexity), true
+
+	case "Stats.makerQuotaUsage":
+		if e.complexity.Stats.MakerQuotaUsage == nil {
+			break
+		}
+
+		return e.complexity.Stats.MakerQuotaUsage(childComplexity), true
+
+	case "Stats.maxExpirationTime":
+		if e.complexity.Stats.MaxExpirationTime == nil {
+			break
+		}
+
+		return e.complexity.Stats.MaxExpirationTime(childComplexity), true
+
+	case "Stats.maxOrdersPerMaker":
+		if e.complexity.Stats.MaxOrdersPerMaker == nil {
+			break
+		}
+
+		return e.complexity.Stats.MaxOrdersPerMaker(childComplexity), true
+
+	case "Stats.maxOrdersPerPeer":
+		if e.complexity.Stats.MaxOrdersPerPeer == nil {
+			break
+		}
+
+		return e.complexity.Stats.MaxOrdersPerPeer(childComplexity), true
+
+	case "Stats.numOrders":
+		if e.complexity.Stats.NumOrders == nil {
+			break
+		}
+
+		return e.complexity.Stats.NumOrders(childComplexity), true
+
+	case "Stats.numOrdersIncludingRemoved":
+		if e.complexity.Stats.NumOrdersIncludingRemoved == nil {
+			break
+		}
+
+		return e.complexity.Stats.NumOrdersIncludingRemoved(childComplexity), true
+
+	case "Stats.numOrdersIncludingRemovedV4":
+		if e.complexity.Stats.NumOrdersIncludingRemovedV4 == nil {
 			break
 		}
 
@@ -1163,6 +1822,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Stats.PeerID(childComplexity), true
 
+	case "Stats.peerQuotaUsage":
+		if e.complexity.Stats.PeerQuotaUsage == nil {
+			break
+		}
+
+		return e.complexity.Stats.PeerQuotaUsage(childComplexity), true
+
 	case "Stats.pubSubTopic":
 		if e.complexity.Stats.PubSubTopic == nil {
 			break
@@ -1203,7 +1869,12 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			break
 		}
 
-		return e.complexity.Subscription.OrderEvents(childComplexity), true
+		args, err := ec.field_Subscription_orderEvents_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.OrderEvents(childComplexity, args["filters"].([]*gqltypes.OrderFilter), args["filtersV4"].([]*gqltypes.OrderFilterV4), args["endStates"].([]gqltypes.OrderEndState), args["since"].(*string)), true
 
 	}
 	return 0, false
@@ -1367,18 +2038,50 @@ enum OrderField {
     expirationTimeSeconds
     salt
     fillableTakerAssetAmount
-}
-
-"""
-The kind of comparison to be used in a filter.
-"""
-enum FilterKind {
-    EQUAL
-    NOT_EQUAL
+    """
+    The address of any token in makerAssetData, including the components of MultiAsset and ERC1155 asset data. Can only be used in EQUAL and IN filters, not for sorting.
+    """
+    makerToken
+    """
+    The ID of any ERC721 or ERC1155 token in makerAssetData. If used together with a makerToken filter in the same list of filters, both must match the same component of makerAssetData. Can only be used in EQUAL and IN filters, not for sorting.
+    """
+    makerTokenId
+    """
+    Same as makerToken but for takerAssetData.
+    """
+    takerToken
+    """
+    Same as makerTokenId but for takerAssetData.
+    """
+    takerTokenId
+    """
+    Same as makerToken but for makerFeeAssetData.
+    """
+    makerFeeToken
+    """
+    Same as makerToken but for takerFeeAssetData.
+    """
+    takerFeeToken
+}
+
+"""
+The kind of comparison to be used in a filter.
+"""
+enum FilterKind {
+    EQUAL
+    NOT_EQUAL
     GREATER
     GREATER_OR_EQUAL
     LESS
     LESS_OR_EQUAL
+    """
+    Matches if the field is equal to any of the values in the filter value, which must be a list.
+    """
+    IN
+    """
+    Matches if all of the filters in at least one of the filter groups match. The field and value of the filter are ignored.
+    """
+    OR
 }
 
 """
@@ -1393,12 +2096,19 @@ enum SortDirection {
 A filter on orders. Can be used in queries to only return orders that meet certain criteria.
 """
 input OrderFilter {
-    field: OrderField!
+    """
+    field is required unless kind is OR.
+    """
+    field: OrderField
     kind: FilterKind!
     """
-    value must match the type of the filter field.
+    value must match the type of the filter field. For IN filters, it must be a list of such values. It is required unless kind is OR.
+    """
+    value: Any
     """
-    value: Any!
+    groups is only used by OR filters. Filters within a group are ANDed together and groups can be nested.
+    """
+    groups: [[OrderFilter!]!]
 }
 
 """
@@ -1501,12 +2211,19 @@ enum OrderFieldV4 {
 A filter on v4 orders. Can be used in queries to only return orders that meet certain criteria.
 """
 input OrderFilterV4 {
-    field: OrderFieldV4!
+    """
+    field is required unless kind is OR.
+    """
+    field: OrderFieldV4
     kind: FilterKind!
     """
-    value must match the type of the filter field.
+    value must match the type of the filter field. For IN filters, it must be a list of such values. It is required unless kind is OR.
+    """
+    value: Any
+    """
+    groups is only used by OR filters. Filters within a group are ANDed together and groups can be nested.
     """
-    value: Any!
+    groups: [[OrderFilterV4!]!]
 }
 
 
@@ -1550,6 +2267,240 @@ type Stats {
     Any order with an expiration time greater than this maximum will be rejected by Mesh.
     """
     maxExpirationTime: String!
+    """
+    The maximum number of orders that are stored for a single maker. Pinned orders don't count towards
+    this quota. A value of 0 means that there is no quota.
+    """
+    maxOrdersPerMaker: Int!
+    """
+    The maximum number of orders that are stored from a single peer. Pinned orders don't count towards
+    this quota. A value of 0 means that there is no quota.
+    """
+    maxOrdersPerPeer: Int!
+    """
+    The makers with the most orders that count towards their storage quota, sorted by the number of orders in
+    descending order.
+    """
+    makerQuotaUsage: [QuotaUsage!]!
+    """
+    The peers with the most orders that count towards their storage quota, sorted by the number of orders in
+    descending order.
+    """
+    peerQuotaUsage: [QuotaUsage!]!
+}
+
+"""
+The number of orders that count towards the storage quota of a single maker or peer.
+"""
+type QuotaUsage {
+    """
+    The address of the maker or the ID of the peer.
+    """
+    id: String!
+    numOrders: Int!
+}
+
+"""
+A peer that Mesh is currently connected to.
+"""
+type Peer {
+    """
+    The peer ID of the peer.
+    """
+    id: String!
+    """
+    One entry for each open connection to the peer.
+    """
+    connections: [PeerConnection!]!
+    """
+    The scores assigned to the peer, keyed by tag. Peers with a low total score are the first to be disconnected when
+    Mesh has too many connections.
+    """
+    tags: [PeerTag!]!
+    """
+    The total score of the peer (i.e. the sum of the values of all tags).
+    """
+    score: Int!
+    """
+    The bandwidth used by the peer across all protocols.
+    """
+    bandwidth: PeerBandwidth!
+}
+
+"""
+A single connection to a peer.
+"""
+type PeerConnection {
+    """
+    The multiaddress of the remote end of the connection. It can be passed to banPeer in order to ban the IP address
+    of the peer.
+    """
+    multiaddr: String!
+    direction: PeerConnectionDirection!
+}
+
+"""
+Whether a connection was opened by the peer (INBOUND) or by Mesh (OUTBOUND).
+"""
+enum PeerConnectionDirection {
+    INBOUND
+    OUTBOUND
+    UNKNOWN
+}
+
+"""
+A score assigned to a peer. Tag is a unique identifier for the score.
+"""
+type PeerTag {
+    tag: String!
+    value: Int!
+}
+
+"""
+Bandwidth used by a peer. Totals are expressed in bytes and encoded as numerical strings. Rates are expressed in bytes
+per second.
+"""
+type PeerBandwidth {
+    totalIn: String!
+    totalOut: String!
+    rateIn: Float!
+    rateOut: Float!
+}
+
+"""
+Information about a single page of results returned by a paginated query.
+"""
+type PageInfo {
+    """
+    An opaque cursor which points to the last item in the page. It can be passed as the ` + "`" + `after` + "`" + ` argument of the same
+    query in order to get the next page. Null if the page is empty.
+    """
+    endCursor: String
+    """
+    Whether or not there are more results after this page.
+    """
+    hasNextPage: Boolean!
+}
+
+"""
+A single page of orders returned by the orders query.
+"""
+type OrderConnection {
+    """
+    The orders in this page.
+    """
+    orders: [OrderWithMetadata!]!
+    """
+    Information about this page, which can be used to get the next page.
+    """
+    pageInfo: PageInfo!
+}
+
+"""
+A single page of v4 orders returned by the ordersv4 query.
+"""
+type OrderV4Connection {
+    """
+    The v4 orders in this page.
+    """
+    orders: [OrderV4WithMetadata!]!
+    """
+    Information about this page, which can be used to get the next page.
+    """
+    pageInfo: PageInfo!
+}
+
+"""
+A single page of order events returned by the orderEventsSince query.
+"""
+type OrderEventPage {
+    """
+    The order events in this page, sorted by sequence number in ascending order.
+    """
+    events: [OrderEvent!]!
+    """
+    The sequence number of the last event in this page, or the given sequence number if the page is empty. Can be
+    used as the sequenceNumber argument to get the next page.
+    """
+    lastSequenceNumber: String!
+    """
+    Whether there are more events after this page.
+    """
+    hasMore: Boolean!
+}
+
+"""
+A single price level in an orderbook. Contains the aggregated remaining fillable amounts of all orders with the same
+price.
+"""
+type OrderbookLevel {
+    """
+    The amount of quote token per unit of base token, expressed in base units of each token (i.e. not adjusted for
+    token decimals) and encoded as a decimal string.
+    """
+    price: String!
+    """
+    The total remaining fillable amount of the base token at this price level, encoded as a numerical string.
+    """
+    baseAmount: String!
+    """
+    The total remaining fillable amount of the quote token at this price level, encoded as a numerical string.
+    """
+    quoteAmount: String!
+    """
+    The number of orders at this price level.
+    """
+    orderCount: Int!
+}
+
+"""
+The aggregated bids and asks for a base/quote token pair. Includes both v3 and v4 orders.
+"""
+type Orderbook {
+    """
+    The address of the base token.
+    """
+    baseToken: String!
+    """
+    The address of the quote token.
+    """
+    quoteToken: String!
+    """
+    Orders which buy the base token in exchange for the quote token, sorted by price in descending order.
+    """
+    bids: [OrderbookLevel!]!
+    """
+    Orders which sell the base token in exchange for the quote token, sorted by price in ascending order.
+    """
+    asks: [OrderbookLevel!]!
+}
+
+"""
+Aggregate values computed over all orders that match a set of filters.
+"""
+type OrderStats {
+    """
+    The number of matching orders.
+    """
+    orderCount: Int!
+    """
+    The sum of the remaining fillable taker asset amounts of all matching orders, encoded as a numerical string.
+    """
+    totalFillableTakerAssetAmount: String!
+    """
+    The number of distinct makers of the matching orders.
+    """
+    makerCount: Int!
+    """
+    The earliest expiration time (expiry for v4 orders) of the matching orders, encoded as a numerical string. Null if
+    no orders match.
+    """
+    minExpirationTimeSeconds: String
+    """
+    The latest expiration time (expiry for v4 orders) of the matching orders, encoded as a numerical string. Null if no
+    orders match.
+    """
+    maxExpirationTimeSeconds: String
 }
 
 type Query {
@@ -1562,7 +2513,9 @@ type Query {
     """
     orderv4(hash: String!): OrderV4WithMetadata
     """
-    Returns an array of orders that satisfy certain criteria.
+    Returns a single page of orders that satisfy certain criteria. Orders are always sorted by hash after any other sort
+    options, so the results have a stable order. In order to get the next page, pass the endCursor of this page as the
+    ` + "`" + `after` + "`" + ` argument. Cursor-based pagination stays correct while orders are being added and removed.
     """
     orders(
         """
@@ -1576,12 +2529,19 @@ type Query {
         """
         filters: [OrderFilter!] = []
         """
-        The maximum number of orders to be included in the results. Defaults to 20.
+        The maximum number of orders to be included in the page. Defaults to 20 and must be greater than zero.
         """
-        limit: Int = 20
-    ): [OrderWithMetadata!]!
+        first: Int = 20
+        """
+        If provided, only orders which come after this cursor will be included in the page. Must be the endCursor
+        returned by a previous query with the same sort options.
+        """
+        after: String
+    ): OrderConnection!
     """
-    Returns an array of v4 orders that satisfy certain criteria.
+    Returns a single page of v4 orders that satisfy certain criteria. Orders are always sorted by hash after any other
+    sort options, so the results have a stable order. In order to get the next page, pass the endCursor of this page as
+    the ` + "`" + `after` + "`" + ` argument. Cursor-based pagination stays correct while orders are being added and removed.
     """
     ordersv4(
         """
@@ -1595,15 +2555,147 @@ type Query {
         """
         filters: [OrderFilterV4!] = []
         """
-        The maximum number of orders to be included in the results. Defaults to 20.
+        The maximum number of orders to be included in the page. Defaults to 20 and must be greater than zero.
+        """
+        first: Int = 20
+        """
+        If provided, only orders which come after this cursor will be included in the page. Must be the endCursor
+        returned by a previous query with the same sort options.
+        """
+        after: String
+    ): OrderV4Connection!
+    """
+    Returns aggregate values, such as the number of orders and their total remaining fillable taker asset amount, for
+    all orders that satisfy certain criteria. The values are computed by the database without returning the orders
+    themselves.
+    """
+    orderStats(
+        """
+        A set of filters. Only the orders that match all filters will be included in the stats. By default no filters
+        are used.
+        """
+        filters: [OrderFilter!] = []
+    ): OrderStats!
+    """
+    Returns aggregate values, such as the number of orders and their total remaining fillable taker asset amount, for
+    all v4 orders that satisfy certain criteria.
+    """
+    orderStatsV4(
+        """
+        A set of filters. Only the orders that match all filters will be included in the stats. By default no filters
+        are used.
+        """
+        filters: [OrderFilterV4!] = []
+    ): OrderStats!
+    """
+    Returns the bids and asks for the given token pair, with the remaining fillable amounts aggregated per price level.
+    Both v3 and v4 orders are included. v3 orders are only included if their maker and taker asset data are both ERC20
+    asset data.
+    """
+    orderbook(
+        """
+        The address of the base token.
+        """
+        baseToken: String!
+        """
+        The address of the quote token.
+        """
+        quoteToken: String!
+        """
+        The maximum number of price levels to be included on each side of the orderbook. Defaults to 20 and must be
+        greater than zero.
+        """
+        depth: Int = 20
+    ): Orderbook!
+    """
+    Returns the order events from the order event log which come after the given sequence number, sorted by sequence
+    number in ascending order. This can be used to catch up on events that were missed while disconnected. Returns an
+    error if some of the requested events have already been pruned from the log or if the sequence number is greater
+    than the sequence number of the latest event in the log (e.g. because the database has been reset).
+    """
+    orderEventsSince(
+        """
+        Only events with a sequence number greater than this will be included. Use "0" to start from the oldest event
+        that is still in the log.
+        """
+        sequenceNumber: String!
+        """
+        The maximum number of events to be included in the page. Defaults to 100 and must be greater than zero.
+        """
+        limit: Int = 100
+    ): OrderEventPage!
+    """
+    Returns orders from the order archive. Orders are added to the archive along with their final state when they are
+    permanently deleted, and are kept for the configured retention period. Results are sorted by the time at which the
+    orders were archived, with the most recently archived orders first.
+    """
+    archivedOrders(
+        """
+        If provided, only the order with this hash will be included in the results.
+        """
+        hash: String
+        """
+        If provided, only orders from this maker (makerAddress for v3 orders and maker for v4 orders) will be included
+        in the results.
+        """
+        maker: String
+        """
+        If not empty, only orders with one of these end states will be included in the results.
+        """
+        endStates: [OrderEndState!] = []
+        """
+        If provided, only orders which were archived at or after this time will be included in the results. Must be
+        formatted according to RFC 3339.
+        """
+        archivedAfter: String
+        """
+        If provided, only orders which were archived before this time will be included in the results. Must be
+        formatted according to RFC 3339.
+        """
+        archivedBefore: String
+        """
+        The maximum number of orders to be included in the results. Defaults to 20 and must be greater than zero.
         """
         limit: Int = 20
-    ): [OrderV4WithMetadata!]!
+        """
+        The number of matching orders to skip. Defaults to 0.
+        """
+        offset: Int = 0
+    ): [ArchivedOrder!]!
+
+    """
+    Validates one or more orders in the same way as the addOrders mutation and returns the same results, but does not
+    store, pin or share any of the orders. This can be used to find out why an order would be rejected before adding
+    it.
+    """
+    validateOrders(
+        orders: [NewOrder!]!
+        """
+        Whether the orders should be validated as pinned orders. Pinned orders are not subject to the maximum
+        expiration time which applies when the database is full. Defaults to true, like for addOrders.
+        """
+        pinned: Boolean = true
+    ): AddOrdersResults!
+    """
+    Validates one or more v4 orders in the same way as the addOrdersV4 mutation and returns the same results, but does
+    not store, pin or share any of the orders.
+    """
+    validateOrdersV4(
+        orders: [NewOrderV4!]!
+        """
+        Whether the orders should be validated as pinned orders. Defaults to true, like for addOrdersV4.
+        """
+        pinned: Boolean = true
+    ): AddOrdersResultsV4!
 
     """
     Returns the current stats.
     """
     stats: Stats!
+    """
+    Returns the peers that Mesh is currently connected to.
+    """
+    peers: [Peer!]!
 }
 
 """
@@ -1780,6 +2872,8 @@ enum RejectedOrderCode {
     DATABASE_FULL_OF_ORDERS
     TAKER_ADDRESS_NOT_ALLOWED
     ORDER_INVALID_SCHEMA
+    MAKER_QUOTA_EXCEEDED
+    PEER_QUOTA_EXCEEDED
 }
 
 type Mutation {
@@ -1806,6 +2900,45 @@ type Mutation {
             keepUnfunded: false,
         },
     ): AddOrdersResultsV4!
+    """
+    Stops watching the orders with the given hashes and permanently deletes them. A STOPPED_WATCHING order event is
+    emitted for each order that was still being watched. The orders may be added again later, e.g. if they are received
+    from a peer. Returns the hashes of the orders that were removed. Hashes of unknown orders are ignored.
+    """
+    removeOrders(hashes: [String!]!): [String!]!
+    """
+    The v4 equivalent of removeOrders.
+    """
+    removeOrdersV4(hashes: [String!]!): [String!]!
+    """
+    Pins or unpins the orders with the given hashes. Pinned orders are not affected by any DDoS prevention or incentive
+    mechanisms and always stay in storage until they are no longer fillable. Returns the hashes of the orders that were
+    updated. Hashes of orders that are unknown or no longer being watched are ignored.
+    """
+    setOrdersPinned(hashes: [String!]!, pinned: Boolean!): [String!]!
+    """
+    The v4 equivalent of setOrdersPinned.
+    """
+    setOrdersPinnedV4(hashes: [String!]!, pinned: Boolean!): [String!]!
+    """
+    Connects to the peer with the given multiaddress. The multiaddress must include the peer ID, e.g.
+    /ip4/1.2.3.4/tcp/60558/ipfs/16Uiu2HAm...
+    """
+    connectPeer(multiaddr: String!): Boolean!
+    """
+    Closes all connections to the peer with the given peer ID. Note that Mesh may discover and connect to the peer
+    again later. Use banPeer to prevent future connections.
+    """
+    disconnectPeer(id: String!): Boolean!
+    """
+    Bans the IP address of the given multiaddress and closes any connections to it. Typically the multiaddress is taken
+    from the connections of a peer returned by the peers query. IP addresses of bootstrap peers cannot be banned.
+    """
+    banPeer(multiaddr: String!): Boolean!
+    """
+    Removes the ban on the IP address of the given multiaddress.
+    """
+    unbanPeer(multiaddr: String!): Boolean!
 }
 
 input AddOrdersOpts {
@@ -1860,40 +2993,88 @@ type OrderEvent {
     the order's state, but there may also be some false positives.
     """
     contractEvents: [ContractEvent!]!
+    """
+    The position of the event in the order event log. Sequence numbers are strictly increasing and can be used to
+    resume a subscription without missing events. Null if the event could not be added to the log.
+    """
+    sequenceNumber: String
 }
 
-enum OrderEndState {
+"""
+An order which has been permanently deleted, along with the final state it was in when Mesh stopped watching it.
+"""
+type ArchivedOrder {
     """
-    The order was successfully validated and added to the Mesh node. The order is now being watched and any changes to
-    the fillability will result in subsequent order events.
+    The hash of the order. Encoded as a hexadecimal string.
     """
-    ADDED
+    hash: String!
     """
-    The order was filled for a partial amount. The order is still fillable up to the fillableTakerAssetAmount.
+    The order, if it is a v3 order.
     """
-    FILLED
+    order: OrderWithMetadata
     """
-    The order was fully filled and its remaining fillableTakerAssetAmount is 0. The order is no longer fillable.
+    The order, if it is a v4 order.
     """
-    FULLY_FILLED
+    orderv4: OrderV4WithMetadata
     """
-    The order was cancelled and is no longer fillable.
+    The final state of the order. This is the end state of the last order event that was emitted for the order, or
+    STOPPED_WATCHING if the order was deleted while it was still being watched.
     """
-    CANCELLED
+    endState: OrderEndState!
     """
-    The order expired and is no longer fillable.
+    The time at which the order reached its final state. Encoded as an RFC 3339 string.
     """
-    EXPIRED
+    timestamp: String!
     """
-    The order was previously expired, but due to a block re-org it is no longer considered expired (should be rare).
+    The number of the block at which the order was last validated. Encoded as a numerical string.
     """
-    UNEXPIRED
+    blockNumber: String!
     """
-    The order has become unfunded and is no longer fillable. This can happen if the maker makes a transfer or changes their allowance.
+    The hash of the block at which the order was last validated. Encoded as a hexadecimal string.
     """
-    UNFUNDED
+    blockHash: String!
     """
-    The fillability of the order has increased. This can happen if a previously processed fill event gets reverted due to a block re-org,
+    Whether or not the order was pinned.
+    """
+    isPinned: Boolean!
+    """
+    The time at which the order was added to the archive. Encoded as an RFC 3339 string.
+    """
+    archivedAt: String!
+}
+
+enum OrderEndState {
+    """
+    The order was successfully validated and added to the Mesh node. The order is now being watched and any changes to
+    the fillability will result in subsequent order events.
+    """
+    ADDED
+    """
+    The order was filled for a partial amount. The order is still fillable up to the fillableTakerAssetAmount.
+    """
+    FILLED
+    """
+    The order was fully filled and its remaining fillableTakerAssetAmount is 0. The order is no longer fillable.
+    """
+    FULLY_FILLED
+    """
+    The order was cancelled and is no longer fillable.
+    """
+    CANCELLED
+    """
+    The order expired and is no longer fillable.
+    """
+    EXPIRED
+    """
+    The order was previously expired, but due to a block re-org it is no longer considered expired (should be rare).
+    """
+    UNEXPIRED
+    """
+    The order has become unfunded and is no longer fillable. This can happen if the maker makes a transfer or changes their allowance.
+    """
+    UNFUNDED
+    """
+    The fillability of the order has increased. This can happen if a previously processed fill event gets reverted due to a block re-org,
     or if a maker makes a transfer or changes their allowance.
     """
     FILLABILITY_INCREASED
@@ -1947,9 +3128,33 @@ type ContractEvent {
 
 type Subscription {
     """
-    Subscribe to all order events. Events are emitted whenever the status of a watched order changes.
+    Subscribe to order events. Events are emitted whenever the status of a watched order changes. By default all order
+    events are included. The filters and endStates arguments can be used to only receive a subset of events. Filtering
+    happens on the server.
     """
-    orderEvents: [OrderEvent!]!
+    orderEvents(
+        """
+        A set of filters for events about v3 orders. Only events for v3 orders that match all filters will be included.
+        By default no filters are used.
+        """
+        filters: [OrderFilter!] = []
+        """
+        A set of filters for events about v4 orders. Only events for v4 orders that match all filters will be included.
+        By default no filters are used.
+        """
+        filtersV4: [OrderFilterV4!] = []
+        """
+        If provided, only events with one of the given end states will be included.
+        """
+        endStates: [OrderEndState!]
+        """
+        If provided, all events in the order event log with a sequence number greater than this are sent before any
+        new events. Typically this is the sequence number of the last event received before disconnecting. Events
+        which have already been pruned from the log can't be replayed, in which case the subscription fails. It also
+        fails if the sequence number is greater than the sequence number of the latest event in the log.
+        """
+        since: String
+    ): [OrderEvent!]!
 }
 `, BuiltIn: false},
 }
@@ -2019,6 +3224,134 @@ func (ec *executionContext) field_Mutation_addOrders_args(ctx context.Context, r
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_banPeer_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["multiaddr"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["multiaddr"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_connectPeer_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["multiaddr"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["multiaddr"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_disconnectPeer_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_removeOrdersV4_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 []string
+	if tmp, ok := rawArgs["hashes"]; ok {
+		arg0, err = ec.unmarshalNString2ᚕstringᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["hashes"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_removeOrders_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 []string
+	if tmp, ok := rawArgs["hashes"]; ok {
+		arg0, err = ec.unmarshalNString2ᚕstringᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["hashes"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_setOrdersPinnedV4_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 []string
+	if tmp, ok := rawArgs["hashes"]; ok {
+		arg0, err = ec.unmarshalNString2ᚕstringᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["hashes"] = arg0
+	var arg1 bool
+	if tmp, ok := rawArgs["pinned"]; ok {
+		arg1, err = ec.unmarshalNBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["pinned"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_setOrdersPinned_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 []string
+	if tmp, ok := rawArgs["hashes"]; ok {
+		arg0, err = ec.unmarshalNString2ᚕstringᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["hashes"] = arg0
+	var arg1 bool
+	if tmp, ok := rawArgs["pinned"]; ok {
+		arg1, err = ec.unmarshalNBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["pinned"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_unbanPeer_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["multiaddr"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["multiaddr"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -2033,6 +3366,118 @@ func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_archivedOrders_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["hash"]; ok {
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["hash"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["maker"]; ok {
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["maker"] = arg1
+	var arg2 []gqltypes.OrderEndState
+	if tmp, ok := rawArgs["endStates"]; ok {
+		arg2, err = ec.unmarshalOOrderEndState2ᚕgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderEndStateᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["endStates"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["archivedAfter"]; ok {
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["archivedAfter"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["archivedBefore"]; ok {
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["archivedBefore"] = arg4
+	var arg5 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		arg5, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg5
+	var arg6 *int
+	if tmp, ok := rawArgs["offset"]; ok {
+		arg6, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["offset"] = arg6
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_orderEventsSince_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["sequenceNumber"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["sequenceNumber"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_orderStatsV4_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 []*gqltypes.OrderFilterV4
+	if tmp, ok := rawArgs["filters"]; ok {
+		arg0, err = ec.unmarshalOOrderFilterV42ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilterV4ᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filters"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_orderStats_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 []*gqltypes.OrderFilter
+	if tmp, ok := rawArgs["filters"]; ok {
+		arg0, err = ec.unmarshalOOrderFilter2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilterᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filters"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_order_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -2047,6 +3492,36 @@ func (ec *executionContext) field_Query_order_args(ctx context.Context, rawArgs
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_orderbook_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["baseToken"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["baseToken"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["quoteToken"]; ok {
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["quoteToken"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["depth"]; ok {
+		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["depth"] = arg2
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_orders_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -2067,13 +3542,21 @@ func (ec *executionContext) field_Query_orders_args(ctx context.Context, rawArgs
 	}
 	args["filters"] = arg1
 	var arg2 *int
-	if tmp, ok := rawArgs["limit"]; ok {
+	if tmp, ok := rawArgs["first"]; ok {
 		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["limit"] = arg2
+	args["first"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg3
 	return args, nil
 }
 
@@ -2097,13 +3580,21 @@ func (ec *executionContext) field_Query_ordersv4_args(ctx context.Context, rawAr
 	}
 	args["filters"] = arg1
 	var arg2 *int
-	if tmp, ok := rawArgs["limit"]; ok {
+	if tmp, ok := rawArgs["first"]; ok {
 		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["limit"] = arg2
+	args["first"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg3
 	return args, nil
 }
 
@@ -2121,24 +3612,106 @@ func (ec *executionContext) field_Query_orderv4_args(ctx context.Context, rawArg
 	return args, nil
 }
 
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_validateOrdersV4_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+	var arg0 []*gqltypes.NewOrderV4
+	if tmp, ok := rawArgs["orders"]; ok {
+		arg0, err = ec.unmarshalNNewOrderV42ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐNewOrderV4ᚄ(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
-
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 bool
+	args["orders"] = arg0
+	var arg1 *bool
+	if tmp, ok := rawArgs["pinned"]; ok {
+		arg1, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["pinned"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_validateOrders_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 []*gqltypes.NewOrder
+	if tmp, ok := rawArgs["orders"]; ok {
+		arg0, err = ec.unmarshalNNewOrder2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐNewOrderᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["orders"] = arg0
+	var arg1 *bool
+	if tmp, ok := rawArgs["pinned"]; ok {
+		arg1, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["pinned"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_orderEvents_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 []*gqltypes.OrderFilter
+	if tmp, ok := rawArgs["filters"]; ok {
+		arg0, err = ec.unmarshalOOrderFilter2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilterᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filters"] = arg0
+	var arg1 []*gqltypes.OrderFilterV4
+	if tmp, ok := rawArgs["filtersV4"]; ok {
+		arg1, err = ec.unmarshalOOrderFilterV42ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilterV4ᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filtersV4"] = arg1
+	var arg2 []gqltypes.OrderEndState
+	if tmp, ok := rawArgs["endStates"]; ok {
+		arg2, err = ec.unmarshalOOrderEndState2ᚕgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderEndStateᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["endStates"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["since"]; ok {
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["since"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
 	if tmp, ok := rawArgs["includeDeprecated"]; ok {
 		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
 		if err != nil {
@@ -2429,7 +4002,7 @@ func (ec *executionContext) _AddOrdersResultsV4_rejected(ctx context.Context, fi
 	return ec.marshalNRejectedOrderResultV42ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐRejectedOrderResultV4ᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ContractEvent_blockHash(ctx context.Context, field graphql.CollectedField, obj *gqltypes.ContractEvent) (ret graphql.Marshaler) {
+func (ec *executionContext) _ArchivedOrder_hash(ctx context.Context, field graphql.CollectedField, obj *gqltypes.ArchivedOrder) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2437,7 +4010,7 @@ func (ec *executionContext) _ContractEvent_blockHash(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ContractEvent",
+		Object:   "ArchivedOrder",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2446,7 +4019,7 @@ func (ec *executionContext) _ContractEvent_blockHash(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BlockHash, nil
+		return obj.Hash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2463,7 +4036,7 @@ func (ec *executionContext) _ContractEvent_blockHash(ctx context.Context, field
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ContractEvent_txHash(ctx context.Context, field graphql.CollectedField, obj *gqltypes.ContractEvent) (ret graphql.Marshaler) {
+func (ec *executionContext) _ArchivedOrder_order(ctx context.Context, field graphql.CollectedField, obj *gqltypes.ArchivedOrder) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2471,7 +4044,7 @@ func (ec *executionContext) _ContractEvent_txHash(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ContractEvent",
+		Object:   "ArchivedOrder",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2480,24 +4053,21 @@ func (ec *executionContext) _ContractEvent_txHash(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TxHash, nil
+		return obj.Order, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*gqltypes.OrderWithMetadata)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOOrderWithMetadata2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderWithMetadata(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ContractEvent_txIndex(ctx context.Context, field graphql.CollectedField, obj *gqltypes.ContractEvent) (ret graphql.Marshaler) {
+func (ec *executionContext) _ArchivedOrder_orderv4(ctx context.Context, field graphql.CollectedField, obj *gqltypes.ArchivedOrder) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2505,7 +4075,7 @@ func (ec *executionContext) _ContractEvent_txIndex(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ContractEvent",
+		Object:   "ArchivedOrder",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2514,24 +4084,21 @@ func (ec *executionContext) _ContractEvent_txIndex(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TxIndex, nil
+		return obj.Orderv4, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*gqltypes.OrderV4WithMetadata)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOOrderV4WithMetadata2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderV4WithMetadata(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ContractEvent_logIndex(ctx context.Context, field graphql.CollectedField, obj *gqltypes.ContractEvent) (ret graphql.Marshaler) {
+func (ec *executionContext) _ArchivedOrder_endState(ctx context.Context, field graphql.CollectedField, obj *gqltypes.ArchivedOrder) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2539,7 +4106,7 @@ func (ec *executionContext) _ContractEvent_logIndex(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ContractEvent",
+		Object:   "ArchivedOrder",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2548,7 +4115,7 @@ func (ec *executionContext) _ContractEvent_logIndex(ctx context.Context, field g
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LogIndex, nil
+		return obj.EndState, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2560,12 +4127,12 @@ func (ec *executionContext) _ContractEvent_logIndex(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(gqltypes.OrderEndState)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNOrderEndState2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderEndState(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ContractEvent_isRemoved(ctx context.Context, field graphql.CollectedField, obj *gqltypes.ContractEvent) (ret graphql.Marshaler) {
+func (ec *executionContext) _ArchivedOrder_timestamp(ctx context.Context, field graphql.CollectedField, obj *gqltypes.ArchivedOrder) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2573,7 +4140,7 @@ func (ec *executionContext) _ContractEvent_isRemoved(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ContractEvent",
+		Object:   "ArchivedOrder",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2582,7 +4149,7 @@ func (ec *executionContext) _ContractEvent_isRemoved(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsRemoved, nil
+		return obj.Timestamp, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2594,12 +4161,12 @@ func (ec *executionContext) _ContractEvent_isRemoved(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ContractEvent_address(ctx context.Context, field graphql.CollectedField, obj *gqltypes.ContractEvent) (ret graphql.Marshaler) {
+func (ec *executionContext) _ArchivedOrder_blockNumber(ctx context.Context, field graphql.CollectedField, obj *gqltypes.ArchivedOrder) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2607,7 +4174,7 @@ func (ec *executionContext) _ContractEvent_address(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ContractEvent",
+		Object:   "ArchivedOrder",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2616,7 +4183,7 @@ func (ec *executionContext) _ContractEvent_address(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Address, nil
+		return obj.BlockNumber, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2633,7 +4200,7 @@ func (ec *executionContext) _ContractEvent_address(ctx context.Context, field gr
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ContractEvent_kind(ctx context.Context, field graphql.CollectedField, obj *gqltypes.ContractEvent) (ret graphql.Marshaler) {
+func (ec *executionContext) _ArchivedOrder_blockHash(ctx context.Context, field graphql.CollectedField, obj *gqltypes.ArchivedOrder) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2641,7 +4208,7 @@ func (ec *executionContext) _ContractEvent_kind(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ContractEvent",
+		Object:   "ArchivedOrder",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2650,7 +4217,7 @@ func (ec *executionContext) _ContractEvent_kind(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Kind, nil
+		return obj.BlockHash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2667,7 +4234,7 @@ func (ec *executionContext) _ContractEvent_kind(ctx context.Context, field graph
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ContractEvent_parameters(ctx context.Context, field graphql.CollectedField, obj *gqltypes.ContractEvent) (ret graphql.Marshaler) {
+func (ec *executionContext) _ArchivedOrder_isPinned(ctx context.Context, field graphql.CollectedField, obj *gqltypes.ArchivedOrder) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2675,7 +4242,7 @@ func (ec *executionContext) _ContractEvent_parameters(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ContractEvent",
+		Object:   "ArchivedOrder",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2684,7 +4251,7 @@ func (ec *executionContext) _ContractEvent_parameters(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Parameters, nil
+		return obj.IsPinned, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2696,12 +4263,12 @@ func (ec *executionContext) _ContractEvent_parameters(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(interface{})
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNAny2interface(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LatestBlock_number(ctx context.Context, field graphql.CollectedField, obj *gqltypes.LatestBlock) (ret graphql.Marshaler) {
+func (ec *executionContext) _ArchivedOrder_archivedAt(ctx context.Context, field graphql.CollectedField, obj *gqltypes.ArchivedOrder) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2709,7 +4276,7 @@ func (ec *executionContext) _LatestBlock_number(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "LatestBlock",
+		Object:   "ArchivedOrder",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2718,7 +4285,7 @@ func (ec *executionContext) _LatestBlock_number(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Number, nil
+		return obj.ArchivedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2735,7 +4302,7 @@ func (ec *executionContext) _LatestBlock_number(ctx context.Context, field graph
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _LatestBlock_hash(ctx context.Context, field graphql.CollectedField, obj *gqltypes.LatestBlock) (ret graphql.Marshaler) {
+func (ec *executionContext) _ContractEvent_blockHash(ctx context.Context, field graphql.CollectedField, obj *gqltypes.ContractEvent) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2743,7 +4310,7 @@ func (ec *executionContext) _LatestBlock_hash(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "LatestBlock",
+		Object:   "ContractEvent",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2752,7 +4319,7 @@ func (ec *executionContext) _LatestBlock_hash(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Hash, nil
+		return obj.BlockHash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2769,7 +4336,7 @@ func (ec *executionContext) _LatestBlock_hash(ctx context.Context, field graphql
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_addOrders(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ContractEvent_txHash(ctx context.Context, field graphql.CollectedField, obj *gqltypes.ContractEvent) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2777,23 +4344,16 @@ func (ec *executionContext) _Mutation_addOrders(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "ContractEvent",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_addOrders_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().AddOrders(rctx, args["orders"].([]*gqltypes.NewOrder), args["pinned"].(*bool), args["opts"].(*gqltypes.AddOrdersOpts))
+		return obj.TxHash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2805,12 +4365,12 @@ func (ec *executionContext) _Mutation_addOrders(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*gqltypes.AddOrdersResults)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNAddOrdersResults2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAddOrdersResults(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_addOrdersV4(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ContractEvent_txIndex(ctx context.Context, field graphql.CollectedField, obj *gqltypes.ContractEvent) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2818,23 +4378,16 @@ func (ec *executionContext) _Mutation_addOrdersV4(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "ContractEvent",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_addOrdersV4_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().AddOrdersV4(rctx, args["orders"].([]*gqltypes.NewOrderV4), args["pinned"].(*bool), args["opts"].(*gqltypes.AddOrdersOpts))
+		return obj.TxIndex, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2846,12 +4399,12 @@ func (ec *executionContext) _Mutation_addOrdersV4(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*gqltypes.AddOrdersResultsV4)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNAddOrdersResultsV42ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAddOrdersResultsV4(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Order_chainId(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _ContractEvent_logIndex(ctx context.Context, field graphql.CollectedField, obj *gqltypes.ContractEvent) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2859,7 +4412,7 @@ func (ec *executionContext) _Order_chainId(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Order",
+		Object:   "ContractEvent",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2868,7 +4421,7 @@ func (ec *executionContext) _Order_chainId(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ChainID, nil
+		return obj.LogIndex, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2880,12 +4433,12 @@ func (ec *executionContext) _Order_chainId(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Order_exchangeAddress(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _ContractEvent_isRemoved(ctx context.Context, field graphql.CollectedField, obj *gqltypes.ContractEvent) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2893,7 +4446,7 @@ func (ec *executionContext) _Order_exchangeAddress(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Order",
+		Object:   "ContractEvent",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2902,7 +4455,7 @@ func (ec *executionContext) _Order_exchangeAddress(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExchangeAddress, nil
+		return obj.IsRemoved, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2914,12 +4467,12 @@ func (ec *executionContext) _Order_exchangeAddress(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Order_makerAddress(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _ContractEvent_address(ctx context.Context, field graphql.CollectedField, obj *gqltypes.ContractEvent) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2927,7 +4480,7 @@ func (ec *executionContext) _Order_makerAddress(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Order",
+		Object:   "ContractEvent",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2936,7 +4489,7 @@ func (ec *executionContext) _Order_makerAddress(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MakerAddress, nil
+		return obj.Address, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2953,7 +4506,7 @@ func (ec *executionContext) _Order_makerAddress(ctx context.Context, field graph
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Order_makerAssetData(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _ContractEvent_kind(ctx context.Context, field graphql.CollectedField, obj *gqltypes.ContractEvent) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2961,7 +4514,7 @@ func (ec *executionContext) _Order_makerAssetData(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Order",
+		Object:   "ContractEvent",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2970,7 +4523,7 @@ func (ec *executionContext) _Order_makerAssetData(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MakerAssetData, nil
+		return obj.Kind, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2987,7 +4540,7 @@ func (ec *executionContext) _Order_makerAssetData(ctx context.Context, field gra
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Order_makerAssetAmount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _ContractEvent_parameters(ctx context.Context, field graphql.CollectedField, obj *gqltypes.ContractEvent) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2995,7 +4548,7 @@ func (ec *executionContext) _Order_makerAssetAmount(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Order",
+		Object:   "ContractEvent",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3004,7 +4557,7 @@ func (ec *executionContext) _Order_makerAssetAmount(ctx context.Context, field g
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MakerAssetAmount, nil
+		return obj.Parameters, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3016,12 +4569,12 @@ func (ec *executionContext) _Order_makerAssetAmount(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(interface{})
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNAny2interface(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Order_makerFeeAssetData(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _LatestBlock_number(ctx context.Context, field graphql.CollectedField, obj *gqltypes.LatestBlock) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3029,7 +4582,7 @@ func (ec *executionContext) _Order_makerFeeAssetData(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Order",
+		Object:   "LatestBlock",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3038,7 +4591,7 @@ func (ec *executionContext) _Order_makerFeeAssetData(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MakerFeeAssetData, nil
+		return obj.Number, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3055,7 +4608,7 @@ func (ec *executionContext) _Order_makerFeeAssetData(ctx context.Context, field
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Order_makerFee(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _LatestBlock_hash(ctx context.Context, field graphql.CollectedField, obj *gqltypes.LatestBlock) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3063,7 +4616,7 @@ func (ec *executionContext) _Order_makerFee(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Order",
+		Object:   "LatestBlock",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3072,7 +4625,7 @@ func (ec *executionContext) _Order_makerFee(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MakerFee, nil
+		return obj.Hash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3089,7 +4642,7 @@ func (ec *executionContext) _Order_makerFee(ctx context.Context, field graphql.C
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Order_takerAddress(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_addOrders(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3097,16 +4650,23 @@ func (ec *executionContext) _Order_takerAddress(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Order",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_addOrders_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TakerAddress, nil
+		return ec.resolvers.Mutation().AddOrders(rctx, args["orders"].([]*gqltypes.NewOrder), args["pinned"].(*bool), args["opts"].(*gqltypes.AddOrdersOpts))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3118,12 +4678,12 @@ func (ec *executionContext) _Order_takerAddress(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*gqltypes.AddOrdersResults)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNAddOrdersResults2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAddOrdersResults(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Order_takerAssetData(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_addOrdersV4(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3131,16 +4691,23 @@ func (ec *executionContext) _Order_takerAssetData(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Order",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_addOrdersV4_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TakerAssetData, nil
+		return ec.resolvers.Mutation().AddOrdersV4(rctx, args["orders"].([]*gqltypes.NewOrderV4), args["pinned"].(*bool), args["opts"].(*gqltypes.AddOrdersOpts))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3152,12 +4719,12 @@ func (ec *executionContext) _Order_takerAssetData(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*gqltypes.AddOrdersResultsV4)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNAddOrdersResultsV42ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAddOrdersResultsV4(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Order_takerAssetAmount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_removeOrders(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3165,16 +4732,23 @@ func (ec *executionContext) _Order_takerAssetAmount(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Order",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_removeOrders_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TakerAssetAmount, nil
+		return ec.resolvers.Mutation().RemoveOrders(rctx, args["hashes"].([]string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3186,12 +4760,12 @@ func (ec *executionContext) _Order_takerAssetAmount(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Order_takerFeeAssetData(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_removeOrdersV4(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3199,16 +4773,23 @@ func (ec *executionContext) _Order_takerFeeAssetData(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Order",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_removeOrdersV4_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TakerFeeAssetData, nil
+		return ec.resolvers.Mutation().RemoveOrdersV4(rctx, args["hashes"].([]string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3220,12 +4801,12 @@ func (ec *executionContext) _Order_takerFeeAssetData(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Order_takerFee(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_setOrdersPinned(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3233,16 +4814,23 @@ func (ec *executionContext) _Order_takerFee(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Order",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_setOrdersPinned_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TakerFee, nil
+		return ec.resolvers.Mutation().SetOrdersPinned(rctx, args["hashes"].([]string), args["pinned"].(bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3254,12 +4842,12 @@ func (ec *executionContext) _Order_takerFee(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Order_senderAddress(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_setOrdersPinnedV4(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3267,16 +4855,23 @@ func (ec *executionContext) _Order_senderAddress(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Order",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_setOrdersPinnedV4_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SenderAddress, nil
+		return ec.resolvers.Mutation().SetOrdersPinnedV4(rctx, args["hashes"].([]string), args["pinned"].(bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3288,12 +4883,12 @@ func (ec *executionContext) _Order_senderAddress(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Order_feeRecipientAddress(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_connectPeer(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3301,16 +4896,23 @@ func (ec *executionContext) _Order_feeRecipientAddress(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Order",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_connectPeer_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FeeRecipientAddress, nil
+		return ec.resolvers.Mutation().ConnectPeer(rctx, args["multiaddr"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3322,12 +4924,12 @@ func (ec *executionContext) _Order_feeRecipientAddress(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Order_expirationTimeSeconds(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_disconnectPeer(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3335,16 +4937,23 @@ func (ec *executionContext) _Order_expirationTimeSeconds(ctx context.Context, fi
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Order",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_disconnectPeer_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExpirationTimeSeconds, nil
+		return ec.resolvers.Mutation().DisconnectPeer(rctx, args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3356,12 +4965,12 @@ func (ec *executionContext) _Order_expirationTimeSeconds(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Order_salt(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_banPeer(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3369,16 +4978,23 @@ func (ec *executionContext) _Order_salt(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Order",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_banPeer_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Salt, nil
+		return ec.resolvers.Mutation().BanPeer(rctx, args["multiaddr"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3390,12 +5006,12 @@ func (ec *executionContext) _Order_salt(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Order_signature(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_unbanPeer(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3403,16 +5019,23 @@ func (ec *executionContext) _Order_signature(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Order",
+		Object:   "Mutation",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_unbanPeer_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Signature, nil
+		return ec.resolvers.Mutation().UnbanPeer(rctx, args["multiaddr"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3424,12 +5047,12 @@ func (ec *executionContext) _Order_signature(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderEvent_order(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderEvent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_chainId(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3437,7 +5060,7 @@ func (ec *executionContext) _OrderEvent_order(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderEvent",
+		Object:   "Order",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3446,21 +5069,24 @@ func (ec *executionContext) _OrderEvent_order(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Order, nil
+		return obj.ChainID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*gqltypes.OrderWithMetadata)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOOrderWithMetadata2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderWithMetadata(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderEvent_orderv4(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderEvent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_exchangeAddress(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3468,7 +5094,7 @@ func (ec *executionContext) _OrderEvent_orderv4(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderEvent",
+		Object:   "Order",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3477,21 +5103,24 @@ func (ec *executionContext) _OrderEvent_orderv4(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Orderv4, nil
+		return obj.ExchangeAddress, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*gqltypes.OrderV4WithMetadata)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOOrderV4WithMetadata2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderV4WithMetadata(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderEvent_endState(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderEvent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_makerAddress(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3499,7 +5128,7 @@ func (ec *executionContext) _OrderEvent_endState(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderEvent",
+		Object:   "Order",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3508,7 +5137,7 @@ func (ec *executionContext) _OrderEvent_endState(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EndState, nil
+		return obj.MakerAddress, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3520,12 +5149,12 @@ func (ec *executionContext) _OrderEvent_endState(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(gqltypes.OrderEndState)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNOrderEndState2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderEndState(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderEvent_timestamp(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderEvent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_makerAssetData(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3533,7 +5162,7 @@ func (ec *executionContext) _OrderEvent_timestamp(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderEvent",
+		Object:   "Order",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3542,7 +5171,7 @@ func (ec *executionContext) _OrderEvent_timestamp(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Timestamp, nil
+		return obj.MakerAssetData, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3559,7 +5188,7 @@ func (ec *executionContext) _OrderEvent_timestamp(ctx context.Context, field gra
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderEvent_contractEvents(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderEvent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_makerAssetAmount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3567,7 +5196,7 @@ func (ec *executionContext) _OrderEvent_contractEvents(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderEvent",
+		Object:   "Order",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3576,7 +5205,7 @@ func (ec *executionContext) _OrderEvent_contractEvents(ctx context.Context, fiel
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ContractEvents, nil
+		return obj.MakerAssetAmount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3588,12 +5217,12 @@ func (ec *executionContext) _OrderEvent_contractEvents(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*gqltypes.ContractEvent)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNContractEvent2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐContractEventᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4_chainId(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_makerFeeAssetData(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3601,7 +5230,7 @@ func (ec *executionContext) _OrderV4_chainId(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4",
+		Object:   "Order",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3610,7 +5239,7 @@ func (ec *executionContext) _OrderV4_chainId(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ChainID, nil
+		return obj.MakerFeeAssetData, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3627,7 +5256,7 @@ func (ec *executionContext) _OrderV4_chainId(ctx context.Context, field graphql.
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4_verifyingContract(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_makerFee(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3635,7 +5264,7 @@ func (ec *executionContext) _OrderV4_verifyingContract(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4",
+		Object:   "Order",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3644,7 +5273,7 @@ func (ec *executionContext) _OrderV4_verifyingContract(ctx context.Context, fiel
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.VerifyingContract, nil
+		return obj.MakerFee, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3661,7 +5290,7 @@ func (ec *executionContext) _OrderV4_verifyingContract(ctx context.Context, fiel
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4_makerToken(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_takerAddress(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3669,7 +5298,7 @@ func (ec *executionContext) _OrderV4_makerToken(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4",
+		Object:   "Order",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3678,7 +5307,7 @@ func (ec *executionContext) _OrderV4_makerToken(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MakerToken, nil
+		return obj.TakerAddress, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3695,7 +5324,7 @@ func (ec *executionContext) _OrderV4_makerToken(ctx context.Context, field graph
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4_takerToken(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_takerAssetData(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3703,7 +5332,7 @@ func (ec *executionContext) _OrderV4_takerToken(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4",
+		Object:   "Order",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3712,7 +5341,7 @@ func (ec *executionContext) _OrderV4_takerToken(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TakerToken, nil
+		return obj.TakerAssetData, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3729,7 +5358,7 @@ func (ec *executionContext) _OrderV4_takerToken(ctx context.Context, field graph
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4_makerAmount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_takerAssetAmount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3737,7 +5366,7 @@ func (ec *executionContext) _OrderV4_makerAmount(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4",
+		Object:   "Order",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3746,7 +5375,7 @@ func (ec *executionContext) _OrderV4_makerAmount(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MakerAmount, nil
+		return obj.TakerAssetAmount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3763,7 +5392,7 @@ func (ec *executionContext) _OrderV4_makerAmount(ctx context.Context, field grap
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4_takerAmount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_takerFeeAssetData(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3771,7 +5400,7 @@ func (ec *executionContext) _OrderV4_takerAmount(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4",
+		Object:   "Order",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3780,7 +5409,7 @@ func (ec *executionContext) _OrderV4_takerAmount(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TakerAmount, nil
+		return obj.TakerFeeAssetData, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3797,7 +5426,7 @@ func (ec *executionContext) _OrderV4_takerAmount(ctx context.Context, field grap
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4_takerTokenFeeAmount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_takerFee(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3805,7 +5434,7 @@ func (ec *executionContext) _OrderV4_takerTokenFeeAmount(ctx context.Context, fi
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4",
+		Object:   "Order",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3814,7 +5443,7 @@ func (ec *executionContext) _OrderV4_takerTokenFeeAmount(ctx context.Context, fi
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TakerTokenFeeAmount, nil
+		return obj.TakerFee, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3831,7 +5460,7 @@ func (ec *executionContext) _OrderV4_takerTokenFeeAmount(ctx context.Context, fi
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4_maker(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_senderAddress(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3839,7 +5468,7 @@ func (ec *executionContext) _OrderV4_maker(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4",
+		Object:   "Order",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3848,7 +5477,7 @@ func (ec *executionContext) _OrderV4_maker(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Maker, nil
+		return obj.SenderAddress, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3865,7 +5494,7 @@ func (ec *executionContext) _OrderV4_maker(ctx context.Context, field graphql.Co
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4_taker(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_feeRecipientAddress(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3873,7 +5502,7 @@ func (ec *executionContext) _OrderV4_taker(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4",
+		Object:   "Order",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3882,7 +5511,7 @@ func (ec *executionContext) _OrderV4_taker(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Taker, nil
+		return obj.FeeRecipientAddress, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3899,7 +5528,7 @@ func (ec *executionContext) _OrderV4_taker(ctx context.Context, field graphql.Co
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4_sender(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_expirationTimeSeconds(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3907,7 +5536,7 @@ func (ec *executionContext) _OrderV4_sender(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4",
+		Object:   "Order",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3916,7 +5545,7 @@ func (ec *executionContext) _OrderV4_sender(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Sender, nil
+		return obj.ExpirationTimeSeconds, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3933,7 +5562,7 @@ func (ec *executionContext) _OrderV4_sender(ctx context.Context, field graphql.C
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4_feeRecipient(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_salt(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3941,7 +5570,7 @@ func (ec *executionContext) _OrderV4_feeRecipient(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4",
+		Object:   "Order",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3950,7 +5579,7 @@ func (ec *executionContext) _OrderV4_feeRecipient(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FeeRecipient, nil
+		return obj.Salt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3967,7 +5596,7 @@ func (ec *executionContext) _OrderV4_feeRecipient(ctx context.Context, field gra
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4_pool(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_signature(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Order) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3975,7 +5604,7 @@ func (ec *executionContext) _OrderV4_pool(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4",
+		Object:   "Order",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3984,7 +5613,7 @@ func (ec *executionContext) _OrderV4_pool(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Pool, nil
+		return obj.Signature, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4001,7 +5630,7 @@ func (ec *executionContext) _OrderV4_pool(ctx context.Context, field graphql.Col
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4_expiry(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderConnection_orders(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderConnection) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4009,7 +5638,7 @@ func (ec *executionContext) _OrderV4_expiry(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4",
+		Object:   "OrderConnection",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4018,7 +5647,7 @@ func (ec *executionContext) _OrderV4_expiry(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Expiry, nil
+		return obj.Orders, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4030,12 +5659,12 @@ func (ec *executionContext) _OrderV4_expiry(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*gqltypes.OrderWithMetadata)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNOrderWithMetadata2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderWithMetadataᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4_salt(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderConnection) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4043,7 +5672,7 @@ func (ec *executionContext) _OrderV4_salt(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4",
+		Object:   "OrderConnection",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4052,7 +5681,7 @@ func (ec *executionContext) _OrderV4_salt(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Salt, nil
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4064,12 +5693,12 @@ func (ec *executionContext) _OrderV4_salt(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*gqltypes.PageInfo)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4_signatureType(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderEvent_order(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderEvent) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4077,7 +5706,7 @@ func (ec *executionContext) _OrderV4_signatureType(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4",
+		Object:   "OrderEvent",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4086,24 +5715,21 @@ func (ec *executionContext) _OrderV4_signatureType(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SignatureType, nil
+		return obj.Order, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*gqltypes.OrderWithMetadata)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOOrderWithMetadata2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderWithMetadata(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4_signatureV(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderEvent_orderv4(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderEvent) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4111,7 +5737,7 @@ func (ec *executionContext) _OrderV4_signatureV(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4",
+		Object:   "OrderEvent",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4120,24 +5746,21 @@ func (ec *executionContext) _OrderV4_signatureV(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SignatureV, nil
+		return obj.Orderv4, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*gqltypes.OrderV4WithMetadata)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOOrderV4WithMetadata2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderV4WithMetadata(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4_signatureR(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderEvent_endState(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderEvent) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4145,7 +5768,7 @@ func (ec *executionContext) _OrderV4_signatureR(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4",
+		Object:   "OrderEvent",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4154,7 +5777,7 @@ func (ec *executionContext) _OrderV4_signatureR(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SignatureR, nil
+		return obj.EndState, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4166,12 +5789,12 @@ func (ec *executionContext) _OrderV4_signatureR(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(gqltypes.OrderEndState)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNOrderEndState2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderEndState(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4_signatureS(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderEvent_timestamp(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderEvent) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4179,7 +5802,7 @@ func (ec *executionContext) _OrderV4_signatureS(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4",
+		Object:   "OrderEvent",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4188,7 +5811,7 @@ func (ec *executionContext) _OrderV4_signatureS(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SignatureS, nil
+		return obj.Timestamp, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4205,7 +5828,7 @@ func (ec *executionContext) _OrderV4_signatureS(ctx context.Context, field graph
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4WithMetadata_chainId(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderEvent_contractEvents(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderEvent) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4213,7 +5836,7 @@ func (ec *executionContext) _OrderV4WithMetadata_chainId(ctx context.Context, fi
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4WithMetadata",
+		Object:   "OrderEvent",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4222,7 +5845,7 @@ func (ec *executionContext) _OrderV4WithMetadata_chainId(ctx context.Context, fi
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ChainID, nil
+		return obj.ContractEvents, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4234,12 +5857,12 @@ func (ec *executionContext) _OrderV4WithMetadata_chainId(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*gqltypes.ContractEvent)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNContractEvent2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐContractEventᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4WithMetadata_verifyingContract(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderEvent_sequenceNumber(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderEvent) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4247,7 +5870,7 @@ func (ec *executionContext) _OrderV4WithMetadata_verifyingContract(ctx context.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4WithMetadata",
+		Object:   "OrderEvent",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4256,24 +5879,21 @@ func (ec *executionContext) _OrderV4WithMetadata_verifyingContract(ctx context.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.VerifyingContract, nil
+		return obj.SequenceNumber, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4WithMetadata_makerToken(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderEventPage_events(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderEventPage) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4281,7 +5901,7 @@ func (ec *executionContext) _OrderV4WithMetadata_makerToken(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4WithMetadata",
+		Object:   "OrderEventPage",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4290,7 +5910,7 @@ func (ec *executionContext) _OrderV4WithMetadata_makerToken(ctx context.Context,
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MakerToken, nil
+		return obj.Events, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4302,12 +5922,12 @@ func (ec *executionContext) _OrderV4WithMetadata_makerToken(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*gqltypes.OrderEvent)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNOrderEvent2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderEventᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4WithMetadata_takerToken(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderEventPage_lastSequenceNumber(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderEventPage) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4315,7 +5935,7 @@ func (ec *executionContext) _OrderV4WithMetadata_takerToken(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4WithMetadata",
+		Object:   "OrderEventPage",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4324,7 +5944,7 @@ func (ec *executionContext) _OrderV4WithMetadata_takerToken(ctx context.Context,
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TakerToken, nil
+		return obj.LastSequenceNumber, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4341,7 +5961,7 @@ func (ec *executionContext) _OrderV4WithMetadata_takerToken(ctx context.Context,
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4WithMetadata_makerAmount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderEventPage_hasMore(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderEventPage) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4349,7 +5969,7 @@ func (ec *executionContext) _OrderV4WithMetadata_makerAmount(ctx context.Context
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4WithMetadata",
+		Object:   "OrderEventPage",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4358,7 +5978,7 @@ func (ec *executionContext) _OrderV4WithMetadata_makerAmount(ctx context.Context
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MakerAmount, nil
+		return obj.HasMore, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4370,12 +5990,12 @@ func (ec *executionContext) _OrderV4WithMetadata_makerAmount(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4WithMetadata_takerAmount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderStats_orderCount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderStats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4383,7 +6003,7 @@ func (ec *executionContext) _OrderV4WithMetadata_takerAmount(ctx context.Context
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4WithMetadata",
+		Object:   "OrderStats",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4392,7 +6012,7 @@ func (ec *executionContext) _OrderV4WithMetadata_takerAmount(ctx context.Context
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TakerAmount, nil
+		return obj.OrderCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4404,12 +6024,12 @@ func (ec *executionContext) _OrderV4WithMetadata_takerAmount(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4WithMetadata_takerTokenFeeAmount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderStats_totalFillableTakerAssetAmount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderStats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4417,7 +6037,7 @@ func (ec *executionContext) _OrderV4WithMetadata_takerTokenFeeAmount(ctx context
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4WithMetadata",
+		Object:   "OrderStats",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4426,7 +6046,7 @@ func (ec *executionContext) _OrderV4WithMetadata_takerTokenFeeAmount(ctx context
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TakerTokenFeeAmount, nil
+		return obj.TotalFillableTakerAssetAmount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4443,7 +6063,7 @@ func (ec *executionContext) _OrderV4WithMetadata_takerTokenFeeAmount(ctx context
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4WithMetadata_maker(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderStats_makerCount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderStats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4451,7 +6071,7 @@ func (ec *executionContext) _OrderV4WithMetadata_maker(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4WithMetadata",
+		Object:   "OrderStats",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4460,7 +6080,7 @@ func (ec *executionContext) _OrderV4WithMetadata_maker(ctx context.Context, fiel
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Maker, nil
+		return obj.MakerCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4472,12 +6092,12 @@ func (ec *executionContext) _OrderV4WithMetadata_maker(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4WithMetadata_taker(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderStats_minExpirationTimeSeconds(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderStats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4485,7 +6105,7 @@ func (ec *executionContext) _OrderV4WithMetadata_taker(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4WithMetadata",
+		Object:   "OrderStats",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4494,24 +6114,21 @@ func (ec *executionContext) _OrderV4WithMetadata_taker(ctx context.Context, fiel
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Taker, nil
+		return obj.MinExpirationTimeSeconds, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4WithMetadata_sender(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderStats_maxExpirationTimeSeconds(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderStats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4519,7 +6136,7 @@ func (ec *executionContext) _OrderV4WithMetadata_sender(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4WithMetadata",
+		Object:   "OrderStats",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4528,24 +6145,21 @@ func (ec *executionContext) _OrderV4WithMetadata_sender(ctx context.Context, fie
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Sender, nil
+		return obj.MaxExpirationTimeSeconds, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4WithMetadata_feeRecipient(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4_chainId(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4553,7 +6167,7 @@ func (ec *executionContext) _OrderV4WithMetadata_feeRecipient(ctx context.Contex
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4WithMetadata",
+		Object:   "OrderV4",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4562,7 +6176,7 @@ func (ec *executionContext) _OrderV4WithMetadata_feeRecipient(ctx context.Contex
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FeeRecipient, nil
+		return obj.ChainID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4579,7 +6193,7 @@ func (ec *executionContext) _OrderV4WithMetadata_feeRecipient(ctx context.Contex
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4WithMetadata_pool(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4_verifyingContract(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4587,7 +6201,7 @@ func (ec *executionContext) _OrderV4WithMetadata_pool(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4WithMetadata",
+		Object:   "OrderV4",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4596,7 +6210,7 @@ func (ec *executionContext) _OrderV4WithMetadata_pool(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Pool, nil
+		return obj.VerifyingContract, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4613,7 +6227,7 @@ func (ec *executionContext) _OrderV4WithMetadata_pool(ctx context.Context, field
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4WithMetadata_expiry(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4_makerToken(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4621,7 +6235,7 @@ func (ec *executionContext) _OrderV4WithMetadata_expiry(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4WithMetadata",
+		Object:   "OrderV4",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4630,7 +6244,7 @@ func (ec *executionContext) _OrderV4WithMetadata_expiry(ctx context.Context, fie
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Expiry, nil
+		return obj.MakerToken, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4647,7 +6261,7 @@ func (ec *executionContext) _OrderV4WithMetadata_expiry(ctx context.Context, fie
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4WithMetadata_salt(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4_takerToken(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4655,7 +6269,7 @@ func (ec *executionContext) _OrderV4WithMetadata_salt(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4WithMetadata",
+		Object:   "OrderV4",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4664,7 +6278,7 @@ func (ec *executionContext) _OrderV4WithMetadata_salt(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Salt, nil
+		return obj.TakerToken, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4681,7 +6295,7 @@ func (ec *executionContext) _OrderV4WithMetadata_salt(ctx context.Context, field
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4WithMetadata_signatureType(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4_makerAmount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4689,7 +6303,7 @@ func (ec *executionContext) _OrderV4WithMetadata_signatureType(ctx context.Conte
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4WithMetadata",
+		Object:   "OrderV4",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4698,7 +6312,7 @@ func (ec *executionContext) _OrderV4WithMetadata_signatureType(ctx context.Conte
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SignatureType, nil
+		return obj.MakerAmount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4715,7 +6329,7 @@ func (ec *executionContext) _OrderV4WithMetadata_signatureType(ctx context.Conte
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4WithMetadata_signatureV(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4_takerAmount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4723,7 +6337,7 @@ func (ec *executionContext) _OrderV4WithMetadata_signatureV(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4WithMetadata",
+		Object:   "OrderV4",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4732,7 +6346,7 @@ func (ec *executionContext) _OrderV4WithMetadata_signatureV(ctx context.Context,
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SignatureV, nil
+		return obj.TakerAmount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4749,7 +6363,7 @@ func (ec *executionContext) _OrderV4WithMetadata_signatureV(ctx context.Context,
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4WithMetadata_signatureR(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4_takerTokenFeeAmount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4757,7 +6371,7 @@ func (ec *executionContext) _OrderV4WithMetadata_signatureR(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4WithMetadata",
+		Object:   "OrderV4",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4766,7 +6380,7 @@ func (ec *executionContext) _OrderV4WithMetadata_signatureR(ctx context.Context,
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SignatureR, nil
+		return obj.TakerTokenFeeAmount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4783,7 +6397,7 @@ func (ec *executionContext) _OrderV4WithMetadata_signatureR(ctx context.Context,
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4WithMetadata_signatureS(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4_maker(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4791,7 +6405,7 @@ func (ec *executionContext) _OrderV4WithMetadata_signatureS(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4WithMetadata",
+		Object:   "OrderV4",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4800,7 +6414,7 @@ func (ec *executionContext) _OrderV4WithMetadata_signatureS(ctx context.Context,
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SignatureS, nil
+		return obj.Maker, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4817,7 +6431,7 @@ func (ec *executionContext) _OrderV4WithMetadata_signatureS(ctx context.Context,
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4WithMetadata_hash(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4_taker(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4825,7 +6439,7 @@ func (ec *executionContext) _OrderV4WithMetadata_hash(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4WithMetadata",
+		Object:   "OrderV4",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4834,7 +6448,7 @@ func (ec *executionContext) _OrderV4WithMetadata_hash(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Hash, nil
+		return obj.Taker, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4851,7 +6465,7 @@ func (ec *executionContext) _OrderV4WithMetadata_hash(ctx context.Context, field
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderV4WithMetadata_fillableTakerAssetAmount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4_sender(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4859,7 +6473,7 @@ func (ec *executionContext) _OrderV4WithMetadata_fillableTakerAssetAmount(ctx co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderV4WithMetadata",
+		Object:   "OrderV4",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4868,7 +6482,7 @@ func (ec *executionContext) _OrderV4WithMetadata_fillableTakerAssetAmount(ctx co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FillableTakerAssetAmount, nil
+		return obj.Sender, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4885,7 +6499,7 @@ func (ec *executionContext) _OrderV4WithMetadata_fillableTakerAssetAmount(ctx co
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderWithMetadata_chainId(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4_feeRecipient(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4893,7 +6507,7 @@ func (ec *executionContext) _OrderWithMetadata_chainId(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderWithMetadata",
+		Object:   "OrderV4",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4902,7 +6516,7 @@ func (ec *executionContext) _OrderWithMetadata_chainId(ctx context.Context, fiel
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ChainID, nil
+		return obj.FeeRecipient, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4919,7 +6533,7 @@ func (ec *executionContext) _OrderWithMetadata_chainId(ctx context.Context, fiel
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderWithMetadata_exchangeAddress(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4_pool(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4927,7 +6541,7 @@ func (ec *executionContext) _OrderWithMetadata_exchangeAddress(ctx context.Conte
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderWithMetadata",
+		Object:   "OrderV4",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4936,7 +6550,7 @@ func (ec *executionContext) _OrderWithMetadata_exchangeAddress(ctx context.Conte
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExchangeAddress, nil
+		return obj.Pool, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4953,7 +6567,7 @@ func (ec *executionContext) _OrderWithMetadata_exchangeAddress(ctx context.Conte
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderWithMetadata_makerAddress(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4_expiry(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4961,7 +6575,7 @@ func (ec *executionContext) _OrderWithMetadata_makerAddress(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderWithMetadata",
+		Object:   "OrderV4",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4970,7 +6584,7 @@ func (ec *executionContext) _OrderWithMetadata_makerAddress(ctx context.Context,
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MakerAddress, nil
+		return obj.Expiry, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4987,7 +6601,7 @@ func (ec *executionContext) _OrderWithMetadata_makerAddress(ctx context.Context,
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderWithMetadata_makerAssetData(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4_salt(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4995,7 +6609,7 @@ func (ec *executionContext) _OrderWithMetadata_makerAssetData(ctx context.Contex
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderWithMetadata",
+		Object:   "OrderV4",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5004,7 +6618,7 @@ func (ec *executionContext) _OrderWithMetadata_makerAssetData(ctx context.Contex
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MakerAssetData, nil
+		return obj.Salt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5021,7 +6635,7 @@ func (ec *executionContext) _OrderWithMetadata_makerAssetData(ctx context.Contex
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderWithMetadata_makerAssetAmount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4_signatureType(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5029,7 +6643,7 @@ func (ec *executionContext) _OrderWithMetadata_makerAssetAmount(ctx context.Cont
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderWithMetadata",
+		Object:   "OrderV4",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5038,7 +6652,7 @@ func (ec *executionContext) _OrderWithMetadata_makerAssetAmount(ctx context.Cont
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MakerAssetAmount, nil
+		return obj.SignatureType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5055,7 +6669,7 @@ func (ec *executionContext) _OrderWithMetadata_makerAssetAmount(ctx context.Cont
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderWithMetadata_makerFeeAssetData(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4_signatureV(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5063,7 +6677,7 @@ func (ec *executionContext) _OrderWithMetadata_makerFeeAssetData(ctx context.Con
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderWithMetadata",
+		Object:   "OrderV4",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5072,7 +6686,7 @@ func (ec *executionContext) _OrderWithMetadata_makerFeeAssetData(ctx context.Con
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MakerFeeAssetData, nil
+		return obj.SignatureV, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5089,7 +6703,7 @@ func (ec *executionContext) _OrderWithMetadata_makerFeeAssetData(ctx context.Con
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderWithMetadata_makerFee(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4_signatureR(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5097,7 +6711,7 @@ func (ec *executionContext) _OrderWithMetadata_makerFee(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderWithMetadata",
+		Object:   "OrderV4",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5106,7 +6720,7 @@ func (ec *executionContext) _OrderWithMetadata_makerFee(ctx context.Context, fie
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MakerFee, nil
+		return obj.SignatureR, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5123,7 +6737,7 @@ func (ec *executionContext) _OrderWithMetadata_makerFee(ctx context.Context, fie
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderWithMetadata_takerAddress(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4_signatureS(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5131,7 +6745,7 @@ func (ec *executionContext) _OrderWithMetadata_takerAddress(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderWithMetadata",
+		Object:   "OrderV4",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5140,7 +6754,7 @@ func (ec *executionContext) _OrderWithMetadata_takerAddress(ctx context.Context,
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TakerAddress, nil
+		return obj.SignatureS, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5157,7 +6771,7 @@ func (ec *executionContext) _OrderWithMetadata_takerAddress(ctx context.Context,
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderWithMetadata_takerAssetData(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4Connection_orders(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4Connection) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5165,7 +6779,7 @@ func (ec *executionContext) _OrderWithMetadata_takerAssetData(ctx context.Contex
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderWithMetadata",
+		Object:   "OrderV4Connection",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5174,7 +6788,7 @@ func (ec *executionContext) _OrderWithMetadata_takerAssetData(ctx context.Contex
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TakerAssetData, nil
+		return obj.Orders, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5186,12 +6800,12 @@ func (ec *executionContext) _OrderWithMetadata_takerAssetData(ctx context.Contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*gqltypes.OrderV4WithMetadata)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNOrderV4WithMetadata2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderV4WithMetadataᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderWithMetadata_takerAssetAmount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4Connection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4Connection) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5199,7 +6813,7 @@ func (ec *executionContext) _OrderWithMetadata_takerAssetAmount(ctx context.Cont
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderWithMetadata",
+		Object:   "OrderV4Connection",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5208,7 +6822,7 @@ func (ec *executionContext) _OrderWithMetadata_takerAssetAmount(ctx context.Cont
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TakerAssetAmount, nil
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5220,12 +6834,12 @@ func (ec *executionContext) _OrderWithMetadata_takerAssetAmount(ctx context.Cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*gqltypes.PageInfo)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderWithMetadata_takerFeeAssetData(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4WithMetadata_chainId(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5233,7 +6847,7 @@ func (ec *executionContext) _OrderWithMetadata_takerFeeAssetData(ctx context.Con
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderWithMetadata",
+		Object:   "OrderV4WithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5242,7 +6856,7 @@ func (ec *executionContext) _OrderWithMetadata_takerFeeAssetData(ctx context.Con
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TakerFeeAssetData, nil
+		return obj.ChainID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5259,7 +6873,7 @@ func (ec *executionContext) _OrderWithMetadata_takerFeeAssetData(ctx context.Con
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderWithMetadata_takerFee(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4WithMetadata_verifyingContract(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5267,7 +6881,7 @@ func (ec *executionContext) _OrderWithMetadata_takerFee(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderWithMetadata",
+		Object:   "OrderV4WithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5276,7 +6890,7 @@ func (ec *executionContext) _OrderWithMetadata_takerFee(ctx context.Context, fie
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TakerFee, nil
+		return obj.VerifyingContract, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5293,7 +6907,7 @@ func (ec *executionContext) _OrderWithMetadata_takerFee(ctx context.Context, fie
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderWithMetadata_senderAddress(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4WithMetadata_makerToken(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5301,7 +6915,7 @@ func (ec *executionContext) _OrderWithMetadata_senderAddress(ctx context.Context
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderWithMetadata",
+		Object:   "OrderV4WithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5310,7 +6924,7 @@ func (ec *executionContext) _OrderWithMetadata_senderAddress(ctx context.Context
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SenderAddress, nil
+		return obj.MakerToken, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5327,7 +6941,7 @@ func (ec *executionContext) _OrderWithMetadata_senderAddress(ctx context.Context
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderWithMetadata_feeRecipientAddress(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4WithMetadata_takerToken(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5335,7 +6949,7 @@ func (ec *executionContext) _OrderWithMetadata_feeRecipientAddress(ctx context.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderWithMetadata",
+		Object:   "OrderV4WithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5344,7 +6958,7 @@ func (ec *executionContext) _OrderWithMetadata_feeRecipientAddress(ctx context.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FeeRecipientAddress, nil
+		return obj.TakerToken, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5361,7 +6975,7 @@ func (ec *executionContext) _OrderWithMetadata_feeRecipientAddress(ctx context.C
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderWithMetadata_expirationTimeSeconds(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4WithMetadata_makerAmount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5369,7 +6983,7 @@ func (ec *executionContext) _OrderWithMetadata_expirationTimeSeconds(ctx context
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderWithMetadata",
+		Object:   "OrderV4WithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5378,7 +6992,7 @@ func (ec *executionContext) _OrderWithMetadata_expirationTimeSeconds(ctx context
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExpirationTimeSeconds, nil
+		return obj.MakerAmount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5395,7 +7009,7 @@ func (ec *executionContext) _OrderWithMetadata_expirationTimeSeconds(ctx context
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderWithMetadata_salt(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4WithMetadata_takerAmount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5403,7 +7017,7 @@ func (ec *executionContext) _OrderWithMetadata_salt(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderWithMetadata",
+		Object:   "OrderV4WithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5412,7 +7026,7 @@ func (ec *executionContext) _OrderWithMetadata_salt(ctx context.Context, field g
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Salt, nil
+		return obj.TakerAmount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5429,7 +7043,7 @@ func (ec *executionContext) _OrderWithMetadata_salt(ctx context.Context, field g
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderWithMetadata_signature(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4WithMetadata_takerTokenFeeAmount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5437,7 +7051,7 @@ func (ec *executionContext) _OrderWithMetadata_signature(ctx context.Context, fi
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderWithMetadata",
+		Object:   "OrderV4WithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5446,7 +7060,7 @@ func (ec *executionContext) _OrderWithMetadata_signature(ctx context.Context, fi
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Signature, nil
+		return obj.TakerTokenFeeAmount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5463,7 +7077,7 @@ func (ec *executionContext) _OrderWithMetadata_signature(ctx context.Context, fi
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderWithMetadata_hash(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4WithMetadata_maker(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5471,7 +7085,7 @@ func (ec *executionContext) _OrderWithMetadata_hash(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderWithMetadata",
+		Object:   "OrderV4WithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5480,7 +7094,7 @@ func (ec *executionContext) _OrderWithMetadata_hash(ctx context.Context, field g
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Hash, nil
+		return obj.Maker, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5497,7 +7111,7 @@ func (ec *executionContext) _OrderWithMetadata_hash(ctx context.Context, field g
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _OrderWithMetadata_fillableTakerAssetAmount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4WithMetadata_taker(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5505,7 +7119,7 @@ func (ec *executionContext) _OrderWithMetadata_fillableTakerAssetAmount(ctx cont
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "OrderWithMetadata",
+		Object:   "OrderV4WithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5514,7 +7128,7 @@ func (ec *executionContext) _OrderWithMetadata_fillableTakerAssetAmount(ctx cont
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FillableTakerAssetAmount, nil
+		return obj.Taker, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5531,7 +7145,7 @@ func (ec *executionContext) _OrderWithMetadata_fillableTakerAssetAmount(ctx cont
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_order(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4WithMetadata_sender(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5539,37 +7153,33 @@ func (ec *executionContext) _Query_order(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "OrderV4WithMetadata",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_order_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Order(rctx, args["hash"].(string))
+		return obj.Sender, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*gqltypes.OrderWithMetadata)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOOrderWithMetadata2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderWithMetadata(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_orderv4(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4WithMetadata_feeRecipient(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5577,37 +7187,33 @@ func (ec *executionContext) _Query_orderv4(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "OrderV4WithMetadata",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_orderv4_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Orderv4(rctx, args["hash"].(string))
+		return obj.FeeRecipient, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*gqltypes.OrderV4WithMetadata)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOOrderV4WithMetadata2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderV4WithMetadata(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_orders(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4WithMetadata_pool(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5615,23 +7221,16 @@ func (ec *executionContext) _Query_orders(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "OrderV4WithMetadata",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_orders_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Orders(rctx, args["sort"].([]*gqltypes.OrderSort), args["filters"].([]*gqltypes.OrderFilter), args["limit"].(*int))
+		return obj.Pool, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5643,12 +7242,12 @@ func (ec *executionContext) _Query_orders(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*gqltypes.OrderWithMetadata)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNOrderWithMetadata2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderWithMetadataᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_ordersv4(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4WithMetadata_expiry(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5656,23 +7255,16 @@ func (ec *executionContext) _Query_ordersv4(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "OrderV4WithMetadata",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_ordersv4_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Ordersv4(rctx, args["sort"].([]*gqltypes.OrderSortV4), args["filters"].([]*gqltypes.OrderFilterV4), args["limit"].(*int))
+		return obj.Expiry, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5684,12 +7276,12 @@ func (ec *executionContext) _Query_ordersv4(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*gqltypes.OrderV4WithMetadata)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNOrderV4WithMetadata2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderV4WithMetadataᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_stats(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4WithMetadata_salt(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5697,16 +7289,16 @@ func (ec *executionContext) _Query_stats(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "OrderV4WithMetadata",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Stats(rctx)
+		return obj.Salt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5718,12 +7310,12 @@ func (ec *executionContext) _Query_stats(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*gqltypes.Stats)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNStats2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐStats(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4WithMetadata_signatureType(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5731,37 +7323,33 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "OrderV4WithMetadata",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query___type_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(args["name"].(string))
+		return obj.SignatureType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4WithMetadata_signatureV(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5769,30 +7357,33 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "OrderV4WithMetadata",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return obj.SignatureV, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RejectedOrderResult_hash(ctx context.Context, field graphql.CollectedField, obj *gqltypes.RejectedOrderResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4WithMetadata_signatureR(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5800,7 +7391,7 @@ func (ec *executionContext) _RejectedOrderResult_hash(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "RejectedOrderResult",
+		Object:   "OrderV4WithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5809,21 +7400,24 @@ func (ec *executionContext) _RejectedOrderResult_hash(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Hash, nil
+		return obj.SignatureR, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RejectedOrderResult_order(ctx context.Context, field graphql.CollectedField, obj *gqltypes.RejectedOrderResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4WithMetadata_signatureS(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5831,7 +7425,7 @@ func (ec *executionContext) _RejectedOrderResult_order(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "RejectedOrderResult",
+		Object:   "OrderV4WithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5840,7 +7434,7 @@ func (ec *executionContext) _RejectedOrderResult_order(ctx context.Context, fiel
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Order, nil
+		return obj.SignatureS, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5852,12 +7446,12 @@ func (ec *executionContext) _RejectedOrderResult_order(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*gqltypes.Order)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNOrder2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrder(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RejectedOrderResult_code(ctx context.Context, field graphql.CollectedField, obj *gqltypes.RejectedOrderResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4WithMetadata_hash(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5865,7 +7459,7 @@ func (ec *executionContext) _RejectedOrderResult_code(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "RejectedOrderResult",
+		Object:   "OrderV4WithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5874,7 +7468,7 @@ func (ec *executionContext) _RejectedOrderResult_code(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Code, nil
+		return obj.Hash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5886,12 +7480,12 @@ func (ec *executionContext) _RejectedOrderResult_code(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(gqltypes.RejectedOrderCode)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNRejectedOrderCode2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐRejectedOrderCode(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RejectedOrderResult_message(ctx context.Context, field graphql.CollectedField, obj *gqltypes.RejectedOrderResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderV4WithMetadata_fillableTakerAssetAmount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderV4WithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5899,7 +7493,7 @@ func (ec *executionContext) _RejectedOrderResult_message(ctx context.Context, fi
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "RejectedOrderResult",
+		Object:   "OrderV4WithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5908,7 +7502,7 @@ func (ec *executionContext) _RejectedOrderResult_message(ctx context.Context, fi
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return obj.FillableTakerAssetAmount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5925,7 +7519,7 @@ func (ec *executionContext) _RejectedOrderResult_message(ctx context.Context, fi
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RejectedOrderResultV4_hash(ctx context.Context, field graphql.CollectedField, obj *gqltypes.RejectedOrderResultV4) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderWithMetadata_chainId(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5933,7 +7527,7 @@ func (ec *executionContext) _RejectedOrderResultV4_hash(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "RejectedOrderResultV4",
+		Object:   "OrderWithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5942,21 +7536,24 @@ func (ec *executionContext) _RejectedOrderResultV4_hash(ctx context.Context, fie
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Hash, nil
+		return obj.ChainID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RejectedOrderResultV4_order(ctx context.Context, field graphql.CollectedField, obj *gqltypes.RejectedOrderResultV4) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderWithMetadata_exchangeAddress(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5964,7 +7561,7 @@ func (ec *executionContext) _RejectedOrderResultV4_order(ctx context.Context, fi
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "RejectedOrderResultV4",
+		Object:   "OrderWithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -5973,7 +7570,7 @@ func (ec *executionContext) _RejectedOrderResultV4_order(ctx context.Context, fi
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Order, nil
+		return obj.ExchangeAddress, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5985,12 +7582,12 @@ func (ec *executionContext) _RejectedOrderResultV4_order(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*gqltypes.OrderV4)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNOrderV42ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderV4(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RejectedOrderResultV4_code(ctx context.Context, field graphql.CollectedField, obj *gqltypes.RejectedOrderResultV4) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderWithMetadata_makerAddress(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5998,7 +7595,7 @@ func (ec *executionContext) _RejectedOrderResultV4_code(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "RejectedOrderResultV4",
+		Object:   "OrderWithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6007,7 +7604,7 @@ func (ec *executionContext) _RejectedOrderResultV4_code(ctx context.Context, fie
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Code, nil
+		return obj.MakerAddress, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6019,12 +7616,12 @@ func (ec *executionContext) _RejectedOrderResultV4_code(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(gqltypes.RejectedOrderCode)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNRejectedOrderCode2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐRejectedOrderCode(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RejectedOrderResultV4_message(ctx context.Context, field graphql.CollectedField, obj *gqltypes.RejectedOrderResultV4) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderWithMetadata_makerAssetData(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6032,7 +7629,7 @@ func (ec *executionContext) _RejectedOrderResultV4_message(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "RejectedOrderResultV4",
+		Object:   "OrderWithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6041,7 +7638,7 @@ func (ec *executionContext) _RejectedOrderResultV4_message(ctx context.Context,
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return obj.MakerAssetData, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6058,7 +7655,7 @@ func (ec *executionContext) _RejectedOrderResultV4_message(ctx context.Context,
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_version(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderWithMetadata_makerAssetAmount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6066,7 +7663,7 @@ func (ec *executionContext) _Stats_version(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Stats",
+		Object:   "OrderWithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6075,7 +7672,7 @@ func (ec *executionContext) _Stats_version(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Version, nil
+		return obj.MakerAssetAmount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6092,7 +7689,7 @@ func (ec *executionContext) _Stats_version(ctx context.Context, field graphql.Co
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_pubSubTopic(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderWithMetadata_makerFeeAssetData(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6100,7 +7697,7 @@ func (ec *executionContext) _Stats_pubSubTopic(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Stats",
+		Object:   "OrderWithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6109,7 +7706,7 @@ func (ec *executionContext) _Stats_pubSubTopic(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PubSubTopic, nil
+		return obj.MakerFeeAssetData, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6126,7 +7723,7 @@ func (ec *executionContext) _Stats_pubSubTopic(ctx context.Context, field graphq
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_rendezvous(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderWithMetadata_makerFee(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6134,7 +7731,7 @@ func (ec *executionContext) _Stats_rendezvous(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Stats",
+		Object:   "OrderWithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6143,7 +7740,7 @@ func (ec *executionContext) _Stats_rendezvous(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Rendezvous, nil
+		return obj.MakerFee, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6160,7 +7757,7 @@ func (ec *executionContext) _Stats_rendezvous(ctx context.Context, field graphql
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_peerID(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderWithMetadata_takerAddress(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6168,7 +7765,7 @@ func (ec *executionContext) _Stats_peerID(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Stats",
+		Object:   "OrderWithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6177,7 +7774,7 @@ func (ec *executionContext) _Stats_peerID(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PeerID, nil
+		return obj.TakerAddress, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6194,7 +7791,7 @@ func (ec *executionContext) _Stats_peerID(ctx context.Context, field graphql.Col
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_ethereumChainID(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderWithMetadata_takerAssetData(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6202,7 +7799,7 @@ func (ec *executionContext) _Stats_ethereumChainID(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Stats",
+		Object:   "OrderWithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6211,7 +7808,7 @@ func (ec *executionContext) _Stats_ethereumChainID(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EthereumChainID, nil
+		return obj.TakerAssetData, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6223,12 +7820,12 @@ func (ec *executionContext) _Stats_ethereumChainID(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_latestBlock(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderWithMetadata_takerAssetAmount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6236,7 +7833,7 @@ func (ec *executionContext) _Stats_latestBlock(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Stats",
+		Object:   "OrderWithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6245,21 +7842,24 @@ func (ec *executionContext) _Stats_latestBlock(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LatestBlock, nil
+		return obj.TakerAssetAmount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*gqltypes.LatestBlock)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOLatestBlock2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐLatestBlock(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_numPeers(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderWithMetadata_takerFeeAssetData(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6267,7 +7867,7 @@ func (ec *executionContext) _Stats_numPeers(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Stats",
+		Object:   "OrderWithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6276,7 +7876,7 @@ func (ec *executionContext) _Stats_numPeers(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.NumPeers, nil
+		return obj.TakerFeeAssetData, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6288,12 +7888,12 @@ func (ec *executionContext) _Stats_numPeers(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_numOrders(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderWithMetadata_takerFee(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6301,7 +7901,7 @@ func (ec *executionContext) _Stats_numOrders(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Stats",
+		Object:   "OrderWithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6310,7 +7910,7 @@ func (ec *executionContext) _Stats_numOrders(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.NumOrders, nil
+		return obj.TakerFee, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6322,12 +7922,12 @@ func (ec *executionContext) _Stats_numOrders(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_numOrdersV4(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderWithMetadata_senderAddress(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6335,7 +7935,7 @@ func (ec *executionContext) _Stats_numOrdersV4(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Stats",
+		Object:   "OrderWithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6344,7 +7944,7 @@ func (ec *executionContext) _Stats_numOrdersV4(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.NumOrdersV4, nil
+		return obj.SenderAddress, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6356,12 +7956,12 @@ func (ec *executionContext) _Stats_numOrdersV4(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_numOrdersIncludingRemoved(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderWithMetadata_feeRecipientAddress(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6369,7 +7969,7 @@ func (ec *executionContext) _Stats_numOrdersIncludingRemoved(ctx context.Context
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Stats",
+		Object:   "OrderWithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6378,7 +7978,7 @@ func (ec *executionContext) _Stats_numOrdersIncludingRemoved(ctx context.Context
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.NumOrdersIncludingRemoved, nil
+		return obj.FeeRecipientAddress, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6390,12 +7990,12 @@ func (ec *executionContext) _Stats_numOrdersIncludingRemoved(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_numOrdersIncludingRemovedV4(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderWithMetadata_expirationTimeSeconds(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6403,7 +8003,7 @@ func (ec *executionContext) _Stats_numOrdersIncludingRemovedV4(ctx context.Conte
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Stats",
+		Object:   "OrderWithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6412,7 +8012,7 @@ func (ec *executionContext) _Stats_numOrdersIncludingRemovedV4(ctx context.Conte
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.NumOrdersIncludingRemovedV4, nil
+		return obj.ExpirationTimeSeconds, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6424,12 +8024,12 @@ func (ec *executionContext) _Stats_numOrdersIncludingRemovedV4(ctx context.Conte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_numPinnedOrders(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderWithMetadata_salt(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6437,7 +8037,7 @@ func (ec *executionContext) _Stats_numPinnedOrders(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Stats",
+		Object:   "OrderWithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6446,7 +8046,7 @@ func (ec *executionContext) _Stats_numPinnedOrders(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.NumPinnedOrders, nil
+		return obj.Salt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6458,12 +8058,12 @@ func (ec *executionContext) _Stats_numPinnedOrders(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_numPinnedOrdersV4(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderWithMetadata_signature(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6471,7 +8071,7 @@ func (ec *executionContext) _Stats_numPinnedOrdersV4(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Stats",
+		Object:   "OrderWithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6480,7 +8080,7 @@ func (ec *executionContext) _Stats_numPinnedOrdersV4(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.NumPinnedOrdersV4, nil
+		return obj.Signature, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6492,12 +8092,12 @@ func (ec *executionContext) _Stats_numPinnedOrdersV4(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_startOfCurrentUTCDay(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderWithMetadata_hash(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6505,7 +8105,7 @@ func (ec *executionContext) _Stats_startOfCurrentUTCDay(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Stats",
+		Object:   "OrderWithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6514,7 +8114,7 @@ func (ec *executionContext) _Stats_startOfCurrentUTCDay(ctx context.Context, fie
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.StartOfCurrentUTCDay, nil
+		return obj.Hash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6531,7 +8131,7 @@ func (ec *executionContext) _Stats_startOfCurrentUTCDay(ctx context.Context, fie
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_ethRPCRequestsSentInCurrentUTCDay(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderWithMetadata_fillableTakerAssetAmount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderWithMetadata) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6539,7 +8139,7 @@ func (ec *executionContext) _Stats_ethRPCRequestsSentInCurrentUTCDay(ctx context
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Stats",
+		Object:   "OrderWithMetadata",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6548,7 +8148,7 @@ func (ec *executionContext) _Stats_ethRPCRequestsSentInCurrentUTCDay(ctx context
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EthRPCRequestsSentInCurrentUTCDay, nil
+		return obj.FillableTakerAssetAmount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6560,12 +8160,12 @@ func (ec *executionContext) _Stats_ethRPCRequestsSentInCurrentUTCDay(ctx context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_ethRPCRateLimitExpiredRequests(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _Orderbook_baseToken(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Orderbook) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6573,7 +8173,7 @@ func (ec *executionContext) _Stats_ethRPCRateLimitExpiredRequests(ctx context.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Stats",
+		Object:   "Orderbook",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6582,7 +8182,7 @@ func (ec *executionContext) _Stats_ethRPCRateLimitExpiredRequests(ctx context.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EthRPCRateLimitExpiredRequests, nil
+		return obj.BaseToken, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6594,12 +8194,12 @@ func (ec *executionContext) _Stats_ethRPCRateLimitExpiredRequests(ctx context.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_secondaryRendezvous(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _Orderbook_quoteToken(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Orderbook) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6607,7 +8207,7 @@ func (ec *executionContext) _Stats_secondaryRendezvous(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Stats",
+		Object:   "Orderbook",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6616,7 +8216,7 @@ func (ec *executionContext) _Stats_secondaryRendezvous(ctx context.Context, fiel
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SecondaryRendezvous, nil
+		return obj.QuoteToken, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6628,12 +8228,12 @@ func (ec *executionContext) _Stats_secondaryRendezvous(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Stats_maxExpirationTime(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+func (ec *executionContext) _Orderbook_bids(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Orderbook) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6641,7 +8241,7 @@ func (ec *executionContext) _Stats_maxExpirationTime(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Stats",
+		Object:   "Orderbook",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6650,7 +8250,7 @@ func (ec *executionContext) _Stats_maxExpirationTime(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MaxExpirationTime, nil
+		return obj.Bids, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6662,56 +8262,46 @@ func (ec *executionContext) _Stats_maxExpirationTime(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*gqltypes.OrderbookLevel)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNOrderbookLevel2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderbookLevelᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Subscription_orderEvents(ctx context.Context, field graphql.CollectedField) (ret func() graphql.Marshaler) {
+func (ec *executionContext) _Orderbook_asks(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Orderbook) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Subscription",
+		Object:   "Orderbook",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().OrderEvents(rctx)
+		return obj.Asks, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
 		if !graphql.HasFieldError(ctx, fc) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return nil
-	}
-	return func() graphql.Marshaler {
-		res, ok := <-resTmp.(<-chan []*gqltypes.OrderEvent)
-		if !ok {
-			return nil
-		}
-		return graphql.WriterFunc(func(w io.Writer) {
-			w.Write([]byte{'{'})
-			graphql.MarshalString(field.Alias).MarshalGQL(w)
-			w.Write([]byte{':'})
-			ec.marshalNOrderEvent2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderEventᚄ(ctx, field.Selections, res).MarshalGQL(w)
-			w.Write([]byte{'}'})
-		})
+		return graphql.Null
 	}
+	res := resTmp.([]*gqltypes.OrderbookLevel)
+	fc.Result = res
+	return ec.marshalNOrderbookLevel2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderbookLevelᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderbookLevel_price(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderbookLevel) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6719,7 +8309,7 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Directive",
+		Object:   "OrderbookLevel",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6728,7 +8318,7 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Price, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6745,7 +8335,7 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderbookLevel_baseAmount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderbookLevel) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6753,7 +8343,7 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Directive",
+		Object:   "OrderbookLevel",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6762,21 +8352,24 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.BaseAmount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderbookLevel_quoteAmount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderbookLevel) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6784,7 +8377,7 @@ func (ec *executionContext) ___Directive_locations(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Directive",
+		Object:   "OrderbookLevel",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6793,7 +8386,7 @@ func (ec *executionContext) ___Directive_locations(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Locations, nil
+		return obj.QuoteAmount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6805,12 +8398,12 @@ func (ec *executionContext) ___Directive_locations(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _OrderbookLevel_orderCount(ctx context.Context, field graphql.CollectedField, obj *gqltypes.OrderbookLevel) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6818,7 +8411,7 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Directive",
+		Object:   "OrderbookLevel",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6827,7 +8420,7 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return obj.OrderCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6839,12 +8432,12 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graphql.CollectedField, obj *gqltypes.PageInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6852,7 +8445,7 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__EnumValue",
+		Object:   "PageInfo",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6861,24 +8454,21 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.EndCursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *gqltypes.PageInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6886,7 +8476,7 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__EnumValue",
+		Object:   "PageInfo",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6895,21 +8485,24 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.HasNextPage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Peer_id(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Peer) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6917,16 +8510,16 @@ func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__EnumValue",
+		Object:   "Peer",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6938,12 +8531,12 @@ func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Peer_connections(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Peer) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6951,30 +8544,33 @@ func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__EnumValue",
+		Object:   "Peer",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return obj.Connections, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*gqltypes.PeerConnection)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNPeerConnection2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐPeerConnectionᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _Peer_tags(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Peer) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6982,7 +8578,7 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Field",
+		Object:   "Peer",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -6991,7 +8587,7 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Tags, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7003,12 +8599,12 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*gqltypes.PeerTag)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNPeerTag2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐPeerTagᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _Peer_score(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Peer) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7016,7 +8612,7 @@ func (ec *executionContext) ___Field_description(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Field",
+		Object:   "Peer",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -7025,21 +8621,24 @@ func (ec *executionContext) ___Field_description(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.Score, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(string)
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _Peer_bandwidth(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Peer) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7047,7 +8646,7 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Field",
+		Object:   "Peer",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -7056,7 +8655,7 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return obj.Bandwidth, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7068,12 +8667,12 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(*gqltypes.PeerBandwidth)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNPeerBandwidth2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐPeerBandwidth(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _PeerBandwidth_totalIn(ctx context.Context, field graphql.CollectedField, obj *gqltypes.PeerBandwidth) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7081,7 +8680,7 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Field",
+		Object:   "PeerBandwidth",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -7090,7 +8689,7 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.TotalIn, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7102,12 +8701,12 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _PeerBandwidth_totalOut(ctx context.Context, field graphql.CollectedField, obj *gqltypes.PeerBandwidth) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7115,16 +8714,16 @@ func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Field",
+		Object:   "PeerBandwidth",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return obj.TotalOut, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7136,12 +8735,12 @@ func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _PeerBandwidth_rateIn(ctx context.Context, field graphql.CollectedField, obj *gqltypes.PeerBandwidth) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7149,30 +8748,33 @@ func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Field",
+		Object:   "PeerBandwidth",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return obj.RateIn, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _PeerBandwidth_rateOut(ctx context.Context, field graphql.CollectedField, obj *gqltypes.PeerBandwidth) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7180,7 +8782,7 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__InputValue",
+		Object:   "PeerBandwidth",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -7189,7 +8791,7 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.RateOut, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7201,12 +8803,12 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _PeerConnection_multiaddr(ctx context.Context, field graphql.CollectedField, obj *gqltypes.PeerConnection) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7214,7 +8816,7 @@ func (ec *executionContext) ___InputValue_description(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__InputValue",
+		Object:   "PeerConnection",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -7223,21 +8825,24 @@ func (ec *executionContext) ___InputValue_description(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.Multiaddr, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _PeerConnection_direction(ctx context.Context, field graphql.CollectedField, obj *gqltypes.PeerConnection) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7245,7 +8850,7 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__InputValue",
+		Object:   "PeerConnection",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -7254,7 +8859,7 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.Direction, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7266,12 +8871,12 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(gqltypes.PeerConnectionDirection)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNPeerConnectionDirection2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐPeerConnectionDirection(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _PeerTag_tag(ctx context.Context, field graphql.CollectedField, obj *gqltypes.PeerTag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7279,7 +8884,7 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__InputValue",
+		Object:   "PeerTag",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -7288,21 +8893,24 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DefaultValue, nil
+		return obj.Tag, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _PeerTag_value(ctx context.Context, field graphql.CollectedField, obj *gqltypes.PeerTag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7310,16 +8918,16 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Schema",
+		Object:   "PeerTag",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Types(), nil
+		return obj.Value, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7331,12 +8939,12 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_order(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7344,33 +8952,37 @@ func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Schema",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_order_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.QueryType(), nil
+		return ec.resolvers.Query().Order(rctx, args["hash"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*gqltypes.OrderWithMetadata)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalOOrderWithMetadata2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderWithMetadata(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_orderv4(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7378,16 +8990,23 @@ func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Schema",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_orderv4_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MutationType(), nil
+		return ec.resolvers.Query().Orderv4(rctx, args["hash"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7396,12 +9015,12 @@ func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field gr
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*gqltypes.OrderV4WithMetadata)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalOOrderV4WithMetadata2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderV4WithMetadata(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_orders(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7409,30 +9028,40 @@ func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Schema",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_orders_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SubscriptionType(), nil
+		return ec.resolvers.Query().Orders(rctx, args["sort"].([]*gqltypes.OrderSort), args["filters"].([]*gqltypes.OrderFilter), args["first"].(*int), args["after"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*gqltypes.OrderConnection)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNOrderConnection2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderConnection(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_ordersv4(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7440,16 +9069,23 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Schema",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_ordersv4_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Directives(), nil
+		return ec.resolvers.Query().Ordersv4(rctx, args["sort"].([]*gqltypes.OrderSortV4), args["filters"].([]*gqltypes.OrderFilterV4), args["first"].(*int), args["after"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7461,12 +9097,12 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Directive)
+	res := resTmp.(*gqltypes.OrderV4Connection)
 	fc.Result = res
-	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+	return ec.marshalNOrderV4Connection2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderV4Connection(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_orderStats(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7474,16 +9110,23 @@ func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Type",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_orderStats_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Kind(), nil
+		return ec.resolvers.Query().OrderStats(rctx, args["filters"].([]*gqltypes.OrderFilter))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7495,12 +9138,12 @@ func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*gqltypes.OrderStats)
 	fc.Result = res
-	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+	return ec.marshalNOrderStats2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderStats(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_orderStatsV4(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7508,30 +9151,40 @@ func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Type",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_orderStatsV4_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name(), nil
+		return ec.resolvers.Query().OrderStatsV4(rctx, args["filters"].([]*gqltypes.OrderFilterV4))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*gqltypes.OrderStats)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNOrderStats2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderStats(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_orderbook(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7539,30 +9192,40 @@ func (ec *executionContext) ___Type_description(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Type",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_orderbook_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return ec.resolvers.Query().Orderbook(rctx, args["baseToken"].(string), args["quoteToken"].(string), args["depth"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*gqltypes.Orderbook)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNOrderbook2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderbook(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_orderEventsSince(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7570,7 +9233,7 @@ func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Type",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -7578,7 +9241,7 @@ func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.Co
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field___Type_fields_args(ctx, rawArgs)
+	args, err := ec.field_Query_orderEventsSince_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -7586,21 +9249,24 @@ func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.Co
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Fields(args["includeDeprecated"].(bool)), nil
+		return ec.resolvers.Query().OrderEventsSince(rctx, args["sequenceNumber"].(string), args["limit"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Field)
+	res := resTmp.(*gqltypes.OrderEventPage)
 	fc.Result = res
-	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+	return ec.marshalNOrderEventPage2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderEventPage(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_archivedOrders(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7608,30 +9274,40 @@ func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Type",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_archivedOrders_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Interfaces(), nil
+		return ec.resolvers.Query().ArchivedOrders(rctx, args["hash"].(*string), args["maker"].(*string), args["endStates"].([]gqltypes.OrderEndState), args["archivedAfter"].(*string), args["archivedBefore"].(*string), args["limit"].(*int), args["offset"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.([]*gqltypes.ArchivedOrder)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNArchivedOrder2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐArchivedOrderᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_validateOrders(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7639,30 +9315,40 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Type",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_validateOrders_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PossibleTypes(), nil
+		return ec.resolvers.Query().ValidateOrders(rctx, args["orders"].([]*gqltypes.NewOrder), args["pinned"].(*bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(*gqltypes.AddOrdersResults)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNAddOrdersResults2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAddOrdersResults(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_validateOrdersV4(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7670,7 +9356,7 @@ func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Type",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -7678,7 +9364,7 @@ func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphq
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field___Type_enumValues_args(ctx, rawArgs)
+	args, err := ec.field_Query_validateOrdersV4_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -7686,21 +9372,24 @@ func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphq
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EnumValues(args["includeDeprecated"].(bool)), nil
+		return ec.resolvers.Query().ValidateOrdersV4(rctx, args["orders"].([]*gqltypes.NewOrderV4), args["pinned"].(*bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.EnumValue)
+	res := resTmp.(*gqltypes.AddOrdersResultsV4)
 	fc.Result = res
-	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNAddOrdersResultsV42ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAddOrdersResultsV4(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_stats(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7708,7 +9397,7 @@ func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Type",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -7717,21 +9406,24 @@ func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.InputFields(), nil
+		return ec.resolvers.Query().Stats(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(*gqltypes.Stats)
 	fc.Result = res
-	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNStats2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐStats(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_peers(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7739,7 +9431,7 @@ func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "__Type",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -7748,428 +9440,3705 @@ func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OfType(), nil
+		return ec.resolvers.Query().Peers(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.([]*gqltypes.Peer)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNPeer2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐPeerᚄ(ctx, field.Selections, res)
 }
 
-// endregion **************************** field.gotpl *****************************
-
-// region    **************************** input.gotpl *****************************
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
 
-func (ec *executionContext) unmarshalInputAddOrdersOpts(ctx context.Context, obj interface{}) (gqltypes.AddOrdersOpts, error) {
-	var it gqltypes.AddOrdersOpts
-	var asMap = obj.(map[string]interface{})
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query___type_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectType(args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
 
-	for k, v := range asMap {
-		switch k {
-		case "keepCancelled":
-			var err error
-			it.KeepCancelled, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "keepExpired":
-			var err error
-			it.KeepExpired, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "keepFullyFilled":
-			var err error
-			it.KeepFullyFilled, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "keepUnfunded":
-			var err error
-			it.KeepUnfunded, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
-			}
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
 	}
 
-	return it, nil
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectSchema()
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Schema)
+	fc.Result = res
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) unmarshalInputNewOrder(ctx context.Context, obj interface{}) (gqltypes.NewOrder, error) {
-	var it gqltypes.NewOrder
-	var asMap = obj.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "chainId":
-			var err error
-			it.ChainID, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "exchangeAddress":
-			var err error
-			it.ExchangeAddress, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "makerAddress":
-			var err error
-			it.MakerAddress, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "makerAssetData":
-			var err error
-			it.MakerAssetData, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "makerAssetAmount":
-			var err error
-			it.MakerAssetAmount, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "makerFeeAssetData":
-			var err error
-			it.MakerFeeAssetData, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "makerFee":
-			var err error
-			it.MakerFee, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "takerAddress":
-			var err error
-			it.TakerAddress, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "takerAssetData":
-			var err error
-			it.TakerAssetData, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "takerAssetAmount":
-			var err error
-			it.TakerAssetAmount, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "takerFeeAssetData":
-			var err error
-			it.TakerFeeAssetData, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "takerFee":
-			var err error
-			it.TakerFee, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "senderAddress":
-			var err error
-			it.SenderAddress, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "feeRecipientAddress":
-			var err error
-			it.FeeRecipientAddress, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "expirationTimeSeconds":
-			var err error
-			it.ExpirationTimeSeconds, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "salt":
-			var err error
-			it.Salt, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "signature":
-			var err error
-			it.Signature, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
+func (ec *executionContext) _QuotaUsage_id(ctx context.Context, field graphql.CollectedField, obj *gqltypes.QuotaUsage) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "QuotaUsage",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
 	}
 
-	return it, nil
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) unmarshalInputNewOrderV4(ctx context.Context, obj interface{}) (gqltypes.NewOrderV4, error) {
-	var it gqltypes.NewOrderV4
-	var asMap = obj.(map[string]interface{})
+func (ec *executionContext) _QuotaUsage_numOrders(ctx context.Context, field graphql.CollectedField, obj *gqltypes.QuotaUsage) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "QuotaUsage",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
 
-	for k, v := range asMap {
-		switch k {
-		case "chainId":
-			var err error
-			it.ChainID, err = ec.unmarshalNString2string(ctx, v)
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NumOrders, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RejectedOrderResult_hash(ctx context.Context, field graphql.CollectedField, obj *gqltypes.RejectedOrderResult) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "RejectedOrderResult",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Hash, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RejectedOrderResult_order(ctx context.Context, field graphql.CollectedField, obj *gqltypes.RejectedOrderResult) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "RejectedOrderResult",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Order, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*gqltypes.Order)
+	fc.Result = res
+	return ec.marshalNOrder2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrder(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RejectedOrderResult_code(ctx context.Context, field graphql.CollectedField, obj *gqltypes.RejectedOrderResult) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "RejectedOrderResult",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Code, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(gqltypes.RejectedOrderCode)
+	fc.Result = res
+	return ec.marshalNRejectedOrderCode2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐRejectedOrderCode(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RejectedOrderResult_message(ctx context.Context, field graphql.CollectedField, obj *gqltypes.RejectedOrderResult) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "RejectedOrderResult",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RejectedOrderResultV4_hash(ctx context.Context, field graphql.CollectedField, obj *gqltypes.RejectedOrderResultV4) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "RejectedOrderResultV4",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Hash, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RejectedOrderResultV4_order(ctx context.Context, field graphql.CollectedField, obj *gqltypes.RejectedOrderResultV4) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "RejectedOrderResultV4",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Order, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*gqltypes.OrderV4)
+	fc.Result = res
+	return ec.marshalNOrderV42ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderV4(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RejectedOrderResultV4_code(ctx context.Context, field graphql.CollectedField, obj *gqltypes.RejectedOrderResultV4) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "RejectedOrderResultV4",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Code, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(gqltypes.RejectedOrderCode)
+	fc.Result = res
+	return ec.marshalNRejectedOrderCode2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐRejectedOrderCode(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RejectedOrderResultV4_message(ctx context.Context, field graphql.CollectedField, obj *gqltypes.RejectedOrderResultV4) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "RejectedOrderResultV4",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Stats_version(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Stats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Version, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Stats_pubSubTopic(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Stats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PubSubTopic, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Stats_rendezvous(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Stats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Rendezvous, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Stats_peerID(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Stats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PeerID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Stats_ethereumChainID(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Stats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EthereumChainID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Stats_latestBlock(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Stats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LatestBlock, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*gqltypes.LatestBlock)
+	fc.Result = res
+	return ec.marshalOLatestBlock2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐLatestBlock(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Stats_numPeers(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Stats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NumPeers, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Stats_numOrders(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Stats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NumOrders, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Stats_numOrdersV4(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Stats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NumOrdersV4, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Stats_numOrdersIncludingRemoved(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Stats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NumOrdersIncludingRemoved, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Stats_numOrdersIncludingRemovedV4(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Stats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NumOrdersIncludingRemovedV4, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Stats_numPinnedOrders(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Stats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NumPinnedOrders, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Stats_numPinnedOrdersV4(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Stats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NumPinnedOrdersV4, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Stats_startOfCurrentUTCDay(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Stats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StartOfCurrentUTCDay, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Stats_ethRPCRequestsSentInCurrentUTCDay(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Stats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EthRPCRequestsSentInCurrentUTCDay, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Stats_ethRPCRateLimitExpiredRequests(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Stats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EthRPCRateLimitExpiredRequests, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Stats_secondaryRendezvous(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Stats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SecondaryRendezvous, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Stats_maxExpirationTime(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Stats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MaxExpirationTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Stats_maxOrdersPerMaker(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Stats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MaxOrdersPerMaker, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Stats_maxOrdersPerPeer(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Stats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MaxOrdersPerPeer, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Stats_makerQuotaUsage(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Stats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MakerQuotaUsage, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*gqltypes.QuotaUsage)
+	fc.Result = res
+	return ec.marshalNQuotaUsage2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐQuotaUsageᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Stats_peerQuotaUsage(ctx context.Context, field graphql.CollectedField, obj *gqltypes.Stats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Stats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PeerQuotaUsage, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*gqltypes.QuotaUsage)
+	fc.Result = res
+	return ec.marshalNQuotaUsage2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐQuotaUsageᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Subscription_orderEvents(ctx context.Context, field graphql.CollectedField) (ret func() graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Subscription",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Subscription_orderEvents_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().OrderEvents(rctx, args["filters"].([]*gqltypes.OrderFilter), args["filtersV4"].([]*gqltypes.OrderFilterV4), args["endStates"].([]gqltypes.OrderEndState), args["since"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func() graphql.Marshaler {
+		res, ok := <-resTmp.(<-chan []*gqltypes.OrderEvent)
+		if !ok {
+			return nil
+		}
+		return graphql.WriterFunc(func(w io.Writer) {
+			w.Write([]byte{'{'})
+			graphql.MarshalString(field.Alias).MarshalGQL(w)
+			w.Write([]byte{':'})
+			ec.marshalNOrderEvent2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderEventᚄ(ctx, field.Selections, res).MarshalGQL(w)
+			w.Write([]byte{'}'})
+		})
+	}
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Directive",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Directive",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Directive",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Locations, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Directive",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__EnumValue",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__EnumValue",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__EnumValue",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__EnumValue",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Field",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Field",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Field",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Field",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Field",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Field",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__InputValue",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__InputValue",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__InputValue",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__InputValue",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DefaultValue, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Schema",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Types(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Schema",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.QueryType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Schema",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MutationType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Schema",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SubscriptionType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Schema",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Directives(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Directive)
+	fc.Result = res
+	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Type",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Type",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Type",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Type",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field___Type_fields_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Fields(args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Field)
+	fc.Result = res
+	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Type",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Interfaces(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Type",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PossibleTypes(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Type",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field___Type_enumValues_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EnumValues(args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.EnumValue)
+	fc.Result = res
+	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Type",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.InputFields(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "__Type",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OfType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputAddOrdersOpts(ctx context.Context, obj interface{}) (gqltypes.AddOrdersOpts, error) {
+	var it gqltypes.AddOrdersOpts
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "keepCancelled":
+			var err error
+			it.KeepCancelled, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "keepExpired":
+			var err error
+			it.KeepExpired, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "keepFullyFilled":
+			var err error
+			it.KeepFullyFilled, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "keepUnfunded":
+			var err error
+			it.KeepUnfunded, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputNewOrder(ctx context.Context, obj interface{}) (gqltypes.NewOrder, error) {
+	var it gqltypes.NewOrder
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "chainId":
+			var err error
+			it.ChainID, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "exchangeAddress":
+			var err error
+			it.ExchangeAddress, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "makerAddress":
+			var err error
+			it.MakerAddress, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "makerAssetData":
+			var err error
+			it.MakerAssetData, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "makerAssetAmount":
+			var err error
+			it.MakerAssetAmount, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "makerFeeAssetData":
+			var err error
+			it.MakerFeeAssetData, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "makerFee":
+			var err error
+			it.MakerFee, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "takerAddress":
+			var err error
+			it.TakerAddress, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "takerAssetData":
+			var err error
+			it.TakerAssetData, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "takerAssetAmount":
+			var err error
+			it.TakerAssetAmount, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "takerFeeAssetData":
+			var err error
+			it.TakerFeeAssetData, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "takerFee":
+			var err error
+			it.TakerFee, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "senderAddress":
+			var err error
+			it.SenderAddress, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "feeRecipientAddress":
+			var err error
+			it.FeeRecipientAddress, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "expirationTimeSeconds":
+			var err error
+			it.ExpirationTimeSeconds, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "salt":
+			var err error
+			it.Salt, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "signature":
+			var err error
+			it.Signature, err = ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputNewOrderV4(ctx context.Context, obj interface{}) (gqltypes.NewOrderV4, error) {
+	var it gqltypes.NewOrderV4
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "chainId":
+			var err error
+			it.ChainID, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "verifyingContract":
+			var err error
+			it.VerifyingContract, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "makerToken":
+			var err error
+			it.MakerToken, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "takerToken":
+			var err error
+			it.TakerToken, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "makerAmount":
+			var err error
+			it.MakerAmount, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "takerAmount":
+			var err error
+			it.TakerAmount, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "takerTokenFeeAmount":
+			var err error
+			it.TakerTokenFeeAmount, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "maker":
+			var err error
+			it.Maker, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "taker":
+			var err error
+			it.Taker, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "sender":
+			var err error
+			it.Sender, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "feeRecipient":
+			var err error
+			it.FeeRecipient, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "pool":
+			var err error
+			it.Pool, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "expiry":
+			var err error
+			it.Expiry, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "salt":
+			var err error
+			it.Salt, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "signatureType":
+			var err error
+			it.SignatureType, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "signatureV":
+			var err error
+			it.SignatureV, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "signatureR":
+			var err error
+			it.SignatureR, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "signatureS":
+			var err error
+			it.SignatureS, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputOrderFilter(ctx context.Context, obj interface{}) (gqltypes.OrderFilter, error) {
+	var it gqltypes.OrderFilter
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "field":
+			var err error
+			it.Field, err = ec.unmarshalOOrderField2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderField(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "kind":
+			var err error
+			it.Kind, err = ec.unmarshalNFilterKind2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐFilterKind(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "value":
+			var err error
+			it.Value, err = ec.unmarshalOAny2interface(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "groups":
+			var err error
+			it.Groups, err = ec.unmarshalOOrderFilter2ᚕᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilterᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputOrderFilterV4(ctx context.Context, obj interface{}) (gqltypes.OrderFilterV4, error) {
+	var it gqltypes.OrderFilterV4
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "field":
+			var err error
+			it.Field, err = ec.unmarshalOOrderFieldV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFieldV4(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "kind":
+			var err error
+			it.Kind, err = ec.unmarshalNFilterKind2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐFilterKind(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "value":
+			var err error
+			it.Value, err = ec.unmarshalOAny2interface(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "groups":
+			var err error
+			it.Groups, err = ec.unmarshalOOrderFilterV42ᚕᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilterV4ᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputOrderSort(ctx context.Context, obj interface{}) (gqltypes.OrderSort, error) {
+	var it gqltypes.OrderSort
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "field":
+			var err error
+			it.Field, err = ec.unmarshalNOrderField2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderField(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "direction":
+			var err error
+			it.Direction, err = ec.unmarshalNSortDirection2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐSortDirection(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputOrderSortV4(ctx context.Context, obj interface{}) (gqltypes.OrderSortV4, error) {
+	var it gqltypes.OrderSortV4
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "field":
+			var err error
+			it.Field, err = ec.unmarshalNOrderFieldV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFieldV4(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "direction":
+			var err error
+			it.Direction, err = ec.unmarshalNSortDirection2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐSortDirection(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var acceptedOrderResultImplementors = []string{"AcceptedOrderResult"}
+
+func (ec *executionContext) _AcceptedOrderResult(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.AcceptedOrderResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, acceptedOrderResultImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AcceptedOrderResult")
+		case "order":
+			out.Values[i] = ec._AcceptedOrderResult_order(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "isNew":
+			out.Values[i] = ec._AcceptedOrderResult_isNew(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var acceptedOrderResultV4Implementors = []string{"AcceptedOrderResultV4"}
+
+func (ec *executionContext) _AcceptedOrderResultV4(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.AcceptedOrderResultV4) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, acceptedOrderResultV4Implementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AcceptedOrderResultV4")
+		case "order":
+			out.Values[i] = ec._AcceptedOrderResultV4_order(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "isNew":
+			out.Values[i] = ec._AcceptedOrderResultV4_isNew(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var addOrdersResultsImplementors = []string{"AddOrdersResults"}
+
+func (ec *executionContext) _AddOrdersResults(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.AddOrdersResults) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, addOrdersResultsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AddOrdersResults")
+		case "accepted":
+			out.Values[i] = ec._AddOrdersResults_accepted(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "rejected":
+			out.Values[i] = ec._AddOrdersResults_rejected(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var addOrdersResultsV4Implementors = []string{"AddOrdersResultsV4"}
+
+func (ec *executionContext) _AddOrdersResultsV4(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.AddOrdersResultsV4) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, addOrdersResultsV4Implementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AddOrdersResultsV4")
+		case "accepted":
+			out.Values[i] = ec._AddOrdersResultsV4_accepted(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "rejected":
+			out.Values[i] = ec._AddOrdersResultsV4_rejected(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var archivedOrderImplementors = []string{"ArchivedOrder"}
+
+func (ec *executionContext) _ArchivedOrder(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.ArchivedOrder) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, archivedOrderImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ArchivedOrder")
+		case "hash":
+			out.Values[i] = ec._ArchivedOrder_hash(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "order":
+			out.Values[i] = ec._ArchivedOrder_order(ctx, field, obj)
+		case "orderv4":
+			out.Values[i] = ec._ArchivedOrder_orderv4(ctx, field, obj)
+		case "endState":
+			out.Values[i] = ec._ArchivedOrder_endState(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._ArchivedOrder_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "blockNumber":
+			out.Values[i] = ec._ArchivedOrder_blockNumber(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "blockHash":
+			out.Values[i] = ec._ArchivedOrder_blockHash(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "isPinned":
+			out.Values[i] = ec._ArchivedOrder_isPinned(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "archivedAt":
+			out.Values[i] = ec._ArchivedOrder_archivedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var contractEventImplementors = []string{"ContractEvent"}
+
+func (ec *executionContext) _ContractEvent(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.ContractEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, contractEventImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ContractEvent")
+		case "blockHash":
+			out.Values[i] = ec._ContractEvent_blockHash(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "txHash":
+			out.Values[i] = ec._ContractEvent_txHash(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "txIndex":
+			out.Values[i] = ec._ContractEvent_txIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "logIndex":
+			out.Values[i] = ec._ContractEvent_logIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "isRemoved":
+			out.Values[i] = ec._ContractEvent_isRemoved(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "address":
+			out.Values[i] = ec._ContractEvent_address(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "kind":
+			out.Values[i] = ec._ContractEvent_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "parameters":
+			out.Values[i] = ec._ContractEvent_parameters(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var latestBlockImplementors = []string{"LatestBlock"}
+
+func (ec *executionContext) _LatestBlock(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.LatestBlock) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, latestBlockImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LatestBlock")
+		case "number":
+			out.Values[i] = ec._LatestBlock_number(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "hash":
+			out.Values[i] = ec._LatestBlock_hash(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var mutationImplementors = []string{"Mutation"}
+
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Mutation",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "addOrders":
+			out.Values[i] = ec._Mutation_addOrders(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "addOrdersV4":
+			out.Values[i] = ec._Mutation_addOrdersV4(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "removeOrders":
+			out.Values[i] = ec._Mutation_removeOrders(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "removeOrdersV4":
+			out.Values[i] = ec._Mutation_removeOrdersV4(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "setOrdersPinned":
+			out.Values[i] = ec._Mutation_setOrdersPinned(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "setOrdersPinnedV4":
+			out.Values[i] = ec._Mutation_setOrdersPinnedV4(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "connectPeer":
+			out.Values[i] = ec._Mutation_connectPeer(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "disconnectPeer":
+			out.Values[i] = ec._Mutation_disconnectPeer(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "banPeer":
+			out.Values[i] = ec._Mutation_banPeer(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "unbanPeer":
+			out.Values[i] = ec._Mutation_unbanPeer(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var orderImplementors = []string{"Order"}
+
+func (ec *executionContext) _Order(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.Order) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orderImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Order")
+		case "chainId":
+			out.Values[i] = ec._Order_chainId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "exchangeAddress":
+			out.Values[i] = ec._Order_exchangeAddress(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "makerAddress":
+			out.Values[i] = ec._Order_makerAddress(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "makerAssetData":
+			out.Values[i] = ec._Order_makerAssetData(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "makerAssetAmount":
+			out.Values[i] = ec._Order_makerAssetAmount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "makerFeeAssetData":
+			out.Values[i] = ec._Order_makerFeeAssetData(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "makerFee":
+			out.Values[i] = ec._Order_makerFee(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "takerAddress":
+			out.Values[i] = ec._Order_takerAddress(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "takerAssetData":
+			out.Values[i] = ec._Order_takerAssetData(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "takerAssetAmount":
+			out.Values[i] = ec._Order_takerAssetAmount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "takerFeeAssetData":
+			out.Values[i] = ec._Order_takerFeeAssetData(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "takerFee":
+			out.Values[i] = ec._Order_takerFee(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "senderAddress":
+			out.Values[i] = ec._Order_senderAddress(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "feeRecipientAddress":
+			out.Values[i] = ec._Order_feeRecipientAddress(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "expirationTimeSeconds":
+			out.Values[i] = ec._Order_expirationTimeSeconds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "salt":
+			out.Values[i] = ec._Order_salt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "signature":
+			out.Values[i] = ec._Order_signature(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var orderConnectionImplementors = []string{"OrderConnection"}
+
+func (ec *executionContext) _OrderConnection(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.OrderConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orderConnectionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrderConnection")
+		case "orders":
+			out.Values[i] = ec._OrderConnection_orders(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "pageInfo":
+			out.Values[i] = ec._OrderConnection_pageInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var orderEventImplementors = []string{"OrderEvent"}
+
+func (ec *executionContext) _OrderEvent(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.OrderEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orderEventImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrderEvent")
+		case "order":
+			out.Values[i] = ec._OrderEvent_order(ctx, field, obj)
+		case "orderv4":
+			out.Values[i] = ec._OrderEvent_orderv4(ctx, field, obj)
+		case "endState":
+			out.Values[i] = ec._OrderEvent_endState(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._OrderEvent_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "contractEvents":
+			out.Values[i] = ec._OrderEvent_contractEvents(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "sequenceNumber":
+			out.Values[i] = ec._OrderEvent_sequenceNumber(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var orderEventPageImplementors = []string{"OrderEventPage"}
+
+func (ec *executionContext) _OrderEventPage(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.OrderEventPage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orderEventPageImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrderEventPage")
+		case "events":
+			out.Values[i] = ec._OrderEventPage_events(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "lastSequenceNumber":
+			out.Values[i] = ec._OrderEventPage_lastSequenceNumber(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "hasMore":
+			out.Values[i] = ec._OrderEventPage_hasMore(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var orderStatsImplementors = []string{"OrderStats"}
+
+func (ec *executionContext) _OrderStats(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.OrderStats) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orderStatsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrderStats")
+		case "orderCount":
+			out.Values[i] = ec._OrderStats_orderCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "totalFillableTakerAssetAmount":
+			out.Values[i] = ec._OrderStats_totalFillableTakerAssetAmount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "makerCount":
+			out.Values[i] = ec._OrderStats_makerCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "minExpirationTimeSeconds":
+			out.Values[i] = ec._OrderStats_minExpirationTimeSeconds(ctx, field, obj)
+		case "maxExpirationTimeSeconds":
+			out.Values[i] = ec._OrderStats_maxExpirationTimeSeconds(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var orderV4Implementors = []string{"OrderV4"}
+
+func (ec *executionContext) _OrderV4(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.OrderV4) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orderV4Implementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrderV4")
+		case "chainId":
+			out.Values[i] = ec._OrderV4_chainId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		case "verifyingContract":
-			var err error
-			it.VerifyingContract, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._OrderV4_verifyingContract(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
 		case "makerToken":
-			var err error
-			it.MakerToken, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._OrderV4_makerToken(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
 		case "takerToken":
-			var err error
-			it.TakerToken, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._OrderV4_takerToken(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
 		case "makerAmount":
-			var err error
-			it.MakerAmount, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._OrderV4_makerAmount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
 		case "takerAmount":
-			var err error
-			it.TakerAmount, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._OrderV4_takerAmount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
 		case "takerTokenFeeAmount":
-			var err error
-			it.TakerTokenFeeAmount, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._OrderV4_takerTokenFeeAmount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
 		case "maker":
-			var err error
-			it.Maker, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._OrderV4_maker(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
 		case "taker":
-			var err error
-			it.Taker, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._OrderV4_taker(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
 		case "sender":
-			var err error
-			it.Sender, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._OrderV4_sender(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
 		case "feeRecipient":
-			var err error
-			it.FeeRecipient, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._OrderV4_feeRecipient(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
 		case "pool":
-			var err error
-			it.Pool, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._OrderV4_pool(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "expiry":
+			out.Values[i] = ec._OrderV4_expiry(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "salt":
+			out.Values[i] = ec._OrderV4_salt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "signatureType":
+			out.Values[i] = ec._OrderV4_signatureType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "signatureV":
+			out.Values[i] = ec._OrderV4_signatureV(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "signatureR":
+			out.Values[i] = ec._OrderV4_signatureR(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "signatureS":
+			out.Values[i] = ec._OrderV4_signatureS(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var orderV4ConnectionImplementors = []string{"OrderV4Connection"}
+
+func (ec *executionContext) _OrderV4Connection(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.OrderV4Connection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orderV4ConnectionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrderV4Connection")
+		case "orders":
+			out.Values[i] = ec._OrderV4Connection_orders(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "pageInfo":
+			out.Values[i] = ec._OrderV4Connection_pageInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var orderV4WithMetadataImplementors = []string{"OrderV4WithMetadata"}
+
+func (ec *executionContext) _OrderV4WithMetadata(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.OrderV4WithMetadata) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orderV4WithMetadataImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrderV4WithMetadata")
+		case "chainId":
+			out.Values[i] = ec._OrderV4WithMetadata_chainId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "verifyingContract":
+			out.Values[i] = ec._OrderV4WithMetadata_verifyingContract(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "makerToken":
+			out.Values[i] = ec._OrderV4WithMetadata_makerToken(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "takerToken":
+			out.Values[i] = ec._OrderV4WithMetadata_takerToken(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "makerAmount":
+			out.Values[i] = ec._OrderV4WithMetadata_makerAmount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "takerAmount":
+			out.Values[i] = ec._OrderV4WithMetadata_takerAmount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "takerTokenFeeAmount":
+			out.Values[i] = ec._OrderV4WithMetadata_takerTokenFeeAmount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "maker":
+			out.Values[i] = ec._OrderV4WithMetadata_maker(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "taker":
+			out.Values[i] = ec._OrderV4WithMetadata_taker(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "sender":
+			out.Values[i] = ec._OrderV4WithMetadata_sender(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "feeRecipient":
+			out.Values[i] = ec._OrderV4WithMetadata_feeRecipient(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "pool":
+			out.Values[i] = ec._OrderV4WithMetadata_pool(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
 		case "expiry":
-			var err error
-			it.Expiry, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._OrderV4WithMetadata_expiry(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
 		case "salt":
-			var err error
-			it.Salt, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._OrderV4WithMetadata_salt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
 		case "signatureType":
-			var err error
-			it.SignatureType, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._OrderV4WithMetadata_signatureType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
 		case "signatureV":
-			var err error
-			it.SignatureV, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._OrderV4WithMetadata_signatureV(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
 		case "signatureR":
-			var err error
-			it.SignatureR, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._OrderV4WithMetadata_signatureR(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
 		case "signatureS":
-			var err error
-			it.SignatureS, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._OrderV4WithMetadata_signatureS(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
+		case "hash":
+			out.Values[i] = ec._OrderV4WithMetadata_hash(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "fillableTakerAssetAmount":
+			out.Values[i] = ec._OrderV4WithMetadata_fillableTakerAssetAmount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-
-	return it, nil
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
 }
 
-func (ec *executionContext) unmarshalInputOrderFilter(ctx context.Context, obj interface{}) (gqltypes.OrderFilter, error) {
-	var it gqltypes.OrderFilter
-	var asMap = obj.(map[string]interface{})
+var orderWithMetadataImplementors = []string{"OrderWithMetadata"}
 
-	for k, v := range asMap {
-		switch k {
-		case "field":
-			var err error
-			it.Field, err = ec.unmarshalNOrderField2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderField(ctx, v)
-			if err != nil {
-				return it, err
+func (ec *executionContext) _OrderWithMetadata(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.OrderWithMetadata) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orderWithMetadataImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrderWithMetadata")
+		case "chainId":
+			out.Values[i] = ec._OrderWithMetadata_chainId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-		case "kind":
-			var err error
-			it.Kind, err = ec.unmarshalNFilterKind2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐFilterKind(ctx, v)
-			if err != nil {
-				return it, err
+		case "exchangeAddress":
+			out.Values[i] = ec._OrderWithMetadata_exchangeAddress(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-		case "value":
-			var err error
-			it.Value, err = ec.unmarshalNAny2interface(ctx, v)
-			if err != nil {
-				return it, err
+		case "makerAddress":
+			out.Values[i] = ec._OrderWithMetadata_makerAddress(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-		}
-	}
-
-	return it, nil
-}
-
-func (ec *executionContext) unmarshalInputOrderFilterV4(ctx context.Context, obj interface{}) (gqltypes.OrderFilterV4, error) {
-	var it gqltypes.OrderFilterV4
-	var asMap = obj.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "field":
-			var err error
-			it.Field, err = ec.unmarshalNOrderFieldV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFieldV4(ctx, v)
-			if err != nil {
-				return it, err
+		case "makerAssetData":
+			out.Values[i] = ec._OrderWithMetadata_makerAssetData(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-		case "kind":
-			var err error
-			it.Kind, err = ec.unmarshalNFilterKind2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐFilterKind(ctx, v)
-			if err != nil {
-				return it, err
+		case "makerAssetAmount":
+			out.Values[i] = ec._OrderWithMetadata_makerAssetAmount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-		case "value":
-			var err error
-			it.Value, err = ec.unmarshalNAny2interface(ctx, v)
-			if err != nil {
-				return it, err
+		case "makerFeeAssetData":
+			out.Values[i] = ec._OrderWithMetadata_makerFeeAssetData(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-		}
-	}
-
-	return it, nil
-}
-
-func (ec *executionContext) unmarshalInputOrderSort(ctx context.Context, obj interface{}) (gqltypes.OrderSort, error) {
-	var it gqltypes.OrderSort
-	var asMap = obj.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "field":
-			var err error
-			it.Field, err = ec.unmarshalNOrderField2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderField(ctx, v)
-			if err != nil {
-				return it, err
+		case "makerFee":
+			out.Values[i] = ec._OrderWithMetadata_makerFee(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-		case "direction":
-			var err error
-			it.Direction, err = ec.unmarshalNSortDirection2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐSortDirection(ctx, v)
-			if err != nil {
-				return it, err
+		case "takerAddress":
+			out.Values[i] = ec._OrderWithMetadata_takerAddress(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-		}
-	}
-
-	return it, nil
-}
-
-func (ec *executionContext) unmarshalInputOrderSortV4(ctx context.Context, obj interface{}) (gqltypes.OrderSortV4, error) {
-	var it gqltypes.OrderSortV4
-	var asMap = obj.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "field":
-			var err error
-			it.Field, err = ec.unmarshalNOrderFieldV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFieldV4(ctx, v)
-			if err != nil {
-				return it, err
+		case "takerAssetData":
+			out.Values[i] = ec._OrderWithMetadata_takerAssetData(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-		case "direction":
-			var err error
-			it.Direction, err = ec.unmarshalNSortDirection2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐSortDirection(ctx, v)
-			if err != nil {
-				return it, err
+		case "takerAssetAmount":
+			out.Values[i] = ec._OrderWithMetadata_takerAssetAmount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "takerFeeAssetData":
+			out.Values[i] = ec._OrderWithMetadata_takerFeeAssetData(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "takerFee":
+			out.Values[i] = ec._OrderWithMetadata_takerFee(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "senderAddress":
+			out.Values[i] = ec._OrderWithMetadata_senderAddress(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "feeRecipientAddress":
+			out.Values[i] = ec._OrderWithMetadata_feeRecipientAddress(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "expirationTimeSeconds":
+			out.Values[i] = ec._OrderWithMetadata_expirationTimeSeconds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "salt":
+			out.Values[i] = ec._OrderWithMetadata_salt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "signature":
+			out.Values[i] = ec._OrderWithMetadata_signature(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "hash":
+			out.Values[i] = ec._OrderWithMetadata_hash(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "fillableTakerAssetAmount":
+			out.Values[i] = ec._OrderWithMetadata_fillableTakerAssetAmount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-
-	return it, nil
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
 }
 
-// endregion **************************** input.gotpl *****************************
-
-// region    ************************** interface.gotpl ***************************
-
-// endregion ************************** interface.gotpl ***************************
-
-// region    **************************** object.gotpl ****************************
-
-var acceptedOrderResultImplementors = []string{"AcceptedOrderResult"}
+var orderbookImplementors = []string{"Orderbook"}
 
-func (ec *executionContext) _AcceptedOrderResult(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.AcceptedOrderResult) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, acceptedOrderResultImplementors)
+func (ec *executionContext) _Orderbook(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.Orderbook) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orderbookImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AcceptedOrderResult")
-		case "order":
-			out.Values[i] = ec._AcceptedOrderResult_order(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("Orderbook")
+		case "baseToken":
+			out.Values[i] = ec._Orderbook_baseToken(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "isNew":
-			out.Values[i] = ec._AcceptedOrderResult_isNew(ctx, field, obj)
+		case "quoteToken":
+			out.Values[i] = ec._Orderbook_quoteToken(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "bids":
+			out.Values[i] = ec._Orderbook_bids(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "asks":
+			out.Values[i] = ec._Orderbook_asks(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -8184,24 +13153,34 @@ func (ec *executionContext) _AcceptedOrderResult(ctx context.Context, sel ast.Se
 	return out
 }
 
-var acceptedOrderResultV4Implementors = []string{"AcceptedOrderResultV4"}
+var orderbookLevelImplementors = []string{"OrderbookLevel"}
 
-func (ec *executionContext) _AcceptedOrderResultV4(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.AcceptedOrderResultV4) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, acceptedOrderResultV4Implementors)
+func (ec *executionContext) _OrderbookLevel(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.OrderbookLevel) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orderbookLevelImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AcceptedOrderResultV4")
-		case "order":
-			out.Values[i] = ec._AcceptedOrderResultV4_order(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("OrderbookLevel")
+		case "price":
+			out.Values[i] = ec._OrderbookLevel_price(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "isNew":
-			out.Values[i] = ec._AcceptedOrderResultV4_isNew(ctx, field, obj)
+		case "baseAmount":
+			out.Values[i] = ec._OrderbookLevel_baseAmount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "quoteAmount":
+			out.Values[i] = ec._OrderbookLevel_quoteAmount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "orderCount":
+			out.Values[i] = ec._OrderbookLevel_orderCount(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -8216,24 +13195,21 @@ func (ec *executionContext) _AcceptedOrderResultV4(ctx context.Context, sel ast.
 	return out
 }
 
-var addOrdersResultsImplementors = []string{"AddOrdersResults"}
+var pageInfoImplementors = []string{"PageInfo"}
 
-func (ec *executionContext) _AddOrdersResults(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.AddOrdersResults) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, addOrdersResultsImplementors)
+func (ec *executionContext) _PageInfo(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.PageInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pageInfoImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AddOrdersResults")
-		case "accepted":
-			out.Values[i] = ec._AddOrdersResults_accepted(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "rejected":
-			out.Values[i] = ec._AddOrdersResults_rejected(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PageInfo")
+		case "endCursor":
+			out.Values[i] = ec._PageInfo_endCursor(ctx, field, obj)
+		case "hasNextPage":
+			out.Values[i] = ec._PageInfo_hasNextPage(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -8248,24 +13224,39 @@ func (ec *executionContext) _AddOrdersResults(ctx context.Context, sel ast.Selec
 	return out
 }
 
-var addOrdersResultsV4Implementors = []string{"AddOrdersResultsV4"}
+var peerImplementors = []string{"Peer"}
 
-func (ec *executionContext) _AddOrdersResultsV4(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.AddOrdersResultsV4) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, addOrdersResultsV4Implementors)
+func (ec *executionContext) _Peer(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.Peer) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, peerImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AddOrdersResultsV4")
-		case "accepted":
-			out.Values[i] = ec._AddOrdersResultsV4_accepted(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("Peer")
+		case "id":
+			out.Values[i] = ec._Peer_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "rejected":
-			out.Values[i] = ec._AddOrdersResultsV4_rejected(ctx, field, obj)
+		case "connections":
+			out.Values[i] = ec._Peer_connections(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "tags":
+			out.Values[i] = ec._Peer_tags(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "score":
+			out.Values[i] = ec._Peer_score(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "bandwidth":
+			out.Values[i] = ec._Peer_bandwidth(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -8280,54 +13271,66 @@ func (ec *executionContext) _AddOrdersResultsV4(ctx context.Context, sel ast.Sel
 	return out
 }
 
-var contractEventImplementors = []string{"ContractEvent"}
+var peerBandwidthImplementors = []string{"PeerBandwidth"}
 
-func (ec *executionContext) _ContractEvent(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.ContractEvent) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, contractEventImplementors)
+func (ec *executionContext) _PeerBandwidth(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.PeerBandwidth) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, peerBandwidthImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ContractEvent")
-		case "blockHash":
-			out.Values[i] = ec._ContractEvent_blockHash(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "txHash":
-			out.Values[i] = ec._ContractEvent_txHash(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "txIndex":
-			out.Values[i] = ec._ContractEvent_txIndex(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PeerBandwidth")
+		case "totalIn":
+			out.Values[i] = ec._PeerBandwidth_totalIn(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "logIndex":
-			out.Values[i] = ec._ContractEvent_logIndex(ctx, field, obj)
+		case "totalOut":
+			out.Values[i] = ec._PeerBandwidth_totalOut(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "isRemoved":
-			out.Values[i] = ec._ContractEvent_isRemoved(ctx, field, obj)
+		case "rateIn":
+			out.Values[i] = ec._PeerBandwidth_rateIn(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "address":
-			out.Values[i] = ec._ContractEvent_address(ctx, field, obj)
+		case "rateOut":
+			out.Values[i] = ec._PeerBandwidth_rateOut(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "kind":
-			out.Values[i] = ec._ContractEvent_kind(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var peerConnectionImplementors = []string{"PeerConnection"}
+
+func (ec *executionContext) _PeerConnection(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.PeerConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, peerConnectionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PeerConnection")
+		case "multiaddr":
+			out.Values[i] = ec._PeerConnection_multiaddr(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "parameters":
-			out.Values[i] = ec._ContractEvent_parameters(ctx, field, obj)
+		case "direction":
+			out.Values[i] = ec._PeerConnection_direction(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -8342,24 +13345,24 @@ func (ec *executionContext) _ContractEvent(ctx context.Context, sel ast.Selectio
 	return out
 }
 
-var latestBlockImplementors = []string{"LatestBlock"}
+var peerTagImplementors = []string{"PeerTag"}
 
-func (ec *executionContext) _LatestBlock(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.LatestBlock) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, latestBlockImplementors)
+func (ec *executionContext) _PeerTag(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.PeerTag) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, peerTagImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("LatestBlock")
-		case "number":
-			out.Values[i] = ec._LatestBlock_number(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PeerTag")
+		case "tag":
+			out.Values[i] = ec._PeerTag_tag(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "hash":
-			out.Values[i] = ec._LatestBlock_hash(ctx, field, obj)
+		case "value":
+			out.Values[i] = ec._PeerTag_value(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -8374,13 +13377,13 @@ func (ec *executionContext) _LatestBlock(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var mutationImplementors = []string{"Mutation"}
+var queryImplementors = []string{"Query"}
 
-func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
 
 	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Mutation",
+		Object: "Query",
 	})
 
 	out := graphql.NewFieldSet(fields)
@@ -8388,17 +13391,187 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Mutation")
-		case "addOrders":
-			out.Values[i] = ec._Mutation_addOrders(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "addOrdersV4":
-			out.Values[i] = ec._Mutation_addOrdersV4(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
+			out.Values[i] = graphql.MarshalString("Query")
+		case "order":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_order(ctx, field)
+				return res
+			})
+		case "orderv4":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_orderv4(ctx, field)
+				return res
+			})
+		case "orders":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_orders(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "ordersv4":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_ordersv4(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "orderStats":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_orderStats(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "orderStatsV4":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_orderStatsV4(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "orderbook":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_orderbook(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "orderEventsSince":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_orderEventsSince(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "archivedOrders":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_archivedOrders(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "validateOrders":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_validateOrders(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "validateOrdersV4":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_validateOrdersV4(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "stats":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_stats(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "peers":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_peers(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "__type":
+			out.Values[i] = ec._Query___type(ctx, field)
+		case "__schema":
+			out.Values[i] = ec._Query___schema(ctx, field)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8410,99 +13583,24 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 	return out
 }
 
-var orderImplementors = []string{"Order"}
+var quotaUsageImplementors = []string{"QuotaUsage"}
 
-func (ec *executionContext) _Order(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.Order) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, orderImplementors)
+func (ec *executionContext) _QuotaUsage(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.QuotaUsage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, quotaUsageImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Order")
-		case "chainId":
-			out.Values[i] = ec._Order_chainId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "exchangeAddress":
-			out.Values[i] = ec._Order_exchangeAddress(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "makerAddress":
-			out.Values[i] = ec._Order_makerAddress(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "makerAssetData":
-			out.Values[i] = ec._Order_makerAssetData(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "makerAssetAmount":
-			out.Values[i] = ec._Order_makerAssetAmount(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "makerFeeAssetData":
-			out.Values[i] = ec._Order_makerFeeAssetData(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "makerFee":
-			out.Values[i] = ec._Order_makerFee(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "takerAddress":
-			out.Values[i] = ec._Order_takerAddress(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "takerAssetData":
-			out.Values[i] = ec._Order_takerAssetData(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "takerAssetAmount":
-			out.Values[i] = ec._Order_takerAssetAmount(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "takerFeeAssetData":
-			out.Values[i] = ec._Order_takerFeeAssetData(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "takerFee":
-			out.Values[i] = ec._Order_takerFee(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "senderAddress":
-			out.Values[i] = ec._Order_senderAddress(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "feeRecipientAddress":
-			out.Values[i] = ec._Order_feeRecipientAddress(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "expirationTimeSeconds":
-			out.Values[i] = ec._Order_expirationTimeSeconds(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "salt":
-			out.Values[i] = ec._Order_salt(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("QuotaUsage")
+		case "id":
+			out.Values[i] = ec._QuotaUsage_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "signature":
-			out.Values[i] = ec._Order_signature(ctx, field, obj)
+		case "numOrders":
+			out.Values[i] = ec._QuotaUsage_numOrders(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -8517,145 +13615,70 @@ func (ec *executionContext) _Order(ctx context.Context, sel ast.SelectionSet, ob
 	return out
 }
 
-var orderEventImplementors = []string{"OrderEvent"}
+var rejectedOrderResultImplementors = []string{"RejectedOrderResult"}
 
-func (ec *executionContext) _OrderEvent(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.OrderEvent) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, orderEventImplementors)
+func (ec *executionContext) _RejectedOrderResult(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.RejectedOrderResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, rejectedOrderResultImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("OrderEvent")
+			out.Values[i] = graphql.MarshalString("RejectedOrderResult")
+		case "hash":
+			out.Values[i] = ec._RejectedOrderResult_hash(ctx, field, obj)
 		case "order":
-			out.Values[i] = ec._OrderEvent_order(ctx, field, obj)
-		case "orderv4":
-			out.Values[i] = ec._OrderEvent_orderv4(ctx, field, obj)
-		case "endState":
-			out.Values[i] = ec._OrderEvent_endState(ctx, field, obj)
+			out.Values[i] = ec._RejectedOrderResult_order(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "timestamp":
-			out.Values[i] = ec._OrderEvent_timestamp(ctx, field, obj)
+		case "code":
+			out.Values[i] = ec._RejectedOrderResult_code(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "contractEvents":
-			out.Values[i] = ec._OrderEvent_contractEvents(ctx, field, obj)
+		case "message":
+			out.Values[i] = ec._RejectedOrderResult_message(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
 		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
-
-var orderV4Implementors = []string{"OrderV4"}
-
-func (ec *executionContext) _OrderV4(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.OrderV4) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, orderV4Implementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("OrderV4")
-		case "chainId":
-			out.Values[i] = ec._OrderV4_chainId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "verifyingContract":
-			out.Values[i] = ec._OrderV4_verifyingContract(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "makerToken":
-			out.Values[i] = ec._OrderV4_makerToken(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "takerToken":
-			out.Values[i] = ec._OrderV4_takerToken(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "makerAmount":
-			out.Values[i] = ec._OrderV4_makerAmount(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "takerAmount":
-			out.Values[i] = ec._OrderV4_takerAmount(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "takerTokenFeeAmount":
-			out.Values[i] = ec._OrderV4_takerTokenFeeAmount(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "maker":
-			out.Values[i] = ec._OrderV4_maker(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "taker":
-			out.Values[i] = ec._OrderV4_taker(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "sender":
-			out.Values[i] = ec._OrderV4_sender(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "feeRecipient":
-			out.Values[i] = ec._OrderV4_feeRecipient(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "pool":
-			out.Values[i] = ec._OrderV4_pool(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "expiry":
-			out.Values[i] = ec._OrderV4_expiry(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "salt":
-			out.Values[i] = ec._OrderV4_salt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "signatureType":
-			out.Values[i] = ec._OrderV4_signatureType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "signatureV":
-			out.Values[i] = ec._OrderV4_signatureV(ctx, field, obj)
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var rejectedOrderResultV4Implementors = []string{"RejectedOrderResultV4"}
+
+func (ec *executionContext) _RejectedOrderResultV4(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.RejectedOrderResultV4) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, rejectedOrderResultV4Implementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RejectedOrderResultV4")
+		case "hash":
+			out.Values[i] = ec._RejectedOrderResultV4_hash(ctx, field, obj)
+		case "order":
+			out.Values[i] = ec._RejectedOrderResultV4_order(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "signatureR":
-			out.Values[i] = ec._OrderV4_signatureR(ctx, field, obj)
+		case "code":
+			out.Values[i] = ec._RejectedOrderResultV4_code(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "signatureS":
-			out.Values[i] = ec._OrderV4_signatureS(ctx, field, obj)
+		case "message":
+			out.Values[i] = ec._RejectedOrderResultV4_message(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -8670,114 +13693,121 @@ func (ec *executionContext) _OrderV4(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var orderV4WithMetadataImplementors = []string{"OrderV4WithMetadata"}
+var statsImplementors = []string{"Stats"}
 
-func (ec *executionContext) _OrderV4WithMetadata(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.OrderV4WithMetadata) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, orderV4WithMetadataImplementors)
+func (ec *executionContext) _Stats(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.Stats) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, statsImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("OrderV4WithMetadata")
-		case "chainId":
-			out.Values[i] = ec._OrderV4WithMetadata_chainId(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("Stats")
+		case "version":
+			out.Values[i] = ec._Stats_version(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "verifyingContract":
-			out.Values[i] = ec._OrderV4WithMetadata_verifyingContract(ctx, field, obj)
+		case "pubSubTopic":
+			out.Values[i] = ec._Stats_pubSubTopic(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "makerToken":
-			out.Values[i] = ec._OrderV4WithMetadata_makerToken(ctx, field, obj)
+		case "rendezvous":
+			out.Values[i] = ec._Stats_rendezvous(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "takerToken":
-			out.Values[i] = ec._OrderV4WithMetadata_takerToken(ctx, field, obj)
+		case "peerID":
+			out.Values[i] = ec._Stats_peerID(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "makerAmount":
-			out.Values[i] = ec._OrderV4WithMetadata_makerAmount(ctx, field, obj)
+		case "ethereumChainID":
+			out.Values[i] = ec._Stats_ethereumChainID(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "takerAmount":
-			out.Values[i] = ec._OrderV4WithMetadata_takerAmount(ctx, field, obj)
+		case "latestBlock":
+			out.Values[i] = ec._Stats_latestBlock(ctx, field, obj)
+		case "numPeers":
+			out.Values[i] = ec._Stats_numPeers(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "takerTokenFeeAmount":
-			out.Values[i] = ec._OrderV4WithMetadata_takerTokenFeeAmount(ctx, field, obj)
+		case "numOrders":
+			out.Values[i] = ec._Stats_numOrders(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "maker":
-			out.Values[i] = ec._OrderV4WithMetadata_maker(ctx, field, obj)
+		case "numOrdersV4":
+			out.Values[i] = ec._Stats_numOrdersV4(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "taker":
-			out.Values[i] = ec._OrderV4WithMetadata_taker(ctx, field, obj)
+		case "numOrdersIncludingRemoved":
+			out.Values[i] = ec._Stats_numOrdersIncludingRemoved(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "sender":
-			out.Values[i] = ec._OrderV4WithMetadata_sender(ctx, field, obj)
+		case "numOrdersIncludingRemovedV4":
+			out.Values[i] = ec._Stats_numOrdersIncludingRemovedV4(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "feeRecipient":
-			out.Values[i] = ec._OrderV4WithMetadata_feeRecipient(ctx, field, obj)
+		case "numPinnedOrders":
+			out.Values[i] = ec._Stats_numPinnedOrders(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "pool":
-			out.Values[i] = ec._OrderV4WithMetadata_pool(ctx, field, obj)
+		case "numPinnedOrdersV4":
+			out.Values[i] = ec._Stats_numPinnedOrdersV4(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "expiry":
-			out.Values[i] = ec._OrderV4WithMetadata_expiry(ctx, field, obj)
+		case "startOfCurrentUTCDay":
+			out.Values[i] = ec._Stats_startOfCurrentUTCDay(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "salt":
-			out.Values[i] = ec._OrderV4WithMetadata_salt(ctx, field, obj)
+		case "ethRPCRequestsSentInCurrentUTCDay":
+			out.Values[i] = ec._Stats_ethRPCRequestsSentInCurrentUTCDay(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "signatureType":
-			out.Values[i] = ec._OrderV4WithMetadata_signatureType(ctx, field, obj)
+		case "ethRPCRateLimitExpiredRequests":
+			out.Values[i] = ec._Stats_ethRPCRateLimitExpiredRequests(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "signatureV":
-			out.Values[i] = ec._OrderV4WithMetadata_signatureV(ctx, field, obj)
+		case "secondaryRendezvous":
+			out.Values[i] = ec._Stats_secondaryRendezvous(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "signatureR":
-			out.Values[i] = ec._OrderV4WithMetadata_signatureR(ctx, field, obj)
+		case "maxExpirationTime":
+			out.Values[i] = ec._Stats_maxExpirationTime(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "signatureS":
-			out.Values[i] = ec._OrderV4WithMetadata_signatureS(ctx, field, obj)
+		case "maxOrdersPerMaker":
+			out.Values[i] = ec._Stats_maxOrdersPerMaker(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "hash":
-			out.Values[i] = ec._OrderV4WithMetadata_hash(ctx, field, obj)
+		case "maxOrdersPerPeer":
+			out.Values[i] = ec._Stats_maxOrdersPerPeer(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "fillableTakerAssetAmount":
-			out.Values[i] = ec._OrderV4WithMetadata_fillableTakerAssetAmount(ctx, field, obj)
+		case "makerQuotaUsage":
+			out.Values[i] = ec._Stats_makerQuotaUsage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "peerQuotaUsage":
+			out.Values[i] = ec._Stats_peerQuotaUsage(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -8792,674 +13822,896 @@ func (ec *executionContext) _OrderV4WithMetadata(ctx context.Context, sel ast.Se
 	return out
 }
 
-var orderWithMetadataImplementors = []string{"OrderWithMetadata"}
+var subscriptionImplementors = []string{"Subscription"}
 
-func (ec *executionContext) _OrderWithMetadata(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.OrderWithMetadata) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, orderWithMetadataImplementors)
+func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func() graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Subscription",
+	})
+	if len(fields) != 1 {
+		ec.Errorf(ctx, "must subscribe to exactly one stream")
+		return nil
+	}
+
+	switch fields[0].Name {
+	case "orderEvents":
+		return ec._Subscription_orderEvents(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
+	}
+}
+
+var __DirectiveImplementors = []string{"__Directive"}
+
+func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("OrderWithMetadata")
-		case "chainId":
-			out.Values[i] = ec._OrderWithMetadata_chainId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "exchangeAddress":
-			out.Values[i] = ec._OrderWithMetadata_exchangeAddress(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__Directive")
+		case "name":
+			out.Values[i] = ec.___Directive_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "makerAddress":
-			out.Values[i] = ec._OrderWithMetadata_makerAddress(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Directive_description(ctx, field, obj)
+		case "locations":
+			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "makerAssetData":
-			out.Values[i] = ec._OrderWithMetadata_makerAssetData(ctx, field, obj)
+		case "args":
+			out.Values[i] = ec.___Directive_args(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "makerAssetAmount":
-			out.Values[i] = ec._OrderWithMetadata_makerAssetAmount(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var __EnumValueImplementors = []string{"__EnumValue"}
+
+func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__EnumValue")
+		case "name":
+			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "makerFeeAssetData":
-			out.Values[i] = ec._OrderWithMetadata_makerFeeAssetData(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "makerFee":
-			out.Values[i] = ec._OrderWithMetadata_makerFee(ctx, field, obj)
+		case "deprecationReason":
+			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var __FieldImplementors = []string{"__Field"}
+
+func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Field")
+		case "name":
+			out.Values[i] = ec.___Field_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "takerAddress":
-			out.Values[i] = ec._OrderWithMetadata_takerAddress(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Field_description(ctx, field, obj)
+		case "args":
+			out.Values[i] = ec.___Field_args(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "takerAssetData":
-			out.Values[i] = ec._OrderWithMetadata_takerAssetData(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec.___Field_type(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "takerAssetAmount":
-			out.Values[i] = ec._OrderWithMetadata_takerAssetAmount(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "takerFeeAssetData":
-			out.Values[i] = ec._OrderWithMetadata_takerFeeAssetData(ctx, field, obj)
+		case "deprecationReason":
+			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var __InputValueImplementors = []string{"__InputValue"}
+
+func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__InputValue")
+		case "name":
+			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "takerFee":
-			out.Values[i] = ec._OrderWithMetadata_takerFee(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "senderAddress":
-			out.Values[i] = ec._OrderWithMetadata_senderAddress(ctx, field, obj)
+		case "defaultValue":
+			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var __SchemaImplementors = []string{"__Schema"}
+
+func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Schema")
+		case "types":
+			out.Values[i] = ec.___Schema_types(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "feeRecipientAddress":
-			out.Values[i] = ec._OrderWithMetadata_feeRecipientAddress(ctx, field, obj)
+		case "queryType":
+			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "expirationTimeSeconds":
-			out.Values[i] = ec._OrderWithMetadata_expirationTimeSeconds(ctx, field, obj)
+		case "mutationType":
+			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
+		case "subscriptionType":
+			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
+		case "directives":
+			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "salt":
-			out.Values[i] = ec._OrderWithMetadata_salt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var __TypeImplementors = []string{"__Type"}
+
+func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Type")
+		case "kind":
+			out.Values[i] = ec.___Type_kind(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "signature":
-			out.Values[i] = ec._OrderWithMetadata_signature(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+		case "name":
+			out.Values[i] = ec.___Type_name(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Type_description(ctx, field, obj)
+		case "fields":
+			out.Values[i] = ec.___Type_fields(ctx, field, obj)
+		case "interfaces":
+			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
+		case "possibleTypes":
+			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
+		case "enumValues":
+			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
+		case "inputFields":
+			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
+		case "ofType":
+			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) marshalNAcceptedOrderResult2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAcceptedOrderResult(ctx context.Context, sel ast.SelectionSet, v gqltypes.AcceptedOrderResult) graphql.Marshaler {
+	return ec._AcceptedOrderResult(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAcceptedOrderResult2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAcceptedOrderResultᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqltypes.AcceptedOrderResult) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "hash":
-			out.Values[i] = ec._OrderWithMetadata_hash(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+			ret[i] = ec.marshalNAcceptedOrderResult2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAcceptedOrderResult(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNAcceptedOrderResult2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAcceptedOrderResult(ctx context.Context, sel ast.SelectionSet, v *gqltypes.AcceptedOrderResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._AcceptedOrderResult(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNAcceptedOrderResultV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAcceptedOrderResultV4(ctx context.Context, sel ast.SelectionSet, v gqltypes.AcceptedOrderResultV4) graphql.Marshaler {
+	return ec._AcceptedOrderResultV4(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAcceptedOrderResultV42ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAcceptedOrderResultV4ᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqltypes.AcceptedOrderResultV4) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "fillableTakerAssetAmount":
-			out.Values[i] = ec._OrderWithMetadata_fillableTakerAssetAmount(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+			ret[i] = ec.marshalNAcceptedOrderResultV42ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAcceptedOrderResultV4(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNAcceptedOrderResultV42ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAcceptedOrderResultV4(ctx context.Context, sel ast.SelectionSet, v *gqltypes.AcceptedOrderResultV4) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._AcceptedOrderResultV4(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNAddOrdersResults2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAddOrdersResults(ctx context.Context, sel ast.SelectionSet, v gqltypes.AddOrdersResults) graphql.Marshaler {
+	return ec._AddOrdersResults(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAddOrdersResults2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAddOrdersResults(ctx context.Context, sel ast.SelectionSet, v *gqltypes.AddOrdersResults) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._AddOrdersResults(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNAddOrdersResultsV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAddOrdersResultsV4(ctx context.Context, sel ast.SelectionSet, v gqltypes.AddOrdersResultsV4) graphql.Marshaler {
+	return ec._AddOrdersResultsV4(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAddOrdersResultsV42ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAddOrdersResultsV4(ctx context.Context, sel ast.SelectionSet, v *gqltypes.AddOrdersResultsV4) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._AddOrdersResultsV4(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNAny2interface(ctx context.Context, v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return graphql.UnmarshalAny(v)
+}
+
+func (ec *executionContext) marshalNAny2interface(ctx context.Context, sel ast.SelectionSet, v interface{}) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := graphql.MarshalAny(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalNArchivedOrder2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐArchivedOrder(ctx context.Context, sel ast.SelectionSet, v gqltypes.ArchivedOrder) graphql.Marshaler {
+	return ec._ArchivedOrder(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNArchivedOrder2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐArchivedOrderᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqltypes.ArchivedOrder) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNArchivedOrder2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐArchivedOrder(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNArchivedOrder2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐArchivedOrder(ctx context.Context, sel ast.SelectionSet, v *gqltypes.ArchivedOrder) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._ArchivedOrder(ctx, sel, v)
 }
 
-var queryImplementors = []string{"Query"}
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
+	return graphql.UnmarshalBoolean(v)
+}
 
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
 
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Query",
-	})
+func (ec *executionContext) marshalNContractEvent2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐContractEvent(ctx context.Context, sel ast.SelectionSet, v gqltypes.ContractEvent) graphql.Marshaler {
+	return ec._ContractEvent(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "order":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_order(ctx, field)
-				return res
-			})
-		case "orderv4":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_orderv4(ctx, field)
-				return res
-			})
-		case "orders":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_orders(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "ordersv4":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_ordersv4(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "stats":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_stats(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
+func (ec *executionContext) marshalNContractEvent2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐContractEventᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqltypes.ContractEvent) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
-			})
-		case "__type":
-			out.Values[i] = ec._Query___type(ctx, field)
-		case "__schema":
-			out.Values[i] = ec._Query___schema(ctx, field)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNContractEvent2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐContractEvent(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNContractEvent2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐContractEvent(ctx context.Context, sel ast.SelectionSet, v *gqltypes.ContractEvent) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._ContractEvent(ctx, sel, v)
 }
 
-var rejectedOrderResultImplementors = []string{"RejectedOrderResult"}
+func (ec *executionContext) unmarshalNFilterKind2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐFilterKind(ctx context.Context, v interface{}) (gqltypes.FilterKind, error) {
+	var res gqltypes.FilterKind
+	return res, res.UnmarshalGQL(v)
+}
 
-func (ec *executionContext) _RejectedOrderResult(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.RejectedOrderResult) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, rejectedOrderResultImplementors)
+func (ec *executionContext) marshalNFilterKind2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐFilterKind(ctx context.Context, sel ast.SelectionSet, v gqltypes.FilterKind) graphql.Marshaler {
+	return v
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("RejectedOrderResult")
-		case "hash":
-			out.Values[i] = ec._RejectedOrderResult_hash(ctx, field, obj)
-		case "order":
-			out.Values[i] = ec._RejectedOrderResult_order(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "code":
-			out.Values[i] = ec._RejectedOrderResult_code(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "message":
-			out.Values[i] = ec._RejectedOrderResult_message(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v interface{}) (float64, error) {
+	return graphql.UnmarshalFloat(v)
+}
+
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	res := graphql.MarshalFloat(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
+	return graphql.UnmarshalInt(v)
+}
+
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	res := graphql.MarshalInt(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+	return res
 }
 
-var rejectedOrderResultV4Implementors = []string{"RejectedOrderResultV4"}
-
-func (ec *executionContext) _RejectedOrderResultV4(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.RejectedOrderResultV4) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, rejectedOrderResultV4Implementors)
+func (ec *executionContext) unmarshalNNewOrder2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐNewOrder(ctx context.Context, v interface{}) (gqltypes.NewOrder, error) {
+	return ec.unmarshalInputNewOrder(ctx, v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("RejectedOrderResultV4")
-		case "hash":
-			out.Values[i] = ec._RejectedOrderResultV4_hash(ctx, field, obj)
-		case "order":
-			out.Values[i] = ec._RejectedOrderResultV4_order(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "code":
-			out.Values[i] = ec._RejectedOrderResultV4_code(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "message":
-			out.Values[i] = ec._RejectedOrderResultV4_message(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) unmarshalNNewOrder2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐNewOrderᚄ(ctx context.Context, v interface{}) ([]*gqltypes.NewOrder, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
+	var err error
+	res := make([]*gqltypes.NewOrder, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalNNewOrder2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐNewOrder(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
 	}
-	return out
+	return res, nil
 }
 
-var statsImplementors = []string{"Stats"}
+func (ec *executionContext) unmarshalNNewOrder2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐNewOrder(ctx context.Context, v interface{}) (*gqltypes.NewOrder, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalNNewOrder2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐNewOrder(ctx, v)
+	return &res, err
+}
 
-func (ec *executionContext) _Stats(ctx context.Context, sel ast.SelectionSet, obj *gqltypes.Stats) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, statsImplementors)
+func (ec *executionContext) unmarshalNNewOrderV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐNewOrderV4(ctx context.Context, v interface{}) (gqltypes.NewOrderV4, error) {
+	return ec.unmarshalInputNewOrderV4(ctx, v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Stats")
-		case "version":
-			out.Values[i] = ec._Stats_version(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "pubSubTopic":
-			out.Values[i] = ec._Stats_pubSubTopic(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "rendezvous":
-			out.Values[i] = ec._Stats_rendezvous(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "peerID":
-			out.Values[i] = ec._Stats_peerID(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "ethereumChainID":
-			out.Values[i] = ec._Stats_ethereumChainID(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "latestBlock":
-			out.Values[i] = ec._Stats_latestBlock(ctx, field, obj)
-		case "numPeers":
-			out.Values[i] = ec._Stats_numPeers(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "numOrders":
-			out.Values[i] = ec._Stats_numOrders(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "numOrdersV4":
-			out.Values[i] = ec._Stats_numOrdersV4(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "numOrdersIncludingRemoved":
-			out.Values[i] = ec._Stats_numOrdersIncludingRemoved(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "numOrdersIncludingRemovedV4":
-			out.Values[i] = ec._Stats_numOrdersIncludingRemovedV4(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "numPinnedOrders":
-			out.Values[i] = ec._Stats_numPinnedOrders(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "numPinnedOrdersV4":
-			out.Values[i] = ec._Stats_numPinnedOrdersV4(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "startOfCurrentUTCDay":
-			out.Values[i] = ec._Stats_startOfCurrentUTCDay(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "ethRPCRequestsSentInCurrentUTCDay":
-			out.Values[i] = ec._Stats_ethRPCRequestsSentInCurrentUTCDay(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "ethRPCRateLimitExpiredRequests":
-			out.Values[i] = ec._Stats_ethRPCRateLimitExpiredRequests(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "secondaryRendezvous":
-			out.Values[i] = ec._Stats_secondaryRendezvous(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "maxExpirationTime":
-			out.Values[i] = ec._Stats_maxExpirationTime(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) unmarshalNNewOrderV42ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐNewOrderV4ᚄ(ctx context.Context, v interface{}) ([]*gqltypes.NewOrderV4, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
+	var err error
+	res := make([]*gqltypes.NewOrderV4, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalNNewOrderV42ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐNewOrderV4(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
 	}
-	return out
+	return res, nil
 }
 
-var subscriptionImplementors = []string{"Subscription"}
-
-func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func() graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Subscription",
-	})
-	if len(fields) != 1 {
-		ec.Errorf(ctx, "must subscribe to exactly one stream")
-		return nil
-	}
-
-	switch fields[0].Name {
-	case "orderEvents":
-		return ec._Subscription_orderEvents(ctx, fields[0])
-	default:
-		panic("unknown field " + strconv.Quote(fields[0].Name))
+func (ec *executionContext) unmarshalNNewOrderV42ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐNewOrderV4(ctx context.Context, v interface{}) (*gqltypes.NewOrderV4, error) {
+	if v == nil {
+		return nil, nil
 	}
+	res, err := ec.unmarshalNNewOrderV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐNewOrderV4(ctx, v)
+	return &res, err
 }
 
-var __DirectiveImplementors = []string{"__Directive"}
-
-func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+func (ec *executionContext) marshalNOrder2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrder(ctx context.Context, sel ast.SelectionSet, v gqltypes.Order) graphql.Marshaler {
+	return ec._Order(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Directive")
-		case "name":
-			out.Values[i] = ec.___Directive_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Directive_description(ctx, field, obj)
-		case "locations":
-			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "args":
-			out.Values[i] = ec.___Directive_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNOrder2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrder(ctx context.Context, sel ast.SelectionSet, v *gqltypes.Order) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	return ec._Order(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNOrderConnection2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderConnection(ctx context.Context, sel ast.SelectionSet, v gqltypes.OrderConnection) graphql.Marshaler {
+	return ec._OrderConnection(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNOrderConnection2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderConnection(ctx context.Context, sel ast.SelectionSet, v *gqltypes.OrderConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._OrderConnection(ctx, sel, v)
 }
 
-var __EnumValueImplementors = []string{"__EnumValue"}
+func (ec *executionContext) unmarshalNOrderEndState2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderEndState(ctx context.Context, v interface{}) (gqltypes.OrderEndState, error) {
+	var res gqltypes.OrderEndState
+	return res, res.UnmarshalGQL(v)
+}
 
-func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+func (ec *executionContext) marshalNOrderEndState2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderEndState(ctx context.Context, sel ast.SelectionSet, v gqltypes.OrderEndState) graphql.Marshaler {
+	return v
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__EnumValue")
-		case "name":
-			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNOrderEvent2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderEvent(ctx context.Context, sel ast.SelectionSet, v gqltypes.OrderEvent) graphql.Marshaler {
+	return ec._OrderEvent(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNOrderEvent2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderEventᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqltypes.OrderEvent) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "deprecationReason":
-			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNOrderEvent2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderEvent(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNOrderEvent2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderEvent(ctx context.Context, sel ast.SelectionSet, v *gqltypes.OrderEvent) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._OrderEvent(ctx, sel, v)
 }
 
-var __FieldImplementors = []string{"__Field"}
-
-func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+func (ec *executionContext) marshalNOrderEventPage2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderEventPage(ctx context.Context, sel ast.SelectionSet, v gqltypes.OrderEventPage) graphql.Marshaler {
+	return ec._OrderEventPage(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Field")
-		case "name":
-			out.Values[i] = ec.___Field_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Field_description(ctx, field, obj)
-		case "args":
-			out.Values[i] = ec.___Field_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "type":
-			out.Values[i] = ec.___Field_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "isDeprecated":
-			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNOrderEventPage2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderEventPage(ctx context.Context, sel ast.SelectionSet, v *gqltypes.OrderEventPage) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._OrderEventPage(ctx, sel, v)
 }
 
-var __InputValueImplementors = []string{"__InputValue"}
+func (ec *executionContext) unmarshalNOrderField2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderField(ctx context.Context, v interface{}) (gqltypes.OrderField, error) {
+	var res gqltypes.OrderField
+	return res, res.UnmarshalGQL(v)
+}
 
-func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
+func (ec *executionContext) marshalNOrderField2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderField(ctx context.Context, sel ast.SelectionSet, v gqltypes.OrderField) graphql.Marshaler {
+	return v
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__InputValue")
-		case "name":
-			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
-		case "type":
-			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "defaultValue":
-			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) unmarshalNOrderFieldV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFieldV4(ctx context.Context, v interface{}) (gqltypes.OrderFieldV4, error) {
+	var res gqltypes.OrderFieldV4
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalNOrderFieldV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFieldV4(ctx context.Context, sel ast.SelectionSet, v gqltypes.OrderFieldV4) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNOrderFilter2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilter(ctx context.Context, v interface{}) (gqltypes.OrderFilter, error) {
+	return ec.unmarshalInputOrderFilter(ctx, v)
+}
+
+func (ec *executionContext) unmarshalNOrderFilter2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilterᚄ(ctx context.Context, v interface{}) ([]*gqltypes.OrderFilter, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
+	var err error
+	res := make([]*gqltypes.OrderFilter, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalNOrderFilter2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilter(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
 	}
-	return out
+	return res, nil
 }
 
-var __SchemaImplementors = []string{"__Schema"}
+func (ec *executionContext) unmarshalNOrderFilter2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilter(ctx context.Context, v interface{}) (*gqltypes.OrderFilter, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalNOrderFilter2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilter(ctx, v)
+	return &res, err
+}
 
-func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
+func (ec *executionContext) unmarshalNOrderFilterV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilterV4(ctx context.Context, v interface{}) (gqltypes.OrderFilterV4, error) {
+	return ec.unmarshalInputOrderFilterV4(ctx, v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Schema")
-		case "types":
-			out.Values[i] = ec.___Schema_types(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "queryType":
-			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "mutationType":
-			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
-		case "subscriptionType":
-			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
-		case "directives":
-			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) unmarshalNOrderFilterV42ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilterV4ᚄ(ctx context.Context, v interface{}) ([]*gqltypes.OrderFilterV4, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
+	var err error
+	res := make([]*gqltypes.OrderFilterV4, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalNOrderFilterV42ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilterV4(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalNOrderFilterV42ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilterV4(ctx context.Context, v interface{}) (*gqltypes.OrderFilterV4, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalNOrderFilterV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilterV4(ctx, v)
+	return &res, err
+}
+
+func (ec *executionContext) unmarshalNOrderSort2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderSort(ctx context.Context, v interface{}) (gqltypes.OrderSort, error) {
+	return ec.unmarshalInputOrderSort(ctx, v)
+}
+
+func (ec *executionContext) unmarshalNOrderSort2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderSort(ctx context.Context, v interface{}) (*gqltypes.OrderSort, error) {
+	if v == nil {
+		return nil, nil
 	}
-	return out
+	res, err := ec.unmarshalNOrderSort2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderSort(ctx, v)
+	return &res, err
 }
 
-var __TypeImplementors = []string{"__Type"}
+func (ec *executionContext) unmarshalNOrderSortV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderSortV4(ctx context.Context, v interface{}) (gqltypes.OrderSortV4, error) {
+	return ec.unmarshalInputOrderSortV4(ctx, v)
+}
 
-func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
+func (ec *executionContext) unmarshalNOrderSortV42ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderSortV4(ctx context.Context, v interface{}) (*gqltypes.OrderSortV4, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalNOrderSortV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderSortV4(ctx, v)
+	return &res, err
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Type")
-		case "kind":
-			out.Values[i] = ec.___Type_kind(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "name":
-			out.Values[i] = ec.___Type_name(ctx, field, obj)
-		case "description":
-			out.Values[i] = ec.___Type_description(ctx, field, obj)
-		case "fields":
-			out.Values[i] = ec.___Type_fields(ctx, field, obj)
-		case "interfaces":
-			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
-		case "possibleTypes":
-			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
-		case "enumValues":
-			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
-		case "inputFields":
-			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
-		case "ofType":
-			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNOrderStats2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderStats(ctx context.Context, sel ast.SelectionSet, v gqltypes.OrderStats) graphql.Marshaler {
+	return ec._OrderStats(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNOrderStats2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderStats(ctx context.Context, sel ast.SelectionSet, v *gqltypes.OrderStats) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	return ec._OrderStats(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNOrderV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderV4(ctx context.Context, sel ast.SelectionSet, v gqltypes.OrderV4) graphql.Marshaler {
+	return ec._OrderV4(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNOrderV42ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderV4(ctx context.Context, sel ast.SelectionSet, v *gqltypes.OrderV4) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._OrderV4(ctx, sel, v)
 }
 
-// endregion **************************** object.gotpl ****************************
+func (ec *executionContext) marshalNOrderV4Connection2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderV4Connection(ctx context.Context, sel ast.SelectionSet, v gqltypes.OrderV4Connection) graphql.Marshaler {
+	return ec._OrderV4Connection(ctx, sel, &v)
+}
 
-// region    ***************************** type.gotpl *****************************
+func (ec *executionContext) marshalNOrderV4Connection2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderV4Connection(ctx context.Context, sel ast.SelectionSet, v *gqltypes.OrderV4Connection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._OrderV4Connection(ctx, sel, v)
+}
 
-func (ec *executionContext) marshalNAcceptedOrderResult2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAcceptedOrderResult(ctx context.Context, sel ast.SelectionSet, v gqltypes.AcceptedOrderResult) graphql.Marshaler {
-	return ec._AcceptedOrderResult(ctx, sel, &v)
+func (ec *executionContext) marshalNOrderV4WithMetadata2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderV4WithMetadata(ctx context.Context, sel ast.SelectionSet, v gqltypes.OrderV4WithMetadata) graphql.Marshaler {
+	return ec._OrderV4WithMetadata(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNAcceptedOrderResult2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAcceptedOrderResultᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqltypes.AcceptedOrderResult) graphql.Marshaler {
+func (ec *executionContext) marshalNOrderV4WithMetadata2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderV4WithMetadataᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqltypes.OrderV4WithMetadata) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -9483,7 +14735,7 @@ func (ec *executionContext) marshalNAcceptedOrderResult2ᚕᚖgithubᚗcomᚋ0xP
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNAcceptedOrderResult2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAcceptedOrderResult(ctx, sel, v[i])
+			ret[i] = ec.marshalNOrderV4WithMetadata2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderV4WithMetadata(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9496,21 +14748,21 @@ func (ec *executionContext) marshalNAcceptedOrderResult2ᚕᚖgithubᚗcomᚋ0xP
 	return ret
 }
 
-func (ec *executionContext) marshalNAcceptedOrderResult2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAcceptedOrderResult(ctx context.Context, sel ast.SelectionSet, v *gqltypes.AcceptedOrderResult) graphql.Marshaler {
+func (ec *executionContext) marshalNOrderV4WithMetadata2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderV4WithMetadata(ctx context.Context, sel ast.SelectionSet, v *gqltypes.OrderV4WithMetadata) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._AcceptedOrderResult(ctx, sel, v)
+	return ec._OrderV4WithMetadata(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNAcceptedOrderResultV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAcceptedOrderResultV4(ctx context.Context, sel ast.SelectionSet, v gqltypes.AcceptedOrderResultV4) graphql.Marshaler {
-	return ec._AcceptedOrderResultV4(ctx, sel, &v)
+func (ec *executionContext) marshalNOrderWithMetadata2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderWithMetadata(ctx context.Context, sel ast.SelectionSet, v gqltypes.OrderWithMetadata) graphql.Marshaler {
+	return ec._OrderWithMetadata(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNAcceptedOrderResultV42ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAcceptedOrderResultV4ᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqltypes.AcceptedOrderResultV4) graphql.Marshaler {
+func (ec *executionContext) marshalNOrderWithMetadata2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderWithMetadataᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqltypes.OrderWithMetadata) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -9534,7 +14786,7 @@ func (ec *executionContext) marshalNAcceptedOrderResultV42ᚕᚖgithubᚗcomᚋ0
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNAcceptedOrderResultV42ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAcceptedOrderResultV4(ctx, sel, v[i])
+			ret[i] = ec.marshalNOrderWithMetadata2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderWithMetadata(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9547,86 +14799,35 @@ func (ec *executionContext) marshalNAcceptedOrderResultV42ᚕᚖgithubᚗcomᚋ0
 	return ret
 }
 
-func (ec *executionContext) marshalNAcceptedOrderResultV42ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAcceptedOrderResultV4(ctx context.Context, sel ast.SelectionSet, v *gqltypes.AcceptedOrderResultV4) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	return ec._AcceptedOrderResultV4(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNAddOrdersResults2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAddOrdersResults(ctx context.Context, sel ast.SelectionSet, v gqltypes.AddOrdersResults) graphql.Marshaler {
-	return ec._AddOrdersResults(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNAddOrdersResults2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAddOrdersResults(ctx context.Context, sel ast.SelectionSet, v *gqltypes.AddOrdersResults) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	return ec._AddOrdersResults(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNAddOrdersResultsV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAddOrdersResultsV4(ctx context.Context, sel ast.SelectionSet, v gqltypes.AddOrdersResultsV4) graphql.Marshaler {
-	return ec._AddOrdersResultsV4(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNAddOrdersResultsV42ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐAddOrdersResultsV4(ctx context.Context, sel ast.SelectionSet, v *gqltypes.AddOrdersResultsV4) graphql.Marshaler {
+func (ec *executionContext) marshalNOrderWithMetadata2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderWithMetadata(ctx context.Context, sel ast.SelectionSet, v *gqltypes.OrderWithMetadata) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._AddOrdersResultsV4(ctx, sel, v)
+	return ec._OrderWithMetadata(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNAny2interface(ctx context.Context, v interface{}) (interface{}, error) {
-	if v == nil {
-		return nil, nil
-	}
-	return graphql.UnmarshalAny(v)
+func (ec *executionContext) marshalNOrderbook2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderbook(ctx context.Context, sel ast.SelectionSet, v gqltypes.Orderbook) graphql.Marshaler {
+	return ec._Orderbook(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNAny2interface(ctx context.Context, sel ast.SelectionSet, v interface{}) graphql.Marshaler {
+func (ec *executionContext) marshalNOrderbook2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderbook(ctx context.Context, sel ast.SelectionSet, v *gqltypes.Orderbook) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	res := graphql.MarshalAny(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-	}
-	return res
-}
-
-func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
-	return graphql.UnmarshalBoolean(v)
-}
-
-func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	res := graphql.MarshalBoolean(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-	}
-	return res
+	return ec._Orderbook(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNContractEvent2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐContractEvent(ctx context.Context, sel ast.SelectionSet, v gqltypes.ContractEvent) graphql.Marshaler {
-	return ec._ContractEvent(ctx, sel, &v)
+func (ec *executionContext) marshalNOrderbookLevel2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderbookLevel(ctx context.Context, sel ast.SelectionSet, v gqltypes.OrderbookLevel) graphql.Marshaler {
+	return ec._OrderbookLevel(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNContractEvent2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐContractEventᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqltypes.ContractEvent) graphql.Marshaler {
+func (ec *executionContext) marshalNOrderbookLevel2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderbookLevelᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqltypes.OrderbookLevel) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -9650,144 +14851,48 @@ func (ec *executionContext) marshalNContractEvent2ᚕᚖgithubᚗcomᚋ0xProject
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNContractEvent2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐContractEvent(ctx, sel, v[i])
+			ret[i] = ec.marshalNOrderbookLevel2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderbookLevel(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
 		} else {
 			go f(i)
 		}
-
-	}
-	wg.Wait()
-	return ret
-}
-
-func (ec *executionContext) marshalNContractEvent2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐContractEvent(ctx context.Context, sel ast.SelectionSet, v *gqltypes.ContractEvent) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	return ec._ContractEvent(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNFilterKind2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐFilterKind(ctx context.Context, v interface{}) (gqltypes.FilterKind, error) {
-	var res gqltypes.FilterKind
-	return res, res.UnmarshalGQL(v)
-}
-
-func (ec *executionContext) marshalNFilterKind2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐFilterKind(ctx context.Context, sel ast.SelectionSet, v gqltypes.FilterKind) graphql.Marshaler {
-	return v
-}
-
-func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
-	return graphql.UnmarshalInt(v)
-}
-
-func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
-	res := graphql.MarshalInt(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-	}
-	return res
-}
-
-func (ec *executionContext) unmarshalNNewOrder2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐNewOrder(ctx context.Context, v interface{}) (gqltypes.NewOrder, error) {
-	return ec.unmarshalInputNewOrder(ctx, v)
-}
-
-func (ec *executionContext) unmarshalNNewOrder2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐNewOrderᚄ(ctx context.Context, v interface{}) ([]*gqltypes.NewOrder, error) {
-	var vSlice []interface{}
-	if v != nil {
-		if tmp1, ok := v.([]interface{}); ok {
-			vSlice = tmp1
-		} else {
-			vSlice = []interface{}{v}
-		}
-	}
-	var err error
-	res := make([]*gqltypes.NewOrder, len(vSlice))
-	for i := range vSlice {
-		res[i], err = ec.unmarshalNNewOrder2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐNewOrder(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
-		}
-	}
-	return res, nil
-}
-
-func (ec *executionContext) unmarshalNNewOrder2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐNewOrder(ctx context.Context, v interface{}) (*gqltypes.NewOrder, error) {
-	if v == nil {
-		return nil, nil
-	}
-	res, err := ec.unmarshalNNewOrder2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐNewOrder(ctx, v)
-	return &res, err
-}
-
-func (ec *executionContext) unmarshalNNewOrderV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐNewOrderV4(ctx context.Context, v interface{}) (gqltypes.NewOrderV4, error) {
-	return ec.unmarshalInputNewOrderV4(ctx, v)
-}
-
-func (ec *executionContext) unmarshalNNewOrderV42ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐNewOrderV4ᚄ(ctx context.Context, v interface{}) ([]*gqltypes.NewOrderV4, error) {
-	var vSlice []interface{}
-	if v != nil {
-		if tmp1, ok := v.([]interface{}); ok {
-			vSlice = tmp1
-		} else {
-			vSlice = []interface{}{v}
-		}
-	}
-	var err error
-	res := make([]*gqltypes.NewOrderV4, len(vSlice))
-	for i := range vSlice {
-		res[i], err = ec.unmarshalNNewOrderV42ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐNewOrderV4(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
-		}
+
 	}
-	return res, nil
+	wg.Wait()
+	return ret
 }
 
-func (ec *executionContext) unmarshalNNewOrderV42ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐNewOrderV4(ctx context.Context, v interface{}) (*gqltypes.NewOrderV4, error) {
+func (ec *executionContext) marshalNOrderbookLevel2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderbookLevel(ctx context.Context, sel ast.SelectionSet, v *gqltypes.OrderbookLevel) graphql.Marshaler {
 	if v == nil {
-		return nil, nil
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
 	}
-	res, err := ec.unmarshalNNewOrderV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐNewOrderV4(ctx, v)
-	return &res, err
+	return ec._OrderbookLevel(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNOrder2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrder(ctx context.Context, sel ast.SelectionSet, v gqltypes.Order) graphql.Marshaler {
-	return ec._Order(ctx, sel, &v)
+func (ec *executionContext) marshalNPageInfo2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v gqltypes.PageInfo) graphql.Marshaler {
+	return ec._PageInfo(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNOrder2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrder(ctx context.Context, sel ast.SelectionSet, v *gqltypes.Order) graphql.Marshaler {
+func (ec *executionContext) marshalNPageInfo2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v *gqltypes.PageInfo) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._Order(ctx, sel, v)
+	return ec._PageInfo(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNOrderEndState2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderEndState(ctx context.Context, v interface{}) (gqltypes.OrderEndState, error) {
-	var res gqltypes.OrderEndState
-	return res, res.UnmarshalGQL(v)
-}
-
-func (ec *executionContext) marshalNOrderEndState2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderEndState(ctx context.Context, sel ast.SelectionSet, v gqltypes.OrderEndState) graphql.Marshaler {
-	return v
-}
-
-func (ec *executionContext) marshalNOrderEvent2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderEvent(ctx context.Context, sel ast.SelectionSet, v gqltypes.OrderEvent) graphql.Marshaler {
-	return ec._OrderEvent(ctx, sel, &v)
+func (ec *executionContext) marshalNPeer2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐPeer(ctx context.Context, sel ast.SelectionSet, v gqltypes.Peer) graphql.Marshaler {
+	return ec._Peer(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNOrderEvent2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderEventᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqltypes.OrderEvent) graphql.Marshaler {
+func (ec *executionContext) marshalNPeer2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐPeerᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqltypes.Peer) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -9811,7 +14916,7 @@ func (ec *executionContext) marshalNOrderEvent2ᚕᚖgithubᚗcomᚋ0xProjectᚋ
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNOrderEvent2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderEvent(ctx, sel, v[i])
+			ret[i] = ec.marshalNPeer2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐPeer(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9824,101 +14929,95 @@ func (ec *executionContext) marshalNOrderEvent2ᚕᚖgithubᚗcomᚋ0xProjectᚋ
 	return ret
 }
 
-func (ec *executionContext) marshalNOrderEvent2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderEvent(ctx context.Context, sel ast.SelectionSet, v *gqltypes.OrderEvent) graphql.Marshaler {
+func (ec *executionContext) marshalNPeer2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐPeer(ctx context.Context, sel ast.SelectionSet, v *gqltypes.Peer) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._OrderEvent(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNOrderField2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderField(ctx context.Context, v interface{}) (gqltypes.OrderField, error) {
-	var res gqltypes.OrderField
-	return res, res.UnmarshalGQL(v)
-}
-
-func (ec *executionContext) marshalNOrderField2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderField(ctx context.Context, sel ast.SelectionSet, v gqltypes.OrderField) graphql.Marshaler {
-	return v
-}
-
-func (ec *executionContext) unmarshalNOrderFieldV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFieldV4(ctx context.Context, v interface{}) (gqltypes.OrderFieldV4, error) {
-	var res gqltypes.OrderFieldV4
-	return res, res.UnmarshalGQL(v)
-}
-
-func (ec *executionContext) marshalNOrderFieldV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFieldV4(ctx context.Context, sel ast.SelectionSet, v gqltypes.OrderFieldV4) graphql.Marshaler {
-	return v
-}
-
-func (ec *executionContext) unmarshalNOrderFilter2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilter(ctx context.Context, v interface{}) (gqltypes.OrderFilter, error) {
-	return ec.unmarshalInputOrderFilter(ctx, v)
-}
-
-func (ec *executionContext) unmarshalNOrderFilter2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilter(ctx context.Context, v interface{}) (*gqltypes.OrderFilter, error) {
-	if v == nil {
-		return nil, nil
-	}
-	res, err := ec.unmarshalNOrderFilter2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilter(ctx, v)
-	return &res, err
+	return ec._Peer(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNOrderFilterV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilterV4(ctx context.Context, v interface{}) (gqltypes.OrderFilterV4, error) {
-	return ec.unmarshalInputOrderFilterV4(ctx, v)
+func (ec *executionContext) marshalNPeerBandwidth2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐPeerBandwidth(ctx context.Context, sel ast.SelectionSet, v gqltypes.PeerBandwidth) graphql.Marshaler {
+	return ec._PeerBandwidth(ctx, sel, &v)
 }
 
-func (ec *executionContext) unmarshalNOrderFilterV42ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilterV4(ctx context.Context, v interface{}) (*gqltypes.OrderFilterV4, error) {
+func (ec *executionContext) marshalNPeerBandwidth2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐPeerBandwidth(ctx context.Context, sel ast.SelectionSet, v *gqltypes.PeerBandwidth) graphql.Marshaler {
 	if v == nil {
-		return nil, nil
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
 	}
-	res, err := ec.unmarshalNOrderFilterV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilterV4(ctx, v)
-	return &res, err
+	return ec._PeerBandwidth(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNOrderSort2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderSort(ctx context.Context, v interface{}) (gqltypes.OrderSort, error) {
-	return ec.unmarshalInputOrderSort(ctx, v)
+func (ec *executionContext) marshalNPeerConnection2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐPeerConnection(ctx context.Context, sel ast.SelectionSet, v gqltypes.PeerConnection) graphql.Marshaler {
+	return ec._PeerConnection(ctx, sel, &v)
 }
 
-func (ec *executionContext) unmarshalNOrderSort2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderSort(ctx context.Context, v interface{}) (*gqltypes.OrderSort, error) {
-	if v == nil {
-		return nil, nil
+func (ec *executionContext) marshalNPeerConnection2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐPeerConnectionᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqltypes.PeerConnection) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
-	res, err := ec.unmarshalNOrderSort2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderSort(ctx, v)
-	return &res, err
-}
-
-func (ec *executionContext) unmarshalNOrderSortV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderSortV4(ctx context.Context, v interface{}) (gqltypes.OrderSortV4, error) {
-	return ec.unmarshalInputOrderSortV4(ctx, v)
-}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPeerConnection2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐPeerConnection(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) unmarshalNOrderSortV42ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderSortV4(ctx context.Context, v interface{}) (*gqltypes.OrderSortV4, error) {
-	if v == nil {
-		return nil, nil
 	}
-	res, err := ec.unmarshalNOrderSortV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderSortV4(ctx, v)
-	return &res, err
-}
-
-func (ec *executionContext) marshalNOrderV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderV4(ctx context.Context, sel ast.SelectionSet, v gqltypes.OrderV4) graphql.Marshaler {
-	return ec._OrderV4(ctx, sel, &v)
+	wg.Wait()
+	return ret
 }
 
-func (ec *executionContext) marshalNOrderV42ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderV4(ctx context.Context, sel ast.SelectionSet, v *gqltypes.OrderV4) graphql.Marshaler {
+func (ec *executionContext) marshalNPeerConnection2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐPeerConnection(ctx context.Context, sel ast.SelectionSet, v *gqltypes.PeerConnection) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._OrderV4(ctx, sel, v)
+	return ec._PeerConnection(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNOrderV4WithMetadata2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderV4WithMetadata(ctx context.Context, sel ast.SelectionSet, v gqltypes.OrderV4WithMetadata) graphql.Marshaler {
-	return ec._OrderV4WithMetadata(ctx, sel, &v)
+func (ec *executionContext) unmarshalNPeerConnectionDirection2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐPeerConnectionDirection(ctx context.Context, v interface{}) (gqltypes.PeerConnectionDirection, error) {
+	var res gqltypes.PeerConnectionDirection
+	return res, res.UnmarshalGQL(v)
 }
 
-func (ec *executionContext) marshalNOrderV4WithMetadata2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderV4WithMetadataᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqltypes.OrderV4WithMetadata) graphql.Marshaler {
+func (ec *executionContext) marshalNPeerConnectionDirection2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐPeerConnectionDirection(ctx context.Context, sel ast.SelectionSet, v gqltypes.PeerConnectionDirection) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNPeerTag2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐPeerTag(ctx context.Context, sel ast.SelectionSet, v gqltypes.PeerTag) graphql.Marshaler {
+	return ec._PeerTag(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNPeerTag2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐPeerTagᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqltypes.PeerTag) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -9942,7 +15041,7 @@ func (ec *executionContext) marshalNOrderV4WithMetadata2ᚕᚖgithubᚗcomᚋ0xP
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNOrderV4WithMetadata2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderV4WithMetadata(ctx, sel, v[i])
+			ret[i] = ec.marshalNPeerTag2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐPeerTag(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9955,21 +15054,21 @@ func (ec *executionContext) marshalNOrderV4WithMetadata2ᚕᚖgithubᚗcomᚋ0xP
 	return ret
 }
 
-func (ec *executionContext) marshalNOrderV4WithMetadata2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderV4WithMetadata(ctx context.Context, sel ast.SelectionSet, v *gqltypes.OrderV4WithMetadata) graphql.Marshaler {
+func (ec *executionContext) marshalNPeerTag2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐPeerTag(ctx context.Context, sel ast.SelectionSet, v *gqltypes.PeerTag) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._OrderV4WithMetadata(ctx, sel, v)
+	return ec._PeerTag(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNOrderWithMetadata2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderWithMetadata(ctx context.Context, sel ast.SelectionSet, v gqltypes.OrderWithMetadata) graphql.Marshaler {
-	return ec._OrderWithMetadata(ctx, sel, &v)
+func (ec *executionContext) marshalNQuotaUsage2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐQuotaUsage(ctx context.Context, sel ast.SelectionSet, v gqltypes.QuotaUsage) graphql.Marshaler {
+	return ec._QuotaUsage(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNOrderWithMetadata2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderWithMetadataᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqltypes.OrderWithMetadata) graphql.Marshaler {
+func (ec *executionContext) marshalNQuotaUsage2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐQuotaUsageᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqltypes.QuotaUsage) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -9993,7 +15092,7 @@ func (ec *executionContext) marshalNOrderWithMetadata2ᚕᚖgithubᚗcomᚋ0xPro
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNOrderWithMetadata2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderWithMetadata(ctx, sel, v[i])
+			ret[i] = ec.marshalNQuotaUsage2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐQuotaUsage(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -10006,14 +15105,14 @@ func (ec *executionContext) marshalNOrderWithMetadata2ᚕᚖgithubᚗcomᚋ0xPro
 	return ret
 }
 
-func (ec *executionContext) marshalNOrderWithMetadata2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderWithMetadata(ctx context.Context, sel ast.SelectionSet, v *gqltypes.OrderWithMetadata) graphql.Marshaler {
+func (ec *executionContext) marshalNQuotaUsage2ᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐQuotaUsage(ctx context.Context, sel ast.SelectionSet, v *gqltypes.QuotaUsage) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._OrderWithMetadata(ctx, sel, v)
+	return ec._QuotaUsage(ctx, sel, v)
 }
 
 func (ec *executionContext) unmarshalNRejectedOrderCode2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐRejectedOrderCode(ctx context.Context, v interface{}) (gqltypes.RejectedOrderCode, error) {
@@ -10431,6 +15530,20 @@ func (ec *executionContext) unmarshalOAddOrdersOpts2ᚖgithubᚗcomᚋ0xProject
 	return &res, err
 }
 
+func (ec *executionContext) unmarshalOAny2interface(ctx context.Context, v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return graphql.UnmarshalAny(v)
+}
+
+func (ec *executionContext) marshalOAny2interface(ctx context.Context, sel ast.SelectionSet, v interface{}) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalAny(v)
+}
+
 func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
 	return graphql.UnmarshalBoolean(v)
 }
@@ -10488,6 +15601,104 @@ func (ec *executionContext) marshalOLatestBlock2ᚖgithubᚗcomᚋ0xProjectᚋ0x
 	return ec._LatestBlock(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOOrderEndState2ᚕgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderEndStateᚄ(ctx context.Context, v interface{}) ([]gqltypes.OrderEndState, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]gqltypes.OrderEndState, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalNOrderEndState2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderEndState(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOOrderEndState2ᚕgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderEndStateᚄ(ctx context.Context, sel ast.SelectionSet, v []gqltypes.OrderEndState) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNOrderEndState2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderEndState(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) unmarshalOOrderField2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderField(ctx context.Context, v interface{}) (gqltypes.OrderField, error) {
+	var res gqltypes.OrderField
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalOOrderField2githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderField(ctx context.Context, sel ast.SelectionSet, v gqltypes.OrderField) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalOOrderFieldV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFieldV4(ctx context.Context, v interface{}) (gqltypes.OrderFieldV4, error) {
+	var res gqltypes.OrderFieldV4
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalOOrderFieldV42githubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFieldV4(ctx context.Context, sel ast.SelectionSet, v gqltypes.OrderFieldV4) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalOOrderFilter2ᚕᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilterᚄ(ctx context.Context, v interface{}) ([][]*gqltypes.OrderFilter, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([][]*gqltypes.OrderFilter, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalNOrderFilter2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilterᚄ(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (ec *executionContext) unmarshalOOrderFilter2ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilterᚄ(ctx context.Context, v interface{}) ([]*gqltypes.OrderFilter, error) {
 	var vSlice []interface{}
 	if v != nil {
@@ -10508,6 +15719,26 @@ func (ec *executionContext) unmarshalOOrderFilter2ᚕᚖgithubᚗcomᚋ0xProject
 	return res, nil
 }
 
+func (ec *executionContext) unmarshalOOrderFilterV42ᚕᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilterV4ᚄ(ctx context.Context, v interface{}) ([][]*gqltypes.OrderFilterV4, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([][]*gqltypes.OrderFilterV4, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalNOrderFilterV42ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilterV4ᚄ(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (ec *executionContext) unmarshalOOrderFilterV42ᚕᚖgithubᚗcomᚋ0xProjectᚋ0xᚑmeshᚋgraphqlᚋgqltypesᚐOrderFilterV4ᚄ(ctx context.Context, v interface{}) ([]*gqltypes.OrderFilterV4, error) {
 	var vSlice []interface{}
 	if v != nil {
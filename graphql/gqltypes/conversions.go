@@ -1,18 +1,23 @@
 package gqltypes
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/0xProject/0x-mesh/common/types"
 	"github.com/0xProject/0x-mesh/db"
+	"github.com/0xProject/0x-mesh/p2p"
 	"github.com/0xProject/0x-mesh/zeroex"
 	"github.com/0xProject/0x-mesh/zeroex/ordervalidator"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/libp2p/go-libp2p-core/network"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -56,6 +61,72 @@ func StatsFromCommonType(stats *types.Stats) *Stats {
 		EthRPCRateLimitExpiredRequests:    int(stats.EthRPCRateLimitExpiredRequests),
 		SecondaryRendezvous:               stats.SecondaryRendezvous,
 		MaxExpirationTime:                 stats.MaxExpirationTime.String(),
+		MaxOrdersPerMaker:                 stats.MaxOrdersPerMaker,
+		MaxOrdersPerPeer:                  stats.MaxOrdersPerPeer,
+		MakerQuotaUsage:                   QuotaUsagesFromCommonType(stats.MakerQuotaUsage),
+		PeerQuotaUsage:                    QuotaUsagesFromCommonType(stats.PeerQuotaUsage),
+	}
+}
+
+func QuotaUsagesFromCommonType(usages []*types.QuotaUsage) []*QuotaUsage {
+	result := make([]*QuotaUsage, len(usages))
+	for i, usage := range usages {
+		result[i] = &QuotaUsage{
+			ID:        usage.ID,
+			NumOrders: usage.NumOrders,
+		}
+	}
+	return result
+}
+
+func PeersFromP2PType(peerInfos []*p2p.PeerInfo) []*Peer {
+	result := make([]*Peer, len(peerInfos))
+	for i, peerInfo := range peerInfos {
+		result[i] = PeerFromP2PType(peerInfo)
+	}
+	return result
+}
+
+func PeerFromP2PType(peerInfo *p2p.PeerInfo) *Peer {
+	connections := make([]*PeerConnection, len(peerInfo.Connections))
+	for i, conn := range peerInfo.Connections {
+		connections[i] = &PeerConnection{
+			Multiaddr: conn.RemoteMultiaddr.String(),
+			Direction: PeerConnectionDirectionFromP2PType(conn.Direction),
+		}
+	}
+	tags := make([]*PeerTag, 0, len(peerInfo.Tags))
+	for tag, value := range peerInfo.Tags {
+		tags = append(tags, &PeerTag{
+			Tag:   tag,
+			Value: value,
+		})
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		return tags[i].Tag < tags[j].Tag
+	})
+	return &Peer{
+		ID:          peerInfo.ID.String(),
+		Connections: connections,
+		Tags:        tags,
+		Score:       peerInfo.Score,
+		Bandwidth: &PeerBandwidth{
+			TotalIn:  strconv.FormatInt(peerInfo.Bandwidth.TotalIn, 10),
+			TotalOut: strconv.FormatInt(peerInfo.Bandwidth.TotalOut, 10),
+			RateIn:   peerInfo.Bandwidth.RateIn,
+			RateOut:  peerInfo.Bandwidth.RateOut,
+		},
+	}
+}
+
+func PeerConnectionDirectionFromP2PType(direction network.Direction) PeerConnectionDirection {
+	switch direction {
+	case network.DirInbound:
+		return PeerConnectionDirectionInbound
+	case network.DirOutbound:
+		return PeerConnectionDirectionOutbound
+	default:
+		return PeerConnectionDirectionUnknown
 	}
 }
 
@@ -66,6 +137,64 @@ func LatestBlockFromCommonType(latestBlock types.LatestBlock) *LatestBlock {
 	}
 }
 
+func OrderStatsFromCommonType(stats *types.OrderStats) *OrderStats {
+	result := &OrderStats{
+		OrderCount:                    stats.OrderCount,
+		TotalFillableTakerAssetAmount: stats.TotalFillableTakerAssetAmount.String(),
+		MakerCount:                    stats.MakerCount,
+	}
+	if stats.MinExpirationTimeSeconds != nil {
+		minExpirationTimeSeconds := stats.MinExpirationTimeSeconds.String()
+		result.MinExpirationTimeSeconds = &minExpirationTimeSeconds
+	}
+	if stats.MaxExpirationTimeSeconds != nil {
+		maxExpirationTimeSeconds := stats.MaxExpirationTimeSeconds.String()
+		result.MaxExpirationTimeSeconds = &maxExpirationTimeSeconds
+	}
+	return result
+}
+
+// orderbookPriceDecimals is the number of decimal places used when encoding
+// orderbook prices as strings.
+const orderbookPriceDecimals = 18
+
+func OrderbookFromCommonType(orderbook *types.Orderbook) *Orderbook {
+	return &Orderbook{
+		BaseToken:  strings.ToLower(orderbook.BaseToken.Hex()),
+		QuoteToken: strings.ToLower(orderbook.QuoteToken.Hex()),
+		Bids:       OrderbookLevelsFromCommonType(orderbook.Bids),
+		Asks:       OrderbookLevelsFromCommonType(orderbook.Asks),
+	}
+}
+
+func OrderbookLevelsFromCommonType(levels []*types.OrderbookLevel) []*OrderbookLevel {
+	result := make([]*OrderbookLevel, len(levels))
+	for i, level := range levels {
+		result[i] = OrderbookLevelFromCommonType(level)
+	}
+	return result
+}
+
+func OrderbookLevelFromCommonType(level *types.OrderbookLevel) *OrderbookLevel {
+	return &OrderbookLevel{
+		Price:       ratToDecimalString(level.Price, orderbookPriceDecimals),
+		BaseAmount:  level.BaseAmount.String(),
+		QuoteAmount: level.QuoteAmount.String(),
+		OrderCount:  level.OrderCount,
+	}
+}
+
+// ratToDecimalString encodes value as a decimal string rounded to the given
+// number of decimal places, without any trailing zeros.
+func ratToDecimalString(value *big.Rat, decimals int) string {
+	result := value.FloatString(decimals)
+	if strings.Contains(result, ".") {
+		result = strings.TrimRight(result, "0")
+		result = strings.TrimSuffix(result, ".")
+	}
+	return result
+}
+
 func NewOrderToSignedOrder(newOrder *NewOrder) (*zeroex.SignedOrder, []error) {
 	errors := []error{}
 	chainID, ok := math.ParseBig256(newOrder.ChainID)
@@ -472,6 +601,10 @@ func OrderEventFromZeroExType(event *zeroex.OrderEvent) *OrderEvent {
 			FillableTakerAssetAmount: event.FillableTakerAssetAmount.String(),
 		}
 	}
+	if event.SequenceNumber != 0 {
+		sequenceNumber := strconv.FormatUint(event.SequenceNumber, 10)
+		baseEvent.SequenceNumber = &sequenceNumber
+	}
 	return baseEvent
 }
 
@@ -483,6 +616,37 @@ func OrderEventsFromZeroExType(orderEvents []*zeroex.OrderEvent) []*OrderEvent {
 	return result
 }
 
+func ArchivedOrderFromCommonType(order *types.ArchivedOrder) *ArchivedOrder {
+	// The order is converted in the same way as the order of an order event.
+	orderEvent := OrderEventFromZeroExType(&zeroex.OrderEvent{
+		OrderHash:                order.Hash,
+		SignedOrder:              order.SignedOrder,
+		SignedOrderV4:            order.SignedOrderV4,
+		FillableTakerAssetAmount: order.FillableTakerAssetAmount,
+		EndState:                 order.EndState,
+		Timestamp:                order.Timestamp,
+	})
+	return &ArchivedOrder{
+		Hash:        order.Hash.Hex(),
+		Order:       orderEvent.Order,
+		Orderv4:     orderEvent.Orderv4,
+		EndState:    orderEvent.EndState,
+		Timestamp:   orderEvent.Timestamp,
+		BlockNumber: order.BlockNumber.String(),
+		BlockHash:   order.BlockHash.Hex(),
+		IsPinned:    order.IsPinned,
+		ArchivedAt:  order.ArchivedAt.Format(time.RFC3339),
+	}
+}
+
+func ArchivedOrdersFromCommonType(orders []*types.ArchivedOrder) []*ArchivedOrder {
+	result := make([]*ArchivedOrder, len(orders))
+	for i, order := range orders {
+		result[i] = ArchivedOrderFromCommonType(order)
+	}
+	return result
+}
+
 func ContractEventFromZeroExType(event *zeroex.ContractEvent) *ContractEvent {
 	return &ContractEvent{
 		BlockHash:  event.BlockHash.Hex(),
@@ -548,6 +712,10 @@ func RejectedCodeFromValidatorStatus(status ordervalidator.RejectedOrderStatus)
 		return RejectedOrderCodeDatabaseFullOfOrders, nil
 	case ordervalidator.ROTakerAddressNotAllowed.Code:
 		return RejectedOrderCodeTakerAddressNotAllowed, nil
+	case ordervalidator.ROMakerQuotaExceeded.Code:
+		return RejectedOrderCodeMakerQuotaExceeded, nil
+	case ordervalidator.ROPeerQuotaExceeded.Code:
+		return RejectedOrderCodePeerQuotaExceeded, nil
 	case ordervalidator.ROInvalidSchemaCode:
 		return RejectedOrderCodeOrderInvalidSchema, nil
 	default:
@@ -569,22 +737,286 @@ func FilterKindToDBType(kind FilterKind) (db.FilterKind, error) {
 		return db.Less, nil
 	case FilterKindLessOrEqual:
 		return db.LessOrEqual, nil
+	case FilterKindIn:
+		return db.In, nil
+	case FilterKindOr:
+		return db.Or, nil
 	default:
 		return "", fmt.Errorf("invalid filter kind: %q", kind)
 	}
 }
 
+// OrderFieldToDBType converts an OrderField to the corresponding
+// db.OrderField.
+func OrderFieldToDBType(field OrderField) db.OrderField {
+	switch field {
+	case OrderFieldChainID:
+		return db.OFChainID
+	default:
+		return db.OrderField(field)
+	}
+}
+
+// OrderFieldV4ToDBType converts an OrderFieldV4 to the corresponding
+// db.OrderFieldV4.
+func OrderFieldV4ToDBType(field OrderFieldV4) db.OrderFieldV4 {
+	switch field {
+	case OrderFieldV4ChainID:
+		return db.OV4FChainID
+	default:
+		return db.OrderFieldV4(field)
+	}
+}
+
+// CheckSortField returns an error if the given field cannot be used for
+// sorting orders.
+func CheckSortField(field OrderField) error {
+	if isTokenField(field) {
+		return fmt.Errorf("cannot sort by %s (it can only be used in filters)", field)
+	}
+	return nil
+}
+
+// isTokenField returns true if the given field is one of the filter-only
+// fields that match the tokens contained in the asset data of an order.
+func isTokenField(field OrderField) bool {
+	switch field {
+	case OrderFieldMakerToken, OrderFieldMakerTokenID, OrderFieldTakerToken, OrderFieldTakerTokenID, OrderFieldMakerFeeToken, OrderFieldTakerFeeToken:
+		return true
+	default:
+		return false
+	}
+}
+
+// findTokenAndTokenIDFilters returns the EQUAL filters for the given token and
+// token ID fields if filters contains exactly one of each. Such a pair is
+// converted to a single db.OrderFilter so that both values have to match the
+// same component of the asset data.
+func findTokenAndTokenIDFilters(filters []*OrderFilter, tokenField, tokenIDField OrderField) (tokenFilter, tokenIDFilter *OrderFilter) {
+	for _, filter := range filters {
+		if filter.Kind != FilterKindEqual {
+			continue
+		}
+		switch filter.Field {
+		case tokenField:
+			if tokenFilter != nil {
+				return nil, nil
+			}
+			tokenFilter = filter
+		case tokenIDField:
+			if tokenIDFilter != nil {
+				return nil, nil
+			}
+			tokenIDFilter = filter
+		}
+	}
+	if tokenFilter == nil || tokenIDFilter == nil {
+		return nil, nil
+	}
+	return tokenFilter, tokenIDFilter
+}
+
+// tokenFilterToDBType converts an EQUAL filter on one of the token fields to
+// the corresponding db.OrderFilter. value must already have been converted by
+// FilterValueFromJSON.
+func tokenFilterToDBType(field OrderField, value interface{}) (db.OrderFilter, error) {
+	switch field {
+	case OrderFieldMakerToken:
+		return db.MakerAssetIncludesTokenAddress(value.(common.Address)), nil
+	case OrderFieldMakerTokenID:
+		return db.MakerAssetIncludesTokenID(value.(*big.Int)), nil
+	case OrderFieldTakerToken:
+		return db.TakerAssetIncludesTokenAddress(value.(common.Address)), nil
+	case OrderFieldTakerTokenID:
+		return db.TakerAssetIncludesTokenID(value.(*big.Int)), nil
+	case OrderFieldMakerFeeToken:
+		return db.MakerFeeAssetIncludesTokenAddress(value.(common.Address)), nil
+	case OrderFieldTakerFeeToken:
+		return db.TakerFeeAssetIncludesTokenAddress(value.(common.Address)), nil
+	default:
+		return db.OrderFilter{}, fmt.Errorf("invalid token filter field: %q", field)
+	}
+}
+
+// tokenFiltersToDBType converts a filter on one of the token fields to the
+// corresponding db.OrderFilter. IN filters are converted to an OR filter with
+// one group for each value.
+func tokenFiltersToDBType(filter *OrderFilter) (db.OrderFilter, error) {
+	value, err := FilterValueFromJSON(*filter)
+	if err != nil {
+		return db.OrderFilter{}, err
+	}
+	switch filter.Kind {
+	case FilterKindEqual:
+		return tokenFilterToDBType(filter.Field, value)
+	case FilterKindIn:
+		values := value.([]interface{})
+		if len(values) == 0 {
+			return db.OrderFilter{}, fmt.Errorf("IN filters on %s must have at least one value", filter.Field)
+		}
+		groups := make([][]db.OrderFilter, len(values))
+		for i, value := range values {
+			dbFilter, err := tokenFilterToDBType(filter.Field, value)
+			if err != nil {
+				return db.OrderFilter{}, err
+			}
+			groups[i] = []db.OrderFilter{dbFilter}
+		}
+		return db.OrderFilter{
+			Kind:   db.Or,
+			Groups: groups,
+		}, nil
+	default:
+		return db.OrderFilter{}, fmt.Errorf("%s can only be used in EQUAL and IN filters (got %s)", filter.Field, filter.Kind)
+	}
+}
+
+// OrderFiltersToDBType converts the given filters to the corresponding
+// db.OrderFilters. Filters on the token fields (e.g. makerToken) are
+// converted to filters on the parsed asset data of the order.
+func OrderFiltersToDBType(filters []*OrderFilter) ([]db.OrderFilter, error) {
+	makerTokenFilter, makerTokenIDFilter := findTokenAndTokenIDFilters(filters, OrderFieldMakerToken, OrderFieldMakerTokenID)
+	takerTokenFilter, takerTokenIDFilter := findTokenAndTokenIDFilters(filters, OrderFieldTakerToken, OrderFieldTakerTokenID)
+	dbFilters := make([]db.OrderFilter, 0, len(filters))
+	for _, filter := range filters {
+		switch {
+		case filter == makerTokenIDFilter || filter == takerTokenIDFilter:
+			// Handled together with the corresponding token filter.
+			continue
+		case filter == makerTokenFilter || filter == takerTokenFilter:
+			tokenAddress, err := stringToAddress(filter.Value)
+			if err != nil {
+				return nil, err
+			}
+			if filter == makerTokenFilter {
+				tokenID, err := stringToBigInt(makerTokenIDFilter.Value)
+				if err != nil {
+					return nil, err
+				}
+				dbFilters = append(dbFilters, db.MakerAssetIncludesTokenAddressAndTokenID(tokenAddress, tokenID))
+			} else {
+				tokenID, err := stringToBigInt(takerTokenIDFilter.Value)
+				if err != nil {
+					return nil, err
+				}
+				dbFilters = append(dbFilters, db.TakerAssetIncludesTokenAddressAndTokenID(tokenAddress, tokenID))
+			}
+			continue
+		case isTokenField(filter.Field) && filter.Kind != FilterKindOr:
+			if len(filter.Groups) != 0 {
+				return nil, fmt.Errorf("only OR filters can have groups (got %s)", filter.Kind)
+			}
+			dbFilter, err := tokenFiltersToDBType(filter)
+			if err != nil {
+				return nil, err
+			}
+			dbFilters = append(dbFilters, dbFilter)
+			continue
+		}
+		kind, err := FilterKindToDBType(filter.Kind)
+		if err != nil {
+			return nil, err
+		}
+		if kind == db.Or {
+			if len(filter.Groups) == 0 {
+				return nil, errors.New("OR filters must have at least one group")
+			}
+			dbGroups := make([][]db.OrderFilter, len(filter.Groups))
+			for i, group := range filter.Groups {
+				if len(group) == 0 {
+					return nil, errors.New("OR filter groups must not be empty")
+				}
+				dbGroups[i], err = OrderFiltersToDBType(group)
+				if err != nil {
+					return nil, err
+				}
+			}
+			dbFilters = append(dbFilters, db.OrderFilter{
+				Kind:   kind,
+				Groups: dbGroups,
+			})
+			continue
+		}
+		if len(filter.Groups) != 0 {
+			return nil, fmt.Errorf("only OR filters can have groups (got %s)", filter.Kind)
+		}
+		filterValue, err := FilterValueFromJSON(*filter)
+		if err != nil {
+			return nil, err
+		}
+		dbFilters = append(dbFilters, db.OrderFilter{
+			Field: OrderFieldToDBType(filter.Field),
+			Kind:  kind,
+			Value: filterValue,
+		})
+	}
+	return dbFilters, nil
+}
+
+// OrderFiltersV4ToDBType converts the given filters to the corresponding
+// db.OrderFilterV4s.
+func OrderFiltersV4ToDBType(filters []*OrderFilterV4) ([]db.OrderFilterV4, error) {
+	dbFilters := make([]db.OrderFilterV4, 0, len(filters))
+	for _, filter := range filters {
+		kind, err := FilterKindToDBType(filter.Kind)
+		if err != nil {
+			return nil, err
+		}
+		if kind == db.Or {
+			if len(filter.Groups) == 0 {
+				return nil, errors.New("OR filters must have at least one group")
+			}
+			dbGroups := make([][]db.OrderFilterV4, len(filter.Groups))
+			for i, group := range filter.Groups {
+				if len(group) == 0 {
+					return nil, errors.New("OR filter groups must not be empty")
+				}
+				dbGroups[i], err = OrderFiltersV4ToDBType(group)
+				if err != nil {
+					return nil, err
+				}
+			}
+			dbFilters = append(dbFilters, db.OrderFilterV4{
+				Kind:   kind,
+				Groups: dbGroups,
+			})
+			continue
+		}
+		if len(filter.Groups) != 0 {
+			return nil, fmt.Errorf("only OR filters can have groups (got %s)", filter.Kind)
+		}
+		filterValue, err := FilterValueFromJSONV4(*filter)
+		if err != nil {
+			return nil, err
+		}
+		dbFilters = append(dbFilters, db.OrderFilterV4{
+			Field: OrderFieldV4ToDBType(filter.Field),
+			Kind:  kind,
+			Value: filterValue,
+		})
+	}
+	return dbFilters, nil
+}
+
 // FilterValueFromJSON converts the filter value from the JSON type to the
 // corresponding Go type. It returns an error if the JSON type does not match
-// what was expected based on the filter field.
+// what was expected based on the filter field. The values of IN filters are
+// converted to a []interface{}.
 func FilterValueFromJSON(f OrderFilter) (interface{}, error) {
+	if f.Kind == FilterKindIn {
+		return filterValuesFromJSON(f.Value, func(value interface{}) (interface{}, error) {
+			return FilterValueFromJSON(OrderFilter{Field: f.Field, Kind: FilterKindEqual, Value: value})
+		})
+	}
 	switch f.Field {
 	case OrderFieldChainID, OrderFieldMakerAssetAmount, OrderFieldMakerFee, OrderFieldTakerAssetAmount, OrderFieldTakerFee, OrderFieldExpirationTimeSeconds, OrderFieldSalt, OrderFieldFillableTakerAssetAmount:
 		return stringToBigInt(f.Value)
 	case OrderFieldHash:
 		return stringToHash(f.Value)
-	case OrderFieldExchangeAddress, OrderFieldMakerAddress, OrderFieldTakerAddress, OrderFieldSenderAddress, OrderFieldFeeRecipientAddress:
+	case OrderFieldExchangeAddress, OrderFieldMakerAddress, OrderFieldTakerAddress, OrderFieldSenderAddress, OrderFieldFeeRecipientAddress, OrderFieldMakerToken, OrderFieldTakerToken, OrderFieldMakerFeeToken, OrderFieldTakerFeeToken:
 		return stringToAddress(f.Value)
+	case OrderFieldMakerTokenID, OrderFieldTakerTokenID:
+		return stringToBigInt(f.Value)
 	case OrderFieldMakerAssetData, OrderFieldMakerFeeAssetData, OrderFieldTakerAssetData, OrderFieldTakerFeeAssetData:
 		return stringToBytes(f.Value)
 	default:
@@ -594,32 +1026,72 @@ func FilterValueFromJSON(f OrderFilter) (interface{}, error) {
 
 // FilterValueFromJSONV4 converts the filter value from the JSON type to the
 // corresponding Go type. It returns an error if the JSON type does not match
-// what was expected based on the filter field.
+// what was expected based on the filter field. The values of IN filters are
+// converted to a []interface{}.
 func FilterValueFromJSONV4(f OrderFilterV4) (interface{}, error) {
+	if f.Kind == FilterKindIn {
+		return filterValuesFromJSON(f.Value, func(value interface{}) (interface{}, error) {
+			return FilterValueFromJSONV4(OrderFilterV4{Field: f.Field, Kind: FilterKindEqual, Value: value})
+		})
+	}
 	// TODO(oskar) add byte32 conversions here
 	switch f.Field {
 	case OrderFieldV4ChainID, OrderFieldV4MakerAmount, OrderFieldV4TakerAmount, OrderFieldV4TakerTokenFeeAmount, OrderFieldV4Expiry, OrderFieldV4Salt, OrderFieldV4FillableTakerAssetAmount:
 		return stringToBigInt(f.Value)
 	case OrderFieldV4Hash:
 		return stringToHash(f.Value)
-	case OrderFieldV4VerifyingContract, OrderFieldV4Maker, OrderFieldV4Taker, OrderFieldV4Sender, OrderFieldV4FeeRecipient:
+	case OrderFieldV4VerifyingContract, OrderFieldV4MakerToken, OrderFieldV4TakerToken, OrderFieldV4Maker, OrderFieldV4Taker, OrderFieldV4Sender, OrderFieldV4FeeRecipient:
 		return stringToAddress(f.Value)
 	default:
 		return "", fmt.Errorf("invalid filter field: %q", f.Field)
 	}
 }
 
+func filterValuesFromJSON(value interface{}, convert func(value interface{}) (interface{}, error)) (interface{}, error) {
+	jsonValues, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid type for IN filter value (expected a list but got %T)", value)
+	}
+	values := make([]interface{}, len(jsonValues))
+	for i, jsonValue := range jsonValues {
+		converted, err := convert(jsonValue)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = converted
+	}
+	return values, nil
+}
+
 // FilterValueToJSON converts the filter value from a native Go type to the
 // corresponding JSON value. It returns an error if the Go type does not match
-// what was expected based on the filter field.
-func FilterValueToJSON(f OrderFilter) (string, error) {
+// what was expected based on the filter field. The value of an IN filter is
+// converted to a []string.
+func FilterValueToJSON(f OrderFilter) (interface{}, error) {
+	if f.Kind == FilterKindIn {
+		values, ok := f.Value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid type for IN filter value (expected []interface{} but got %T)", f.Value)
+		}
+		jsonValues := make([]string, len(values))
+		for i, value := range values {
+			jsonValue, err := FilterValueToJSON(OrderFilter{Field: f.Field, Kind: FilterKindEqual, Value: value})
+			if err != nil {
+				return nil, err
+			}
+			jsonValues[i] = jsonValue.(string)
+		}
+		return jsonValues, nil
+	}
 	switch f.Field {
 	case OrderFieldChainID, OrderFieldMakerAssetAmount, OrderFieldMakerFee, OrderFieldTakerAssetAmount, OrderFieldTakerFee, OrderFieldExpirationTimeSeconds, OrderFieldSalt, OrderFieldFillableTakerAssetAmount:
 		return bigIntToString(f.Value)
 	case OrderFieldHash:
 		return hashToString(f.Value)
-	case OrderFieldExchangeAddress, OrderFieldMakerAddress, OrderFieldTakerAddress, OrderFieldSenderAddress, OrderFieldFeeRecipientAddress:
+	case OrderFieldExchangeAddress, OrderFieldMakerAddress, OrderFieldTakerAddress, OrderFieldSenderAddress, OrderFieldFeeRecipientAddress, OrderFieldMakerToken, OrderFieldTakerToken, OrderFieldMakerFeeToken, OrderFieldTakerFeeToken:
 		return addressToString(f.Value)
+	case OrderFieldMakerTokenID, OrderFieldTakerTokenID:
+		return bigIntToString(f.Value)
 	case OrderFieldMakerAssetData, OrderFieldMakerFeeAssetData, OrderFieldTakerAssetData, OrderFieldTakerFeeAssetData:
 		return bytesToString(f.Value)
 	default:
@@ -627,6 +1099,178 @@ func FilterValueToJSON(f OrderFilter) (string, error) {
 	}
 }
 
+// OrderFiltersToJSON returns a copy of the given filters, including any OR
+// filter groups, with each value converted by FilterValueToJSON.
+func OrderFiltersToJSON(filters []OrderFilter) ([]OrderFilter, error) {
+	jsonFilters := make([]OrderFilter, len(filters))
+	for i, filter := range filters {
+		jsonFilters[i] = OrderFilter{
+			Field: filter.Field,
+			Kind:  filter.Kind,
+		}
+		if filter.Kind == FilterKindOr {
+			jsonFilters[i].Groups = make([][]*OrderFilter, len(filter.Groups))
+			for j, group := range filter.Groups {
+				groupFilters := make([]OrderFilter, len(group))
+				for k, groupFilter := range group {
+					groupFilters[k] = *groupFilter
+				}
+				jsonGroupFilters, err := OrderFiltersToJSON(groupFilters)
+				if err != nil {
+					return nil, err
+				}
+				jsonFilters[i].Groups[j] = make([]*OrderFilter, len(jsonGroupFilters))
+				for k := range jsonGroupFilters {
+					jsonFilters[i].Groups[j][k] = &jsonGroupFilters[k]
+				}
+			}
+			continue
+		}
+		jsonValue, err := FilterValueToJSON(filter)
+		if err != nil {
+			return nil, err
+		}
+		jsonFilters[i].Value = jsonValue
+	}
+	return jsonFilters, nil
+}
+
+// FilterValueToJSONV4 is the v4 equivalent of FilterValueToJSON.
+func FilterValueToJSONV4(f OrderFilterV4) (interface{}, error) {
+	if f.Kind == FilterKindIn {
+		values, ok := f.Value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid type for IN filter value (expected []interface{} but got %T)", f.Value)
+		}
+		jsonValues := make([]string, len(values))
+		for i, value := range values {
+			jsonValue, err := FilterValueToJSONV4(OrderFilterV4{Field: f.Field, Kind: FilterKindEqual, Value: value})
+			if err != nil {
+				return nil, err
+			}
+			jsonValues[i] = jsonValue.(string)
+		}
+		return jsonValues, nil
+	}
+	switch f.Field {
+	case OrderFieldV4ChainID, OrderFieldV4MakerAmount, OrderFieldV4TakerAmount, OrderFieldV4TakerTokenFeeAmount, OrderFieldV4Expiry, OrderFieldV4Salt, OrderFieldV4FillableTakerAssetAmount:
+		return bigIntToString(f.Value)
+	case OrderFieldV4Hash:
+		return hashToString(f.Value)
+	case OrderFieldV4VerifyingContract, OrderFieldV4MakerToken, OrderFieldV4TakerToken, OrderFieldV4Maker, OrderFieldV4Taker, OrderFieldV4Sender, OrderFieldV4FeeRecipient:
+		return addressToString(f.Value)
+	default:
+		return "", fmt.Errorf("invalid filter field: %q", f.Field)
+	}
+}
+
+// OrderFiltersV4ToJSON is the v4 equivalent of OrderFiltersToJSON.
+func OrderFiltersV4ToJSON(filters []OrderFilterV4) ([]OrderFilterV4, error) {
+	jsonFilters := make([]OrderFilterV4, len(filters))
+	for i, filter := range filters {
+		jsonFilters[i] = OrderFilterV4{
+			Field: filter.Field,
+			Kind:  filter.Kind,
+		}
+		if filter.Kind == FilterKindOr {
+			jsonFilters[i].Groups = make([][]*OrderFilterV4, len(filter.Groups))
+			for j, group := range filter.Groups {
+				groupFilters := make([]OrderFilterV4, len(group))
+				for k, groupFilter := range group {
+					groupFilters[k] = *groupFilter
+				}
+				jsonGroupFilters, err := OrderFiltersV4ToJSON(groupFilters)
+				if err != nil {
+					return nil, err
+				}
+				jsonFilters[i].Groups[j] = make([]*OrderFilterV4, len(jsonGroupFilters))
+				for k := range jsonGroupFilters {
+					jsonFilters[i].Groups[j][k] = &jsonGroupFilters[k]
+				}
+			}
+			continue
+		}
+		jsonValue, err := FilterValueToJSONV4(filter)
+		if err != nil {
+			return nil, err
+		}
+		jsonFilters[i].Value = jsonValue
+	}
+	return jsonFilters, nil
+}
+
+// OrderFiltersFromJSON is the inverse of OrderFiltersToJSON. It can be
+// used to parse filters which were encoded as JSON (e.g. in a config file).
+func OrderFiltersFromJSON(filters []OrderFilter) ([]OrderFilter, error) {
+	goFilters := make([]OrderFilter, len(filters))
+	for i, filter := range filters {
+		goFilters[i] = OrderFilter{
+			Field: filter.Field,
+			Kind:  filter.Kind,
+		}
+		if filter.Kind == FilterKindOr {
+			goFilters[i].Groups = make([][]*OrderFilter, len(filter.Groups))
+			for j, group := range filter.Groups {
+				groupFilters := make([]OrderFilter, len(group))
+				for k, groupFilter := range group {
+					groupFilters[k] = *groupFilter
+				}
+				goGroupFilters, err := OrderFiltersFromJSON(groupFilters)
+				if err != nil {
+					return nil, err
+				}
+				goFilters[i].Groups[j] = make([]*OrderFilter, len(goGroupFilters))
+				for k := range goGroupFilters {
+					goFilters[i].Groups[j][k] = &goGroupFilters[k]
+				}
+			}
+			continue
+		}
+		value, err := FilterValueFromJSON(filter)
+		if err != nil {
+			return nil, err
+		}
+		goFilters[i].Value = value
+	}
+	return goFilters, nil
+}
+
+// OrderFiltersV4FromJSON is the inverse of OrderFiltersV4ToJSON. It can be
+// used to parse filters which were encoded as JSON (e.g. in a config file).
+func OrderFiltersV4FromJSON(filters []OrderFilterV4) ([]OrderFilterV4, error) {
+	goFilters := make([]OrderFilterV4, len(filters))
+	for i, filter := range filters {
+		goFilters[i] = OrderFilterV4{
+			Field: filter.Field,
+			Kind:  filter.Kind,
+		}
+		if filter.Kind == FilterKindOr {
+			goFilters[i].Groups = make([][]*OrderFilterV4, len(filter.Groups))
+			for j, group := range filter.Groups {
+				groupFilters := make([]OrderFilterV4, len(group))
+				for k, groupFilter := range group {
+					groupFilters[k] = *groupFilter
+				}
+				goGroupFilters, err := OrderFiltersV4FromJSON(groupFilters)
+				if err != nil {
+					return nil, err
+				}
+				goFilters[i].Groups[j] = make([]*OrderFilterV4, len(goGroupFilters))
+				for k := range goGroupFilters {
+					goFilters[i].Groups[j][k] = &goGroupFilters[k]
+				}
+			}
+			continue
+		}
+		value, err := FilterValueFromJSONV4(filter)
+		if err != nil {
+			return nil, err
+		}
+		goFilters[i].Value = value
+	}
+	return goFilters, nil
+}
+
 func bigIntToString(value interface{}) (string, error) {
 	bigInt, ok := value.(*big.Int)
 	if !ok {
@@ -679,6 +1323,29 @@ func stringToBigInt(value interface{}) (*big.Int, error) {
 	return result, nil
 }
 
+// HashesFromStrings converts the given hex encoded hashes to common.Hashes. It
+// returns an error if any of them is not a valid 32 byte hash.
+func HashesFromStrings(hashes []string) ([]common.Hash, error) {
+	result := make([]common.Hash, len(hashes))
+	for i, hash := range hashes {
+		hashBytes, err := hexutil.Decode(hash)
+		if err != nil || len(hashBytes) != common.HashLength {
+			return nil, fmt.Errorf("invalid hash: %q", hash)
+		}
+		result[i] = common.BytesToHash(hashBytes)
+	}
+	return result, nil
+}
+
+// HashesToStrings converts the given hashes to hex encoded strings.
+func HashesToStrings(hashes []common.Hash) []string {
+	result := make([]string, len(hashes))
+	for i, hash := range hashes {
+		result[i] = hash.Hex()
+	}
+	return result
+}
+
 func stringToHash(value interface{}) (common.Hash, error) {
 	valueString, err := filterValueAsString(value)
 	if err != nil {
@@ -0,0 +1,30 @@
+package gqltypes
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterValueFromJSONV4TokenFields(t *testing.T) {
+	token := common.HexToAddress("0x0b1ba0af832d7c05fd64161e0db78e85978e8082")
+	for _, field := range []OrderFieldV4{OrderFieldV4MakerToken, OrderFieldV4TakerToken} {
+		value, err := FilterValueFromJSONV4(OrderFilterV4{
+			Field: field,
+			Kind:  FilterKindEqual,
+			Value: token.Hex(),
+		})
+		require.NoError(t, err, field)
+		assert.Equal(t, token, value, field)
+
+		values, err := FilterValueFromJSONV4(OrderFilterV4{
+			Field: field,
+			Kind:  FilterKindIn,
+			Value: []interface{}{token.Hex()},
+		})
+		require.NoError(t, err, field)
+		assert.Equal(t, []interface{}{token}, values, field)
+	}
+}
@@ -0,0 +1,153 @@
+package gqltypes
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/db"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+)
+
+// ErrInvalidCursor is returned when a cursor could not be decoded or does not
+// match the sort options of the query it was used with.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+const (
+	cursorValueTypeBigInt  = "bigint"
+	cursorValueTypeHash    = "hash"
+	cursorValueTypeAddress = "address"
+	cursorValueTypeBytes   = "bytes"
+)
+
+// orderCursor is the JSON representation of a cursor before it is encoded as
+// an opaque string. A cursor contains the sort options of the query that
+// produced it, so that it can't be used with a different query by mistake, and
+// the values of the last order in the page for each of these sort options.
+type orderCursor struct {
+	Sort   []string      `json:"s"`
+	Values []cursorValue `json:"v"`
+}
+
+type cursorValue struct {
+	Type  string `json:"t"`
+	Value string `json:"v"`
+}
+
+// EncodeOrderCursor returns an opaque cursor which points to the given v3
+// order in the results of a query with the given sort options.
+func EncodeOrderCursor(order *types.OrderWithMetadata, sortOpts []db.OrderSort) (string, error) {
+	values, err := db.OrderSortValues(order, sortOpts)
+	if err != nil {
+		return "", err
+	}
+	return encodeCursor(orderSortKeys(sortOpts), values)
+}
+
+// DecodeOrderCursor decodes a cursor created by EncodeOrderCursor. It returns
+// the sort values which can be passed to db.FindOrdersAfter.
+func DecodeOrderCursor(cursor string, sortOpts []db.OrderSort) ([]interface{}, error) {
+	return decodeCursor(cursor, orderSortKeys(sortOpts))
+}
+
+// EncodeOrderCursorV4 returns an opaque cursor which points to the given v4
+// order in the results of a query with the given sort options.
+func EncodeOrderCursorV4(order *types.OrderWithMetadata, sortOpts []db.OrderSortV4) (string, error) {
+	values, err := db.OrderSortValuesV4(order, sortOpts)
+	if err != nil {
+		return "", err
+	}
+	return encodeCursor(orderSortKeysV4(sortOpts), values)
+}
+
+// DecodeOrderCursorV4 decodes a cursor created by EncodeOrderCursorV4. It
+// returns the sort values which can be passed to db.FindOrdersAfterV4.
+func DecodeOrderCursorV4(cursor string, sortOpts []db.OrderSortV4) ([]interface{}, error) {
+	return decodeCursor(cursor, orderSortKeysV4(sortOpts))
+}
+
+func orderSortKeys(sortOpts []db.OrderSort) []string {
+	keys := make([]string, len(sortOpts))
+	for i, sortOpt := range sortOpts {
+		keys[i] = fmt.Sprintf("%s:%s", sortOpt.Field, sortOpt.Direction)
+	}
+	return keys
+}
+
+func orderSortKeysV4(sortOpts []db.OrderSortV4) []string {
+	keys := make([]string, len(sortOpts))
+	for i, sortOpt := range sortOpts {
+		keys[i] = fmt.Sprintf("%s:%s", sortOpt.Field, sortOpt.Direction)
+	}
+	return keys
+}
+
+func encodeCursor(sortKeys []string, values []interface{}) (string, error) {
+	cursor := orderCursor{
+		Sort:   sortKeys,
+		Values: make([]cursorValue, len(values)),
+	}
+	for i, value := range values {
+		switch v := value.(type) {
+		case *big.Int:
+			cursor.Values[i] = cursorValue{Type: cursorValueTypeBigInt, Value: v.String()}
+		case common.Hash:
+			cursor.Values[i] = cursorValue{Type: cursorValueTypeHash, Value: v.Hex()}
+		case common.Address:
+			cursor.Values[i] = cursorValue{Type: cursorValueTypeAddress, Value: strings.ToLower(v.Hex())}
+		case []byte:
+			cursor.Values[i] = cursorValue{Type: cursorValueTypeBytes, Value: types.BytesToHex(v)}
+		default:
+			return "", fmt.Errorf("unsupported type for cursor value: %T", value)
+		}
+	}
+	cursorJSON, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(cursorJSON), nil
+}
+
+func decodeCursor(encoded string, sortKeys []string) ([]interface{}, error) {
+	cursorJSON, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var cursor orderCursor
+	if err := json.Unmarshal(cursorJSON, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if len(cursor.Sort) != len(sortKeys) || len(cursor.Values) != len(sortKeys) {
+		return nil, ErrInvalidCursor
+	}
+	for i, sortKey := range sortKeys {
+		if cursor.Sort[i] != sortKey {
+			return nil, ErrInvalidCursor
+		}
+	}
+	values := make([]interface{}, len(cursor.Values))
+	for i, value := range cursor.Values {
+		switch value.Type {
+		case cursorValueTypeBigInt:
+			bigInt, ok := math.ParseBig256(value.Value)
+			if !ok {
+				return nil, ErrInvalidCursor
+			}
+			values[i] = bigInt
+		case cursorValueTypeHash:
+			values[i] = common.HexToHash(value.Value)
+		case cursorValueTypeAddress:
+			values[i] = common.HexToAddress(value.Value)
+		case cursorValueTypeBytes:
+			values[i] = types.HexToBytes(value.Value)
+		default:
+			return nil, ErrInvalidCursor
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,29 @@
+package gqltypes
+
+// OrderFilter is a filter on v3 orders. Unlike most types in this package, it
+// is not generated by gqlgen so that Field stays a value rather than a pointer
+// even though it can be omitted for OR filters.
+type OrderFilter struct {
+	// field is required unless kind is OR.
+	Field OrderField `json:"field,omitempty"`
+	Kind  FilterKind `json:"kind"`
+	// value must match the type of the filter field. For IN filters, it must be
+	// a list of such values. It is required unless kind is OR.
+	Value interface{} `json:"value,omitempty"`
+	// groups is only used by OR filters. Filters within a group are ANDed
+	// together and groups can be nested.
+	Groups [][]*OrderFilter `json:"groups,omitempty"`
+}
+
+// OrderFilterV4 is the v4 equivalent of OrderFilter.
+type OrderFilterV4 struct {
+	// field is required unless kind is OR.
+	Field OrderFieldV4 `json:"field,omitempty"`
+	Kind  FilterKind   `json:"kind"`
+	// value must match the type of the filter field. For IN filters, it must be
+	// a list of such values. It is required unless kind is OR.
+	Value interface{} `json:"value,omitempty"`
+	// groups is only used by OR filters. Filters within a group are ANDed
+	// together and groups can be nested.
+	Groups [][]*OrderFilterV4 `json:"groups,omitempty"`
+}
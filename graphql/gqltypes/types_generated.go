@@ -59,6 +59,29 @@ type AddOrdersResultsV4 struct {
 	Rejected []*RejectedOrderResultV4 `json:"rejected"`
 }
 
+// An order which has been permanently deleted, along with the final state it was in when Mesh stopped watching it.
+type ArchivedOrder struct {
+	// The hash of the order. Encoded as a hexadecimal string.
+	Hash string `json:"hash"`
+	// The order, if it is a v3 order.
+	Order *OrderWithMetadata `json:"order"`
+	// The order, if it is a v4 order.
+	Orderv4 *OrderV4WithMetadata `json:"orderv4"`
+	// The final state of the order. This is the end state of the last order event that was emitted for the order, or
+	// STOPPED_WATCHING if the order was deleted while it was still being watched.
+	EndState OrderEndState `json:"endState"`
+	// The time at which the order reached its final state. Encoded as an RFC 3339 string.
+	Timestamp string `json:"timestamp"`
+	// The number of the block at which the order was last validated. Encoded as a numerical string.
+	BlockNumber string `json:"blockNumber"`
+	// The hash of the block at which the order was last validated. Encoded as a hexadecimal string.
+	BlockHash string `json:"blockHash"`
+	// Whether or not the order was pinned.
+	IsPinned bool `json:"isPinned"`
+	// The time at which the order was added to the archive. Encoded as an RFC 3339 string.
+	ArchivedAt string `json:"archivedAt"`
+}
+
 // An on-chain contract event.
 type ContractEvent struct {
 	// The hash of the block where the event was generated.
@@ -152,6 +175,14 @@ type Order struct {
 	Signature             string `json:"signature"`
 }
 
+// A single page of orders returned by the orders query.
+type OrderConnection struct {
+	// The orders in this page.
+	Orders []*OrderWithMetadata `json:"orders"`
+	// Information about this page, which can be used to get the next page.
+	PageInfo *PageInfo `json:"pageInfo"`
+}
+
 type OrderEvent struct {
 	// The order that was affected.
 	Order *OrderWithMetadata `json:"order"`
@@ -171,22 +202,20 @@ type OrderEvent struct {
 	// It is guaranteed that at least one of the events included here will have affected
 	// the order's state, but there may also be some false positives.
 	ContractEvents []*ContractEvent `json:"contractEvents"`
+	// The position of the event in the order event log. Sequence numbers are strictly increasing and can be used to
+	// resume a subscription without missing events. Null if the event could not be added to the log.
+	SequenceNumber *string `json:"sequenceNumber"`
 }
 
-// A filter on orders. Can be used in queries to only return orders that meet certain criteria.
-type OrderFilter struct {
-	Field OrderField `json:"field"`
-	Kind  FilterKind `json:"kind"`
-	// value must match the type of the filter field.
-	Value interface{} `json:"value"`
-}
-
-// A filter on v4 orders. Can be used in queries to only return orders that meet certain criteria.
-type OrderFilterV4 struct {
-	Field OrderFieldV4 `json:"field"`
-	Kind  FilterKind   `json:"kind"`
-	// value must match the type of the filter field.
-	Value interface{} `json:"value"`
+// A single page of order events returned by the orderEventsSince query.
+type OrderEventPage struct {
+	// The order events in this page, sorted by sequence number in ascending order.
+	Events []*OrderEvent `json:"events"`
+	// The sequence number of the last event in this page, or the given sequence number if the page is empty. Can be
+	// used as the sequenceNumber argument to get the next page.
+	LastSequenceNumber string `json:"lastSequenceNumber"`
+	// Whether there are more events after this page.
+	HasMore bool `json:"hasMore"`
 }
 
 // A sort ordering for orders. Can be used in queries to control the order in which results are returned.
@@ -201,6 +230,22 @@ type OrderSortV4 struct {
 	Direction SortDirection `json:"direction"`
 }
 
+// Aggregate values computed over all orders that match a set of filters.
+type OrderStats struct {
+	// The number of matching orders.
+	OrderCount int `json:"orderCount"`
+	// The sum of the remaining fillable taker asset amounts of all matching orders, encoded as a numerical string.
+	TotalFillableTakerAssetAmount string `json:"totalFillableTakerAssetAmount"`
+	// The number of distinct makers of the matching orders.
+	MakerCount int `json:"makerCount"`
+	// The earliest expiration time (expiry for v4 orders) of the matching orders, encoded as a numerical string. Null if
+	// no orders match.
+	MinExpirationTimeSeconds *string `json:"minExpirationTimeSeconds"`
+	// The latest expiration time (expiry for v4 orders) of the matching orders, encoded as a numerical string. Null if no
+	// orders match.
+	MaxExpirationTimeSeconds *string `json:"maxExpirationTimeSeconds"`
+}
+
 // A signed 0x v4 order according to the [protocol specification](https://0xprotocol.readthedocs.io/en/latest/basics/orders.html)
 type OrderV4 struct {
 	ChainID             string `json:"chainId"`
@@ -223,6 +268,14 @@ type OrderV4 struct {
 	SignatureS          string `json:"signatureS"`
 }
 
+// A single page of v4 orders returned by the ordersv4 query.
+type OrderV4Connection struct {
+	// The v4 orders in this page.
+	Orders []*OrderV4WithMetadata `json:"orders"`
+	// Information about this page, which can be used to get the next page.
+	PageInfo *PageInfo `json:"pageInfo"`
+}
+
 // A signed 0x v4 order along with some additional metadata about the order which is not part of the 0x protocol specification.
 type OrderV4WithMetadata struct {
 	ChainID             string `json:"chainId"`
@@ -274,6 +327,86 @@ type OrderWithMetadata struct {
 	FillableTakerAssetAmount string `json:"fillableTakerAssetAmount"`
 }
 
+// The aggregated bids and asks for a base/quote token pair. Includes both v3 and v4 orders.
+type Orderbook struct {
+	// The address of the base token.
+	BaseToken string `json:"baseToken"`
+	// The address of the quote token.
+	QuoteToken string `json:"quoteToken"`
+	// Orders which buy the base token in exchange for the quote token, sorted by price in descending order.
+	Bids []*OrderbookLevel `json:"bids"`
+	// Orders which sell the base token in exchange for the quote token, sorted by price in ascending order.
+	Asks []*OrderbookLevel `json:"asks"`
+}
+
+// A single price level in an orderbook. Contains the aggregated remaining fillable amounts of all orders with the same
+// price.
+type OrderbookLevel struct {
+	// The amount of quote token per unit of base token, expressed in base units of each token (i.e. not adjusted for
+	// token decimals) and encoded as a decimal string.
+	Price string `json:"price"`
+	// The total remaining fillable amount of the base token at this price level, encoded as a numerical string.
+	BaseAmount string `json:"baseAmount"`
+	// The total remaining fillable amount of the quote token at this price level, encoded as a numerical string.
+	QuoteAmount string `json:"quoteAmount"`
+	// The number of orders at this price level.
+	OrderCount int `json:"orderCount"`
+}
+
+// Information about a single page of results returned by a paginated query.
+type PageInfo struct {
+	// An opaque cursor which points to the last item in the page. It can be passed as the `after` argument of the same
+	// query in order to get the next page. Null if the page is empty.
+	EndCursor *string `json:"endCursor"`
+	// Whether or not there are more results after this page.
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+// A peer that Mesh is currently connected to.
+type Peer struct {
+	// The peer ID of the peer.
+	ID string `json:"id"`
+	// One entry for each open connection to the peer.
+	Connections []*PeerConnection `json:"connections"`
+	// The scores assigned to the peer, keyed by tag. Peers with a low total score are the first to be disconnected when
+	// Mesh has too many connections.
+	Tags []*PeerTag `json:"tags"`
+	// The total score of the peer (i.e. the sum of the values of all tags).
+	Score int `json:"score"`
+	// The bandwidth used by the peer across all protocols.
+	Bandwidth *PeerBandwidth `json:"bandwidth"`
+}
+
+// Bandwidth used by a peer. Totals are expressed in bytes and encoded as numerical strings. Rates are expressed in bytes
+// per second.
+type PeerBandwidth struct {
+	TotalIn  string  `json:"totalIn"`
+	TotalOut string  `json:"totalOut"`
+	RateIn   float64 `json:"rateIn"`
+	RateOut  float64 `json:"rateOut"`
+}
+
+// A single connection to a peer.
+type PeerConnection struct {
+	// The multiaddress of the remote end of the connection. It can be passed to banPeer in order to ban the IP address
+	// of the peer.
+	Multiaddr string                  `json:"multiaddr"`
+	Direction PeerConnectionDirection `json:"direction"`
+}
+
+// A score assigned to a peer. Tag is a unique identifier for the score.
+type PeerTag struct {
+	Tag   string `json:"tag"`
+	Value int    `json:"value"`
+}
+
+// The number of orders that count towards the storage quota of a single maker or peer.
+type QuotaUsage struct {
+	// The address of the maker or the ID of the peer.
+	ID        string `json:"id"`
+	NumOrders int    `json:"numOrders"`
+}
+
 type RejectedOrderResult struct {
 	// The hash of the order. May be null if the hash could not be computed.
 	Hash *string `json:"hash"`
@@ -322,6 +455,18 @@ type Stats struct {
 	// The max expiration time expressed as seconds since the Unix Epoch and encoded as a numerical string.
 	// Any order with an expiration time greater than this maximum will be rejected by Mesh.
 	MaxExpirationTime string `json:"maxExpirationTime"`
+	// The maximum number of orders that are stored for a single maker. Pinned orders don't count towards
+	// this quota. A value of 0 means that there is no quota.
+	MaxOrdersPerMaker int `json:"maxOrdersPerMaker"`
+	// The maximum number of orders that are stored from a single peer. Pinned orders don't count towards
+	// this quota. A value of 0 means that there is no quota.
+	MaxOrdersPerPeer int `json:"maxOrdersPerPeer"`
+	// The makers with the most orders that count towards their storage quota, sorted by the number of orders in
+	// descending order.
+	MakerQuotaUsage []*QuotaUsage `json:"makerQuotaUsage"`
+	// The peers with the most orders that count towards their storage quota, sorted by the number of orders in
+	// descending order.
+	PeerQuotaUsage []*QuotaUsage `json:"peerQuotaUsage"`
 }
 
 // The kind of comparison to be used in a filter.
@@ -334,6 +479,10 @@ const (
 	FilterKindGreaterOrEqual FilterKind = "GREATER_OR_EQUAL"
 	FilterKindLess           FilterKind = "LESS"
 	FilterKindLessOrEqual    FilterKind = "LESS_OR_EQUAL"
+	// Matches if the field is equal to any of the values in the filter value, which must be a list.
+	FilterKindIn FilterKind = "IN"
+	// Matches if all of the filters in at least one of the filter groups match. The field and value of the filter are ignored.
+	FilterKindOr FilterKind = "OR"
 )
 
 var AllFilterKind = []FilterKind{
@@ -343,11 +492,13 @@ var AllFilterKind = []FilterKind{
 	FilterKindGreaterOrEqual,
 	FilterKindLess,
 	FilterKindLessOrEqual,
+	FilterKindIn,
+	FilterKindOr,
 }
 
 func (e FilterKind) IsValid() bool {
 	switch e {
-	case FilterKindEqual, FilterKindNotEqual, FilterKindGreater, FilterKindGreaterOrEqual, FilterKindLess, FilterKindLessOrEqual:
+	case FilterKindEqual, FilterKindNotEqual, FilterKindGreater, FilterKindGreaterOrEqual, FilterKindLess, FilterKindLessOrEqual, FilterKindIn, FilterKindOr:
 		return true
 	}
 	return false
@@ -465,6 +616,18 @@ const (
 	OrderFieldExpirationTimeSeconds    OrderField = "expirationTimeSeconds"
 	OrderFieldSalt                     OrderField = "salt"
 	OrderFieldFillableTakerAssetAmount OrderField = "fillableTakerAssetAmount"
+	// The address of any token in makerAssetData, including the components of MultiAsset and ERC1155 asset data. Can only be used in EQUAL and IN filters, not for sorting.
+	OrderFieldMakerToken OrderField = "makerToken"
+	// The ID of any ERC721 or ERC1155 token in makerAssetData. If used together with a makerToken filter in the same list of filters, both must match the same component of makerAssetData. Can only be used in EQUAL and IN filters, not for sorting.
+	OrderFieldMakerTokenID OrderField = "makerTokenId"
+	// Same as makerToken but for takerAssetData.
+	OrderFieldTakerToken OrderField = "takerToken"
+	// Same as makerTokenId but for takerAssetData.
+	OrderFieldTakerTokenID OrderField = "takerTokenId"
+	// Same as makerToken but for makerFeeAssetData.
+	OrderFieldMakerFeeToken OrderField = "makerFeeToken"
+	// Same as makerToken but for takerFeeAssetData.
+	OrderFieldTakerFeeToken OrderField = "takerFeeToken"
 )
 
 var AllOrderField = []OrderField{
@@ -486,11 +649,17 @@ var AllOrderField = []OrderField{
 	OrderFieldExpirationTimeSeconds,
 	OrderFieldSalt,
 	OrderFieldFillableTakerAssetAmount,
+	OrderFieldMakerToken,
+	OrderFieldMakerTokenID,
+	OrderFieldTakerToken,
+	OrderFieldTakerTokenID,
+	OrderFieldMakerFeeToken,
+	OrderFieldTakerFeeToken,
 }
 
 func (e OrderField) IsValid() bool {
 	switch e {
-	case OrderFieldHash, OrderFieldChainID, OrderFieldExchangeAddress, OrderFieldMakerAddress, OrderFieldMakerAssetData, OrderFieldMakerAssetAmount, OrderFieldMakerFeeAssetData, OrderFieldMakerFee, OrderFieldTakerAddress, OrderFieldTakerAssetData, OrderFieldTakerAssetAmount, OrderFieldTakerFeeAssetData, OrderFieldTakerFee, OrderFieldSenderAddress, OrderFieldFeeRecipientAddress, OrderFieldExpirationTimeSeconds, OrderFieldSalt, OrderFieldFillableTakerAssetAmount:
+	case OrderFieldHash, OrderFieldChainID, OrderFieldExchangeAddress, OrderFieldMakerAddress, OrderFieldMakerAssetData, OrderFieldMakerAssetAmount, OrderFieldMakerFeeAssetData, OrderFieldMakerFee, OrderFieldTakerAddress, OrderFieldTakerAssetData, OrderFieldTakerAssetAmount, OrderFieldTakerFeeAssetData, OrderFieldTakerFee, OrderFieldSenderAddress, OrderFieldFeeRecipientAddress, OrderFieldExpirationTimeSeconds, OrderFieldSalt, OrderFieldFillableTakerAssetAmount, OrderFieldMakerToken, OrderFieldMakerTokenID, OrderFieldTakerToken, OrderFieldTakerTokenID, OrderFieldMakerFeeToken, OrderFieldTakerFeeToken:
 		return true
 	}
 	return false
@@ -589,6 +758,50 @@ func (e OrderFieldV4) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// Whether a connection was opened by the peer (INBOUND) or by Mesh (OUTBOUND).
+type PeerConnectionDirection string
+
+const (
+	PeerConnectionDirectionInbound  PeerConnectionDirection = "INBOUND"
+	PeerConnectionDirectionOutbound PeerConnectionDirection = "OUTBOUND"
+	PeerConnectionDirectionUnknown  PeerConnectionDirection = "UNKNOWN"
+)
+
+var AllPeerConnectionDirection = []PeerConnectionDirection{
+	PeerConnectionDirectionInbound,
+	PeerConnectionDirectionOutbound,
+	PeerConnectionDirectionUnknown,
+}
+
+func (e PeerConnectionDirection) IsValid() bool {
+	switch e {
+	case PeerConnectionDirectionInbound, PeerConnectionDirectionOutbound, PeerConnectionDirectionUnknown:
+		return true
+	}
+	return false
+}
+
+func (e PeerConnectionDirection) String() string {
+	return string(e)
+}
+
+func (e *PeerConnectionDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PeerConnectionDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PeerConnectionDirection", str)
+	}
+	return nil
+}
+
+func (e PeerConnectionDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // A set of all possible codes included in RejectedOrderResult.
 type RejectedOrderCode string
 
@@ -615,6 +828,8 @@ const (
 	RejectedOrderCodeDatabaseFullOfOrders             RejectedOrderCode = "DATABASE_FULL_OF_ORDERS"
 	RejectedOrderCodeTakerAddressNotAllowed           RejectedOrderCode = "TAKER_ADDRESS_NOT_ALLOWED"
 	RejectedOrderCodeOrderInvalidSchema               RejectedOrderCode = "ORDER_INVALID_SCHEMA"
+	RejectedOrderCodeMakerQuotaExceeded               RejectedOrderCode = "MAKER_QUOTA_EXCEEDED"
+	RejectedOrderCodePeerQuotaExceeded                RejectedOrderCode = "PEER_QUOTA_EXCEEDED"
 )
 
 var AllRejectedOrderCode = []RejectedOrderCode{
@@ -640,11 +855,13 @@ var AllRejectedOrderCode = []RejectedOrderCode{
 	RejectedOrderCodeDatabaseFullOfOrders,
 	RejectedOrderCodeTakerAddressNotAllowed,
 	RejectedOrderCodeOrderInvalidSchema,
+	RejectedOrderCodeMakerQuotaExceeded,
+	RejectedOrderCodePeerQuotaExceeded,
 }
 
 func (e RejectedOrderCode) IsValid() bool {
 	switch e {
-	case RejectedOrderCodeEthRPCRequestFailed, RejectedOrderCodeOrderHasInvalidMakerAssetAmount, RejectedOrderCodeOrderHasInvalidTakerAssetAmount, RejectedOrderCodeOrderExpired, RejectedOrderCodeOrderFullyFilled, RejectedOrderCodeOrderCancelled, RejectedOrderCodeOrderUnfunded, RejectedOrderCodeOrderHasInvalidMakerAssetData, RejectedOrderCodeOrderHasInvalidMakerFeeAssetData, RejectedOrderCodeOrderHasInvalidTakerAssetData, RejectedOrderCodeOrderHasInvalidTakerFeeAssetData, RejectedOrderCodeOrderHasInvalidSignature, RejectedOrderCodeOrderMaxExpirationExceeded, RejectedOrderCodeInternalError, RejectedOrderCodeMaxOrderSizeExceeded, RejectedOrderCodeOrderAlreadyStoredAndUnfillable, RejectedOrderCodeOrderForIncorrectChain, RejectedOrderCodeIncorrectExchangeAddress, RejectedOrderCodeSenderAddressNotAllowed, RejectedOrderCodeDatabaseFullOfOrders, RejectedOrderCodeTakerAddressNotAllowed, RejectedOrderCodeOrderInvalidSchema:
+	case RejectedOrderCodeEthRPCRequestFailed, RejectedOrderCodeOrderHasInvalidMakerAssetAmount, RejectedOrderCodeOrderHasInvalidTakerAssetAmount, RejectedOrderCodeOrderExpired, RejectedOrderCodeOrderFullyFilled, RejectedOrderCodeOrderCancelled, RejectedOrderCodeOrderUnfunded, RejectedOrderCodeOrderHasInvalidMakerAssetData, RejectedOrderCodeOrderHasInvalidMakerFeeAssetData, RejectedOrderCodeOrderHasInvalidTakerAssetData, RejectedOrderCodeOrderHasInvalidTakerFeeAssetData, RejectedOrderCodeOrderHasInvalidSignature, RejectedOrderCodeOrderMaxExpirationExceeded, RejectedOrderCodeInternalError, RejectedOrderCodeMaxOrderSizeExceeded, RejectedOrderCodeOrderAlreadyStoredAndUnfillable, RejectedOrderCodeOrderForIncorrectChain, RejectedOrderCodeIncorrectExchangeAddress, RejectedOrderCodeSenderAddressNotAllowed, RejectedOrderCodeDatabaseFullOfOrders, RejectedOrderCodeTakerAddressNotAllowed, RejectedOrderCodeOrderInvalidSchema, RejectedOrderCodeMakerQuotaExceeded, RejectedOrderCodePeerQuotaExceeded:
 		return true
 	}
 	return false
@@ -0,0 +1,18 @@
+package graphql
+
+import "fmt"
+
+const errCodeInvalidLimit = "INVALID_LIMIT"
+
+// parseLimit returns the value of the limit (or depth) argument with the given
+// name. If the argument was explicitly set to null, defaultLimit is returned
+// so that the query is never unbounded. Zero and negative values are rejected.
+func parseLimit(name string, limit *int, defaultLimit int) (int, error) {
+	if limit == nil {
+		return defaultLimit, nil
+	}
+	if *limit < 1 {
+		return 0, newError(errCodeInvalidLimit, fmt.Sprintf("%s must be greater than zero", name))
+	}
+	return *limit, nil
+}
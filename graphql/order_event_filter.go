@@ -0,0 +1,130 @@
+package graphql
+
+import (
+	"fmt"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/db"
+	"github.com/0xProject/0x-mesh/graphql/gqltypes"
+	"github.com/0xProject/0x-mesh/zeroex"
+	log "github.com/sirupsen/logrus"
+)
+
+// orderEventFilter determines which order events are sent to a subscriber of
+// the orderEvents subscription.
+type orderEventFilter struct {
+	filters   []db.OrderFilter
+	filtersV4 []db.OrderFilterV4
+	// endStates is the set of end states that should be included. If it is nil,
+	// events with any end state are included.
+	endStates map[zeroex.OrderEventEndState]struct{}
+	// parseAssetData is used to parse the asset data of v3 orders so that
+	// filters on tokens (e.g. makerToken) can be applied.
+	parseAssetData func(assetData []byte) ([]*types.SingleAssetData, error)
+}
+
+func newOrderEventFilter(filters []*gqltypes.OrderFilter, filtersV4 []*gqltypes.OrderFilterV4, endStates []gqltypes.OrderEndState, parseAssetData func(assetData []byte) ([]*types.SingleAssetData, error)) (*orderEventFilter, error) {
+	dbFilters, err := gqltypes.OrderFiltersToDBType(filters)
+	if err != nil {
+		return nil, err
+	}
+	dbFiltersV4, err := gqltypes.OrderFiltersV4ToDBType(filtersV4)
+	if err != nil {
+		return nil, err
+	}
+	// Filters are evaluated in memory for each event. Reject filters which
+	// can't be evaluated instead of silently dropping every event.
+	if err := db.CheckOrderFilters(dbFilters); err != nil {
+		return nil, fmt.Errorf("invalid filters: %s", err)
+	}
+	if err := db.CheckOrderFiltersV4(dbFiltersV4); err != nil {
+		return nil, fmt.Errorf("invalid filtersV4: %s", err)
+	}
+	eventFilter := &orderEventFilter{
+		filters:        dbFilters,
+		filtersV4:      dbFiltersV4,
+		parseAssetData: parseAssetData,
+	}
+	if endStates != nil {
+		eventFilter.endStates = map[zeroex.OrderEventEndState]struct{}{}
+		for _, endState := range endStates {
+			eventFilter.endStates[zeroex.OrderEventEndState(endState)] = struct{}{}
+		}
+	}
+	return eventFilter, nil
+}
+
+// filter returns the subset of the given order events that should be sent to
+// the subscriber.
+func (f *orderEventFilter) filter(orderEvents []*zeroex.OrderEvent) []*zeroex.OrderEvent {
+	if len(f.filters) == 0 && len(f.filtersV4) == 0 && f.endStates == nil {
+		return orderEvents
+	}
+	filtered := []*zeroex.OrderEvent{}
+	for _, orderEvent := range orderEvents {
+		if f.matches(orderEvent) {
+			filtered = append(filtered, orderEvent)
+		}
+	}
+	return filtered
+}
+
+func (f *orderEventFilter) matches(orderEvent *zeroex.OrderEvent) bool {
+	if f.endStates != nil {
+		if _, found := f.endStates[orderEvent.EndState]; !found {
+			return false
+		}
+	}
+	var (
+		matches bool
+		err     error
+	)
+	switch {
+	case orderEvent.SignedOrder != nil:
+		var order *types.OrderWithMetadata
+		order, err = f.orderWithParsedAssetData(orderEvent)
+		if err == nil {
+			matches, err = db.OrderMatchesFilters(order, f.filters)
+		}
+	case orderEvent.SignedOrderV4 != nil:
+		matches, err = db.OrderMatchesFiltersV4(&types.OrderWithMetadata{
+			Hash:                     orderEvent.OrderHash,
+			OrderV4:                  &orderEvent.SignedOrderV4.OrderV4,
+			SignatureV4:              orderEvent.SignedOrderV4.Signature,
+			FillableTakerAssetAmount: orderEvent.FillableTakerAssetAmount,
+		}, f.filtersV4)
+	default:
+		return false
+	}
+	if err != nil {
+		log.WithError(err).WithField("orderHash", orderEvent.OrderHash.Hex()).Warn("could not apply subscription filters to order event")
+		return false
+	}
+	return matches
+}
+
+func (f *orderEventFilter) orderWithParsedAssetData(orderEvent *zeroex.OrderEvent) (*types.OrderWithMetadata, error) {
+	order := &types.OrderWithMetadata{
+		Hash:                     orderEvent.OrderHash,
+		OrderV3:                  &orderEvent.SignedOrder.Order,
+		Signature:                orderEvent.SignedOrder.Signature,
+		FillableTakerAssetAmount: orderEvent.FillableTakerAssetAmount,
+	}
+	if len(f.filters) == 0 {
+		return order, nil
+	}
+	var err error
+	if order.ParsedMakerAssetData, err = f.parseAssetData(order.OrderV3.MakerAssetData); err != nil {
+		return nil, err
+	}
+	if order.ParsedMakerFeeAssetData, err = f.parseAssetData(order.OrderV3.MakerFeeAssetData); err != nil {
+		return nil, err
+	}
+	if order.ParsedTakerAssetData, err = f.parseAssetData(order.OrderV3.TakerAssetData); err != nil {
+		return nil, err
+	}
+	if order.ParsedTakerFeeAssetData, err = f.parseAssetData(order.OrderV3.TakerFeeAssetData); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
@@ -0,0 +1,84 @@
+// +build !js
+
+package graphql
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/db"
+	"github.com/0xProject/0x-mesh/zeroex"
+	"github.com/stretchr/testify/assert"
+)
+
+func noopParseAssetData(assetData []byte) ([]*types.SingleAssetData, error) {
+	return nil, nil
+}
+
+func TestOrderEventFilterSignature(t *testing.T) {
+	event := &zeroex.OrderEvent{
+		SignedOrder: &zeroex.SignedOrder{
+			Signature: []byte{1, 2, 3},
+		},
+	}
+	eventV4 := &zeroex.OrderEvent{
+		SignedOrderV4: &zeroex.SignedOrderV4{
+			Signature: zeroex.SignatureFieldV4{
+				SignatureType: zeroex.EIP712SignatureV4,
+				V:             27,
+				R:             zeroex.BigToBytes32(big.NewInt(1)),
+				S:             zeroex.BigToBytes32(big.NewInt(2)),
+			},
+		},
+	}
+	otherSignatureV4 := eventV4.SignedOrderV4.Signature
+	otherSignatureV4.V = 28
+
+	testCases := []struct {
+		name     string
+		filter   *orderEventFilter
+		event    *zeroex.OrderEvent
+		expected bool
+	}{
+		{
+			name: "v3 matching signature",
+			filter: &orderEventFilter{
+				filters:        []db.OrderFilter{{Field: db.OFSignature, Kind: db.Equal, Value: []byte{1, 2, 3}}},
+				parseAssetData: noopParseAssetData,
+			},
+			event:    event,
+			expected: true,
+		},
+		{
+			name: "v3 other signature",
+			filter: &orderEventFilter{
+				filters:        []db.OrderFilter{{Field: db.OFSignature, Kind: db.Equal, Value: []byte{4, 5, 6}}},
+				parseAssetData: noopParseAssetData,
+			},
+			event:    event,
+			expected: false,
+		},
+		{
+			name: "v4 matching signature",
+			filter: &orderEventFilter{
+				filtersV4: []db.OrderFilterV4{{Field: db.OV4FSignature, Kind: db.Equal, Value: eventV4.SignedOrderV4.Signature.Bytes()}},
+			},
+			event:    eventV4,
+			expected: true,
+		},
+		{
+			name: "v4 other signature",
+			filter: &orderEventFilter{
+				filtersV4: []db.OrderFilterV4{{Field: db.OV4FSignature, Kind: db.Equal, Value: otherSignatureV4.Bytes()}},
+			},
+			event:    eventV4,
+			expected: false,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, testCase.filter.matches(testCase.event))
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package graphql
+
+import (
+	"strconv"
+
+	"github.com/0xProject/0x-mesh/core"
+	"github.com/0xProject/0x-mesh/zeroex"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// orderEventReplay reads the order events that a resumed orderEvents
+// subscription missed from the order event log, one page at a time.
+type orderEventReplay struct {
+	app *core.App
+	// lastSequenceNumber is the sequence number of the last event returned by
+	// next.
+	lastSequenceNumber uint64
+	// pending is the first page of events, which is fetched by
+	// newOrderEventReplay so that errors can be returned to the subscriber
+	// before the subscription starts.
+	pending []*zeroex.OrderEvent
+}
+
+func newOrderEventReplay(app *core.App, since string) (*orderEventReplay, error) {
+	sequenceNumber, err := parseSequenceNumber(since)
+	if err != nil {
+		return nil, err
+	}
+	firstPage, err := app.FindOrderEventsSince(sequenceNumber, defaultOrderEventsPageSize)
+	if err != nil {
+		return nil, err
+	}
+	return &orderEventReplay{
+		app:                app,
+		lastSequenceNumber: sequenceNumber,
+		pending:            firstPage,
+	}, nil
+}
+
+// next returns the next page of events from the order event log. It returns an
+// empty page once all events in the log have been returned.
+func (r *orderEventReplay) next() ([]*zeroex.OrderEvent, error) {
+	page := r.pending
+	r.pending = nil
+	if page == nil {
+		var err error
+		page, err = r.app.FindOrderEventsSince(r.lastSequenceNumber, defaultOrderEventsPageSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(page) > 0 {
+		r.lastSequenceNumber = page[len(page)-1].SequenceNumber
+	}
+	return page, nil
+}
+
+// dropReplayed returns the subset of the given live order events which have
+// not already been returned by next.
+func (r *orderEventReplay) dropReplayed(orderEvents []*zeroex.OrderEvent) []*zeroex.OrderEvent {
+	notReplayed := []*zeroex.OrderEvent{}
+	for _, orderEvent := range orderEvents {
+		// Events without a sequence number could not be added to the log, so
+		// they can't have been replayed.
+		if orderEvent.SequenceNumber == 0 || orderEvent.SequenceNumber > r.lastSequenceNumber {
+			notReplayed = append(notReplayed, orderEvent)
+		}
+	}
+	return notReplayed
+}
+
+func parseSequenceNumber(sequenceNumber string) (uint64, error) {
+	parsed, err := strconv.ParseUint(sequenceNumber, 10, 64)
+	if err != nil {
+		return 0, gqlerror.Errorf("invalid sequence number: %q", sequenceNumber)
+	}
+	return parsed, nil
+}
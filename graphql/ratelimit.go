@@ -0,0 +1,170 @@
+package graphql
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/0xProject/0x-mesh/metrics"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/karlseguin/ccache"
+	log "github.com/sirupsen/logrus"
+	"github.com/vektah/gqlparser/v2/ast"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// clientLimiterCacheSize is the maximum number of clients to keep track of
+	// at once. It controls the size of a cache that holds the rate limiters for
+	// each client.
+	clientLimiterCacheSize = 1000
+	// clientLimiterCacheTTL is the TTL for the rate limiters for each client. If
+	// a client does not send any requests for this duration, they will be
+	// removed from the cache and their rate limiters will be reset.
+	clientLimiterCacheTTL = 5 * time.Minute
+	errCodeRateLimited    = "RATE_LIMITED"
+)
+
+type clientIPContextKey struct{}
+
+// RateLimiterConfig is a set of configuration options for the rate limiter.
+// A limit of 0 disables the corresponding rate limit.
+type RateLimiterConfig struct {
+	// Limit is the maximum rate of operations (queries, mutations and
+	// subscriptions) per second for each client.
+	Limit rate.Limit
+	// Burst is the maximum number of operations that can be sent at once by
+	// each client.
+	Burst int
+	// MutationLimit is the maximum rate of mutations (e.g. addOrders) per second
+	// for each client. Mutations also count toward Limit.
+	MutationLimit rate.Limit
+	// MutationBurst is the maximum number of mutations that can be sent at once
+	// by each client.
+	MutationBurst int
+}
+
+// RateLimiter is a token bucket rate limiter for GraphQL operations. Clients
+// are identified by their API key if they are authenticated (see
+// Authenticator) and by their IP address otherwise.
+type RateLimiter struct {
+	config         RateLimiterConfig
+	clientLimiters *ccache.Cache
+}
+
+type clientLimiters struct {
+	operations *rate.Limiter
+	mutations  *rate.Limiter
+}
+
+// NewRateLimiter creates and returns a new rate limiter. Like the rate
+// limiting pubsub validator, it currently leaks the goroutines started by the
+// caching library used under the hood.
+func NewRateLimiter(config RateLimiterConfig) *RateLimiter {
+	return &RateLimiter{
+		config:         config,
+		clientLimiters: ccache.New(ccache.Configure().MaxSize(clientLimiterCacheSize)),
+	}
+}
+
+// Enabled returns true if any rate limits have been configured.
+func (l *RateLimiter) Enabled() bool {
+	return l.config.Limit != 0 || l.config.MutationLimit != 0
+}
+
+// Middleware returns an http.Handler which adds the IP address of the client
+// to the request context so that unauthenticated clients can be rate limited.
+// Note that if Mesh is behind a proxy, all unauthenticated clients will share
+// the IP address of the proxy.
+func (l *RateLimiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !l.Enabled() {
+			next.ServeHTTP(w, r)
+			return
+		}
+		ip, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			ip = r.RemoteAddr
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), clientIPContextKey{}, ip)))
+	})
+}
+
+// AroundOperations rejects any operations which exceed the rate limits for the
+// client. It satisfies graphql.OperationMiddleware.
+func (l *RateLimiter) AroundOperations(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	if !l.Enabled() {
+		return next(ctx)
+	}
+	opCtx := graphql.GetOperationContext(ctx)
+	isMutation := opCtx.Operation != nil && opCtx.Operation.Operation == ast.Mutation
+	if message := l.limit(ctx, isMutation); message != "" {
+		metrics.GraphqlRequestsRejected.WithLabelValues(metrics.RejectionRateLimited).Inc()
+		return errorResponse(errCodeRateLimited, message)
+	}
+	return next(ctx)
+}
+
+// RESTMiddleware returns an http.Handler which rejects any requests which
+// exceed the rate limits for the client with a 429 status. It is meant for
+// REST APIs such as the SRA server. Requests which may modify state (e.g. POST
+// requests which add orders) count as mutations. If authentication is enabled,
+// it must be wrapped by Authenticator.RESTMiddleware so that authenticated
+// clients are rate limited by API key.
+func (l *RateLimiter) RESTMiddleware(next http.Handler) http.Handler {
+	return l.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !l.Enabled() {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if message := l.limit(r.Context(), isWriteRequest(r)); message != "" {
+			metrics.RESTRequestsRejected.WithLabelValues(metrics.RejectionRateLimited).Inc()
+			http.Error(w, message, http.StatusTooManyRequests)
+			return
+		}
+		next.ServeHTTP(w, r)
+	}))
+}
+
+// limit returns an error message if the client identified by the given
+// context has exceeded its rate limits. Otherwise, it returns an empty string.
+func (l *RateLimiter) limit(ctx context.Context, isMutation bool) string {
+	limiters, err := l.getOrCreateLimitersForClient(clientID(ctx))
+	if err != nil {
+		log.WithError(err).Error("unexpected error in getOrCreateLimitersForClient")
+		return ""
+	}
+	// Note: We check the mutation rate limiter first so that clients who are
+	// exceeding the mutation limit do not contribute toward the general limit.
+	if isMutation && l.config.MutationLimit != 0 && !limiters.mutations.Allow() {
+		return "mutation rate limit exceeded"
+	}
+	if l.config.Limit != 0 && !limiters.operations.Allow() {
+		return "rate limit exceeded"
+	}
+	return ""
+}
+
+func (l *RateLimiter) getOrCreateLimitersForClient(clientID string) (*clientLimiters, error) {
+	item, err := l.clientLimiters.Fetch(clientID, clientLimiterCacheTTL, func() (interface{}, error) {
+		return &clientLimiters{
+			operations: rate.NewLimiter(l.config.Limit, l.config.Burst),
+			mutations:  rate.NewLimiter(l.config.MutationLimit, l.config.MutationBurst),
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item.Value().(*clientLimiters), nil
+}
+
+// clientID returns a string which identifies the client that sent the
+// operation in the given context.
+func clientID(ctx context.Context) string {
+	if key, ok := ctx.Value(apiKeyContextKey{}).(string); ok {
+		return "key:" + key
+	}
+	ip, _ := ctx.Value(clientIPContextKey{}).(string)
+	return "ip:" + ip
+}
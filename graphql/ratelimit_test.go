@@ -0,0 +1,142 @@
+// +build !js
+
+package graphql
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/0xProject/0x-mesh/metrics"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"golang.org/x/time/rate"
+)
+
+// testRateLimit is low enough that no tokens are added to the token buckets
+// while the tests are running.
+const testRateLimit = rate.Limit(0.0001)
+
+func TestRateLimiterMiddleware(t *testing.T) {
+	var ip string
+	handler := NewRateLimiter(RateLimiterConfig{Limit: testRateLimit, Burst: 1}).Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip, _ = r.Context().Value(clientIPContextKey{}).(string)
+	}))
+	req := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+	req.RemoteAddr = "192.0.2.1:1234"
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, "192.0.2.1", ip)
+}
+
+func TestRateLimiterLimitsEachClient(t *testing.T) {
+	rateLimiter := NewRateLimiter(RateLimiterConfig{
+		Limit: testRateLimit,
+		Burst: 2,
+	})
+	clientA := withClientIP(context.Background(), "192.0.2.1")
+	clientB := withClientIP(context.Background(), "192.0.2.2")
+
+	numRejected := testutil.ToFloat64(metrics.GraphqlRequestsRejected.WithLabelValues(metrics.RejectionRateLimited))
+	assertOperationAllowed(t, rateLimiter, clientA, ast.Query)
+	assertOperationAllowed(t, rateLimiter, clientA, ast.Subscription)
+	assertOperationRateLimited(t, rateLimiter, clientA, ast.Query)
+	assert.Equal(t, numRejected+1, testutil.ToFloat64(metrics.GraphqlRequestsRejected.WithLabelValues(metrics.RejectionRateLimited)))
+
+	// Other clients have their own limits.
+	assertOperationAllowed(t, rateLimiter, clientB, ast.Query)
+	assertOperationAllowed(t, rateLimiter, clientB, ast.Query)
+	assertOperationRateLimited(t, rateLimiter, clientB, ast.Query)
+
+	// Authenticated clients are limited by API key rather than IP address.
+	keyFromClientA := withAPIKey(clientA, testReadAPIKey, ScopeRead)
+	keyFromClientB := withAPIKey(clientB, testReadAPIKey, ScopeRead)
+	assertOperationAllowed(t, rateLimiter, keyFromClientA, ast.Query)
+	assertOperationAllowed(t, rateLimiter, keyFromClientB, ast.Query)
+	assertOperationRateLimited(t, rateLimiter, keyFromClientA, ast.Query)
+	assertOperationRateLimited(t, rateLimiter, keyFromClientB, ast.Query)
+}
+
+func TestRateLimiterLimitsMutations(t *testing.T) {
+	rateLimiter := NewRateLimiter(RateLimiterConfig{
+		Limit:         testRateLimit,
+		Burst:         3,
+		MutationLimit: testRateLimit,
+		MutationBurst: 1,
+	})
+	client := withClientIP(context.Background(), "192.0.2.1")
+
+	assertOperationAllowed(t, rateLimiter, client, ast.Mutation)
+	assertOperationRateLimited(t, rateLimiter, client, ast.Mutation)
+	// Rejected mutations don't count toward the general limit.
+	assertOperationAllowed(t, rateLimiter, client, ast.Query)
+	assertOperationAllowed(t, rateLimiter, client, ast.Query)
+	assertOperationRateLimited(t, rateLimiter, client, ast.Query)
+}
+
+func TestRateLimiterDisabled(t *testing.T) {
+	rateLimiter := NewRateLimiter(RateLimiterConfig{})
+	require.False(t, rateLimiter.Enabled())
+	client := withClientIP(context.Background(), "192.0.2.1")
+	for i := 0; i < 10; i++ {
+		assertOperationAllowed(t, rateLimiter, client, ast.Mutation)
+	}
+}
+
+func TestRateLimiterRESTMiddleware(t *testing.T) {
+	rateLimiter := NewRateLimiter(RateLimiterConfig{
+		Limit:         testRateLimit,
+		Burst:         2,
+		MutationLimit: testRateLimit,
+		MutationBurst: 1,
+	})
+	handler := rateLimiter.RESTMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	serve := func(method string, remoteAddr string) int {
+		req := httptest.NewRequest(method, "/sra/v4/orders", nil)
+		req.RemoteAddr = remoteAddr
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+		return recorder.Code
+	}
+
+	numRejected := testutil.ToFloat64(metrics.RESTRequestsRejected.WithLabelValues(metrics.RejectionRateLimited))
+	// POST requests count toward the mutation limit.
+	assert.Equal(t, http.StatusOK, serve(http.MethodPost, "192.0.2.1:1234"))
+	assert.Equal(t, http.StatusTooManyRequests, serve(http.MethodPost, "192.0.2.1:1234"))
+	assert.Equal(t, http.StatusOK, serve(http.MethodGet, "192.0.2.1:1234"))
+	assert.Equal(t, http.StatusTooManyRequests, serve(http.MethodGet, "192.0.2.1:1234"))
+	assert.Equal(t, numRejected+2, testutil.ToFloat64(metrics.RESTRequestsRejected.WithLabelValues(metrics.RejectionRateLimited)))
+
+	// Other clients have their own limits.
+	assert.Equal(t, http.StatusOK, serve(http.MethodGet, "192.0.2.2:1234"))
+}
+
+func withClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPContextKey{}, ip)
+}
+
+func runTestOperation(rateLimiter *RateLimiter, ctx context.Context, operation ast.Operation) (*graphql.Response, bool) {
+	ctx = graphql.WithOperationContext(ctx, newTestOperationContext(operation))
+	called := false
+	response := rateLimiter.AroundOperations(ctx, func(ctx context.Context) graphql.ResponseHandler {
+		called = true
+		return graphql.OneShot(&graphql.Response{})
+	})(ctx)
+	return response, called
+}
+
+func assertOperationAllowed(t *testing.T, rateLimiter *RateLimiter, ctx context.Context, operation ast.Operation) {
+	response, called := runTestOperation(rateLimiter, ctx, operation)
+	assert.True(t, called)
+	assert.Empty(t, response.Errors)
+}
+
+func assertOperationRateLimited(t *testing.T, rateLimiter *RateLimiter, ctx context.Context, operation ast.Operation) {
+	response, called := runTestOperation(rateLimiter, ctx, operation)
+	assert.False(t, called)
+	require.Len(t, response.Errors, 1)
+	assert.Equal(t, errCodeRateLimited, response.Errors[0].Extensions["code"])
+}
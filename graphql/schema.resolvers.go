@@ -5,8 +5,10 @@ package graphql
 
 import (
 	"context"
+	"strconv"
 	"time"
 
+	"github.com/0xProject/0x-mesh/common/types"
 	"github.com/0xProject/0x-mesh/db"
 	"github.com/0xProject/0x-mesh/graphql/generated"
 	"github.com/0xProject/0x-mesh/graphql/gqltypes"
@@ -14,6 +16,8 @@ import (
 	"github.com/0xProject/0x-mesh/zeroex"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
 	log "github.com/sirupsen/logrus"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -78,6 +82,102 @@ func (r *mutationResolver) AddOrdersV4(ctx context.Context, orders []*gqltypes.N
 	return returnResult, err
 }
 
+func (r *mutationResolver) RemoveOrders(ctx context.Context, hashes []string) ([]string, error) {
+	orderHashes, err := gqltypes.HashesFromStrings(hashes)
+	if err != nil {
+		return nil, gqlerror.Errorf("%s", err.Error())
+	}
+	removed, err := r.app.RemoveOrders(orderHashes)
+	if err != nil {
+		return nil, err
+	}
+	return gqltypes.HashesToStrings(removed), nil
+}
+
+func (r *mutationResolver) RemoveOrdersV4(ctx context.Context, hashes []string) ([]string, error) {
+	orderHashes, err := gqltypes.HashesFromStrings(hashes)
+	if err != nil {
+		return nil, gqlerror.Errorf("%s", err.Error())
+	}
+	removed, err := r.app.RemoveOrdersV4(orderHashes)
+	if err != nil {
+		return nil, err
+	}
+	return gqltypes.HashesToStrings(removed), nil
+}
+
+func (r *mutationResolver) SetOrdersPinned(ctx context.Context, hashes []string, pinned bool) ([]string, error) {
+	orderHashes, err := gqltypes.HashesFromStrings(hashes)
+	if err != nil {
+		return nil, gqlerror.Errorf("%s", err.Error())
+	}
+	updated, err := r.app.SetOrdersPinned(orderHashes, pinned)
+	if err != nil {
+		return nil, err
+	}
+	return gqltypes.HashesToStrings(updated), nil
+}
+
+func (r *mutationResolver) SetOrdersPinnedV4(ctx context.Context, hashes []string, pinned bool) ([]string, error) {
+	orderHashes, err := gqltypes.HashesFromStrings(hashes)
+	if err != nil {
+		return nil, gqlerror.Errorf("%s", err.Error())
+	}
+	updated, err := r.app.SetOrdersPinnedV4(orderHashes, pinned)
+	if err != nil {
+		return nil, err
+	}
+	return gqltypes.HashesToStrings(updated), nil
+}
+
+func (r *mutationResolver) ConnectPeer(ctx context.Context, multiaddr string) (bool, error) {
+	maddr, err := ma.NewMultiaddr(multiaddr)
+	if err != nil {
+		return false, gqlerror.Errorf("invalid multiaddr: %s", err.Error())
+	}
+	peerInfo, err := peer.AddrInfoFromP2pAddr(maddr)
+	if err != nil {
+		return false, gqlerror.Errorf("invalid multiaddr: %s", err.Error())
+	}
+	if err := r.app.AddPeer(*peerInfo); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) DisconnectPeer(ctx context.Context, id string) (bool, error) {
+	peerID, err := peer.Decode(id)
+	if err != nil {
+		return false, gqlerror.Errorf("invalid peer ID: %s", err.Error())
+	}
+	if err := r.app.DisconnectPeer(peerID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) BanPeer(ctx context.Context, multiaddr string) (bool, error) {
+	maddr, err := ma.NewMultiaddr(multiaddr)
+	if err != nil {
+		return false, gqlerror.Errorf("invalid multiaddr: %s", err.Error())
+	}
+	if err := r.app.BanPeer(maddr); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) UnbanPeer(ctx context.Context, multiaddr string) (bool, error) {
+	maddr, err := ma.NewMultiaddr(multiaddr)
+	if err != nil {
+		return false, gqlerror.Errorf("invalid multiaddr: %s", err.Error())
+	}
+	if err := r.app.UnbanPeer(maddr); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 func (r *queryResolver) Order(ctx context.Context, hash string) (*gqltypes.OrderWithMetadata, error) {
 	defer metrics.GraphqlQueries.WithLabelValues("order").Inc()
 
@@ -104,7 +204,7 @@ func (r *queryResolver) Orderv4(ctx context.Context, hash string) (*gqltypes.Ord
 	return gqltypes.OrderWithMetadataFromCommonTypeV4(order), nil
 }
 
-func (r *queryResolver) Orders(ctx context.Context, sort []*gqltypes.OrderSort, filters []*gqltypes.OrderFilter, limit *int) ([]*gqltypes.OrderWithMetadata, error) {
+func (r *queryResolver) Orders(ctx context.Context, sort []*gqltypes.OrderSort, filters []*gqltypes.OrderFilter, first *int, after *string) (*gqltypes.OrderConnection, error) {
 	defer metrics.GraphqlQueries.WithLabelValues("orders").Inc()
 	// TODO(albrow): More validation of query args. We can assume
 	//               basic structure is correct but may need to validate
@@ -119,45 +219,185 @@ func (r *queryResolver) Orders(ctx context.Context, sort []*gqltypes.OrderSort,
 			},
 		},
 	}
-	if limit != nil {
-		query.Limit = uint(*limit)
+	pageSize, err := parseLimit("first", first, defaultPageSize)
+	if err != nil {
+		return nil, err
+	}
+	// Fetch one extra order so that we know whether there is a next page.
+	query.Limit = uint(pageSize) + 1
+	dbFilters, err := gqltypes.OrderFiltersToDBType(filters)
+	if err != nil {
+		return nil, err
 	}
-	for _, filter := range filters {
-		kind, err := gqltypes.FilterKindToDBType(filter.Kind)
-		if err != nil {
+	query.Filters = append(query.Filters, dbFilters...)
+	sortsByHash := false
+	for _, sort := range sort {
+		if err := gqltypes.CheckSortField(sort.Field); err != nil {
 			return nil, err
 		}
-		filterValue, err := gqltypes.FilterValueFromJSON(*filter)
+		direction, err := gqltypes.SortDirectionToDBType(sort.Direction)
 		if err != nil {
 			return nil, err
 		}
-		query.Filters = append(query.Filters, db.OrderFilter{
-			Field: db.OrderField(filter.Field),
-			Kind:  kind,
-			Value: filterValue,
+		query.Sort = append(query.Sort, db.OrderSort{
+			Field:     gqltypes.OrderFieldToDBType(sort.Field),
+			Direction: direction,
+		})
+		if sort.Field == gqltypes.OrderFieldHash {
+			sortsByHash = true
+		}
+	}
+	// The hash uniquely identifies an order, so sorting by hash last
+	// guarantees that the cursor for each order is unique.
+	if !sortsByHash {
+		query.Sort = append(query.Sort, db.OrderSort{
+			Field:     db.OFHash,
+			Direction: db.Ascending,
 		})
 	}
+
+	var orders []*types.OrderWithMetadata
+	if after != nil {
+		afterValues, err := gqltypes.DecodeOrderCursor(*after, query.Sort)
+		if err != nil {
+			return nil, err
+		}
+		orders, err = r.app.FindOrdersAfter(query, afterValues)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		orders, err = r.app.FindOrders(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pageInfo := &gqltypes.PageInfo{}
+	if len(orders) > pageSize {
+		orders = orders[:pageSize]
+		pageInfo.HasNextPage = true
+	}
+	if len(orders) > 0 {
+		endCursor, err := gqltypes.EncodeOrderCursor(orders[len(orders)-1], query.Sort)
+		if err != nil {
+			return nil, err
+		}
+		pageInfo.EndCursor = &endCursor
+	}
+	return &gqltypes.OrderConnection{
+		Orders:   gqltypes.OrdersWithMetadataFromCommonType(orders),
+		PageInfo: pageInfo,
+	}, nil
+}
+
+func (r *queryResolver) Ordersv4(ctx context.Context, sort []*gqltypes.OrderSortV4, filters []*gqltypes.OrderFilterV4, first *int, after *string) (*gqltypes.OrderV4Connection, error) {
+	defer metrics.GraphqlQueries.WithLabelValues("ordersv4").Inc()
+	query := &db.OrderQueryV4{
+		// We never include orders that are marked as removed.
+		Filters: []db.OrderFilterV4{
+			{
+				Field: db.OV4FIsRemoved,
+				Kind:  db.Equal,
+				Value: false,
+			},
+		},
+	}
+	pageSize, err := parseLimit("first", first, defaultPageSize)
+	if err != nil {
+		return nil, err
+	}
+	// Fetch one extra order so that we know whether there is a next page.
+	query.Limit = uint(pageSize) + 1
+	dbFilters, err := gqltypes.OrderFiltersV4ToDBType(filters)
+	if err != nil {
+		return nil, err
+	}
+	query.Filters = append(query.Filters, dbFilters...)
+	sortsByHash := false
 	for _, sort := range sort {
 		direction, err := gqltypes.SortDirectionToDBType(sort.Direction)
 		if err != nil {
 			return nil, err
 		}
-		query.Sort = append(query.Sort, db.OrderSort{
-			Field:     db.OrderField(sort.Field),
+		query.Sort = append(query.Sort, db.OrderSortV4{
+			Field:     gqltypes.OrderFieldV4ToDBType(sort.Field),
 			Direction: direction,
 		})
+		if sort.Field == gqltypes.OrderFieldV4Hash {
+			sortsByHash = true
+		}
+	}
+	// The hash uniquely identifies an order, so sorting by hash last
+	// guarantees that the cursor for each order is unique.
+	if !sortsByHash {
+		query.Sort = append(query.Sort, db.OrderSortV4{
+			Field:     db.OV4FHash,
+			Direction: db.Ascending,
+		})
 	}
 
-	orders, err := r.app.FindOrders(query)
+	var orders []*types.OrderWithMetadata
+	if after != nil {
+		afterValues, err := gqltypes.DecodeOrderCursorV4(*after, query.Sort)
+		if err != nil {
+			return nil, err
+		}
+		orders, err = r.app.FindOrdersAfterV4(query, afterValues)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		orders, err = r.app.FindOrdersV4(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pageInfo := &gqltypes.PageInfo{}
+	if len(orders) > pageSize {
+		orders = orders[:pageSize]
+		pageInfo.HasNextPage = true
+	}
+	if len(orders) > 0 {
+		endCursor, err := gqltypes.EncodeOrderCursorV4(orders[len(orders)-1], query.Sort)
+		if err != nil {
+			return nil, err
+		}
+		pageInfo.EndCursor = &endCursor
+	}
+	return &gqltypes.OrderV4Connection{
+		Orders:   gqltypes.OrdersWithMetadataFromCommonTypeV4(orders),
+		PageInfo: pageInfo,
+	}, nil
+}
+
+func (r *queryResolver) OrderStats(ctx context.Context, filters []*gqltypes.OrderFilter) (*gqltypes.OrderStats, error) {
+	defer metrics.GraphqlQueries.WithLabelValues("orderStats").Inc()
+	query := &db.OrderQuery{
+		// We never include orders that are marked as removed.
+		Filters: []db.OrderFilter{
+			{
+				Field: db.OFIsRemoved,
+				Kind:  db.Equal,
+				Value: false,
+			},
+		},
+	}
+	dbFilters, err := gqltypes.OrderFiltersToDBType(filters)
 	if err != nil {
 		return nil, err
 	}
-
-	return gqltypes.OrdersWithMetadataFromCommonType(orders), nil
+	query.Filters = append(query.Filters, dbFilters...)
+	stats, err := r.app.GetOrderStats(query)
+	if err != nil {
+		return nil, err
+	}
+	return gqltypes.OrderStatsFromCommonType(stats), nil
 }
 
-func (r *queryResolver) Ordersv4(ctx context.Context, sort []*gqltypes.OrderSortV4, filters []*gqltypes.OrderFilterV4, limit *int) ([]*gqltypes.OrderV4WithMetadata, error) {
-	defer metrics.GraphqlQueries.WithLabelValues("ordersv4").Inc()
+func (r *queryResolver) OrderStatsV4(ctx context.Context, filters []*gqltypes.OrderFilterV4) (*gqltypes.OrderStats, error) {
+	defer metrics.GraphqlQueries.WithLabelValues("orderStatsV4").Inc()
 	query := &db.OrderQueryV4{
 		// We never include orders that are marked as removed.
 		Filters: []db.OrderFilterV4{
@@ -168,40 +408,162 @@ func (r *queryResolver) Ordersv4(ctx context.Context, sort []*gqltypes.OrderSort
 			},
 		},
 	}
-	if limit != nil {
-		query.Limit = uint(*limit)
+	dbFilters, err := gqltypes.OrderFiltersV4ToDBType(filters)
+	if err != nil {
+		return nil, err
+	}
+	query.Filters = append(query.Filters, dbFilters...)
+	stats, err := r.app.GetOrderStatsV4(query)
+	if err != nil {
+		return nil, err
+	}
+	return gqltypes.OrderStatsFromCommonType(stats), nil
+}
+
+func (r *queryResolver) Orderbook(ctx context.Context, baseToken string, quoteToken string, depth *int) (*gqltypes.Orderbook, error) {
+	defer metrics.GraphqlQueries.WithLabelValues("orderbook").Inc()
+	if !common.IsHexAddress(baseToken) {
+		return nil, gqlerror.Errorf("invalid baseToken address: %q", baseToken)
+	}
+	if !common.IsHexAddress(quoteToken) {
+		return nil, gqlerror.Errorf("invalid quoteToken address: %q", quoteToken)
+	}
+	orderbookDepth, err := parseLimit("depth", depth, defaultOrderbookDepth)
+	if err != nil {
+		return nil, err
+	}
+	orderbook, err := r.app.GetOrderbook(common.HexToAddress(baseToken), common.HexToAddress(quoteToken), orderbookDepth)
+	if err != nil {
+		return nil, err
 	}
-	for _, filter := range filters {
-		kind, err := gqltypes.FilterKindToDBType(filter.Kind)
+	return gqltypes.OrderbookFromCommonType(orderbook), nil
+}
+
+func (r *queryResolver) OrderEventsSince(ctx context.Context, sequenceNumber string, limit *int) (*gqltypes.OrderEventPage, error) {
+	defer metrics.GraphqlQueries.WithLabelValues("orderEventsSince").Inc()
+	since, err := parseSequenceNumber(sequenceNumber)
+	if err != nil {
+		return nil, err
+	}
+	pageSize, err := parseLimit("limit", limit, defaultOrderEventsPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra event to determine whether there are more events.
+	orderEvents, err := r.app.FindOrderEventsSince(since, uint(pageSize+1))
+	if err != nil {
+		return nil, err
+	}
+	page := &gqltypes.OrderEventPage{
+		LastSequenceNumber: strconv.FormatUint(since, 10),
+	}
+	if len(orderEvents) > pageSize {
+		orderEvents = orderEvents[:pageSize]
+		page.HasMore = true
+	}
+	if len(orderEvents) > 0 {
+		page.LastSequenceNumber = strconv.FormatUint(orderEvents[len(orderEvents)-1].SequenceNumber, 10)
+	}
+	page.Events = gqltypes.OrderEventsFromZeroExType(orderEvents)
+	return page, nil
+}
+
+func (r *queryResolver) ArchivedOrders(ctx context.Context, hash *string, maker *string, endStates []gqltypes.OrderEndState, archivedAfter *string, archivedBefore *string, limit *int, offset *int) ([]*gqltypes.ArchivedOrder, error) {
+	defer metrics.GraphqlQueries.WithLabelValues("archivedOrders").Inc()
+	query := &db.ArchivedOrderQuery{}
+	if hash != nil {
+		hashes, err := gqltypes.HashesFromStrings([]string{*hash})
 		if err != nil {
-			return nil, err
+			return nil, gqlerror.Errorf("invalid order hash: %q", *hash)
+		}
+		query.OrderHash = hashes[0]
+	}
+	if maker != nil {
+		if !common.IsHexAddress(*maker) {
+			return nil, gqlerror.Errorf("invalid maker address: %q", *maker)
 		}
-		filterValue, err := gqltypes.FilterValueFromJSONV4(*filter)
+		query.MakerAddress = common.HexToAddress(*maker)
+	}
+	for _, endState := range endStates {
+		query.EndStates = append(query.EndStates, zeroex.OrderEventEndState(endState))
+	}
+	if archivedAfter != nil {
+		after, err := time.Parse(time.RFC3339, *archivedAfter)
 		if err != nil {
-			return nil, err
+			return nil, gqlerror.Errorf("invalid archivedAfter time: %q", *archivedAfter)
 		}
-		query.Filters = append(query.Filters, db.OrderFilterV4{
-			Field: db.OrderFieldV4(filter.Field),
-			Kind:  kind,
-			Value: filterValue,
-		})
+		query.ArchivedAfter = after
 	}
-	for _, sort := range sort {
-		direction, err := gqltypes.SortDirectionToDBType(sort.Direction)
+	if archivedBefore != nil {
+		before, err := time.Parse(time.RFC3339, *archivedBefore)
 		if err != nil {
-			return nil, err
+			return nil, gqlerror.Errorf("invalid archivedBefore time: %q", *archivedBefore)
 		}
-		query.Sort = append(query.Sort, db.OrderSortV4{
-			Field:     db.OrderFieldV4(sort.Field),
-			Direction: direction,
-		})
+		query.ArchivedBefore = before
+	}
+	pageSize, err := parseLimit("limit", limit, defaultPageSize)
+	if err != nil {
+		return nil, err
+	}
+	query.Limit = uint(pageSize)
+	if offset != nil {
+		if *offset < 0 {
+			return nil, gqlerror.Errorf("offset must not be negative")
+		}
+		query.Offset = uint(*offset)
+	}
+	archivedOrders, err := r.app.FindArchivedOrders(query)
+	if err != nil {
+		return nil, err
+	}
+	return gqltypes.ArchivedOrdersFromCommonType(archivedOrders), nil
+}
+
+func (r *queryResolver) ValidateOrders(ctx context.Context, orders []*gqltypes.NewOrder, pinned *bool) (*gqltypes.AddOrdersResults, error) {
+	defer metrics.GraphqlQueries.WithLabelValues("validateOrders").Inc()
+	isPinned := false
+	if pinned != nil {
+		isPinned = (*pinned)
+	}
+	signedOrders, errors := gqltypes.NewOrdersToSignedOrders(orders)
+	if len(errors) > 0 {
+		for _, err := range errors {
+			graphql.AddErrorf(ctx, "%s", err.Error())
+		}
+	}
+	if len(signedOrders) == 0 {
+		return nil, gqlerror.Errorf("no signed orders to validate")
 	}
 
-	orders, err := r.app.FindOrdersV4(query)
+	results, err := r.app.ValidateOrders(ctx, signedOrders, isPinned)
 	if err != nil {
 		return nil, err
 	}
-	return gqltypes.OrdersWithMetadataFromCommonTypeV4(orders), nil
+	return gqltypes.AddOrdersResultsFromValidationResults(results)
+}
+
+func (r *queryResolver) ValidateOrdersV4(ctx context.Context, orders []*gqltypes.NewOrderV4, pinned *bool) (*gqltypes.AddOrdersResultsV4, error) {
+	defer metrics.GraphqlQueries.WithLabelValues("validateOrdersV4").Inc()
+	isPinned := false
+	if pinned != nil {
+		isPinned = (*pinned)
+	}
+	signedOrders, errors := gqltypes.NewOrdersToSignedOrdersV4(orders)
+	if len(errors) > 0 {
+		for _, err := range errors {
+			graphql.AddErrorf(ctx, "%s", err.Error())
+		}
+	}
+	if len(signedOrders) == 0 {
+		return nil, gqlerror.Errorf("no valid signed orders to validate, see other errors")
+	}
+
+	results, err := r.app.ValidateOrdersV4(ctx, signedOrders, isPinned)
+	if err != nil {
+		return nil, err
+	}
+	return gqltypes.AddOrdersResultsFromValidationResultsV4(results)
 }
 
 func (r *queryResolver) Stats(ctx context.Context) (*gqltypes.Stats, error) {
@@ -213,12 +575,82 @@ func (r *queryResolver) Stats(ctx context.Context) (*gqltypes.Stats, error) {
 	return gqltypes.StatsFromCommonType(stats), nil
 }
 
-func (r *subscriptionResolver) OrderEvents(ctx context.Context) (<-chan []*gqltypes.OrderEvent, error) {
+func (r *queryResolver) Peers(ctx context.Context) ([]*gqltypes.Peer, error) {
+	defer metrics.GraphqlQueries.WithLabelValues("peers").Inc()
+	return gqltypes.PeersFromP2PType(r.app.GetPeers()), nil
+}
+
+func (r *subscriptionResolver) OrderEvents(ctx context.Context, filters []*gqltypes.OrderFilter, filtersV4 []*gqltypes.OrderFilterV4, endStates []gqltypes.OrderEndState, since *string) (<-chan []*gqltypes.OrderEvent, error) {
+	eventFilter, err := newOrderEventFilter(filters, filtersV4, endStates, r.app.ParseAssetData)
+	if err != nil {
+		return nil, err
+	}
+	var replay *orderEventReplay
+	if since != nil {
+		replay, err = newOrderEventReplay(r.app, *since)
+		if err != nil {
+			return nil, err
+		}
+	}
 	zeroExChan := make(chan []*zeroex.OrderEvent, orderEventBufferSize)
 	gqlChan := make(chan []*gqltypes.OrderEvent, orderEventBufferSize)
-	subscription := r.app.SubscribeToOrderEvents(zeroExChan)
-	// TODO(albrow): Call subscription.Unsubscribe for slow or disconnected clients.
+
+	// send sends the given order events to the subscriber. It returns false if
+	// the subscriber is slow or disconnected, in which case gqlChan is closed.
+	send := func(orderEvents []*zeroex.OrderEvent) bool {
+		orderEvents = eventFilter.filter(orderEvents)
+		if len(orderEvents) == 0 {
+			return true
+		}
+		select {
+		case gqlChan <- gqltypes.OrderEventsFromZeroExType(orderEvents):
+			log.Debugf("sent %d orders to subscriber", len(orderEvents))
+			return true
+		case <-time.After(r.config.SlowSubscriberTimeout):
+			log.Debug("subscriber is slow or disconnected, unsubscribing")
+			close(gqlChan)
+			return false
+		}
+	}
+	// replayAll sends all events from the order event log that have not been
+	// replayed yet. It returns false if the subscription should be stopped.
+	replayAll := func() bool {
+		for {
+			select {
+			case <-ctx.Done():
+				return false
+			default:
+			}
+			orderEvents, err := replay.next()
+			if err != nil {
+				log.WithError(err).Error("could not replay order events")
+				close(gqlChan)
+				return false
+			}
+			if len(orderEvents) == 0 {
+				return true
+			}
+			if !send(orderEvents) {
+				return false
+			}
+		}
+	}
+
 	go func() {
+		// When resuming, most of the missed events are replayed before
+		// subscribing so that the order watcher isn't blocked on this
+		// subscriber while they are being sent.
+		if replay != nil && !replayAll() {
+			return
+		}
+		subscription := r.app.SubscribeToOrderEvents(zeroExChan)
+		// Replay any events that were added to the log in the meantime. Live
+		// events which have already been replayed are dropped below.
+		if replay != nil && !replayAll() {
+			subscription.Unsubscribe()
+			return
+		}
+		// TODO(albrow): Call subscription.Unsubscribe for slow or disconnected clients.
 		for {
 			select {
 			case <-ctx.Done():
@@ -231,16 +663,13 @@ func (r *subscriptionResolver) OrderEvents(ctx context.Context) (<-chan []*gqlty
 					panic(err)
 				}
 			case orderEvents := <-zeroExChan:
-				select {
-				case gqlChan <- gqltypes.OrderEventsFromZeroExType(orderEvents):
-					log.Debugf("sent %d orders to subscriber", len(orderEvents))
-				case <-time.After(r.config.SlowSubscriberTimeout):
-					log.Debug("subscriber is slow or disconnected, unsubscribing")
+				if replay != nil {
+					orderEvents = replay.dropReplayed(orderEvents)
+				}
+				if !send(orderEvents) {
 					subscription.Unsubscribe()
-					close(gqlChan)
 					return
 				}
-
 			}
 		}
 	}()
@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"math/big"
 	"sort"
 	"sync"
@@ -212,6 +213,70 @@ func TestFindOrders(t *testing.T) {
 	wg.Wait()
 }
 
+type ordersPageResponse struct {
+	Orders struct {
+		Orders []struct {
+			Hash string `json:"hash"`
+		} `json:"orders"`
+		PageInfo struct {
+			EndCursor   *string `json:"endCursor"`
+			HasNextPage bool    `json:"hasNextPage"`
+		} `json:"pageInfo"`
+	} `json:"orders"`
+}
+
+func TestFindOrdersWithCursor(t *testing.T) {
+	teardownSubTest := setupSubTest(t)
+	defer teardownSubTest(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	wg := &sync.WaitGroup{}
+	client, _ := buildAndStartGraphQLServer(t, ctx, wg)
+
+	numOrders := 10
+	orderOptions := scenario.OptionsForAll(orderopts.SetupMakerState(true))
+	signedTestOrders := scenario.NewSignedTestOrdersBatch(t, numOrders, orderOptions)
+	time.Sleep(blockProcessingWaitTime)
+	validationResponse, err := client.AddOrders(ctx, signedTestOrders)
+	require.NoError(t, err)
+	require.Len(t, validationResponse.Accepted, numOrders)
+	expectedHashes := make([]string, numOrders)
+	for i, signedOrder := range signedTestOrders {
+		hash, err := signedOrder.ComputeOrderHash()
+		require.NoError(t, err)
+		expectedHashes[i] = hash.Hex()
+	}
+	sort.Strings(expectedHashes)
+
+	// Walk through all orders in pages of 4 orders.
+	var actualHashes []string
+	var hasNextPage []bool
+	after := ""
+	for {
+		query := `{ orders(first: 4) { orders { hash } pageInfo { endCursor hasNextPage } } }`
+		if after != "" {
+			query = fmt.Sprintf(`{ orders(first: 4, after: %q) { orders { hash } pageInfo { endCursor hasNextPage } } }`, after)
+		}
+		var response ordersPageResponse
+		require.NoError(t, client.RawQuery(ctx, query, &response))
+		for _, order := range response.Orders.Orders {
+			actualHashes = append(actualHashes, order.Hash)
+		}
+		hasNextPage = append(hasNextPage, response.Orders.PageInfo.HasNextPage)
+		if !response.Orders.PageInfo.HasNextPage {
+			break
+		}
+		require.NotNil(t, response.Orders.PageInfo.EndCursor)
+		after = *response.Orders.PageInfo.EndCursor
+	}
+	assert.Equal(t, expectedHashes, actualHashes)
+	assert.Equal(t, []bool{true, true, false}, hasNextPage)
+
+	cancel()
+	wg.Wait()
+}
+
 func TestGetStats(t *testing.T) {
 	teardownSubTest := setupSubTest(t)
 	defer teardownSubTest(t)
@@ -3,8 +3,10 @@
 package integrationtests
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"testing"
 	"time"
@@ -112,3 +114,70 @@ func TestGetOrderV4(t *testing.T) {
 	cancel()
 	wg.Wait()
 }
+
+type ordersV4PageResponse struct {
+	Orders struct {
+		Orders []struct {
+			Hash string `json:"hash"`
+		} `json:"orders"`
+		PageInfo struct {
+			EndCursor   *string `json:"endCursor"`
+			HasNextPage bool    `json:"hasNextPage"`
+		} `json:"pageInfo"`
+	} `json:"ordersv4"`
+}
+
+func TestFindOrdersV4SortedBySignatureWithCursor(t *testing.T) {
+	teardownSubTest := setupSubTest(t)
+	defer teardownSubTest(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	wg := &sync.WaitGroup{}
+	client, _ := buildAndStartGraphQLServer(t, ctx, wg)
+
+	numOrders := 10
+	orderOptions := scenario.OptionsForAll(orderopts.SetupMakerState(true))
+	signedTestOrders := scenario.NewSignedTestOrdersBatchV4(t, numOrders, orderOptions)
+	time.Sleep(blockProcessingWaitTime)
+	validationResponse, err := client.AddOrdersV4(ctx, signedTestOrders)
+	require.NoError(t, err)
+	require.Len(t, validationResponse.Accepted, numOrders)
+	sort.Slice(signedTestOrders, func(i, j int) bool {
+		return bytes.Compare(signedTestOrders[i].Signature.Bytes(), signedTestOrders[j].Signature.Bytes()) < 0
+	})
+	expectedHashes := make([]string, numOrders)
+	for i, signedOrder := range signedTestOrders {
+		hash, err := signedOrder.ComputeOrderHash()
+		require.NoError(t, err)
+		expectedHashes[i] = hash.Hex()
+	}
+
+	// Walk through all orders sorted by signature in pages of 4 orders.
+	var actualHashes []string
+	var hasNextPage []bool
+	after := ""
+	for {
+		arguments := `first: 4, sort: [{ field: signature, direction: ASC }]`
+		if after != "" {
+			arguments += fmt.Sprintf(`, after: %q`, after)
+		}
+		query := fmt.Sprintf(`{ ordersv4(%s) { orders { hash } pageInfo { endCursor hasNextPage } } }`, arguments)
+		var response ordersV4PageResponse
+		require.NoError(t, client.RawQuery(ctx, query, &response))
+		for _, order := range response.Orders.Orders {
+			actualHashes = append(actualHashes, order.Hash)
+		}
+		hasNextPage = append(hasNextPage, response.Orders.PageInfo.HasNextPage)
+		if !response.Orders.PageInfo.HasNextPage {
+			break
+		}
+		require.NotNil(t, response.Orders.PageInfo.EndCursor)
+		after = *response.Orders.PageInfo.EndCursor
+	}
+	assert.Equal(t, expectedHashes, actualHashes)
+	assert.Equal(t, []bool{true, true, false}, hasNextPage)
+
+	cancel()
+	wg.Wait()
+}
@@ -20,6 +20,10 @@ const (
 	OrdersyncStatusLabel  = "status"
 	OrdersyncSuccess      = "success"
 	OrdersyncFailure      = "failure"
+	RejectionReasonLabel  = "reason"
+	RejectionUnauthorized = "unauthorized"
+	RejectionRateLimited  = "rate_limited"
+	RejectionComplexity   = "complexity"
 )
 
 var (
@@ -67,6 +71,20 @@ var (
 		QueryLabel,
 	})
 
+	GraphqlRequestsRejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "mesh_graphql_requests_rejected_total",
+		Help: "Total number of GraphQL requests rejected due to authentication, rate limits or complexity limits",
+	}, []string{
+		RejectionReasonLabel,
+	})
+
+	RESTRequestsRejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "mesh_rest_requests_rejected_total",
+		Help: "Total number of REST API (e.g. SRA) requests rejected due to authentication or rate limits",
+	}, []string{
+		RejectionReasonLabel,
+	})
+
 	OrdersyncRequestsReceived = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "mesh_ordersync_requests_received",
 		Help: "Number of ordersync requests received",
@@ -102,6 +102,7 @@ type Node struct {
 	sub              *pubsub.Subscription
 	subV4            *pubsub.Subscription
 	banner           *banner.Banner
+	bandwidthCounter *metrics.BandwidthCounter
 }
 
 // Config contains configuration options for a Node.
@@ -296,6 +297,7 @@ func New(ctx context.Context, config Config) (*Node, error) {
 		routingDiscovery: routingDiscovery,
 		pubsub:           ps,
 		banner:           banner,
+		bandwidthCounter: bandwidthCounter,
 	}
 
 	return node, nil
@@ -392,6 +392,69 @@ func TestProtectIP(t *testing.T) {
 	require.NoError(t, node1.Connect(node0AddrInfo, testConnectionTimeout))
 }
 
+func TestPeers(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	node0 := newTestNode(t, ctx, nil)
+	node1 := newTestNode(t, ctx, nil)
+	connectTestNodes(t, node0, node1)
+
+	node0.SetPeerScore(node1.ID(), "test-tag", 5)
+	node0.AddPeerScore(node1.ID(), "test-tag", 2)
+
+	peers := node0.Peers()
+	require.Len(t, peers, 1)
+	assert.Equal(t, node1.ID(), peers[0].ID)
+	require.NotEmpty(t, peers[0].Connections)
+	for _, conn := range peers[0].Connections {
+		assert.Equal(t, p2pnet.DirOutbound, conn.Direction)
+	}
+	assert.Equal(t, 7, peers[0].Tags["test-tag"])
+	assert.True(t, peers[0].Score >= 7, "score should include the value of all tags")
+
+	// From the perspective of node1 the connection is inbound.
+	peers = node1.Peers()
+	require.Len(t, peers, 1)
+	assert.Equal(t, node0.ID(), peers[0].ID)
+	for _, conn := range peers[0].Connections {
+		assert.Equal(t, p2pnet.DirInbound, conn.Direction)
+	}
+}
+
+func TestDisconnectPeer(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	node0 := newTestNode(t, ctx, nil)
+	node1 := newTestNode(t, ctx, nil)
+	connectTestNodes(t, node0, node1)
+	require.Len(t, node0.Peers(), 1)
+
+	require.NoError(t, node0.DisconnectPeer(node1.ID()))
+	assert.Empty(t, node0.Peers())
+}
+
+func TestBanIPClosesConnections(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	node0 := newTestNode(t, ctx, nil)
+	node1 := newTestNode(t, ctx, nil)
+	connectTestNodes(t, node0, node1)
+
+	peers := node0.Peers()
+	require.Len(t, peers, 1)
+	require.NotEmpty(t, peers[0].Connections)
+	require.NoError(t, node0.BanIP(peers[0].Connections[0].RemoteMultiaddr))
+	assert.Empty(t, node0.Peers())
+
+	// After unbanning the IP address, node0 should be able to connect to node1
+	// again.
+	require.NoError(t, node0.UnbanIP(peers[0].Connections[0].RemoteMultiaddr))
+	connectTestNodes(t, node0, node1)
+}
+
 func TestRateValidatorGlobal(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,99 @@
+package p2p
+
+import (
+	"sort"
+
+	"github.com/libp2p/go-libp2p-core/metrics"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+// PeerInfo contains information about a peer that the node is currently
+// connected to.
+type PeerInfo struct {
+	ID peer.ID
+	// Connections holds one entry for each open connection to the peer.
+	Connections []ConnInfo
+	// Tags holds the scores that have been assigned to the peer via
+	// AddPeerScore and SetPeerScore, keyed by tag.
+	Tags map[string]int
+	// Score is the total score of the peer (i.e. the sum of all tags). Peers
+	// with a low total score are the first to be disconnected.
+	Score int
+	// Bandwidth holds the bandwidth used by the peer across all protocols.
+	Bandwidth metrics.Stats
+}
+
+// ConnInfo contains information about a single connection to a peer.
+type ConnInfo struct {
+	RemoteMultiaddr ma.Multiaddr
+	Direction       network.Direction
+}
+
+// Peers returns information about each peer that the node is currently
+// connected to, sorted by peer ID.
+func (n *Node) Peers() []*PeerInfo {
+	peerIDs := n.host.Network().Peers()
+	sort.Slice(peerIDs, func(i, j int) bool {
+		return peerIDs[i] < peerIDs[j]
+	})
+	peerInfos := make([]*PeerInfo, 0, len(peerIDs))
+	for _, peerID := range peerIDs {
+		conns := n.host.Network().ConnsToPeer(peerID)
+		connInfos := make([]ConnInfo, len(conns))
+		for i, conn := range conns {
+			connInfos[i] = ConnInfo{
+				RemoteMultiaddr: conn.RemoteMultiaddr(),
+				Direction:       conn.Stat().Direction,
+			}
+		}
+		tags := map[string]int{}
+		score := 0
+		// GetTagInfo returns nil if the connection manager is not tracking the
+		// peer.
+		if tagInfo := n.connManager.GetTagInfo(peerID); tagInfo != nil {
+			for tag, value := range tagInfo.Tags {
+				tags[tag] = value
+			}
+			score = tagInfo.Value
+		}
+		peerInfos = append(peerInfos, &PeerInfo{
+			ID:          peerID,
+			Connections: connInfos,
+			Tags:        tags,
+			Score:       score,
+			Bandwidth:   n.bandwidthCounter.GetBandwidthForPeer(peerID),
+		})
+	}
+	return peerInfos
+}
+
+// DisconnectPeer closes all connections to the peer with the given ID. Note
+// that this does not prevent the peer from being discovered and connected to
+// again later. Use BanIP to prevent future connections.
+func (n *Node) DisconnectPeer(id peer.ID) error {
+	return n.host.Network().ClosePeer(id)
+}
+
+// BanIP bans the IP address of the given multiaddress and closes any open
+// connections to that IP address. It returns banner.ErrProtectedIP if the IP
+// address is protected (e.g. it belongs to a bootstrap peer).
+func (n *Node) BanIP(maddr ma.Multiaddr) error {
+	if err := n.banner.BanIP(maddr); err != nil {
+		return err
+	}
+	// Banning the IP doesn't close existing connections, so we do that
+	// separately.
+	for _, conn := range n.host.Network().Conns() {
+		if n.banner.IsAddrBanned(conn.RemoteMultiaddr()) {
+			_ = conn.Close()
+		}
+	}
+	return nil
+}
+
+// UnbanIP removes the ban on the IP address of the given multiaddress.
+func (n *Node) UnbanIP(maddr ma.Multiaddr) error {
+	return n.banner.UnbanIP(maddr)
+}
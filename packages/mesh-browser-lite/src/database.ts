@@ -37,6 +37,9 @@ export interface FilterOption<T extends Record> {
     field: Extract<keyof T, string>;
     kind: FilterKind;
     value: any;
+    // Only used by OR filters. The filter matches if all of the filters in at
+    // least one of the groups match.
+    groups?: FilterOption<T>[][];
 }
 
 export enum SortDirection {
@@ -52,6 +55,8 @@ export enum FilterKind {
     LessOrEqual = '<=',
     GreaterOrEqual = '>=',
     Contains = 'CONTAINS',
+    In = 'IN',
+    Or = 'OR',
 }
 
 export interface Order {
@@ -82,6 +87,8 @@ export interface Order {
     isExpired: number;
     parsedMakerAssetData: string;
     parsedMakerFeeAssetData: string;
+    parsedTakerAssetData: string;
+    parsedTakerFeeAssetData: string;
     lastValidatedBlockNumber: string;
     lastValidatedBlockHash: string;
     keepCancelled: number;
@@ -178,6 +185,10 @@ export class Database {
             dhtstore: '&key,data',
             peerstore: '&key,data',
         });
+        this._db.version(2).stores({
+            orders:
+                '&hash,chainId,makerAddress,makerAssetData,makerAssetAmount,makerFee,makerFeeAssetData,takerAddress,takerAssetData,takerFeeAssetData,takerAssetAmount,takerFee,senderAddress,feeRecipientAddress,expirationTimeSeconds,salt,signature,exchangeAddress,fillableTakerAssetAmount,lastUpdated,isRemoved,isPinned,isUnfillable,isExpired,parsedMakerAssetData,parsedMakerFeeAssetData,parsedTakerAssetData,parsedTakerFeeAssetData,lastValidatedBlockNumber,lastValidatedBlockHash,keepCancelled,keepExpired,keepFullyFilled,keepUnfunded,[isNotPinned+expirationTimeSeconds]',
+        });
 
         this._orders = this._db.table('orders');
         this._miniHeaders = this._db.table('miniHeaders');
@@ -524,6 +535,9 @@ function buildCollectionWithDexieIndexes<T extends Record, Key>(
                 // If needed, we should try to find a way to optimize this.
                 col = table.filter(containsFilterFunc(filter));
                 break;
+            case FilterKind.In:
+                col = table.where(filter.field).anyOf(filter.value);
+                break;
             default:
                 throw new Error(`unexpected filter kind: ${filter.kind}`);
         }
@@ -588,38 +602,39 @@ async function runQueryInMemoryAsync<T extends Record, Key>(
 }
 
 function filterRecords<T extends Record>(filters: FilterOption<T>[], records: T[]): T[] {
-    let result = records;
     // Note(albrow): As an optimization, we could use the native Dexie.js index for
     // the *first* filter when possible.
-    for (const filter of filters) {
-        switch (filter.kind) {
-            case FilterKind.Equal:
-                result = result.filter((record) => record[filter.field] === filter.value);
-                break;
-            case FilterKind.NotEqual:
-                result = result.filter((record) => record[filter.field] !== filter.value);
-                break;
-            case FilterKind.Greater:
-                result = result.filter((record) => record[filter.field] > filter.value);
-                break;
-            case FilterKind.GreaterOrEqual:
-                result = result.filter((record) => record[filter.field] >= filter.value);
-                break;
-            case FilterKind.Less:
-                result = result.filter((record) => record[filter.field] < filter.value);
-                break;
-            case FilterKind.LessOrEqual:
-                result = result.filter((record) => record[filter.field] <= filter.value);
-                break;
-            case FilterKind.Contains:
-                result = result.filter(containsFilterFunc(filter));
-                break;
-            default:
-                throw new Error(`unexpected filter kind: ${filter.kind}`);
-        }
-    }
+    return records.filter((record) => recordMatchesFilters(filters, record));
+}
+
+function recordMatchesFilters<T extends Record>(filters: FilterOption<T>[], record: T): boolean {
+    return filters.every((filter) => recordMatchesFilter(filter, record));
+}
 
-    return result;
+function recordMatchesFilter<T extends Record>(filter: FilterOption<T>, record: T): boolean {
+    switch (filter.kind) {
+        case FilterKind.Equal:
+            return record[filter.field] === filter.value;
+        case FilterKind.NotEqual:
+            return record[filter.field] !== filter.value;
+        case FilterKind.Greater:
+            return record[filter.field] > filter.value;
+        case FilterKind.GreaterOrEqual:
+            return record[filter.field] >= filter.value;
+        case FilterKind.Less:
+            return record[filter.field] < filter.value;
+        case FilterKind.LessOrEqual:
+            return record[filter.field] <= filter.value;
+        case FilterKind.Contains:
+            return containsFilterFunc(filter)(record);
+        case FilterKind.In:
+            return (filter.value as any[]).includes(record[filter.field]);
+        case FilterKind.Or:
+            // tslint:disable-next-line:no-non-null-assertion
+            return filter.groups!.some((group) => recordMatchesFilters(group, record));
+        default:
+            throw new Error(`unexpected filter kind: ${filter.kind}`);
+    }
 }
 
 function sortRecords<T extends Record>(sortOpts: SortOption<T>[], records: T[]): T[] {
@@ -682,6 +697,11 @@ function canUseNativeDexieIndexes<T extends Record, Key>(table: Dexie.Table<T, K
         return false;
     }
     // tslint:disable-next-line:no-non-null-assertion
+    if (queryUsesFilters(query) && query.filters![0].kind === FilterKind.Or) {
+        // OR filters can't be expressed with a single Dexie index.
+        return false;
+    }
+    // tslint:disable-next-line:no-non-null-assertion
     if (queryUsesFilters(query) && queryUsesSortOptions(query) && query.filters![0].field !== query.sort![0].field) {
         // Dexie does not support sorting and filtering by two different fields.
         return false;
@@ -272,13 +272,13 @@ export class MeshGraphQLClient {
             variables: {
                 sort: query.sort || [],
                 filters: query.filters?.map(convertFilterValue) || [],
-                limit: query.limit || defaultOrderQueryLimit,
+                first: query.limit || defaultOrderQueryLimit,
             },
         });
         if (resp.data == null) {
             throw new Error('received no data');
         }
-        return resp.data.orders.map(fromStringifiedOrderWithMetadata);
+        return resp.data.orders.orders.map(fromStringifiedOrderWithMetadata);
     }
 
     public async findOrdersV4Async(
@@ -290,13 +290,13 @@ export class MeshGraphQLClient {
             variables: {
                 sort: query.sort || [],
                 filters: query.filters?.map(convertFilterValue) || [],
-                limit: query.limit || defaultOrderQueryLimit,
+                first: query.limit || defaultOrderQueryLimit,
             },
         });
         if (resp.data == null) {
             throw new Error('received no data');
         }
-        return resp.data.ordersv4.map(fromStringifiedOrderWithMetadataV4);
+        return resp.data.ordersv4.orders.map(fromStringifiedOrderWithMetadataV4);
     }
 
     public onReconnected(cb: () => void): void {
@@ -48,6 +48,16 @@ export const statsQuery = gql`
             ethRPCRequestsSentInCurrentUTCDay
             ethRPCRateLimitExpiredRequests
             maxExpirationTime
+            maxOrdersPerMaker
+            maxOrdersPerPeer
+            makerQuotaUsage {
+                id
+                numOrders
+            }
+            peerQuotaUsage {
+                id
+                numOrders
+            }
         }
     }
 `;
@@ -191,28 +201,30 @@ export const ordersQuery = gql`
     query Orders(
         $filters: [OrderFilter!] = []
         $sort: [OrderSort!] = [{ field: hash, direction: ASC }]
-        $limit: Int = 100
+        $first: Int = 100
     ) {
-        orders(filters: $filters, sort: $sort, limit: $limit) {
-            hash
-            chainId
-            exchangeAddress
-            makerAddress
-            makerAssetData
-            makerAssetAmount
-            makerFeeAssetData
-            makerFee
-            takerAddress
-            takerAssetData
-            takerAssetAmount
-            takerFeeAssetData
-            takerFee
-            senderAddress
-            feeRecipientAddress
-            expirationTimeSeconds
-            salt
-            signature
-            fillableTakerAssetAmount
+        orders(filters: $filters, sort: $sort, first: $first) {
+            orders {
+                hash
+                chainId
+                exchangeAddress
+                makerAddress
+                makerAssetData
+                makerAssetAmount
+                makerFeeAssetData
+                makerFee
+                takerAddress
+                takerAssetData
+                takerAssetAmount
+                takerFeeAssetData
+                takerFee
+                senderAddress
+                feeRecipientAddress
+                expirationTimeSeconds
+                salt
+                signature
+                fillableTakerAssetAmount
+            }
         }
     }
 `;
@@ -221,10 +233,12 @@ export const ordersQueryV4 = gql`
     query Orders(
         $filters: [OrderFilterV4!] = []
         $sort: [OrderSortV4!] = [{ field: hash, direction: ASC }]
-        $limit: Int = 100
+        $first: Int = 100
     ) {
-        ordersv4(filters: $filters, sort: $sort, limit: $limit) {
-            ${ORDER_V4_WITH_METADATA_FIELDS}
+        ordersv4(filters: $filters, sort: $sort, first: $first) {
+            orders {
+                ${ORDER_V4_WITH_METADATA_FIELDS}
+            }
         }
     }
 `;
@@ -62,11 +62,15 @@ export interface OrderResponseV4 {
 }
 
 export interface OrdersResponse {
-    orders: StringifiedOrderWithMetadata[];
+    orders: {
+        orders: StringifiedOrderWithMetadata[];
+    };
 }
 
 export interface OrdersResponseV4 {
-    ordersv4: StringifiedOrderWithMetadataV4[];
+    ordersv4: {
+        orders: StringifiedOrderWithMetadataV4[];
+    };
 }
 
 export interface OrderEventResponse {
@@ -92,6 +96,15 @@ export interface Stats {
     startOfCurrentUTCDay: Date;
     ethRPCRequestsSentInCurrentUTCDay: number;
     ethRPCRateLimitExpiredRequests: number;
+    maxOrdersPerMaker: number;
+    maxOrdersPerPeer: number;
+    makerQuotaUsage: QuotaUsage[];
+    peerQuotaUsage: QuotaUsage[];
+}
+
+export interface QuotaUsage {
+    id: string;
+    numOrders: number;
 }
 
 export interface LatestBlock {
@@ -166,6 +179,8 @@ export enum RejectedOrderCode {
     SenderAddressNotAllowed = 'SENDER_ADDRESS_NOT_ALLOWED',
     DatabaseFullOfOrders = 'DATABASE_FULL_OF_ORDERS',
     TakerAddressNotAllowed = 'TAKER_ADDRESS_NOT_ALLOWED',
+    MakerQuotaExceeded = 'MAKER_QUOTA_EXCEEDED',
+    PeerQuotaExceeded = 'PEER_QUOTA_EXCEEDED',
 }
 
 export interface OrderEvent {
@@ -246,6 +261,10 @@ export enum FilterKind {
     GreaterOrEqual = 'GREATER_OR_EQUAL',
     Less = 'LESS',
     LessOrEqual = 'LESS_OR_EQUAL',
+    // Matches if the field is equal to any of the values in the filter value, which must be an array.
+    In = 'IN',
+    // Matches if all of the filters in at least one of the groups match. The field and value are ignored.
+    Or = 'OR',
 }
 
 export interface OrderSort {
@@ -253,10 +272,21 @@ export interface OrderSort {
     direction: SortDirection;
 }
 
+// Fields that match the tokens contained in the asset data of an order, including the components of
+// MultiAsset and ERC1155 asset data. They can only be used in EQUAL and IN filters, not for sorting.
+export type OrderTokenField =
+    | 'makerToken'
+    | 'makerTokenId'
+    | 'takerToken'
+    | 'takerTokenId'
+    | 'makerFeeToken'
+    | 'takerFeeToken';
+
 export interface OrderFilter {
-    field: OrderField;
+    field?: OrderField | OrderTokenField;
     kind: FilterKind;
-    value: OrderWithMetadata[OrderField];
+    value?: OrderWithMetadata[OrderField] | Array<OrderWithMetadata[OrderField]>;
+    groups?: OrderFilter[][];
 }
 
 export interface OrderQuery {
@@ -289,6 +319,10 @@ export interface StringifiedStats {
     startOfCurrentUTCDay: string;
     ethRPCRequestsSentInCurrentUTCDay: number;
     ethRPCRateLimitExpiredRequests: number;
+    maxOrdersPerMaker: number;
+    maxOrdersPerPeer: number;
+    makerQuotaUsage: QuotaUsage[];
+    peerQuotaUsage: QuotaUsage[];
 }
 
 export interface StringifiedSignedOrder {
@@ -600,8 +634,10 @@ export function fromStringifiedOrderEvent(event: StringifiedOrderEvent): OrderEv
  * Converts filter.value the the appropriate JSON/GraphQL type (e.g. BigNumber gets converted to string).
  */
 export function convertFilterValue(filter: OrderFilter): OrderFilter {
+    const convertValue = (value: any) => (BigNumber.isBigNumber(value) ? value.toString() : value);
     return {
         ...filter,
-        value: BigNumber.isBigNumber(filter.value) ? filter.value.toString() : filter.value,
+        value: Array.isArray(filter.value) ? filter.value.map(convertValue) : convertValue(filter.value),
+        groups: filter.groups?.map((group) => group.map(convertFilterValue)),
     };
 }
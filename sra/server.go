@@ -0,0 +1,259 @@
+// Package sra implements a subset of the 0x Standard Relayer API (SRA) on top
+// of a Mesh node so that tooling which speaks SRA can interact with Mesh directly.
+// Both the v3 API (under /sra/v3) and the v4 API (under /sra/v4) are
+// supported. See https://github.com/0xProject/standard-relayer-api and
+// https://0x.org/docs/api#sra for the specifications.
+package sra
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/db"
+	"github.com/0xProject/0x-mesh/zeroex/ordervalidator"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	defaultPage    = 1
+	defaultPerPage = 20
+	maxPerPage     = 1000
+	// maxRequestBodySize is the maximum size (in bytes) of the body of a POST
+	// request.
+	maxRequestBodySize = 1 << 20
+)
+
+// App is the subset of the methods of core.App which are used by the Handler.
+type App interface {
+	GetOrder(hash common.Hash) (*types.OrderWithMetadata, error)
+	GetOrderV4(hash common.Hash) (*types.OrderWithMetadata, error)
+	FindOrders(query *db.OrderQuery) ([]*types.OrderWithMetadata, error)
+	FindOrdersV4(query *db.OrderQueryV4) ([]*types.OrderWithMetadata, error)
+	GetOrderStats(query *db.OrderQuery) (*types.OrderStats, error)
+	GetOrderStatsV4(query *db.OrderQueryV4) (*types.OrderStats, error)
+	AddOrdersRaw(ctx context.Context, signedOrdersRaw []*json.RawMessage, pinned bool, opts *types.AddOrdersOpts) (*ordervalidator.ValidationResults, error)
+	AddOrdersRawV4(ctx context.Context, signedOrdersRaw []*json.RawMessage, pinned bool, opts *types.AddOrdersOpts) (*ordervalidator.ValidationResults, error)
+}
+
+// Handler is an http.Handler which serves the Standard Relayer API.
+type Handler struct {
+	app App
+	mux *http.ServeMux
+}
+
+// NewHandler returns a new Handler backed by the given app (typically a
+// *core.App). Orders submitted via POST requests are not pinned. The Handler
+// does not do any authentication or rate limiting itself, so it should be
+// wrapped in the same middleware as the GraphQL server.
+func NewHandler(app App) *Handler {
+	h := &Handler{
+		app: app,
+		mux: http.NewServeMux(),
+	}
+	h.mux.HandleFunc("/sra/v3/orders", h.handleOrders)
+	h.mux.HandleFunc("/sra/v3/order", h.handlePostOrder)
+	h.mux.HandleFunc("/sra/v3/order/", h.handleGetOrder)
+	h.mux.HandleFunc("/sra/v3/orderbook", h.handleOrderbook)
+	h.mux.HandleFunc("/sra/v4/orders", h.handleOrdersV4)
+	h.mux.HandleFunc("/sra/v4/order", h.handlePostOrderV4)
+	h.mux.HandleFunc("/sra/v4/order/", h.handleGetOrderV4)
+	h.mux.HandleFunc("/sra/v4/orderbook", h.handleOrderbookV4)
+	return h
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// pagination holds the page and perPage query parameters of a request.
+type pagination struct {
+	page    int
+	perPage int
+}
+
+func (p pagination) offset() int {
+	return (p.page - 1) * p.perPage
+}
+
+func parsePagination(query url.Values) (pagination, *ValidationError) {
+	p := pagination{
+		page:    defaultPage,
+		perPage: defaultPerPage,
+	}
+	if value := query.Get("page"); value != "" {
+		page, err := strconv.Atoi(value)
+		if err != nil || page < 1 {
+			return p, &ValidationError{
+				Field:  "page",
+				Code:   ValidationErrorCodeValueOutOfRange,
+				Reason: "page must be a positive integer",
+			}
+		}
+		p.page = page
+	}
+	if value := query.Get("perPage"); value != "" {
+		perPage, err := strconv.Atoi(value)
+		if err != nil || perPage < 1 || perPage > maxPerPage {
+			return p, &ValidationError{
+				Field:  "perPage",
+				Code:   ValidationErrorCodeValueOutOfRange,
+				Reason: fmt.Sprintf("perPage must be an integer between 1 and %d", maxPerPage),
+			}
+		}
+		p.perPage = perPage
+	}
+	return p, nil
+}
+
+// parseAddressParam returns the address in the given query parameter or nil if
+// the parameter is not set.
+func parseAddressParam(query url.Values, field string) (*common.Address, *ValidationError) {
+	value := query.Get(field)
+	if value == "" {
+		return nil, nil
+	}
+	if !common.IsHexAddress(value) {
+		return nil, &ValidationError{
+			Field:  field,
+			Code:   ValidationErrorCodeInvalidAddress,
+			Reason: "must be a valid Ethereum address",
+		}
+	}
+	address := common.HexToAddress(value)
+	return &address, nil
+}
+
+// parseBytesParam returns the hex encoded bytes in the given query parameter
+// or nil if the parameter is not set.
+func parseBytesParam(query url.Values, field string) ([]byte, *ValidationError) {
+	value := query.Get(field)
+	if value == "" {
+		return nil, nil
+	}
+	bytes, err := hexutil.Decode(value)
+	if err != nil {
+		return nil, &ValidationError{
+			Field:  field,
+			Code:   ValidationErrorCodeIncorrectFormat,
+			Reason: "must be a 0x-prefixed hex string",
+		}
+	}
+	return bytes, nil
+}
+
+// parseHashFromPath returns the order hash at the end of the URL path, e.g.
+// /sra/v4/order/0x1234...
+func parseHashFromPath(path string) (common.Hash, *ValidationError) {
+	value := path[strings.LastIndex(path, "/")+1:]
+	hashBytes, err := hexutil.Decode(value)
+	if err != nil || len(hashBytes) != common.HashLength {
+		return common.Hash{}, &ValidationError{
+			Field:  "orderHash",
+			Code:   ValidationErrorCodeInvalidSignatureOrHash,
+			Reason: "must be a 0x-prefixed 32 byte hex string",
+		}
+	}
+	return common.BytesToHash(hashBytes), nil
+}
+
+// readOrdersRaw reads the body of a POST request. If isList is true, the body
+// must be a list of orders. Otherwise it must be a single order.
+func readOrdersRaw(w http.ResponseWriter, r *http.Request, isList bool) ([]*json.RawMessage, error) {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBodySize))
+	if isList {
+		var ordersRaw []*json.RawMessage
+		if err := decoder.Decode(&ordersRaw); err != nil {
+			return nil, err
+		}
+		return ordersRaw, nil
+	}
+	var orderRaw json.RawMessage
+	if err := decoder.Decode(&orderRaw); err != nil {
+		return nil, err
+	}
+	return []*json.RawMessage{&orderRaw}, nil
+}
+
+// writeValidationResults writes an empty response if all orders were accepted
+// and an error response with one validation error for each rejected order
+// otherwise.
+func writeValidationResults(w http.ResponseWriter, results *ordervalidator.ValidationResults) {
+	if len(results.Rejected) == 0 {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	validationErrors := make([]*ValidationError, len(results.Rejected))
+	for i, rejected := range results.Rejected {
+		validationErrors[i] = &ValidationError{
+			Field:  "signedOrder",
+			Code:   validationErrorCodeForRejectedOrder(rejected),
+			Reason: fmt.Sprintf("%s (orderHash: %s)", rejected.Status.Message, rejected.OrderHash.Hex()),
+		}
+	}
+	writeValidationErrors(w, validationErrors...)
+}
+
+func validationErrorCodeForRejectedOrder(rejected *ordervalidator.RejectedOrderInfo) int {
+	switch {
+	case rejected.Kind == ordervalidator.MeshError:
+		return ValidationErrorCodeInternalError
+	case rejected.Status.Code == ordervalidator.ROInvalidSchemaCode:
+		return ValidationErrorCodeIncorrectFormat
+	case rejected.Status.Code == ordervalidator.ROInvalidSignature.Code:
+		return ValidationErrorCodeInvalidSignatureOrHash
+	default:
+		return ValidationErrorCodeInvalidOrder
+	}
+}
+
+func writeValidationErrors(w http.ResponseWriter, validationErrors ...*ValidationError) {
+	writeJSON(w, http.StatusBadRequest, &ErrorResponse{
+		Code:             ErrorCodeValidationFailed,
+		Reason:           "Validation Failed",
+		ValidationErrors: validationErrors,
+	})
+}
+
+func writeMalformedJSON(w http.ResponseWriter) {
+	writeJSON(w, http.StatusBadRequest, &ErrorResponse{
+		Code:   ErrorCodeMalformedJSON,
+		Reason: "Malformed JSON",
+	})
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	writeJSON(w, http.StatusNotFound, &ErrorResponse{
+		Reason: "Not Found",
+	})
+}
+
+func writeMethodNotAllowed(w http.ResponseWriter, allowed string) {
+	w.Header().Set("Allow", allowed)
+	writeJSON(w, http.StatusMethodNotAllowed, &ErrorResponse{
+		Reason: "Method Not Allowed",
+	})
+}
+
+func writeInternalError(w http.ResponseWriter, err error) {
+	log.WithError(err).Error("internal error in SRA handler")
+	writeJSON(w, http.StatusInternalServerError, &ErrorResponse{
+		Reason: "Internal Server Error",
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.WithError(err).Error("could not write SRA response")
+	}
+}
@@ -0,0 +1,506 @@
+// +build !js
+
+package sra
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/constants"
+	"github.com/0xProject/0x-mesh/db"
+	"github.com/0xProject/0x-mesh/ethereum"
+	"github.com/0xProject/0x-mesh/zeroex"
+	"github.com/0xProject/0x-mesh/zeroex/ordervalidator"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	contractAddresses = ethereum.GanacheAddresses
+	baseToken         = common.HexToAddress("0x0000000000000000000000000000000000000b0b")
+	quoteToken        = common.HexToAddress("0x000000000000000000000000000000000000c0c0")
+	otherToken        = common.HexToAddress("0x000000000000000000000000000000000000d0d0")
+	trader            = common.HexToAddress("0x000000000000000000000000000000000000e0e0")
+)
+
+// testApp implements App. Orders are stored in a real database so that
+// queries, sorting and pagination are handled exactly as they would be by
+// core.App. Calls to AddOrdersRaw and AddOrdersRawV4 are recorded and return
+// the configured results.
+type testApp struct {
+	*db.DB
+	results      *ordervalidator.ValidationResults
+	addedOrders  []*json.RawMessage
+	addedPinned  []bool
+	addedVersion []int
+}
+
+var _ App = &testApp{}
+
+func newTestApp(t *testing.T, ctx context.Context) *testApp {
+	database, err := db.New(ctx, db.TestOptions())
+	require.NoError(t, err)
+	return &testApp{
+		DB:      database,
+		results: &ordervalidator.ValidationResults{},
+	}
+}
+
+func (app *testApp) AddOrdersRaw(ctx context.Context, signedOrdersRaw []*json.RawMessage, pinned bool, opts *types.AddOrdersOpts) (*ordervalidator.ValidationResults, error) {
+	app.addedOrders = append(app.addedOrders, signedOrdersRaw...)
+	app.addedPinned = append(app.addedPinned, pinned)
+	app.addedVersion = append(app.addedVersion, 3)
+	return app.results, nil
+}
+
+func (app *testApp) AddOrdersRawV4(ctx context.Context, signedOrdersRaw []*json.RawMessage, pinned bool, opts *types.AddOrdersOpts) (*ordervalidator.ValidationResults, error) {
+	app.addedOrders = append(app.addedOrders, signedOrdersRaw...)
+	app.addedPinned = append(app.addedPinned, pinned)
+	app.addedVersion = append(app.addedVersion, 4)
+	return app.results, nil
+}
+
+func TestGetOrdersV4Pagination(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	app := newTestApp(t, ctx)
+	handler := NewHandler(app)
+
+	orders := make([]*types.OrderWithMetadata, 5)
+	for i := range orders {
+		orders[i] = newTestOrderV4(baseToken, quoteToken, big.NewInt(1), big.NewInt(int64(i+1)))
+	}
+	removedOrder := newTestOrderV4(baseToken, quoteToken, big.NewInt(1), big.NewInt(1))
+	removedOrder.IsRemoved = true
+	addOrders(t, app, append(orders, removedOrder)...)
+	sortByHash(orders)
+
+	var page PaginatedOrdersV4
+	getJSON(t, handler, "/sra/v4/orders?page=2&perPage=2", http.StatusOK, &page)
+	assert.Equal(t, 5, page.Total, "removed orders should not be counted")
+	assert.Equal(t, 2, page.Page)
+	assert.Equal(t, 2, page.PerPage)
+	assertRecordsV4(t, orders[2:4], page.Records)
+
+	getJSON(t, handler, "/sra/v4/orders?page=3&perPage=2", http.StatusOK, &page)
+	assertRecordsV4(t, orders[4:], page.Records)
+
+	getJSON(t, handler, "/sra/v4/orders?page=4&perPage=2", http.StatusOK, &page)
+	assert.Equal(t, 5, page.Total)
+	assert.Empty(t, page.Records)
+
+	// The defaults are used if page and perPage are not set.
+	getJSON(t, handler, "/sra/v4/orders", http.StatusOK, &page)
+	assert.Equal(t, defaultPage, page.Page)
+	assert.Equal(t, defaultPerPage, page.PerPage)
+	assertRecordsV4(t, orders, page.Records)
+
+	invalidQueries := map[string]string{
+		"page=0":                                "page",
+		"page=foo":                              "page",
+		"perPage=0":                             "perPage",
+		fmt.Sprintf("perPage=%d", maxPerPage+1): "perPage",
+	}
+	for query, field := range invalidQueries {
+		assertValidationError(t, handler, "/sra/v4/orders?"+query, field, ValidationErrorCodeValueOutOfRange)
+	}
+}
+
+func TestGetOrdersV4Filters(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	app := newTestApp(t, ctx)
+	handler := NewHandler(app)
+
+	baseOrder := newTestOrderV4(baseToken, quoteToken, big.NewInt(1), big.NewInt(1))
+	otherOrder := newTestOrderV4(otherToken, quoteToken, big.NewInt(1), big.NewInt(1))
+	makerOrder := newTestOrderV4(otherToken, quoteToken, big.NewInt(1), big.NewInt(1))
+	makerOrder.OrderV4.Maker = trader
+	takerOrder := newTestOrderV4(otherToken, quoteToken, big.NewInt(1), big.NewInt(1))
+	takerOrder.OrderV4.Taker = trader
+	addOrders(t, app, baseOrder, otherOrder, makerOrder, takerOrder)
+
+	testCases := []struct {
+		query          url.Values
+		expectedOrders []*types.OrderWithMetadata
+	}{
+		{
+			query:          url.Values{"makerToken": {baseToken.Hex()}},
+			expectedOrders: []*types.OrderWithMetadata{baseOrder},
+		},
+		{
+			query:          url.Values{"makerToken": {otherToken.Hex()}, "takerToken": {quoteToken.Hex()}},
+			expectedOrders: []*types.OrderWithMetadata{otherOrder, makerOrder, takerOrder},
+		},
+		{
+			query:          url.Values{"maker": {trader.Hex()}},
+			expectedOrders: []*types.OrderWithMetadata{makerOrder},
+		},
+		{
+			query:          url.Values{"trader": {trader.Hex()}},
+			expectedOrders: []*types.OrderWithMetadata{makerOrder, takerOrder},
+		},
+		{
+			query:          url.Values{"takerToken": {baseToken.Hex()}},
+			expectedOrders: []*types.OrderWithMetadata{},
+		},
+	}
+	for _, tc := range testCases {
+		var page PaginatedOrdersV4
+		getJSON(t, handler, "/sra/v4/orders?"+tc.query.Encode(), http.StatusOK, &page)
+		sortByHash(tc.expectedOrders)
+		assert.Equal(t, len(tc.expectedOrders), page.Total, tc.query.Encode())
+		assertRecordsV4(t, tc.expectedOrders, page.Records)
+	}
+
+	assertValidationError(t, handler, "/sra/v4/orders?makerToken=foo", "makerToken", ValidationErrorCodeInvalidAddress)
+}
+
+func TestGetOrdersFilters(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	app := newTestApp(t, ctx)
+	handler := NewHandler(app)
+
+	zrxOrder := newTestOrder(constants.ZRXAssetData, constants.WETHAssetData, big.NewInt(1), big.NewInt(1))
+	wethOrder := newTestOrder(constants.WETHAssetData, constants.ZRXAssetData, big.NewInt(1), big.NewInt(1))
+	wethOrder.OrderV3.MakerAddress = trader
+	addOrders(t, app, zrxOrder, wethOrder)
+
+	testCases := []struct {
+		query          url.Values
+		expectedOrders []*types.OrderWithMetadata
+	}{
+		{
+			query:          url.Values{"makerAssetData": {common.ToHex(constants.ZRXAssetData)}},
+			expectedOrders: []*types.OrderWithMetadata{zrxOrder},
+		},
+		{
+			query:          url.Values{"traderAddress": {trader.Hex()}},
+			expectedOrders: []*types.OrderWithMetadata{wethOrder},
+		},
+		{
+			query:          url.Values{"traderAssetData": {common.ToHex(constants.ZRXAssetData)}},
+			expectedOrders: []*types.OrderWithMetadata{zrxOrder, wethOrder},
+		},
+	}
+	for _, tc := range testCases {
+		var page PaginatedOrders
+		getJSON(t, handler, "/sra/v3/orders?"+tc.query.Encode(), http.StatusOK, &page)
+		sortByHash(tc.expectedOrders)
+		assert.Equal(t, len(tc.expectedOrders), page.Total, tc.query.Encode())
+		require.Len(t, page.Records, len(tc.expectedOrders), tc.query.Encode())
+		for i, order := range tc.expectedOrders {
+			assert.Equal(t, order.Hash.Hex(), page.Records[i].MetaData.OrderHash)
+		}
+	}
+
+	assertValidationError(t, handler, "/sra/v3/orders?makerAssetData=foo", "makerAssetData", ValidationErrorCodeIncorrectFormat)
+}
+
+func TestGetOrderbookV4(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	app := newTestApp(t, ctx)
+	handler := NewHandler(app)
+
+	// Asks sell the base token. Their price is takerAmount/makerAmount.
+	asks := []*types.OrderWithMetadata{
+		newTestOrderV4(baseToken, quoteToken, big.NewInt(4), big.NewInt(2)),
+		newTestOrderV4(baseToken, quoteToken, big.NewInt(1), big.NewInt(1)),
+		newTestOrderV4(baseToken, quoteToken, big.NewInt(2), big.NewInt(3)),
+		newTestOrderV4(baseToken, quoteToken, big.NewInt(1), big.NewInt(3)),
+	}
+	// Bids buy the base token. Their price is makerAmount/takerAmount.
+	bids := []*types.OrderWithMetadata{
+		newTestOrderV4(quoteToken, baseToken, big.NewInt(3), big.NewInt(1)),
+		newTestOrderV4(quoteToken, baseToken, big.NewInt(3), big.NewInt(2)),
+		newTestOrderV4(quoteToken, baseToken, big.NewInt(1), big.NewInt(1)),
+	}
+	// Orders for other pairs are not included.
+	addOrders(t, app, append(append(asks, bids...), newTestOrderV4(baseToken, otherToken, big.NewInt(1), big.NewInt(1)))...)
+
+	query := url.Values{
+		"baseToken":  {baseToken.Hex()},
+		"quoteToken": {quoteToken.Hex()},
+		"perPage":    {"2"},
+	}
+	var firstPage OrderbookV4
+	getJSON(t, handler, "/sra/v4/orderbook?"+query.Encode(), http.StatusOK, &firstPage)
+	query.Set("page", "2")
+	var secondPage OrderbookV4
+	getJSON(t, handler, "/sra/v4/orderbook?"+query.Encode(), http.StatusOK, &secondPage)
+
+	assert.Equal(t, len(asks), firstPage.Asks.Total)
+	assert.Equal(t, len(bids), firstPage.Bids.Total)
+	assert.Equal(t, 2, secondPage.Asks.Page)
+	// Asks are sorted by price in ascending order: 0.5, 1, 1.5, 3.
+	assertRecordsV4(t, []*types.OrderWithMetadata{asks[0], asks[1]}, firstPage.Asks.Records)
+	assertRecordsV4(t, []*types.OrderWithMetadata{asks[2], asks[3]}, secondPage.Asks.Records)
+	// Bids are sorted by price in descending order: 3, 1.5, 1.
+	assertRecordsV4(t, []*types.OrderWithMetadata{bids[0], bids[1]}, firstPage.Bids.Records)
+	assertRecordsV4(t, []*types.OrderWithMetadata{bids[2]}, secondPage.Bids.Records)
+
+	assertValidationError(t, handler, "/sra/v4/orderbook?quoteToken="+quoteToken.Hex(), "baseToken", ValidationErrorCodeRequiredField)
+}
+
+func TestGetOrderbook(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	app := newTestApp(t, ctx)
+	handler := NewHandler(app)
+
+	baseAssetData := constants.ZRXAssetData
+	quoteAssetData := constants.WETHAssetData
+	asks := []*types.OrderWithMetadata{
+		newTestOrder(baseAssetData, quoteAssetData, big.NewInt(1), big.NewInt(2)),
+		newTestOrder(baseAssetData, quoteAssetData, big.NewInt(2), big.NewInt(1)),
+	}
+	bids := []*types.OrderWithMetadata{
+		newTestOrder(quoteAssetData, baseAssetData, big.NewInt(1), big.NewInt(2)),
+		newTestOrder(quoteAssetData, baseAssetData, big.NewInt(2), big.NewInt(1)),
+	}
+	addOrders(t, app, append(asks, bids...)...)
+
+	query := url.Values{
+		"baseAssetData":  {common.ToHex(baseAssetData)},
+		"quoteAssetData": {common.ToHex(quoteAssetData)},
+		"perPage":        {"1"},
+	}
+	var firstPage Orderbook
+	getJSON(t, handler, "/sra/v3/orderbook?"+query.Encode(), http.StatusOK, &firstPage)
+	query.Set("page", "2")
+	var secondPage Orderbook
+	getJSON(t, handler, "/sra/v3/orderbook?"+query.Encode(), http.StatusOK, &secondPage)
+
+	assert.Equal(t, 2, firstPage.Asks.Total)
+	assert.Equal(t, 2, firstPage.Bids.Total)
+	require.Len(t, firstPage.Asks.Records, 1)
+	require.Len(t, secondPage.Asks.Records, 1)
+	require.Len(t, firstPage.Bids.Records, 1)
+	require.Len(t, secondPage.Bids.Records, 1)
+	assert.Equal(t, asks[1].Hash.Hex(), firstPage.Asks.Records[0].MetaData.OrderHash)
+	assert.Equal(t, asks[0].Hash.Hex(), secondPage.Asks.Records[0].MetaData.OrderHash)
+	assert.Equal(t, bids[1].Hash.Hex(), firstPage.Bids.Records[0].MetaData.OrderHash)
+	assert.Equal(t, bids[0].Hash.Hex(), secondPage.Bids.Records[0].MetaData.OrderHash)
+}
+
+func TestPostOrderV4(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	app := newTestApp(t, ctx)
+	handler := NewHandler(app)
+
+	order := newTestOrderV4(baseToken, quoteToken, big.NewInt(1), big.NewInt(1))
+	sraOrder, err := OrderV4FromSignedOrder(order.SignedOrderV4())
+	require.NoError(t, err)
+	body, err := json.Marshal(sraOrder)
+	require.NoError(t, err)
+
+	recorder := serve(handler, http.MethodPost, "/sra/v4/order", body)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	require.Len(t, app.addedOrders, 1)
+	assert.Equal(t, []bool{false}, app.addedPinned, "orders added via SRA should not be pinned")
+	assert.Equal(t, []int{4}, app.addedVersion)
+	var meshOrder zeroex.SignedOrderV4
+	require.NoError(t, json.Unmarshal(*app.addedOrders[0], &meshOrder))
+	meshOrderHash, err := meshOrder.ComputeOrderHash()
+	require.NoError(t, err)
+	expectedOrderHash, err := order.SignedOrderV4().ComputeOrderHash()
+	require.NoError(t, err)
+	assert.Equal(t, expectedOrderHash, meshOrderHash)
+
+	// POST /sra/v4/orders accepts a list of orders.
+	recorder = serve(handler, http.MethodPost, "/sra/v4/orders", []byte(fmt.Sprintf("[%s, %s]", body, body)))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Len(t, app.addedOrders, 3)
+	assert.Equal(t, []bool{false, false}, app.addedPinned)
+
+	// Rejected orders are returned as validation errors.
+	app.results = &ordervalidator.ValidationResults{
+		Rejected: []*ordervalidator.RejectedOrderInfo{
+			{
+				OrderHash: order.Hash,
+				Kind:      ordervalidator.ZeroExValidation,
+				Status:    ordervalidator.ROExpired,
+			},
+			{
+				OrderHash: order.Hash,
+				Kind:      ordervalidator.ZeroExValidation,
+				Status:    ordervalidator.ROInvalidSignature,
+			},
+		},
+	}
+	recorder = serve(handler, http.MethodPost, "/sra/v4/order", body)
+	errorResponse := decodeErrorResponse(t, recorder, http.StatusBadRequest)
+	assert.Equal(t, ErrorCodeValidationFailed, errorResponse.Code)
+	require.Len(t, errorResponse.ValidationErrors, 2)
+	assert.Equal(t, ValidationErrorCodeInvalidOrder, errorResponse.ValidationErrors[0].Code)
+	assert.Equal(t, ValidationErrorCodeInvalidSignatureOrHash, errorResponse.ValidationErrors[1].Code)
+
+	malformedBodies := map[string]string{
+		"/sra/v4/order":  `{"makerToken": `,
+		"/sra/v4/orders": `{"makerToken": "0x"}`,
+	}
+	for path, malformedBody := range malformedBodies {
+		recorder = serve(handler, http.MethodPost, path, []byte(malformedBody))
+		errorResponse = decodeErrorResponse(t, recorder, http.StatusBadRequest)
+		assert.Equal(t, ErrorCodeMalformedJSON, errorResponse.Code, path)
+	}
+
+	recorder = serve(handler, http.MethodPut, "/sra/v4/order", body)
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+	assert.Len(t, app.addedOrders, 4, "invalid requests should not add any orders")
+}
+
+func TestPostOrder(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	app := newTestApp(t, ctx)
+	handler := NewHandler(app)
+
+	body, err := json.Marshal(newTestOrder(constants.ZRXAssetData, constants.WETHAssetData, big.NewInt(1), big.NewInt(1)).SignedOrder())
+	require.NoError(t, err)
+	recorder := serve(handler, http.MethodPost, "/sra/v3/order", body)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, []bool{false}, app.addedPinned, "orders added via SRA should not be pinned")
+	assert.Equal(t, []int{3}, app.addedVersion)
+
+	recorder = serve(handler, http.MethodPost, "/sra/v3/order", []byte(`not json`))
+	errorResponse := decodeErrorResponse(t, recorder, http.StatusBadRequest)
+	assert.Equal(t, ErrorCodeMalformedJSON, errorResponse.Code)
+	assert.Len(t, app.addedOrders, 1)
+}
+
+func addOrders(t *testing.T, app *testApp, orders ...*types.OrderWithMetadata) {
+	_, _, _, err := app.AddOrders(orders)
+	require.NoError(t, err)
+}
+
+func sortByHash(orders []*types.OrderWithMetadata) {
+	sort.Slice(orders, func(i, j int) bool {
+		return bytes.Compare(orders[i].Hash.Bytes(), orders[j].Hash.Bytes()) < 0
+	})
+}
+
+func serve(handler http.Handler, method string, target string, body []byte) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, bytes.NewReader(body))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func getJSON(t *testing.T, handler http.Handler, target string, expectedStatus int, response interface{}) {
+	recorder := serve(handler, http.MethodGet, target, nil)
+	require.Equal(t, expectedStatus, recorder.Code, recorder.Body.String())
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), response))
+}
+
+func decodeErrorResponse(t *testing.T, recorder *httptest.ResponseRecorder, expectedStatus int) *ErrorResponse {
+	require.Equal(t, expectedStatus, recorder.Code, recorder.Body.String())
+	var errorResponse ErrorResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &errorResponse))
+	return &errorResponse
+}
+
+func assertValidationError(t *testing.T, handler http.Handler, target string, expectedField string, expectedCode int) {
+	errorResponse := decodeErrorResponse(t, serve(handler, http.MethodGet, target, nil), http.StatusBadRequest)
+	assert.Equal(t, ErrorCodeValidationFailed, errorResponse.Code, target)
+	require.NotEmpty(t, errorResponse.ValidationErrors, target)
+	assert.Equal(t, expectedField, errorResponse.ValidationErrors[0].Field, target)
+	assert.Equal(t, expectedCode, errorResponse.ValidationErrors[0].Code, target)
+}
+
+func assertRecordsV4(t *testing.T, expectedOrders []*types.OrderWithMetadata, records []*OrderRecordV4) {
+	actualHashes := make([]string, len(records))
+	for i, record := range records {
+		actualHashes[i] = record.MetaData.OrderHash
+	}
+	expectedHashes := make([]string, len(expectedOrders))
+	for i, order := range expectedOrders {
+		expectedHashes[i] = order.Hash.Hex()
+	}
+	assert.Equal(t, expectedHashes, actualHashes)
+}
+
+func newTestOrderV4(makerToken, takerToken common.Address, makerAmount, takerAmount *big.Int) *types.OrderWithMetadata {
+	order := &zeroex.OrderV4{
+		ChainID:             big.NewInt(constants.TestChainID),
+		VerifyingContract:   contractAddresses.ExchangeProxy,
+		MakerToken:          makerToken,
+		TakerToken:          takerToken,
+		MakerAmount:         makerAmount,
+		TakerAmount:         takerAmount,
+		Maker:               constants.GanacheAccount1,
+		Taker:               constants.NullAddress,
+		Sender:              constants.NullAddress,
+		FeeRecipient:        constants.NullAddress,
+		Pool:                zeroex.BigToBytes32(big.NewInt(0)),
+		Salt:                big.NewInt(time.Now().UnixNano()),
+		Expiry:              big.NewInt(time.Now().Add(24 * time.Hour).Unix()),
+		TakerTokenFeeAmount: big.NewInt(0),
+	}
+	hash, err := order.ComputeOrderHash()
+	if err != nil {
+		panic(err)
+	}
+	return &types.OrderWithMetadata{
+		Hash:    hash,
+		OrderV4: order,
+		SignatureV4: zeroex.SignatureFieldV4{
+			SignatureType: zeroex.EIP712SignatureV4,
+			V:             27,
+			R:             zeroex.BigToBytes32(big.NewInt(250)),
+			S:             zeroex.BigToBytes32(big.NewInt(250)),
+		},
+		LastUpdated:              time.Now(),
+		FillableTakerAssetAmount: takerAmount,
+		LastValidatedBlockNumber: big.NewInt(1),
+		LastValidatedBlockHash:   common.BigToHash(big.NewInt(1)),
+	}
+}
+
+func newTestOrder(makerAssetData, takerAssetData []byte, makerAssetAmount, takerAssetAmount *big.Int) *types.OrderWithMetadata {
+	order := &zeroex.Order{
+		ChainID:               big.NewInt(constants.TestChainID),
+		MakerAddress:          constants.GanacheAccount1,
+		TakerAddress:          constants.NullAddress,
+		SenderAddress:         constants.NullAddress,
+		FeeRecipientAddress:   constants.NullAddress,
+		MakerAssetData:        makerAssetData,
+		MakerFeeAssetData:     constants.NullBytes,
+		TakerAssetData:        takerAssetData,
+		TakerFeeAssetData:     constants.NullBytes,
+		Salt:                  big.NewInt(time.Now().UnixNano()),
+		MakerFee:              big.NewInt(0),
+		TakerFee:              big.NewInt(0),
+		MakerAssetAmount:      makerAssetAmount,
+		TakerAssetAmount:      takerAssetAmount,
+		ExpirationTimeSeconds: big.NewInt(time.Now().Add(24 * time.Hour).Unix()),
+		ExchangeAddress:       contractAddresses.Exchange,
+	}
+	hash, err := order.ComputeOrderHash()
+	if err != nil {
+		panic(err)
+	}
+	return &types.OrderWithMetadata{
+		Hash:                     hash,
+		OrderV3:                  order,
+		Signature:                []byte{1, 2, 255, 255},
+		LastUpdated:              time.Now(),
+		FillableTakerAssetAmount: takerAssetAmount,
+		LastValidatedBlockNumber: big.NewInt(1),
+		LastValidatedBlockHash:   common.BigToHash(big.NewInt(1)),
+	}
+}
@@ -0,0 +1,181 @@
+package sra
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/0xProject/0x-mesh/zeroex"
+)
+
+// Error codes as defined by the Standard Relayer API.
+const (
+	ErrorCodeValidationFailed = 100
+	ErrorCodeMalformedJSON    = 101
+)
+
+// Validation error codes as defined by the Standard Relayer API.
+const (
+	ValidationErrorCodeRequiredField          = 1000
+	ValidationErrorCodeIncorrectFormat        = 1001
+	ValidationErrorCodeInvalidAddress         = 1002
+	ValidationErrorCodeValueOutOfRange        = 1004
+	ValidationErrorCodeInvalidSignatureOrHash = 1005
+	ValidationErrorCodeInvalidOrder           = 1007
+	ValidationErrorCodeInternalError          = 1008
+)
+
+// ErrorResponse is the body of any response with a 4xx or 5xx status code.
+type ErrorResponse struct {
+	Code             int                `json:"code,omitempty"`
+	Reason           string             `json:"reason"`
+	ValidationErrors []*ValidationError `json:"validationErrors,omitempty"`
+}
+
+// ValidationError describes why a single field of a request is invalid.
+type ValidationError struct {
+	Field  string `json:"field"`
+	Code   int    `json:"code"`
+	Reason string `json:"reason"`
+}
+
+// PaginatedOrders is a single page of v3 orders.
+type PaginatedOrders struct {
+	Total   int            `json:"total"`
+	Page    int            `json:"page"`
+	PerPage int            `json:"perPage"`
+	Records []*OrderRecord `json:"records"`
+}
+
+// OrderRecord is a v3 order together with its metadata.
+type OrderRecord struct {
+	Order    *zeroex.SignedOrder `json:"order"`
+	MetaData OrderMetaData       `json:"metaData"`
+}
+
+// OrderMetaData contains the metadata for a v3 order.
+type OrderMetaData struct {
+	OrderHash                         string `json:"orderHash"`
+	RemainingFillableTakerAssetAmount string `json:"remainingFillableTakerAssetAmount"`
+}
+
+// Orderbook contains the bids and asks for a single v3 asset pair.
+type Orderbook struct {
+	Bids *PaginatedOrders `json:"bids"`
+	Asks *PaginatedOrders `json:"asks"`
+}
+
+// PaginatedOrdersV4 is a single page of v4 orders.
+type PaginatedOrdersV4 struct {
+	Total   int              `json:"total"`
+	Page    int              `json:"page"`
+	PerPage int              `json:"perPage"`
+	Records []*OrderRecordV4 `json:"records"`
+}
+
+// OrderRecordV4 is a v4 order together with its metadata.
+type OrderRecordV4 struct {
+	Order    *OrderV4        `json:"order"`
+	MetaData OrderMetaDataV4 `json:"metaData"`
+}
+
+// OrderMetaDataV4 contains the metadata for a v4 order.
+type OrderMetaDataV4 struct {
+	OrderHash                    string `json:"orderHash"`
+	RemainingFillableTakerAmount string `json:"remainingFillableTakerAmount"`
+}
+
+// OrderbookV4 contains the bids and asks for a single v4 token pair.
+type OrderbookV4 struct {
+	Bids *PaginatedOrdersV4 `json:"bids"`
+	Asks *PaginatedOrdersV4 `json:"asks"`
+}
+
+// OrderV4 is the Standard Relayer API representation of a signed v4 limit
+// order. It differs from zeroex.SignedOrderJSONV4 in that the signature is a
+// nested object and numerical signature fields are encoded as numbers.
+type OrderV4 struct {
+	ChainID             int64       `json:"chainId"`
+	VerifyingContract   string      `json:"verifyingContract"`
+	MakerToken          string      `json:"makerToken"`
+	TakerToken          string      `json:"takerToken"`
+	MakerAmount         string      `json:"makerAmount"`
+	TakerAmount         string      `json:"takerAmount"`
+	TakerTokenFeeAmount string      `json:"takerTokenFeeAmount"`
+	Maker               string      `json:"maker"`
+	Taker               string      `json:"taker"`
+	Sender              string      `json:"sender"`
+	FeeRecipient        string      `json:"feeRecipient"`
+	Pool                string      `json:"pool"`
+	Expiry              string      `json:"expiry"`
+	Salt                string      `json:"salt"`
+	Signature           SignatureV4 `json:"signature"`
+}
+
+// SignatureV4 is the Standard Relayer API representation of a v4 signature.
+type SignatureV4 struct {
+	SignatureType uint8  `json:"signatureType"`
+	V             uint8  `json:"v"`
+	R             string `json:"r"`
+	S             string `json:"s"`
+}
+
+// OrderV4FromSignedOrder converts a zeroex.SignedOrderV4 to its Standard
+// Relayer API representation.
+func OrderV4FromSignedOrder(signedOrder *zeroex.SignedOrderV4) (*OrderV4, error) {
+	meshJSON, err := signedOrder.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	var signedOrderJSON zeroex.SignedOrderJSONV4
+	if err := json.Unmarshal(meshJSON, &signedOrderJSON); err != nil {
+		return nil, err
+	}
+	return &OrderV4{
+		ChainID:             signedOrderJSON.ChainID,
+		VerifyingContract:   signedOrderJSON.VerifyingContract,
+		MakerToken:          signedOrderJSON.MakerToken,
+		TakerToken:          signedOrderJSON.TakerToken,
+		MakerAmount:         signedOrderJSON.MakerAmount,
+		TakerAmount:         signedOrderJSON.TakerAmount,
+		TakerTokenFeeAmount: signedOrderJSON.TakerTokenFeeAmount,
+		Maker:               signedOrderJSON.Maker,
+		Taker:               signedOrderJSON.Taker,
+		Sender:              signedOrderJSON.Sender,
+		FeeRecipient:        signedOrderJSON.FeeRecipient,
+		Pool:                signedOrderJSON.Pool,
+		Expiry:              signedOrderJSON.Expiry,
+		Salt:                signedOrderJSON.Salt,
+		Signature: SignatureV4{
+			SignatureType: uint8(signedOrder.Signature.SignatureType),
+			V:             signedOrder.Signature.V,
+			R:             signedOrderJSON.SignatureR,
+			S:             signedOrderJSON.SignatureS,
+		},
+	}, nil
+}
+
+// MeshJSON converts the order to the JSON representation used by Mesh (see
+// zeroex.SignedOrderJSONV4) so that it can be passed to
+// core.App.AddOrdersRawV4.
+func (o *OrderV4) MeshJSON() (json.RawMessage, error) {
+	return json.Marshal(zeroex.SignedOrderJSONV4{
+		ChainID:             o.ChainID,
+		VerifyingContract:   o.VerifyingContract,
+		MakerToken:          o.MakerToken,
+		TakerToken:          o.TakerToken,
+		MakerAmount:         o.MakerAmount,
+		TakerAmount:         o.TakerAmount,
+		TakerTokenFeeAmount: o.TakerTokenFeeAmount,
+		Maker:               o.Maker,
+		Taker:               o.Taker,
+		Sender:              o.Sender,
+		FeeRecipient:        o.FeeRecipient,
+		Pool:                o.Pool,
+		Expiry:              o.Expiry,
+		Salt:                o.Salt,
+		SignatureType:       strconv.FormatUint(uint64(o.Signature.SignatureType), 10),
+		SignatureV:          strconv.FormatUint(uint64(o.Signature.V), 10),
+		SignatureR:          o.Signature.R,
+		SignatureS:          o.Signature.S,
+	})
+}
@@ -0,0 +1,326 @@
+package sra
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/db"
+)
+
+// handleOrders handles GET /sra/v3/orders. Orders are sorted by hash.
+func (h *Handler) handleOrders(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+	query := r.URL.Query()
+	p, validationErr := parsePagination(query)
+	if validationErr != nil {
+		writeValidationErrors(w, validationErr)
+		return
+	}
+	filters, validationErr := orderFiltersFromQuery(query)
+	if validationErr != nil {
+		writeValidationErrors(w, validationErr)
+		return
+	}
+
+	stats, err := h.app.GetOrderStats(&db.OrderQuery{Filters: filters})
+	if err != nil {
+		writeInternalError(w, err)
+		return
+	}
+	orders, err := h.app.FindOrders(&db.OrderQuery{
+		Filters: filters,
+		Sort: []db.OrderSort{
+			{
+				Field:     db.OFHash,
+				Direction: db.Ascending,
+			},
+		},
+		Limit:  uint(p.perPage),
+		Offset: uint(p.offset()),
+	})
+	if err != nil {
+		writeInternalError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, newPaginatedOrders(orders, stats.OrderCount, p))
+}
+
+// handleGetOrder handles GET /sra/v3/order/{orderHash}.
+func (h *Handler) handleGetOrder(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+	hash, validationErr := parseHashFromPath(r.URL.Path)
+	if validationErr != nil {
+		writeValidationErrors(w, validationErr)
+		return
+	}
+	order, err := h.app.GetOrder(hash)
+	if err != nil {
+		if err == db.ErrNotFound {
+			writeNotFound(w)
+			return
+		}
+		writeInternalError(w, err)
+		return
+	}
+	if order.IsRemoved {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, newOrderRecord(order))
+}
+
+// handleOrderbook handles GET /sra/v3/orderbook. Bids are orders where
+// makerAssetData is the quote asset and takerAssetData is the base asset. Asks
+// are orders where makerAssetData is the base asset and takerAssetData is the
+// quote asset. Bids are sorted by price in descending order and asks in
+// ascending order. Both are paginated by the database.
+func (h *Handler) handleOrderbook(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+	query := r.URL.Query()
+	p, validationErr := parsePagination(query)
+	if validationErr != nil {
+		writeValidationErrors(w, validationErr)
+		return
+	}
+	var validationErrors []*ValidationError
+	baseAssetData, validationErr := parseBytesParam(query, "baseAssetData")
+	if validationErr != nil {
+		validationErrors = append(validationErrors, validationErr)
+	} else if baseAssetData == nil {
+		validationErrors = append(validationErrors, &ValidationError{
+			Field:  "baseAssetData",
+			Code:   ValidationErrorCodeRequiredField,
+			Reason: "baseAssetData is required",
+		})
+	}
+	quoteAssetData, validationErr := parseBytesParam(query, "quoteAssetData")
+	if validationErr != nil {
+		validationErrors = append(validationErrors, validationErr)
+	} else if quoteAssetData == nil {
+		validationErrors = append(validationErrors, &ValidationError{
+			Field:  "quoteAssetData",
+			Code:   ValidationErrorCodeRequiredField,
+			Reason: "quoteAssetData is required",
+		})
+	}
+	if len(validationErrors) > 0 {
+		writeValidationErrors(w, validationErrors...)
+		return
+	}
+
+	bids, err := h.findOrderbookOrders(quoteAssetData, baseAssetData, p)
+	if err != nil {
+		writeInternalError(w, err)
+		return
+	}
+	asks, err := h.findOrderbookOrders(baseAssetData, quoteAssetData, p)
+	if err != nil {
+		writeInternalError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, &Orderbook{
+		Bids: bids,
+		Asks: asks,
+	})
+}
+
+// findOrderbookOrders returns the current page of orders with the given maker
+// and taker asset data. Orders are sorted by their price in the database, i.e.
+// takerAssetAmount/makerAssetAmount, in ascending order. For asks this is the
+// amount of quote asset per unit of base asset. For bids it is the inverse, so
+// sorting in ascending order sorts bids by their price in descending order.
+// Orders with the same price are sorted by hash.
+func (h *Handler) findOrderbookOrders(makerAssetData, takerAssetData []byte, p pagination) (*PaginatedOrders, error) {
+	filters := []db.OrderFilter{
+		{
+			Field: db.OFIsRemoved,
+			Kind:  db.Equal,
+			Value: false,
+		},
+		{
+			Field: db.OFMakerAssetData,
+			Kind:  db.Equal,
+			Value: makerAssetData,
+		},
+		{
+			Field: db.OFTakerAssetData,
+			Kind:  db.Equal,
+			Value: takerAssetData,
+		},
+	}
+	stats, err := h.app.GetOrderStats(&db.OrderQuery{Filters: filters})
+	if err != nil {
+		return nil, err
+	}
+	orders, err := h.app.FindOrders(&db.OrderQuery{
+		Filters: filters,
+		Sort: []db.OrderSort{
+			{
+				Field:     db.OFPrice,
+				Direction: db.Ascending,
+			},
+			{
+				Field:     db.OFHash,
+				Direction: db.Ascending,
+			},
+		},
+		Limit:  uint(p.perPage),
+		Offset: uint(p.offset()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newPaginatedOrders(orders, stats.OrderCount, p), nil
+}
+
+// handlePostOrder handles POST /sra/v3/order.
+func (h *Handler) handlePostOrder(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeMethodNotAllowed(w, http.MethodPost)
+		return
+	}
+	ordersRaw, err := readOrdersRaw(w, r, false)
+	if err != nil {
+		writeMalformedJSON(w)
+		return
+	}
+	results, err := h.app.AddOrdersRaw(r.Context(), ordersRaw, false, nil)
+	if err != nil {
+		writeInternalError(w, err)
+		return
+	}
+	writeValidationResults(w, results)
+}
+
+// orderFiltersFromQuery converts the query parameters of a GET /sra/v3/orders
+// request to database filters. Unsupported query parameters are ignored.
+func orderFiltersFromQuery(query url.Values) ([]db.OrderFilter, *ValidationError) {
+	filters := []db.OrderFilter{
+		{
+			Field: db.OFIsRemoved,
+			Kind:  db.Equal,
+			Value: false,
+		},
+	}
+	addressFields := []struct {
+		param string
+		field db.OrderField
+	}{
+		{param: "exchangeAddress", field: db.OFExchangeAddress},
+		{param: "makerAddress", field: db.OFMakerAddress},
+		{param: "takerAddress", field: db.OFTakerAddress},
+		{param: "senderAddress", field: db.OFSenderAddress},
+		{param: "feeRecipientAddress", field: db.OFFeeRecipientAddress},
+	}
+	for _, addressField := range addressFields {
+		address, validationErr := parseAddressParam(query, addressField.param)
+		if validationErr != nil {
+			return nil, validationErr
+		}
+		if address != nil {
+			filters = append(filters, db.OrderFilter{
+				Field: addressField.field,
+				Kind:  db.Equal,
+				Value: *address,
+			})
+		}
+	}
+	assetDataFields := []struct {
+		param string
+		field db.OrderField
+	}{
+		{param: "makerAssetData", field: db.OFMakerAssetData},
+		{param: "takerAssetData", field: db.OFTakerAssetData},
+		{param: "makerFeeAssetData", field: db.OFMakerFeeAssetData},
+		{param: "takerFeeAssetData", field: db.OFTakerFeeAssetData},
+	}
+	for _, assetDataField := range assetDataFields {
+		assetData, validationErr := parseBytesParam(query, assetDataField.param)
+		if validationErr != nil {
+			return nil, validationErr
+		}
+		if assetData != nil {
+			filters = append(filters, db.OrderFilter{
+				Field: assetDataField.field,
+				Kind:  db.Equal,
+				Value: assetData,
+			})
+		}
+	}
+
+	makerAssetAddress, validationErr := parseAddressParam(query, "makerAssetAddress")
+	if validationErr != nil {
+		return nil, validationErr
+	}
+	if makerAssetAddress != nil {
+		filters = append(filters, db.MakerAssetIncludesTokenAddress(*makerAssetAddress))
+	}
+	takerAssetAddress, validationErr := parseAddressParam(query, "takerAssetAddress")
+	if validationErr != nil {
+		return nil, validationErr
+	}
+	if takerAssetAddress != nil {
+		filters = append(filters, db.TakerAssetIncludesTokenAddress(*takerAssetAddress))
+	}
+
+	// traderAddress and traderAssetData match orders where either the maker or
+	// the taker side matches.
+	traderAddress, validationErr := parseAddressParam(query, "traderAddress")
+	if validationErr != nil {
+		return nil, validationErr
+	}
+	if traderAddress != nil {
+		filters = append(filters, eitherSideFilter(db.OFMakerAddress, db.OFTakerAddress, *traderAddress))
+	}
+	traderAssetData, validationErr := parseBytesParam(query, "traderAssetData")
+	if validationErr != nil {
+		return nil, validationErr
+	}
+	if traderAssetData != nil {
+		filters = append(filters, eitherSideFilter(db.OFMakerAssetData, db.OFTakerAssetData, traderAssetData))
+	}
+	return filters, nil
+}
+
+func eitherSideFilter(makerField, takerField db.OrderField, value interface{}) db.OrderFilter {
+	return db.OrderFilter{
+		Kind: db.Or,
+		Groups: [][]db.OrderFilter{
+			{{Field: makerField, Kind: db.Equal, Value: value}},
+			{{Field: takerField, Kind: db.Equal, Value: value}},
+		},
+	}
+}
+
+func newPaginatedOrders(orders []*types.OrderWithMetadata, total int, p pagination) *PaginatedOrders {
+	records := make([]*OrderRecord, len(orders))
+	for i, order := range orders {
+		records[i] = newOrderRecord(order)
+	}
+	return &PaginatedOrders{
+		Total:   total,
+		Page:    p.page,
+		PerPage: p.perPage,
+		Records: records,
+	}
+}
+
+func newOrderRecord(order *types.OrderWithMetadata) *OrderRecord {
+	return &OrderRecord{
+		Order: order.SignedOrder(),
+		MetaData: OrderMetaData{
+			OrderHash:                         order.Hash.Hex(),
+			RemainingFillableTakerAssetAmount: order.FillableTakerAssetAmount.String(),
+		},
+	}
+}
@@ -0,0 +1,329 @@
+package sra
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/db"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// handleOrdersV4 handles GET /sra/v4/orders and POST /sra/v4/orders. Orders
+// returned by GET requests are sorted by hash. POST requests accept a list of
+// orders.
+func (h *Handler) handleOrdersV4(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.getOrdersV4(w, r)
+	case http.MethodPost:
+		h.postOrdersV4(w, r, true)
+	default:
+		writeMethodNotAllowed(w, http.MethodGet+", "+http.MethodPost)
+	}
+}
+
+func (h *Handler) getOrdersV4(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	p, validationErr := parsePagination(query)
+	if validationErr != nil {
+		writeValidationErrors(w, validationErr)
+		return
+	}
+	filters, validationErr := orderFiltersV4FromQuery(query)
+	if validationErr != nil {
+		writeValidationErrors(w, validationErr)
+		return
+	}
+
+	stats, err := h.app.GetOrderStatsV4(&db.OrderQueryV4{Filters: filters})
+	if err != nil {
+		writeInternalError(w, err)
+		return
+	}
+	orders, err := h.app.FindOrdersV4(&db.OrderQueryV4{
+		Filters: filters,
+		Sort: []db.OrderSortV4{
+			{
+				Field:     db.OV4FHash,
+				Direction: db.Ascending,
+			},
+		},
+		Limit:  uint(p.perPage),
+		Offset: uint(p.offset()),
+	})
+	if err != nil {
+		writeInternalError(w, err)
+		return
+	}
+	paginatedOrders, err := newPaginatedOrdersV4(orders, stats.OrderCount, p)
+	if err != nil {
+		writeInternalError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, paginatedOrders)
+}
+
+// handleGetOrderV4 handles GET /sra/v4/order/{orderHash}.
+func (h *Handler) handleGetOrderV4(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+	hash, validationErr := parseHashFromPath(r.URL.Path)
+	if validationErr != nil {
+		writeValidationErrors(w, validationErr)
+		return
+	}
+	order, err := h.app.GetOrderV4(hash)
+	if err != nil {
+		if err == db.ErrNotFound {
+			writeNotFound(w)
+			return
+		}
+		writeInternalError(w, err)
+		return
+	}
+	if order.IsRemoved {
+		writeNotFound(w)
+		return
+	}
+	record, err := newOrderRecordV4(order)
+	if err != nil {
+		writeInternalError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, record)
+}
+
+// handleOrderbookV4 handles GET /sra/v4/orderbook. Bids are orders where
+// makerToken is the quote token and takerToken is the base token. Asks are
+// orders where makerToken is the base token and takerToken is the quote token.
+// Bids are sorted by price in descending order and asks in ascending order.
+// Both are paginated by the database.
+func (h *Handler) handleOrderbookV4(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+	query := r.URL.Query()
+	p, validationErr := parsePagination(query)
+	if validationErr != nil {
+		writeValidationErrors(w, validationErr)
+		return
+	}
+	var validationErrors []*ValidationError
+	baseToken, validationErr := parseAddressParam(query, "baseToken")
+	if validationErr != nil {
+		validationErrors = append(validationErrors, validationErr)
+	} else if baseToken == nil {
+		validationErrors = append(validationErrors, &ValidationError{
+			Field:  "baseToken",
+			Code:   ValidationErrorCodeRequiredField,
+			Reason: "baseToken is required",
+		})
+	}
+	quoteToken, validationErr := parseAddressParam(query, "quoteToken")
+	if validationErr != nil {
+		validationErrors = append(validationErrors, validationErr)
+	} else if quoteToken == nil {
+		validationErrors = append(validationErrors, &ValidationError{
+			Field:  "quoteToken",
+			Code:   ValidationErrorCodeRequiredField,
+			Reason: "quoteToken is required",
+		})
+	}
+	if len(validationErrors) > 0 {
+		writeValidationErrors(w, validationErrors...)
+		return
+	}
+
+	bids, err := h.findOrderbookOrdersV4(*quoteToken, *baseToken, p)
+	if err != nil {
+		writeInternalError(w, err)
+		return
+	}
+	asks, err := h.findOrderbookOrdersV4(*baseToken, *quoteToken, p)
+	if err != nil {
+		writeInternalError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, &OrderbookV4{
+		Bids: bids,
+		Asks: asks,
+	})
+}
+
+// findOrderbookOrdersV4 returns the current page of orders with the given
+// maker and taker tokens. Orders are sorted by their price in the database,
+// i.e. takerAmount/makerAmount, in ascending order. For asks this is the amount
+// of quote token per unit of base token. For bids it is the inverse, so sorting
+// in ascending order sorts bids by their price in descending order. Orders with
+// the same price are sorted by hash.
+func (h *Handler) findOrderbookOrdersV4(makerToken, takerToken common.Address, p pagination) (*PaginatedOrdersV4, error) {
+	filters := []db.OrderFilterV4{
+		{
+			Field: db.OV4FIsRemoved,
+			Kind:  db.Equal,
+			Value: false,
+		},
+		{
+			Field: db.OV4FMakerToken,
+			Kind:  db.Equal,
+			Value: makerToken,
+		},
+		{
+			Field: db.OV4FTakerToken,
+			Kind:  db.Equal,
+			Value: takerToken,
+		},
+	}
+	stats, err := h.app.GetOrderStatsV4(&db.OrderQueryV4{Filters: filters})
+	if err != nil {
+		return nil, err
+	}
+	orders, err := h.app.FindOrdersV4(&db.OrderQueryV4{
+		Filters: filters,
+		Sort: []db.OrderSortV4{
+			{
+				Field:     db.OV4FPrice,
+				Direction: db.Ascending,
+			},
+			{
+				Field:     db.OV4FHash,
+				Direction: db.Ascending,
+			},
+		},
+		Limit:  uint(p.perPage),
+		Offset: uint(p.offset()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newPaginatedOrdersV4(orders, stats.OrderCount, p)
+}
+
+// handlePostOrderV4 handles POST /sra/v4/order, which accepts a single order.
+func (h *Handler) handlePostOrderV4(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeMethodNotAllowed(w, http.MethodPost)
+		return
+	}
+	h.postOrdersV4(w, r, false)
+}
+
+func (h *Handler) postOrdersV4(w http.ResponseWriter, r *http.Request, isList bool) {
+	ordersRaw, err := readOrdersRaw(w, r, isList)
+	if err != nil {
+		writeMalformedJSON(w)
+		return
+	}
+	// Convert each order from the SRA representation to the representation used
+	// by Mesh so that the orders are validated against the Mesh JSON schema.
+	meshOrdersRaw := make([]*json.RawMessage, len(ordersRaw))
+	for i, orderRaw := range ordersRaw {
+		var order OrderV4
+		if err := json.Unmarshal(*orderRaw, &order); err != nil {
+			writeMalformedJSON(w)
+			return
+		}
+		meshOrderRaw, err := order.MeshJSON()
+		if err != nil {
+			writeInternalError(w, err)
+			return
+		}
+		meshOrdersRaw[i] = &meshOrderRaw
+	}
+	results, err := h.app.AddOrdersRawV4(r.Context(), meshOrdersRaw, false, nil)
+	if err != nil {
+		writeInternalError(w, err)
+		return
+	}
+	writeValidationResults(w, results)
+}
+
+// orderFiltersV4FromQuery converts the query parameters of a GET
+// /sra/v4/orders request to database filters. Unsupported query parameters are
+// ignored.
+func orderFiltersV4FromQuery(query url.Values) ([]db.OrderFilterV4, *ValidationError) {
+	filters := []db.OrderFilterV4{
+		{
+			Field: db.OV4FIsRemoved,
+			Kind:  db.Equal,
+			Value: false,
+		},
+	}
+	addressFields := []struct {
+		param string
+		field db.OrderFieldV4
+	}{
+		{param: "verifyingContract", field: db.OV4FVerifyingContract},
+		{param: "makerToken", field: db.OV4FMakerToken},
+		{param: "takerToken", field: db.OV4FTakerToken},
+		{param: "maker", field: db.OV4FMaker},
+		{param: "taker", field: db.OV4FTaker},
+		{param: "sender", field: db.OV4FSender},
+		{param: "feeRecipient", field: db.OV4FFeeRecipient},
+	}
+	for _, addressField := range addressFields {
+		address, validationErr := parseAddressParam(query, addressField.param)
+		if validationErr != nil {
+			return nil, validationErr
+		}
+		if address != nil {
+			filters = append(filters, db.OrderFilterV4{
+				Field: addressField.field,
+				Kind:  db.Equal,
+				Value: *address,
+			})
+		}
+	}
+
+	// trader matches orders where either the maker or the taker matches.
+	trader, validationErr := parseAddressParam(query, "trader")
+	if validationErr != nil {
+		return nil, validationErr
+	}
+	if trader != nil {
+		filters = append(filters, db.OrderFilterV4{
+			Kind: db.Or,
+			Groups: [][]db.OrderFilterV4{
+				{{Field: db.OV4FMaker, Kind: db.Equal, Value: *trader}},
+				{{Field: db.OV4FTaker, Kind: db.Equal, Value: *trader}},
+			},
+		})
+	}
+	return filters, nil
+}
+
+func newPaginatedOrdersV4(orders []*types.OrderWithMetadata, total int, p pagination) (*PaginatedOrdersV4, error) {
+	records := make([]*OrderRecordV4, len(orders))
+	for i, order := range orders {
+		record, err := newOrderRecordV4(order)
+		if err != nil {
+			return nil, err
+		}
+		records[i] = record
+	}
+	return &PaginatedOrdersV4{
+		Total:   total,
+		Page:    p.page,
+		PerPage: p.perPage,
+		Records: records,
+	}, nil
+}
+
+func newOrderRecordV4(order *types.OrderWithMetadata) (*OrderRecordV4, error) {
+	sraOrder, err := OrderV4FromSignedOrder(order.SignedOrderV4())
+	if err != nil {
+		return nil, err
+	}
+	return &OrderRecordV4{
+		Order: sraOrder,
+		MetaData: OrderMetaDataV4{
+			OrderHash:                    order.Hash.Hex(),
+			RemainingFillableTakerAmount: order.FillableTakerAssetAmount.String(),
+		},
+	}, nil
+}
@@ -140,6 +140,10 @@ type OrderEvent struct {
 	// They did not all necessarily cause the orders state change itself, only it's re-evaluation.
 	// Since it's state _did_ change, at least one of them did cause the actual state change.
 	ContractEvents []*ContractEvent `json:"contractEvents"`
+	// SequenceNumber is the position of this event in the persisted order event
+	// log. Sequence numbers start at 1 and are strictly increasing. It is 0 if
+	// the event has not been persisted.
+	SequenceNumber uint64 `json:"sequenceNumber"`
 }
 
 type orderEventJSON struct {
@@ -150,6 +154,7 @@ type orderEventJSON struct {
 	EndState                 string               `json:"endState"`
 	FillableTakerAssetAmount string               `json:"fillableTakerAssetAmount"`
 	ContractEvents           []*contractEventJSON `json:"contractEvents"`
+	SequenceNumber           uint64               `json:"sequenceNumber"`
 }
 
 // MarshalJSON implements a custom JSON marshaller for the OrderEvent type
@@ -162,6 +167,7 @@ func (o OrderEvent) MarshalJSON() ([]byte, error) {
 		"endState":                 o.EndState,
 		"fillableTakerAssetAmount": o.FillableTakerAssetAmount.String(),
 		"contractEvents":           o.ContractEvents,
+		"sequenceNumber":           o.SequenceNumber,
 	})
 }
 
@@ -181,6 +187,7 @@ func (o *OrderEvent) fromOrderEventJSON(orderEventJSON orderEventJSON) error {
 	o.SignedOrder = orderEventJSON.SignedOrder
 	o.SignedOrderV4 = orderEventJSON.SignedOrderV4
 	o.EndState = OrderEventEndState(orderEventJSON.EndState)
+	o.SequenceNumber = orderEventJSON.SequenceNumber
 	var ok bool
 	o.FillableTakerAssetAmount, ok = math.ParseBig256(orderEventJSON.FillableTakerAssetAmount)
 	if !ok {
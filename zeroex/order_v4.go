@@ -56,6 +56,16 @@ type SignatureFieldV4 struct {
 	S             Bytes32         `json:"s"`
 }
 
+// Bytes returns the signature as a single byte slice consisting of the
+// signature type, v, r and s, in that order. Signatures of the same type are
+// sorted by v, then r, then s when the byte slices are compared.
+func (s SignatureFieldV4) Bytes() []byte {
+	result := make([]byte, 0, 66)
+	result = append(result, byte(s.SignatureType), s.V)
+	result = append(result, s.R.Bytes()...)
+	return append(result, s.S.Bytes()...)
+}
+
 // SignatureTypeV4 represents the type of 0x signature encountered
 type SignatureTypeV4 uint8
 
@@ -177,6 +177,14 @@ var (
 		Code:    "TakerAddressNotAllowed",
 		Message: "the taker address is not a whitelisted address",
 	}
+	ROMakerQuotaExceeded = RejectedOrderStatus{
+		Code:    "MakerQuotaExceeded",
+		Message: "the maker already has the maximum number of orders in storage and the order doesn't expire sooner than any of them (see MAX_ORDERS_PER_MAKER)",
+	}
+	ROPeerQuotaExceeded = RejectedOrderStatus{
+		Code:    "PeerQuotaExceeded",
+		Message: "the peer already sent the maximum number of orders in storage and the order doesn't expire sooner than any of them (see MAX_ORDERS_PER_PEER)",
+	}
 )
 
 // ROInvalidSchemaCode is the RejectedOrderStatus emitted if an order doesn't conform to the order schema
@@ -804,8 +804,8 @@ func (d *Decoder) RemoveKnownERC20(address common.Address) {
 	delete(d.knownERC20Addresses, address)
 }
 
-// isKnownERC20 checks if the supplied address is a known ERC20 contract
-func (d *Decoder) isKnownERC20(address common.Address) bool {
+// IsKnownERC20 checks if the supplied address is a known ERC20 contract
+func (d *Decoder) IsKnownERC20(address common.Address) bool {
 	d.knownERC20AddressesMu.RLock()
 	defer d.knownERC20AddressesMu.RUnlock()
 	_, exists := d.knownERC20Addresses[address]
@@ -829,8 +829,8 @@ func (d *Decoder) RemoveKnownERC721(address common.Address) {
 	delete(d.knownERC721Addresses, address)
 }
 
-// isKnownERC721 checks if the supplied address is a known ERC721 contract
-func (d *Decoder) isKnownERC721(address common.Address) bool {
+// IsKnownERC721 checks if the supplied address is a known ERC721 contract
+func (d *Decoder) IsKnownERC721(address common.Address) bool {
 	d.knownERC721AddressesMu.RLock()
 	defer d.knownERC721AddressesMu.RUnlock()
 	_, exists := d.knownERC721Addresses[address]
@@ -854,8 +854,8 @@ func (d *Decoder) RemoveKnownERC1155(address common.Address) {
 	delete(d.knownERC1155Addresses, address)
 }
 
-// isKnownERC1155 checks if the supplied address is a known ERC1155 contract
-func (d *Decoder) isKnownERC1155(address common.Address) bool {
+// IsKnownERC1155 checks if the supplied address is a known ERC1155 contract
+func (d *Decoder) IsKnownERC1155(address common.Address) bool {
 	d.knownERC1155AddressesMu.RLock()
 	defer d.knownERC1155AddressesMu.RUnlock()
 	_, exists := d.knownERC1155Addresses[address]
@@ -879,8 +879,8 @@ func (d *Decoder) RemoveKnownExchange(address common.Address) {
 	delete(d.knownExchangeAddresses, address)
 }
 
-// isKnownExchange checks if the supplied address is a known Exchange contract address
-func (d *Decoder) isKnownExchange(address common.Address) bool {
+// IsKnownExchange checks if the supplied address is a known Exchange contract address
+func (d *Decoder) IsKnownExchange(address common.Address) bool {
 	d.knownExchangeAddressesMu.RLock()
 	defer d.knownExchangeAddressesMu.RUnlock()
 	_, exists := d.knownExchangeAddresses[address]
@@ -891,7 +891,7 @@ func (d *Decoder) isKnownExchange(address common.Address) bool {
 // contract addresses and the log topic.
 func (d *Decoder) FindEventType(log types.Log) (string, error) {
 	firstTopic := log.Topics[0]
-	if isKnown := d.isKnownERC20(log.Address); isKnown {
+	if isKnown := d.IsKnownERC20(log.Address); isKnown {
 		eventName, ok := d.erc20TopicToEventName[firstTopic]
 		if !ok {
 			return "", UnsupportedEventError{Topics: log.Topics, ContractAddress: log.Address}
@@ -901,21 +901,21 @@ func (d *Decoder) FindEventType(log types.Log) (string, error) {
 		}
 		return fmt.Sprintf("ERC20%sEvent", eventName), nil
 	}
-	if isKnown := d.isKnownERC721(log.Address); isKnown {
+	if isKnown := d.IsKnownERC721(log.Address); isKnown {
 		eventName, ok := d.erc721TopicToEventName[firstTopic]
 		if !ok {
 			return "", UnsupportedEventError{Topics: log.Topics, ContractAddress: log.Address}
 		}
 		return fmt.Sprintf("ERC721%sEvent", eventName), nil
 	}
-	if isKnown := d.isKnownERC1155(log.Address); isKnown {
+	if isKnown := d.IsKnownERC1155(log.Address); isKnown {
 		eventName, ok := d.erc1155TopicToEventName[firstTopic]
 		if !ok {
 			return "", UnsupportedEventError{Topics: log.Topics, ContractAddress: log.Address}
 		}
 		return fmt.Sprintf("ERC1155%sEvent", eventName), nil
 	}
-	if isKnown := d.isKnownExchange(log.Address); isKnown {
+	if isKnown := d.IsKnownExchange(log.Address); isKnown {
 		eventName, ok := d.exchangeTopicToEventName[firstTopic]
 		if ok {
 			return fmt.Sprintf("Exchange%sEvent", eventName), nil
@@ -933,16 +933,16 @@ func (d *Decoder) FindEventType(log types.Log) (string, error) {
 // Decode attempts to decode the supplied log given the event types relevant to 0x orders. The
 // decoded result is stored in the value pointed to by supplied `decodedLog` struct.
 func (d *Decoder) Decode(log types.Log, decodedLog interface{}) error {
-	if isKnown := d.isKnownERC20(log.Address); isKnown {
+	if isKnown := d.IsKnownERC20(log.Address); isKnown {
 		return d.decodeERC20(log, decodedLog)
 	}
-	if isKnown := d.isKnownERC721(log.Address); isKnown {
+	if isKnown := d.IsKnownERC721(log.Address); isKnown {
 		return d.decodeERC721(log, decodedLog)
 	}
-	if isKnown := d.isKnownERC1155(log.Address); isKnown {
+	if isKnown := d.IsKnownERC1155(log.Address); isKnown {
 		return d.decodeERC1155(log, decodedLog)
 	}
-	if isKnown := d.isKnownExchange(log.Address); isKnown {
+	if isKnown := d.IsKnownExchange(log.Address); isKnown {
 		return d.decodeExchange(log, decodedLog)
 	}
 
@@ -0,0 +1,300 @@
+package orderwatch
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/db"
+	"github.com/0xProject/0x-mesh/zeroex"
+	"github.com/ethereum/go-ethereum/common"
+	logger "github.com/sirupsen/logrus"
+)
+
+// IntegrityCheckResult describes the inconsistencies between the stored orders
+// and the state of the Watcher that were found by CheckIntegrity.
+type IntegrityCheckResult struct {
+	// NumOrdersChecked is the number of v3 and v4 orders that were checked.
+	NumOrdersChecked int
+	// OrdersWithStaleAssetData are the hashes of the v3 orders whose parsed
+	// asset data didn't match their asset data. The Watcher looks up the orders
+	// which are affected by a contract event by their parsed asset data, so
+	// events for a token in the asset data are missed for these orders.
+	OrdersWithStaleAssetData []common.Hash
+	// OrdersWithUnknownContracts are the hashes of the orders that use a token
+	// or exchange contract which isn't registered with the event decoder.
+	// Events from contracts which aren't registered are ignored.
+	// Orders that are marked as removed are not watched and are not checked
+	// for unknown contracts.
+	OrdersWithUnknownContracts []common.Hash
+	// UnrecoverableOrders are the hashes of the v3 orders whose asset data
+	// couldn't be decoded.
+	UnrecoverableOrders []common.Hash
+	// Repaired is true if the inconsistencies were repaired. Orders with stale
+	// asset data are updated, the unknown contracts are registered with the
+	// event decoder and unrecoverable orders are marked for revalidation by the
+	// next cleanup.
+	Repaired bool
+}
+
+// NumProblems returns the number of inconsistencies that were found.
+func (r *IntegrityCheckResult) NumProblems() int {
+	return len(r.OrdersWithStaleAssetData) + len(r.OrdersWithUnknownContracts) + len(r.UnrecoverableOrders)
+}
+
+// decoderContract is a contract that has to be registered with the event
+// decoder so that events from it are decoded.
+type decoderContract struct {
+	// kind is the name of the token standard (e.g. "ERC20Token") or "Exchange".
+	kind    string
+	address common.Address
+}
+
+// CheckIntegrity checks that the parsed asset data of each stored order
+// matches its asset data and that all of the contracts that are used by the
+// stored orders are registered with the event decoder. If repair is true, the
+// inconsistencies are repaired. Once Watch has been called, the cleanup worker
+// also runs the check (with repair) after each cleanup.
+func (w *Watcher) CheckIntegrity(repair bool) (*IntegrityCheckResult, error) {
+	w.handleBlockEventsMu.Lock()
+	defer w.handleBlockEventsMu.Unlock()
+
+	orders, err := w.db.FindOrders(nil)
+	if err != nil {
+		return nil, err
+	}
+	ordersV4, err := w.db.FindOrdersV4(nil)
+	if err != nil {
+		return nil, err
+	}
+	result := &IntegrityCheckResult{
+		NumOrdersChecked: len(orders) + len(ordersV4),
+		Repaired:         repair,
+	}
+	for _, order := range orders {
+		if err := w.checkOrderIntegrity(order, repair, result); err != nil {
+			return nil, err
+		}
+	}
+	for _, order := range ordersV4 {
+		if err := w.checkOrderIntegrity(order, repair, result); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (w *Watcher) checkOrderIntegrity(order *types.OrderWithMetadata, repair bool, result *IntegrityCheckResult) error {
+	contracts, err := w.decoderContractsForOrder(order)
+	if err != nil {
+		return w.handleUnrecoverableOrder(order, err, repair, result)
+	}
+	if order.IsRemoved {
+		contracts = nil
+	}
+	hasUnknownContracts := false
+	for _, contract := range contracts {
+		if w.isKnownDecoderContract(contract) {
+			continue
+		}
+		logger.WithFields(logger.Fields{
+			"orderHash":       order.Hash.Hex(),
+			"contractAddress": contract.address.Hex(),
+			"kind":            contract.kind,
+		}).Warn("order uses a contract which is not registered with the event decoder")
+		hasUnknownContracts = true
+		if repair {
+			w.registerDecoderContract(contract)
+		}
+	}
+	if hasUnknownContracts {
+		result.OrdersWithUnknownContracts = append(result.OrdersWithUnknownContracts, order.Hash)
+	}
+
+	if order.OrderV3 == nil {
+		return nil
+	}
+	var parsedAssetData [4][]*types.SingleAssetData
+	for i, assetData := range [][]byte{order.OrderV3.MakerAssetData, order.OrderV3.MakerFeeAssetData, order.OrderV3.TakerAssetData, order.OrderV3.TakerFeeAssetData} {
+		parsedAssetData[i], err = db.ParseContractAddressesAndTokenIdsFromAssetData(w.assetDataDecoder, assetData, w.contractAddresses)
+		if err != nil {
+			return w.handleUnrecoverableOrder(order, err, repair, result)
+		}
+	}
+	if singleAssetDatasAreEqual(parsedAssetData[0], order.ParsedMakerAssetData) &&
+		singleAssetDatasAreEqual(parsedAssetData[1], order.ParsedMakerFeeAssetData) &&
+		singleAssetDatasAreEqual(parsedAssetData[2], order.ParsedTakerAssetData) &&
+		singleAssetDatasAreEqual(parsedAssetData[3], order.ParsedTakerFeeAssetData) {
+		return nil
+	}
+	logger.WithField("orderHash", order.Hash.Hex()).Warn("parsed asset data of order does not match its asset data")
+	result.OrdersWithStaleAssetData = append(result.OrdersWithStaleAssetData, order.Hash)
+	if !repair {
+		return nil
+	}
+	return w.db.UpdateOrder(order.Hash, func(existingOrder *types.OrderWithMetadata) (*types.OrderWithMetadata, error) {
+		existingOrder.ParsedMakerAssetData = parsedAssetData[0]
+		existingOrder.ParsedMakerFeeAssetData = parsedAssetData[1]
+		existingOrder.ParsedTakerAssetData = parsedAssetData[2]
+		existingOrder.ParsedTakerFeeAssetData = parsedAssetData[3]
+		return existingOrder, nil
+	})
+}
+
+// handleUnrecoverableOrder records an order whose asset data couldn't be
+// decoded. If repair is true, the order is marked for revalidation by setting
+// its LastUpdated time to the zero time. The cleanup worker revalidates all
+// orders which haven't been updated recently.
+func (w *Watcher) handleUnrecoverableOrder(order *types.OrderWithMetadata, decodeErr error, repair bool, result *IntegrityCheckResult) error {
+	logger.WithFields(logger.Fields{
+		"error":     decodeErr.Error(),
+		"orderHash": order.Hash.Hex(),
+	}).Warn("could not decode asset data of order")
+	result.UnrecoverableOrders = append(result.UnrecoverableOrders, order.Hash)
+	if !repair {
+		return nil
+	}
+	return w.db.UpdateOrder(order.Hash, func(existingOrder *types.OrderWithMetadata) (*types.OrderWithMetadata, error) {
+		existingOrder.LastUpdated = time.Time{}
+		return existingOrder, nil
+	})
+}
+
+// decoderContractsForOrder returns the contracts which are registered with the
+// event decoder by setupInMemoryOrderState.
+func (w *Watcher) decoderContractsForOrder(order *types.OrderWithMetadata) ([]*decoderContract, error) {
+	if order.OrderV4 != nil {
+		return []*decoderContract{
+			{kind: "Exchange", address: order.OrderV4.VerifyingContract},
+			{kind: "ERC20Token", address: order.OrderV4.MakerToken},
+		}, nil
+	}
+	contracts := []*decoderContract{
+		{kind: "Exchange", address: order.OrderV3.ExchangeAddress},
+	}
+	makerContracts, err := w.decoderContractsForAssetData(order.OrderV3.MakerAssetData)
+	if err != nil {
+		return nil, err
+	}
+	contracts = append(contracts, makerContracts...)
+	if order.OrderV3.MakerFee.Cmp(big.NewInt(0)) == 1 {
+		makerFeeContracts, err := w.decoderContractsForAssetData(order.OrderV3.MakerFeeAssetData)
+		if err != nil {
+			return nil, err
+		}
+		contracts = append(contracts, makerFeeContracts...)
+	}
+	return contracts, nil
+}
+
+// decoderContractsForAssetData returns the contracts which are registered with
+// the event decoder by addAssetDataAddressToEventDecoder.
+func (w *Watcher) decoderContractsForAssetData(assetData []byte) ([]*decoderContract, error) {
+	assetDataName, err := w.assetDataDecoder.GetName(assetData)
+	if err != nil {
+		return nil, err
+	}
+	switch assetDataName {
+	case "ERC20Token":
+		var decodedAssetData zeroex.ERC20AssetData
+		if err := w.assetDataDecoder.Decode(assetData, &decodedAssetData); err != nil {
+			return nil, err
+		}
+		return []*decoderContract{{kind: assetDataName, address: decodedAssetData.Address}}, nil
+	case "ERC721Token":
+		var decodedAssetData zeroex.ERC721AssetData
+		if err := w.assetDataDecoder.Decode(assetData, &decodedAssetData); err != nil {
+			return nil, err
+		}
+		return []*decoderContract{{kind: assetDataName, address: decodedAssetData.Address}}, nil
+	case "ERC1155Assets":
+		var decodedAssetData zeroex.ERC1155AssetData
+		if err := w.assetDataDecoder.Decode(assetData, &decodedAssetData); err != nil {
+			return nil, err
+		}
+		return []*decoderContract{{kind: assetDataName, address: decodedAssetData.Address}}, nil
+	case "StaticCall":
+		var decodedAssetData zeroex.StaticCallAssetData
+		if err := w.assetDataDecoder.Decode(assetData, &decodedAssetData); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	case "MultiAsset":
+		var decodedAssetData zeroex.MultiAssetData
+		if err := w.assetDataDecoder.Decode(assetData, &decodedAssetData); err != nil {
+			return nil, err
+		}
+		contracts := []*decoderContract{}
+		for _, nestedAssetData := range decodedAssetData.NestedAssetData {
+			nestedContracts, err := w.decoderContractsForAssetData(nestedAssetData)
+			if err != nil {
+				return nil, err
+			}
+			contracts = append(contracts, nestedContracts...)
+		}
+		return contracts, nil
+	default:
+		return nil, fmt.Errorf("unrecognized assetData type name found: %s", assetDataName)
+	}
+}
+
+// registerDecoderContract registers a single contract with the event decoder.
+// The seen count of the contract is only incremented if it is zero. A non-zero
+// count means that the contract is already counted for the orders that use it
+// and incrementing it again would keep it registered after all of them are
+// removed.
+func (w *Watcher) registerDecoderContract(contract *decoderContract) {
+	switch contract.kind {
+	case "ERC20Token":
+		w.eventDecoder.AddKnownERC20(contract.address)
+	case "ERC721Token":
+		w.eventDecoder.AddKnownERC721(contract.address)
+	case "ERC1155Assets":
+		w.eventDecoder.AddKnownERC1155(contract.address)
+	case "Exchange":
+		// Exchanges are not counted.
+		w.eventDecoder.AddKnownExchange(contract.address)
+		return
+	default:
+		return
+	}
+	if w.contractAddressToSeenCount.Get(contract.address) == 0 {
+		w.contractAddressToSeenCount.Inc(contract.address)
+	}
+}
+
+func (w *Watcher) isKnownDecoderContract(contract *decoderContract) bool {
+	switch contract.kind {
+	case "ERC20Token":
+		return w.eventDecoder.IsKnownERC20(contract.address)
+	case "ERC721Token":
+		return w.eventDecoder.IsKnownERC721(contract.address)
+	case "ERC1155Assets":
+		return w.eventDecoder.IsKnownERC1155(contract.address)
+	case "Exchange":
+		return w.eventDecoder.IsKnownExchange(contract.address)
+	default:
+		return false
+	}
+}
+
+// singleAssetDatasAreEqual returns true if a and b contain the same tokens in
+// the same order. nil and empty slices are considered equal.
+func singleAssetDatasAreEqual(a, b []*types.SingleAssetData) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Address != b[i].Address {
+			return false
+		}
+		if (a[i].TokenID == nil) != (b[i].TokenID == nil) {
+			return false
+		}
+		if a[i].TokenID != nil && a[i].TokenID.Cmp(b[i].TokenID) != 0 {
+			return false
+		}
+	}
+	return true
+}
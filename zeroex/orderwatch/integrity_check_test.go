@@ -0,0 +1,122 @@
+// +build !js
+
+package orderwatch
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/constants"
+	"github.com/0xProject/0x-mesh/db"
+	"github.com/0xProject/0x-mesh/zeroex"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckIntegrity(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	database, err := db.New(ctx, db.TestOptions())
+	require.NoError(t, err)
+
+	validOrder := newIntegrityTestOrder(t, constants.ZRXAssetData)
+	staleOrder := newIntegrityTestOrder(t, constants.ZRXAssetData)
+	staleOrder.ParsedMakerAssetData = []*types.SingleAssetData{}
+	corruptedOrder := newIntegrityTestOrder(t, constants.ZRXAssetData)
+	corruptedOrder.OrderV3.MakerAssetData = []byte{1, 2, 3, 4}
+	orderV4 := &types.OrderWithMetadata{
+		Hash: common.HexToHash("0x4"),
+		OrderV4: &zeroex.OrderV4{
+			ChainID:             big.NewInt(constants.TestChainID),
+			VerifyingContract:   ganacheAddresses.ExchangeProxy,
+			MakerToken:          ganacheAddresses.WETH9,
+			TakerToken:          ganacheAddresses.ZRXToken,
+			MakerAmount:         big.NewInt(100),
+			TakerAmount:         big.NewInt(42),
+			TakerTokenFeeAmount: big.NewInt(0),
+			Maker:               constants.GanacheAccount1,
+			Taker:               constants.NullAddress,
+			Sender:              constants.NullAddress,
+			FeeRecipient:        constants.NullAddress,
+			Pool:                zeroex.BigToBytes32(big.NewInt(0)),
+			Expiry:              big.NewInt(time.Now().Add(24 * time.Hour).Unix()),
+			Salt:                big.NewInt(0),
+		},
+		FillableTakerAssetAmount: big.NewInt(42),
+		LastUpdated:              time.Now(),
+		LastValidatedBlockNumber: big.NewInt(0),
+	}
+	_, _, _, err = database.AddOrders([]*types.OrderWithMetadata{validOrder, staleOrder, corruptedOrder, orderV4})
+	require.NoError(t, err)
+
+	w, err := New(Config{
+		DB:                database,
+		ChainID:           constants.TestChainID,
+		ContractAddresses: ganacheAddresses,
+		MaxOrders:         1000,
+	})
+	require.NoError(t, err)
+	// Simulate an event decoder which got out of sync with the database.
+	w.eventDecoder.RemoveKnownERC20(ganacheAddresses.WETH9)
+
+	result, err := w.CheckIntegrity(false)
+	require.NoError(t, err)
+	assert.Equal(t, 4, result.NumOrdersChecked)
+	assert.Equal(t, []common.Hash{staleOrder.Hash}, result.OrdersWithStaleAssetData)
+	assert.Equal(t, []common.Hash{orderV4.Hash}, result.OrdersWithUnknownContracts)
+	assert.Equal(t, []common.Hash{corruptedOrder.Hash}, result.UnrecoverableOrders)
+	assert.False(t, w.eventDecoder.IsKnownERC20(ganacheAddresses.WETH9), "nothing should be repaired")
+
+	result, err = w.CheckIntegrity(true)
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.NumProblems())
+	assert.True(t, w.eventDecoder.IsKnownERC20(ganacheAddresses.WETH9))
+	// Only the missing contract is registered and its seen count, which still
+	// includes the v4 order, is not incremented again.
+	assert.Equal(t, uint(1), w.contractAddressToSeenCount.Get(ganacheAddresses.WETH9))
+	assert.Equal(t, uint(2), w.contractAddressToSeenCount.Get(ganacheAddresses.ZRXToken))
+	repairedOrder, err := database.GetOrder(staleOrder.Hash)
+	require.NoError(t, err)
+	require.Len(t, repairedOrder.ParsedMakerAssetData, 1)
+	assert.Equal(t, ganacheAddresses.ZRXToken, repairedOrder.ParsedMakerAssetData[0].Address)
+	markedOrder, err := database.GetOrder(corruptedOrder.Hash)
+	require.NoError(t, err)
+	assert.True(t, markedOrder.LastUpdated.IsZero(), "unrecoverable order should be marked for revalidation")
+
+	// Only the unrecoverable order is left.
+	result, err = w.CheckIntegrity(false)
+	require.NoError(t, err)
+	assert.Empty(t, result.OrdersWithStaleAssetData)
+	assert.Empty(t, result.OrdersWithUnknownContracts)
+	assert.Equal(t, []common.Hash{corruptedOrder.Hash}, result.UnrecoverableOrders)
+}
+
+// newIntegrityTestOrder returns a v3 order with the given maker asset data and
+// correctly parsed asset data.
+func newIntegrityTestOrder(t *testing.T, makerAssetData []byte) *types.OrderWithMetadata {
+	signedOrder := newQuotaTestOrder(constants.GanacheAccount1, big.NewInt(time.Now().Add(24*time.Hour).Unix()))
+	signedOrder.MakerAssetData = makerAssetData
+	hash, err := signedOrder.ComputeOrderHash()
+	require.NoError(t, err)
+	assetDataDecoder := zeroex.NewAssetDataDecoder()
+	parsedMakerAssetData, err := db.ParseContractAddressesAndTokenIdsFromAssetData(assetDataDecoder, signedOrder.MakerAssetData, ganacheAddresses)
+	require.NoError(t, err)
+	parsedTakerAssetData, err := db.ParseContractAddressesAndTokenIdsFromAssetData(assetDataDecoder, signedOrder.TakerAssetData, ganacheAddresses)
+	require.NoError(t, err)
+	return &types.OrderWithMetadata{
+		Hash:                     hash,
+		OrderV3:                  &signedOrder.Order,
+		Signature:                signedOrder.Signature,
+		FillableTakerAssetAmount: signedOrder.TakerAssetAmount,
+		LastUpdated:              time.Now(),
+		LastValidatedBlockNumber: big.NewInt(0),
+		ParsedMakerAssetData:     parsedMakerAssetData,
+		ParsedMakerFeeAssetData:  []*types.SingleAssetData{},
+		ParsedTakerAssetData:     parsedTakerAssetData,
+		ParsedTakerFeeAssetData:  []*types.SingleAssetData{},
+	}
+}
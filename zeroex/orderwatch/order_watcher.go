@@ -80,6 +80,9 @@ type Watcher struct {
 	wasStartedOnce             bool
 	mu                         sync.Mutex
 	maxOrders                  int
+	maxOrdersPerMaker          int
+	maxOrdersPerPeer           int
+	archiveRetention           time.Duration
 	handleBlockEventsMu        sync.RWMutex
 	// atLeastOneBlockProcessed is closed to signal that the BlockWatcher has processed at least one
 	// block. Validation of orders should block until this has completed
@@ -104,6 +107,15 @@ type Config struct {
 	ChainID           int
 	ContractAddresses ethereum.ContractAddresses
 	MaxOrders         int
+	// MaxOrdersPerMaker is the maximum number of orders that are stored for a
+	// single maker and MaxOrdersPerPeer is the maximum number of orders that
+	// are stored from a single peer. Pinned orders don't count towards these
+	// quotas. There is no quota if they are 0.
+	MaxOrdersPerMaker int
+	MaxOrdersPerPeer  int
+	// ArchiveRetention is how long permanently deleted orders are kept in the
+	// order archive. Orders are not archived if it is 0.
+	ArchiveRetention time.Duration
 }
 
 // New instantiates a new order watcher
@@ -128,20 +140,37 @@ func New(config Config) (*Watcher, error) {
 		assetDataDecoder:           assetDataDecoder,
 		contractAddresses:          config.ContractAddresses,
 		maxOrders:                  config.MaxOrders,
+		maxOrdersPerMaker:          config.MaxOrdersPerMaker,
+		maxOrdersPerPeer:           config.MaxOrdersPerPeer,
+		archiveRetention:           config.ArchiveRetention,
 		blockEventsChan:            make(chan []*blockwatch.Event, 100),
 		atLeastOneBlockProcessed:   make(chan struct{}),
 		didProcessABlock:           false,
 	}
 
-	// Pre-populate the OrderWatcher with all orders already stored in the DB
+	// Pre-populate the OrderWatcher with all orders already stored in the DB.
+	// Orders that are marked as removed are no longer being watched.
 	orders, err := w.db.FindOrders(nil)
 	if err != nil {
 		return nil, err
 	}
-	for _, order := range orders {
+	ordersV4, err := w.db.FindOrdersV4(nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, order := range append(orders, ordersV4...) {
+		if order.IsRemoved {
+			continue
+		}
 		err := w.setupInMemoryOrderState(order)
 		if err != nil {
-			return nil, err
+			// A single corrupted order shouldn't prevent Mesh from starting.
+			// CheckIntegrity finds these orders and marks them for
+			// revalidation.
+			logger.WithFields(logger.Fields{
+				"error":     err.Error(),
+				"orderHash": order.Hash.Hex(),
+			}).Error("could not add stored order to event decoder")
 		}
 	}
 
@@ -160,6 +189,12 @@ func (w *Watcher) Watch(ctx context.Context) error {
 	w.wasStartedOnce = true
 	w.mu.Unlock()
 
+	if err := w.backfillParsedTakerAssetData(); err != nil {
+		// Orders which could not be backfilled are still watched. They just
+		// won't match filters on the taker token.
+		logger.WithError(err).Error("could not backfill parsed taker asset data")
+	}
+
 	g, ctx := errgroup.WithContext(ctx)
 
 	namedLoops := []struct {
@@ -184,6 +219,50 @@ func (w *Watcher) Watch(ctx context.Context) error {
 	return g.Wait()
 }
 
+// backfillParsedTakerAssetData parses the taker asset data and taker fee asset
+// data of v3 orders that were stored before Mesh started parsing them. The
+// database stores null for these orders, whereas orders whose asset data
+// doesn't contain any tokens are stored with an empty list.
+func (w *Watcher) backfillParsedTakerAssetData() error {
+	orders, err := w.db.FindOrders(&db.OrderQuery{
+		Filters: []db.OrderFilter{
+			{
+				Field: db.OFParsedTakerAssetData,
+				Kind:  db.Equal,
+				Value: "null",
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if len(orders) == 0 {
+		return nil
+	}
+	logger.WithField("numOrders", len(orders)).Info("backfilling parsed taker asset data")
+	for _, order := range orders {
+		parsedTakerAssetData, err := db.ParseContractAddressesAndTokenIdsFromAssetData(w.assetDataDecoder, order.OrderV3.TakerAssetData, w.contractAddresses)
+		if err != nil {
+			logger.WithError(err).WithField("orderHash", order.Hash.Hex()).Warn("could not parse taker asset data")
+			continue
+		}
+		parsedTakerFeeAssetData, err := db.ParseContractAddressesAndTokenIdsFromAssetData(w.assetDataDecoder, order.OrderV3.TakerFeeAssetData, w.contractAddresses)
+		if err != nil {
+			logger.WithError(err).WithField("orderHash", order.Hash.Hex()).Warn("could not parse taker fee asset data")
+			continue
+		}
+		err = w.db.UpdateOrder(order.Hash, func(existingOrder *types.OrderWithMetadata) (*types.OrderWithMetadata, error) {
+			existingOrder.ParsedTakerAssetData = parsedTakerAssetData
+			existingOrder.ParsedTakerFeeAssetData = parsedTakerFeeAssetData
+			return existingOrder, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (w *Watcher) mainLoop(ctx context.Context) error {
 	// Set up the channel used for subscribing to block events.
 	w.blockSubscription = w.blockWatcher.Subscribe(w.blockEventsChan)
@@ -242,6 +321,20 @@ func (w *Watcher) cleanupLoop(ctx context.Context) error {
 		if err := w.Cleanup(ctx, defaultLastUpdatedBuffer); err != nil {
 			return err
 		}
+		// The event decoder is set up from the stored orders when the Watcher
+		// is created, so most inconsistencies between them only show up while
+		// Mesh is running.
+		result, err := w.CheckIntegrity(true)
+		if err != nil {
+			return err
+		}
+		if result.NumProblems() > 0 {
+			logger.WithFields(logger.Fields{
+				"numOrdersWithStaleAssetData":   len(result.OrdersWithStaleAssetData),
+				"numOrdersWithUnknownContracts": len(result.OrdersWithUnknownContracts),
+				"numUnrecoverableOrders":        len(result.UnrecoverableOrders),
+			}).Warn("repaired inconsistencies between the stored orders and the order watcher")
+		}
 	}
 }
 
@@ -249,6 +342,9 @@ func (w *Watcher) removedCheckerLoop(ctx context.Context) error {
 	if err := w.permanentlyDeleteStaleRemovedOrders(); err != nil {
 		return err
 	}
+	if err := w.deleteExpiredArchivedOrders(); err != nil {
+		return err
+	}
 	lastDeleted := time.Now()
 
 	for {
@@ -273,6 +369,9 @@ func (w *Watcher) removedCheckerLoop(ctx context.Context) error {
 				if err := w.permanentlyDeleteStaleRemovedOrders(); err != nil {
 					return err
 				}
+				if err := w.deleteExpiredArchivedOrders(); err != nil {
+					return err
+				}
 				lastDeleted = time.Now()
 			}
 		}
@@ -301,7 +400,7 @@ func (w *Watcher) handleOrderExpirations(validationBlock *types.MiniHeader, orde
 		if order.KeepExpired {
 			w.markOrderUnfillable(order, nil, validationBlock)
 		} else {
-			w.unwatchOrder(order, nil, validationBlock)
+			w.unwatchOrder(order, nil, validationBlock, zeroex.ESOrderExpired)
 		}
 		orderEvent := &zeroex.OrderEvent{
 			Timestamp:                validationBlock.Timestamp,
@@ -477,7 +576,7 @@ func (w *Watcher) handleBlockEvents(ctx context.Context, events []*blockwatch.Ev
 
 	orderEvents := append(expirationOrderEvents, postValidationOrderEvents...)
 	if len(orderEvents) > 0 {
-		w.orderFeed.Send(orderEvents)
+		w.publishOrderEvents(orderEvents)
 	}
 
 	w.atLeastOneBlockProcessedMu.Lock()
@@ -525,7 +624,7 @@ func (w *Watcher) RevalidateOrdersForMissingEvents(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	w.orderFeed.Send(orderEvents)
+	w.publishOrderEvents(orderEvents)
 	return nil
 }
 
@@ -1024,7 +1123,7 @@ func (w *Watcher) Cleanup(ctx context.Context, lastUpdatedBuffer time.Duration)
 	}
 
 	if len(orderEvents) > 0 {
-		w.orderFeed.Send(orderEvents)
+		w.publishOrderEvents(orderEvents)
 	}
 
 	orderCount, err := w.db.CountOrders(nil)
@@ -1040,6 +1139,9 @@ func (w *Watcher) Cleanup(ctx context.Context, lastUpdatedBuffer time.Duration)
 		if err != nil {
 			return err
 		}
+		if err := w.archiveOrders(ordersRemoved); err != nil {
+			return err
+		}
 
 		logger.WithField("numOrdersRemoved", len(ordersRemoved)).Info("Removed orders with long expiration")
 	}
@@ -1176,6 +1278,9 @@ func (w *Watcher) add(orderInfos []*ordervalidator.AcceptedOrderInfo, validation
 		w.recentlyValidatedOrders = append(w.recentlyValidatedOrders, order)
 		w.recentlyValidatedOrdersMu.Unlock()
 	}
+	if err := w.archiveOrders(removedOrders); err != nil {
+		return nil, err
+	}
 	for _, order := range removedOrders {
 		stoppedWatchingEvent := &zeroex.OrderEvent{
 			Timestamp:                now,
@@ -1188,27 +1293,8 @@ func (w *Watcher) add(orderInfos []*ordervalidator.AcceptedOrderInfo, validation
 		orderEvents = append(orderEvents, stoppedWatchingEvent)
 
 		// Remove in-memory state
-		if order.OrderV3 != nil {
-			err = w.removeAssetDataAddressFromEventDecoder(order.OrderV3.MakerAssetData)
-			if err != nil {
-				// This should never happen since the same error would have happened when adding
-				// the assetData to the EventDecoder.
-				logger.WithFields(logger.Fields{
-					"error":       err.Error(),
-					"signedOrder": order.SignedOrder(),
-				}).Error("Unexpected error when trying to remove an assetData from decoder")
-				return nil, err
-			}
-		}
-		if order.OrderV4 != nil {
-			err = w.removeTokenAddressFromEventDecoder(order.OrderV4.MakerToken)
-			if err != nil {
-				// This should never happen since the same error would have happened when adding
-				// the assetData to the EventDecoder.
-				logger.WithFields(logger.Fields{
-					"error":       err.Error(),
-					"signedOrder": order.SignedOrder(),
-				}).Error("Unexpected error when trying to remove an make token from decoder")
+		if !order.IsRemoved {
+			if err := w.teardownInMemoryOrderState(order); err != nil {
 				return nil, err
 			}
 		}
@@ -1284,6 +1370,7 @@ func (w *Watcher) orderInfoToOrderWithMetadata(orderInfo *ordervalidator.Accepte
 			KeepExpired:              opts.KeepExpired,
 			KeepFullyFilled:          opts.KeepFullyFilled,
 			KeepUnfunded:             opts.KeepUnfunded,
+			SourcePeerID:             opts.SourcePeerID,
 		}, nil
 	}
 	if orderInfo.SignedOrder == nil {
@@ -1299,6 +1386,14 @@ func (w *Watcher) orderInfoToOrderWithMetadata(orderInfo *ordervalidator.Accepte
 	if err != nil {
 		return nil, err
 	}
+	parsedTakerAssetData, err := db.ParseContractAddressesAndTokenIdsFromAssetData(w.assetDataDecoder, orderInfo.SignedOrder.TakerAssetData, w.contractAddresses)
+	if err != nil {
+		return nil, err
+	}
+	parsedTakerFeeAssetData, err := db.ParseContractAddressesAndTokenIdsFromAssetData(w.assetDataDecoder, orderInfo.SignedOrder.TakerFeeAssetData, w.contractAddresses)
+	if err != nil {
+		return nil, err
+	}
 	return &types.OrderWithMetadata{
 		Hash: orderInfo.OrderHash,
 		OrderV3: &zeroex.Order{
@@ -1327,6 +1422,8 @@ func (w *Watcher) orderInfoToOrderWithMetadata(orderInfo *ordervalidator.Accepte
 		LastUpdated:              now,
 		ParsedMakerAssetData:     parsedMakerAssetData,
 		ParsedMakerFeeAssetData:  parsedMakerFeeAssetData,
+		ParsedTakerAssetData:     parsedTakerAssetData,
+		ParsedTakerFeeAssetData:  parsedTakerFeeAssetData,
 		FillableTakerAssetAmount: orderInfo.FillableTakerAssetAmount,
 		LastValidatedBlockNumber: validationBlock.Number,
 		LastValidatedBlockHash:   validationBlock.Hash,
@@ -1334,6 +1431,7 @@ func (w *Watcher) orderInfoToOrderWithMetadata(orderInfo *ordervalidator.Accepte
 		KeepExpired:              opts.KeepExpired,
 		KeepFullyFilled:          opts.KeepFullyFilled,
 		KeepUnfunded:             opts.KeepUnfunded,
+		SourcePeerID:             opts.SourcePeerID,
 	}, nil
 }
 
@@ -1362,6 +1460,31 @@ func (w *Watcher) setupInMemoryOrderState(order *types.OrderWithMetadata) error
 	return nil
 }
 
+// teardownInMemoryOrderState undoes setupInMemoryOrderState. It must be called
+// exactly once when an order stops being watched, i.e. when it is marked as
+// removed or when it is deleted without having been marked as removed.
+func (w *Watcher) teardownInMemoryOrderState(order *types.OrderWithMetadata) error {
+	if order.OrderV3 != nil {
+		err := w.removeAssetDataAddressFromEventDecoder(order.OrderV3.MakerAssetData)
+		if err == nil && order.OrderV3.MakerFee.Cmp(big.NewInt(0)) == 1 {
+			err = w.removeAssetDataAddressFromEventDecoder(order.OrderV3.MakerFeeAssetData)
+		}
+		if err != nil {
+			// This should never happen since the same error would have happened when adding
+			// the assetData to the EventDecoder.
+			logger.WithFields(logger.Fields{
+				"error":       err.Error(),
+				"signedOrder": order.SignedOrder(),
+			}).Error("Unexpected error when trying to remove an assetData from decoder")
+			return err
+		}
+	}
+	if order.OrderV4 != nil {
+		return w.removeTokenAddressFromEventDecoder(order.OrderV4.MakerToken)
+	}
+	return nil
+}
+
 // Subscribe allows one to subscribe to the order events emitted by the OrderWatcher.
 // To unsubscribe, simply call `Unsubscribe` on the returned subscription.
 // The sink channel should have ample buffer space to avoid blocking other subscribers.
@@ -1370,6 +1493,19 @@ func (w *Watcher) Subscribe(sink chan<- []*zeroex.OrderEvent) event.Subscription
 	return w.orderScope.Track(w.orderFeed.Subscribe(sink))
 }
 
+// publishOrderEvents adds the given order events to the order event log, which
+// assigns each of them a sequence number, and then sends them to all
+// subscribers. If the events could not be logged, they are still sent without a
+// sequence number.
+func (w *Watcher) publishOrderEvents(orderEvents []*zeroex.OrderEvent) {
+	if len(orderEvents) > 0 {
+		if err := w.db.AddOrderEvents(orderEvents); err != nil {
+			logger.WithError(err).WithField("numOrderEvents", len(orderEvents)).Error("could not add order events to the order event log")
+		}
+	}
+	w.orderFeed.Send(orderEvents)
+}
+
 func (w *Watcher) findOrder(orderHash common.Hash) *types.OrderWithMetadata {
 	// V3
 	order, err := w.db.GetOrder(orderHash)
@@ -1798,7 +1934,7 @@ func (w *Watcher) convertValidationResultsIntoOrderEvents(
 					(endState == zeroex.ESOrderBecameUnfunded && order.KeepUnfunded) {
 					w.markOrderUnfillable(order, big.NewInt(0), validationBlock)
 				} else {
-					w.unwatchOrder(order, big.NewInt(0), validationBlock)
+					w.unwatchOrder(order, big.NewInt(0), validationBlock, endState)
 				}
 				orderEvent := &zeroex.OrderEvent{
 					Timestamp:                validationBlock.Timestamp,
@@ -1911,6 +2047,11 @@ func (w *Watcher) ValidateAndStoreValidOrders(ctx context.Context, orders []*zer
 		}
 	}
 
+	newOrderInfos, err = w.enforceStorageQuotas(results, newOrderInfos, pinned, opts)
+	if err != nil {
+		return nil, err
+	}
+
 	// Add the order to the OrderWatcher. This also saves the order in the
 	// database.
 	allOrderEvents := []*zeroex.OrderEvent{}
@@ -1919,6 +2060,11 @@ func (w *Watcher) ValidateAndStoreValidOrders(ctx context.Context, orders []*zer
 		return nil, err
 	}
 	allOrderEvents = append(allOrderEvents, orderEvents...)
+	evictionEvents, err := w.evictOrdersOverQuota(newOrderInfos, pinned, opts)
+	if err != nil {
+		return nil, err
+	}
+	allOrderEvents = append(allOrderEvents, evictionEvents...)
 
 	if len(allOrderEvents) > 0 {
 		// NOTE(albrow): Send can block if the subscriber(s) are slow. Blocking here can cause problems when Mesh is
@@ -1926,7 +2072,7 @@ func (w *Watcher) ValidateAndStoreValidOrders(ctx context.Context, orders []*zer
 		// is done.
 		done := make(chan interface{})
 		go func() {
-			w.orderFeed.Send(allOrderEvents)
+			w.publishOrderEvents(allOrderEvents)
 			done <- struct{}{}
 		}()
 		select {
@@ -1940,6 +2086,129 @@ func (w *Watcher) ValidateAndStoreValidOrders(ctx context.Context, orders []*zer
 	return results, nil
 }
 
+// ValidateOrders applies the same general 0x validation and Mesh-specific
+// validation to the given orders as ValidateAndStoreValidOrders, but does not
+// add any of them to the OrderWatcher. pinned affects validation in the same
+// way as it does for ValidateAndStoreValidOrders. New orders which would exceed
+// the storage quota of their maker are rejected.
+func (w *Watcher) ValidateOrders(ctx context.Context, orders []*zeroex.SignedOrder, chainID int, pinned bool) (*ordervalidator.ValidationResults, error) {
+	if len(orders) == 0 {
+		return &ordervalidator.ValidationResults{}, nil
+	}
+	results, validMeshOrders, err := w.meshSpecificOrderValidation(orders, chainID, pinned)
+	if err != nil {
+		return nil, err
+	}
+	_, zeroexResults, err := w.onchainOrderValidation(ctx, validMeshOrders)
+	if err != nil {
+		return nil, err
+	}
+	results.Accepted = append(results.Accepted, zeroexResults.Accepted...)
+	results.Rejected = append(results.Rejected, zeroexResults.Rejected...)
+	if err := w.checkStorageQuotas(results, pinned); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// RemoveOrders stops watching the v3 orders with the given hashes and
+// permanently deletes them from the database. A STOPPED_WATCHING event is
+// emitted for each order that was still being watched. Hashes that do not
+// belong to a stored v3 order are ignored. It returns the hashes of the orders
+// that were removed.
+func (w *Watcher) RemoveOrders(hashes []common.Hash) ([]common.Hash, error) {
+	return w.removeOrders(hashes, w.db.GetOrder)
+}
+
+func (w *Watcher) removeOrders(hashes []common.Hash, getOrder func(hash common.Hash) (*types.OrderWithMetadata, error)) ([]common.Hash, error) {
+	removed, orderEvents, err := w.deleteOrders(hashes, getOrder)
+	// Events are published even if an error occurred so that subscribers learn
+	// about the orders which were removed before it.
+	if len(orderEvents) > 0 {
+		w.publishOrderEvents(orderEvents)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return removed, nil
+}
+
+// deleteOrders permanently deletes the orders with the given hashes. It
+// returns the hashes of the orders that were deleted and a STOPPED_WATCHING
+// event for each of them that was still being watched. The events are
+// returned even if an error occurred.
+func (w *Watcher) deleteOrders(hashes []common.Hash, getOrder func(hash common.Hash) (*types.OrderWithMetadata, error)) ([]common.Hash, []*zeroex.OrderEvent, error) {
+	// Block events are not processed while orders are being removed. Otherwise
+	// an order could be revalidated and updated after it was deleted.
+	w.handleBlockEventsMu.Lock()
+	defer w.handleBlockEventsMu.Unlock()
+
+	now := time.Now().UTC()
+	removed := []common.Hash{}
+	orderEvents := []*zeroex.OrderEvent{}
+	var err error
+	for _, hash := range hashes {
+		var order *types.OrderWithMetadata
+		order, err = getOrder(hash)
+		if err == db.ErrNotFound {
+			err = nil
+			continue
+		} else if err != nil {
+			break
+		}
+		if err = w.permanentlyDeleteOrder(order); err != nil {
+			break
+		}
+		removed = append(removed, hash)
+		// Orders that are marked as removed are no longer being watched and a
+		// STOPPED_WATCHING event has already been emitted for them.
+		if !order.IsRemoved {
+			orderEvents = append(orderEvents, &zeroex.OrderEvent{
+				Timestamp:                now,
+				OrderHash:                order.Hash,
+				SignedOrder:              order.SignedOrder(),
+				SignedOrderV4:            order.SignedOrderV4(),
+				FillableTakerAssetAmount: order.FillableTakerAssetAmount,
+				EndState:                 zeroex.ESStoppedWatching,
+			})
+		}
+	}
+	return removed, orderEvents, err
+}
+
+// SetOrdersPinned marks the v3 orders with the given hashes as pinned or
+// unpinned. Pinned orders are not affected by the DDoS prevention or incentive
+// mechanisms and are not removed when the database is full. Hashes that do not
+// belong to a v3 order which is currently being watched are ignored. It
+// returns the hashes of the orders that were updated.
+func (w *Watcher) SetOrdersPinned(hashes []common.Hash, pinned bool) ([]common.Hash, error) {
+	return setOrdersPinned(hashes, pinned, w.db.UpdateOrderV3)
+}
+
+func setOrdersPinned(hashes []common.Hash, pinned bool, updateOrder func(hash common.Hash, updateFunc func(existingOrder *types.OrderWithMetadata) (*types.OrderWithMetadata, error)) error) ([]common.Hash, error) {
+	updated := []common.Hash{}
+	for _, hash := range hashes {
+		isWatched := false
+		err := updateOrder(hash, func(existingOrder *types.OrderWithMetadata) (*types.OrderWithMetadata, error) {
+			// Orders that are marked as removed are left unchanged.
+			isWatched = !existingOrder.IsRemoved
+			if isWatched {
+				existingOrder.IsPinned = pinned
+			}
+			return existingOrder, nil
+		})
+		if err == db.ErrNotFound {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		if isWatched {
+			updated = append(updated, hash)
+		}
+	}
+	return updated, nil
+}
+
 func (w *Watcher) onchainOrderValidation(ctx context.Context, orders []*zeroex.SignedOrder) (*types.MiniHeader, *ordervalidator.ValidationResults, error) {
 	// HACK(fabio): While we wait for EIP-1898 support in Parity, we have no choice but to do the `eth_call`
 	// at the latest known block _number_. As outlined in the `Rationale` section of EIP-1898, this approach cannot account
@@ -2167,8 +2436,11 @@ func (w *Watcher) updateOrderFillableTakerAssetAmountAndBlockInfo(order *types.O
 }
 
 func (w *Watcher) rewatchOrder(order *types.OrderWithMetadata, newFillableTakerAssetAmount *big.Int, validationBlock *types.MiniHeader) {
+	wasRemoved := false
 	err := w.db.UpdateOrder(order.Hash, func(orderToUpdate *types.OrderWithMetadata) (*types.OrderWithMetadata, error) {
+		wasRemoved = orderToUpdate.IsRemoved
 		orderToUpdate.IsRemoved = false
+		orderToUpdate.EndState = ""
 		orderToUpdate.IsUnfillable = false
 		orderToUpdate.IsExpired = false
 		orderToUpdate.LastUpdated = time.Now().UTC()
@@ -2182,6 +2454,15 @@ func (w *Watcher) rewatchOrder(order *types.OrderWithMetadata, newFillableTakerA
 			"error": err.Error(),
 			"order": order,
 		}).Error("Failed to update order")
+		return
+	}
+	if wasRemoved {
+		if err := w.setupInMemoryOrderState(order); err != nil {
+			logger.WithFields(logger.Fields{
+				"error":     err.Error(),
+				"orderHash": order.Hash.Hex(),
+			}).Error("could not add rewatched order to event decoder")
+		}
 	}
 }
 
@@ -2214,9 +2495,15 @@ func (w *Watcher) markOrderUnfillable(order *types.OrderWithMetadata, newFillabl
 	}
 }
 
-func (w *Watcher) unwatchOrder(order *types.OrderWithMetadata, newFillableAmount *big.Int, validationBlock *types.MiniHeader) {
+// unwatchOrder marks the order as removed. endState is the end state of the
+// order event that is emitted for the order and is stored with the order so that
+// it can be archived with the right end state when it is deleted.
+func (w *Watcher) unwatchOrder(order *types.OrderWithMetadata, newFillableAmount *big.Int, validationBlock *types.MiniHeader, endState zeroex.OrderEventEndState) {
+	wasRemoved := false
 	err := w.db.UpdateOrder(order.Hash, func(orderToUpdate *types.OrderWithMetadata) (*types.OrderWithMetadata, error) {
+		wasRemoved = orderToUpdate.IsRemoved
 		orderToUpdate.IsRemoved = true
+		orderToUpdate.EndState = endState
 		orderToUpdate.IsUnfillable = true
 		if orderToUpdate.OrderV3 != nil {
 			if big.NewInt(validationBlock.Timestamp.Unix()).Cmp(orderToUpdate.OrderV3.ExpirationTimeSeconds) >= 0 {
@@ -2241,6 +2528,15 @@ func (w *Watcher) unwatchOrder(order *types.OrderWithMetadata, newFillableAmount
 			"error": err.Error(),
 			"order": order,
 		}).Error("Failed to update order")
+		return
+	}
+	if !wasRemoved {
+		if err := w.teardownInMemoryOrderState(order); err != nil {
+			logger.WithFields(logger.Fields{
+				"error":     err.Error(),
+				"orderHash": order.Hash.Hex(),
+			}).Error("could not remove unwatched order from event decoder")
+		}
 	}
 }
 
@@ -2270,27 +2566,90 @@ func (w *Watcher) updateOrderExpirationState(order *types.OrderWithMetadata, val
 }
 
 func (w *Watcher) permanentlyDeleteOrder(order *types.OrderWithMetadata) error {
-	// After permanently deleting an order, we also remove its assetData
-	// from the Decoder for v3 orders.
+	if err := w.archiveOrders([]*types.OrderWithMetadata{order}); err != nil {
+		return err
+	}
 	if order.OrderV3 != nil {
 		if err := w.db.DeleteOrder(order.Hash); err != nil {
 			return err
 		}
-		err := w.removeAssetDataAddressFromEventDecoder(order.OrderV3.MakerAssetData)
-		if err != nil {
-			// This should never happen since the same error would have happened when adding
-			// the assetData to the EventDecoder.
-			logger.WithFields(logger.Fields{
-				"error":       err.Error(),
-				"signedOrder": order.SignedOrder,
-			}).Error("Unexpected error when trying to remove an assetData from decoder")
-			return err
-		}
 	} else {
 		if err := w.db.DeleteOrderV4(order.Hash); err != nil {
 			return err
 		}
 	}
+	// Orders that are marked as removed already released their in-memory
+	// state when they were unwatched.
+	if order.IsRemoved {
+		return nil
+	}
+	return w.teardownInMemoryOrderState(order)
+}
+
+// archiveOrders adds the given orders to the order archive along with their
+// final state. It should be called whenever orders are permanently deleted.
+func (w *Watcher) archiveOrders(orders []*types.OrderWithMetadata) error {
+	if w.archiveRetention == 0 || len(orders) == 0 {
+		return nil
+	}
+	now := time.Now().UTC()
+	archivedOrders := make([]*types.ArchivedOrder, len(orders))
+	for i, order := range orders {
+		archivedOrder := &types.ArchivedOrder{
+			Hash:                     order.Hash,
+			SignedOrder:              order.SignedOrder(),
+			SignedOrderV4:            order.SignedOrderV4(),
+			EndState:                 zeroex.ESStoppedWatching,
+			FillableTakerAssetAmount: order.FillableTakerAssetAmount,
+			BlockNumber:              order.LastValidatedBlockNumber,
+			BlockHash:                order.LastValidatedBlockHash,
+			Timestamp:                now,
+			IsPinned:                 order.IsPinned,
+			ArchivedAt:               now,
+		}
+		// Orders that are still being watched are deleted without emitting any
+		// other order events, so we just stopped watching them. For orders that
+		// were already marked as removed, the final state is the state of the
+		// order event that was emitted when they were marked as removed.
+		// Orders which were marked as removed before the end state was stored
+		// with them fall back to the last order event that was emitted for
+		// them.
+		if order.IsRemoved && order.EndState != "" {
+			archivedOrder.EndState = order.EndState
+			archivedOrder.Timestamp = order.LastUpdated
+		} else if order.IsRemoved {
+			latestEvent, err := w.db.GetLatestOrderEvent(order.Hash)
+			if err == db.ErrNotFound {
+				// The order event log has already been pruned.
+				archivedOrder.Timestamp = order.LastUpdated
+				if order.IsExpired {
+					archivedOrder.EndState = zeroex.ESOrderExpired
+				}
+			} else if err != nil {
+				return err
+			} else {
+				archivedOrder.EndState = latestEvent.EndState
+				archivedOrder.Timestamp = latestEvent.Timestamp
+			}
+		}
+		archivedOrders[i] = archivedOrder
+	}
+	return w.db.AddArchivedOrders(archivedOrders)
+}
+
+// deleteExpiredArchivedOrders removes all orders from the order archive which
+// have been archived for longer than the archive retention period.
+func (w *Watcher) deleteExpiredArchivedOrders() error {
+	if w.archiveRetention == 0 {
+		return nil
+	}
+	numDeleted, err := w.db.DeleteArchivedOrdersBefore(time.Now().Add(-w.archiveRetention))
+	if err != nil {
+		return err
+	}
+	if numDeleted > 0 {
+		logger.WithField("numDeleted", numDeleted).Debug("deleted expired orders from the order archive")
+	}
 	return nil
 }
 
@@ -2388,7 +2747,7 @@ func (w *Watcher) addAssetDataAddressToEventDecoder(assetData []byte) error {
 	return nil
 }
 
-// Whenever we delete an order from the DB, we must also decrement the count of orders
+// Whenever an order stops being watched, we must also decrement the count of orders
 // involving a specific token address. We therefore call this method which decrements the
 // count, and if it reaches 0 for a given token, it removes the token address from the
 // contract event decoder.
@@ -306,6 +306,199 @@ func TestOrderWatcherDoesntStoreInvalidOrdersWithConfigurations(t *testing.T) {
 	}
 }
 
+func TestOrderWatcherValidateOrdersDoesntStoreOrders(t *testing.T) {
+	if !serialTestsEnabled {
+		t.Skip("Serial tests (tests which cannot run in parallel) are disabled. You can enable them with the --serial flag")
+	}
+
+	teardownSubTest := setupSubTest(t)
+	defer teardownSubTest(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	database, err := db.New(ctx, db.TestOptions())
+	require.NoError(t, err)
+
+	blockWatcher, orderWatcher := setupOrderWatcher(ctx, t, ethRPCClient, database)
+	orderEventsChan := make(chan []*zeroex.OrderEvent, 10)
+	orderWatcher.Subscribe(orderEventsChan)
+
+	validOrder := scenario.NewSignedTestOrder(t,
+		orderopts.SetupMakerState(true),
+		orderopts.MakerAssetData(scenario.ZRXAssetData),
+	)
+	unfundedOrder := scenario.NewSignedTestOrder(t,
+		orderopts.MakerAssetData(scenario.ZRXAssetData),
+		orderopts.MakerFee(big.NewInt(1)),
+		orderopts.MakerFeeAssetData(scenario.WETHAssetData),
+	)
+	err = blockWatcher.SyncToLatestBlock()
+	require.NoError(t, err)
+
+	validationResults, err := orderWatcher.ValidateOrders(ctx, []*zeroex.SignedOrder{validOrder, unfundedOrder}, constants.TestChainID, false)
+	require.NoError(t, err)
+	require.Len(t, validationResults.Accepted, 1)
+	assert.True(t, validationResults.Accepted[0].IsNew)
+	require.Len(t, validationResults.Rejected, 1)
+	assert.Equal(t, ordervalidator.ROUnfunded.Code, validationResults.Rejected[0].Status.Code)
+
+	// Neither order should have been stored and no order events should have
+	// been emitted.
+	orders, err := database.FindOrders(nil)
+	require.NoError(t, err)
+	assert.Len(t, orders, 0)
+	select {
+	case orderEvents := <-orderEventsChan:
+		t.Errorf("expected no order events but got %d", len(orderEvents))
+	default:
+	}
+}
+
+func TestOrderWatcherSetOrdersPinnedAndRemoveOrders(t *testing.T) {
+	if !serialTestsEnabled {
+		t.Skip("Serial tests (tests which cannot run in parallel) are disabled. You can enable them with the --serial flag")
+	}
+
+	teardownSubTest := setupSubTest(t)
+	defer teardownSubTest(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	database, err := db.New(ctx, db.TestOptions())
+	require.NoError(t, err)
+
+	signedOrder := scenario.NewSignedTestOrder(t, orderopts.SetupMakerState(true))
+	orderHash, err := signedOrder.ComputeOrderHash()
+	require.NoError(t, err)
+	unknownHash := common.HexToHash("0x1")
+	blockWatcher, orderWatcher := setupOrderWatcher(ctx, t, ethRPCClient, database)
+	watchOrder(ctx, t, orderWatcher, blockWatcher, signedOrder, false, &types.AddOrdersOpts{})
+	orderEventsChan := make(chan []*zeroex.OrderEvent, 10)
+	orderWatcher.Subscribe(orderEventsChan)
+
+	updated, err := orderWatcher.SetOrdersPinned([]common.Hash{orderHash, unknownHash}, true)
+	require.NoError(t, err)
+	assert.Equal(t, []common.Hash{orderHash}, updated)
+	storedOrder, err := database.GetOrder(orderHash)
+	require.NoError(t, err)
+	assert.True(t, storedOrder.IsPinned)
+
+	// v4 orders are updated by SetOrdersPinnedV4 so the v3 order should be ignored.
+	updated, err = orderWatcher.SetOrdersPinnedV4([]common.Hash{orderHash}, false)
+	require.NoError(t, err)
+	assert.Empty(t, updated)
+
+	updated, err = orderWatcher.SetOrdersPinned([]common.Hash{orderHash}, false)
+	require.NoError(t, err)
+	assert.Equal(t, []common.Hash{orderHash}, updated)
+	storedOrder, err = database.GetOrder(orderHash)
+	require.NoError(t, err)
+	assert.False(t, storedOrder.IsPinned)
+
+	removed, err := orderWatcher.RemoveOrders([]common.Hash{orderHash, unknownHash})
+	require.NoError(t, err)
+	assert.Equal(t, []common.Hash{orderHash}, removed)
+	orderEvents := waitForOrderEvents(t, orderEventsChan, 1, 4*time.Second)
+	require.Len(t, orderEvents, 1)
+	assert.Equal(t, orderHash, orderEvents[0].OrderHash)
+	assert.Equal(t, zeroex.ESStoppedWatching, orderEvents[0].EndState)
+	_, err = database.GetOrder(orderHash)
+	assert.Equal(t, db.ErrNotFound, err)
+
+	// Removing the order again should be a no-op.
+	removed, err = orderWatcher.RemoveOrders([]common.Hash{orderHash})
+	require.NoError(t, err)
+	assert.Empty(t, removed)
+}
+
+func TestOrderWatcherRemoveUnwatchedOrders(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	database, err := db.New(ctx, db.TestOptions())
+	require.NoError(t, err)
+
+	order := newIntegrityTestOrder(t, constants.ZRXAssetData)
+	unwatchedOrder := newIntegrityTestOrder(t, constants.ZRXAssetData)
+	unwatchedOrder.IsRemoved = true
+	unwatchedOrder.IsUnfillable = true
+	_, _, _, err = database.AddOrders([]*types.OrderWithMetadata{order, unwatchedOrder})
+	require.NoError(t, err)
+
+	w, err := New(Config{
+		DB:                database,
+		ChainID:           constants.TestChainID,
+		ContractAddresses: ganacheAddresses,
+		MaxOrders:         1000,
+	})
+	require.NoError(t, err)
+	// Orders that are marked as removed are not watched.
+	assert.Equal(t, uint(1), w.contractAddressToSeenCount.Get(ganacheAddresses.ZRXToken))
+
+	validationBlock := &types.MiniHeader{
+		Number:    big.NewInt(1),
+		Hash:      common.HexToHash("0x1"),
+		Timestamp: time.Now(),
+	}
+	w.unwatchOrder(order, big.NewInt(0), validationBlock, zeroex.ESOrderFullyFilled)
+	assert.Equal(t, uint(0), w.contractAddressToSeenCount.Get(ganacheAddresses.ZRXToken))
+	assert.False(t, w.eventDecoder.IsKnownERC20(ganacheAddresses.ZRXToken))
+
+	// Removing orders which are no longer watched must not decrement the seen
+	// count again.
+	removed, err := w.RemoveOrders([]common.Hash{order.Hash, unwatchedOrder.Hash})
+	require.NoError(t, err)
+	assert.Equal(t, []common.Hash{order.Hash, unwatchedOrder.Hash}, removed)
+	assert.Equal(t, uint(0), w.contractAddressToSeenCount.Get(ganacheAddresses.ZRXToken))
+
+	// Rewatching an order registers its contracts again.
+	rewatchedOrder := newIntegrityTestOrder(t, constants.ZRXAssetData)
+	rewatchedOrder.IsRemoved = true
+	_, _, _, err = database.AddOrders([]*types.OrderWithMetadata{rewatchedOrder})
+	require.NoError(t, err)
+	w.rewatchOrder(rewatchedOrder, rewatchedOrder.FillableTakerAssetAmount, validationBlock)
+	assert.Equal(t, uint(1), w.contractAddressToSeenCount.Get(ganacheAddresses.ZRXToken))
+	assert.True(t, w.eventDecoder.IsKnownERC20(ganacheAddresses.ZRXToken))
+}
+
+func TestOrderWatcherArchivesEndStateOfUnwatchedOrders(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	database, err := db.New(ctx, db.TestOptions())
+	require.NoError(t, err)
+
+	order := newIntegrityTestOrder(t, constants.ZRXAssetData)
+	_, _, _, err = database.AddOrders([]*types.OrderWithMetadata{order})
+	require.NoError(t, err)
+	w, err := New(Config{
+		DB:                database,
+		ChainID:           constants.TestChainID,
+		ContractAddresses: ganacheAddresses,
+		MaxOrders:         1000,
+		ArchiveRetention:  time.Hour,
+	})
+	require.NoError(t, err)
+
+	// No order event is logged for the order, as if the order event log had
+	// already been pruned.
+	validationBlock := &types.MiniHeader{
+		Number:    big.NewInt(1),
+		Hash:      common.HexToHash("0x1"),
+		Timestamp: time.Now(),
+	}
+	w.unwatchOrder(order, big.NewInt(0), validationBlock, zeroex.ESOrderCancelled)
+	storedOrder, err := database.GetOrder(order.Hash)
+	require.NoError(t, err)
+	assert.Equal(t, zeroex.ESOrderCancelled, storedOrder.EndState)
+
+	_, err = w.RemoveOrders([]common.Hash{order.Hash})
+	require.NoError(t, err)
+	archivedOrders, err := database.FindArchivedOrders(&db.ArchivedOrderQuery{OrderHash: order.Hash})
+	require.NoError(t, err)
+	require.Len(t, archivedOrders, 1)
+	assert.Equal(t, zeroex.ESOrderCancelled, archivedOrders[0].EndState)
+	assert.WithinDuration(t, storedOrder.LastUpdated, archivedOrders[0].Timestamp, time.Millisecond)
+}
+
 func TestOrderWatcherStoresValidOrdersWithConfigurations(t *testing.T) {
 	if !serialTestsEnabled {
 		t.Skip("Serial tests (tests which cannot run in parallel) are disabled. You can enable them with the --serial flag")
@@ -66,6 +66,11 @@ func (w *Watcher) ValidateAndStoreValidOrdersV4(ctx context.Context, orders []*z
 		}
 	}
 
+	newOrderInfos, err = w.enforceStorageQuotas(results, newOrderInfos, pinned, opts)
+	if err != nil {
+		return nil, err
+	}
+
 	// Add the order to the OrderWatcher. This also saves the order in the
 	// database.
 	allOrderEvents := []*zeroex.OrderEvent{}
@@ -74,6 +79,11 @@ func (w *Watcher) ValidateAndStoreValidOrdersV4(ctx context.Context, orders []*z
 		return nil, err
 	}
 	allOrderEvents = append(allOrderEvents, orderEvents...)
+	evictionEvents, err := w.evictOrdersOverQuota(newOrderInfos, pinned, opts)
+	if err != nil {
+		return nil, err
+	}
+	allOrderEvents = append(allOrderEvents, evictionEvents...)
 
 	if len(allOrderEvents) > 0 {
 		// NOTE(albrow): Send can block if the subscriber(s) are slow. Blocking here can cause problems when Mesh is
@@ -81,7 +91,7 @@ func (w *Watcher) ValidateAndStoreValidOrdersV4(ctx context.Context, orders []*z
 		// is done.
 		done := make(chan interface{})
 		go func() {
-			w.orderFeed.Send(allOrderEvents)
+			w.publishOrderEvents(allOrderEvents)
 			done <- struct{}{}
 		}()
 		select {
@@ -95,6 +105,37 @@ func (w *Watcher) ValidateAndStoreValidOrdersV4(ctx context.Context, orders []*z
 	return results, nil
 }
 
+// ValidateOrdersV4 is the v4 equivalent of ValidateOrders.
+func (w *Watcher) ValidateOrdersV4(ctx context.Context, orders []*zeroex.SignedOrderV4, chainID int, pinned bool) (*ordervalidator.ValidationResults, error) {
+	if len(orders) == 0 {
+		return &ordervalidator.ValidationResults{}, nil
+	}
+	results, validMeshOrders, err := w.meshSpecificOrderValidationV4(orders, chainID, pinned)
+	if err != nil {
+		return nil, err
+	}
+	_, zeroexResults, err := w.onchainOrderValidationV4(ctx, validMeshOrders)
+	if err != nil {
+		return nil, err
+	}
+	results.Accepted = append(results.Accepted, zeroexResults.Accepted...)
+	results.Rejected = append(results.Rejected, zeroexResults.Rejected...)
+	if err := w.checkStorageQuotas(results, pinned); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// RemoveOrdersV4 is the v4 equivalent of RemoveOrders.
+func (w *Watcher) RemoveOrdersV4(hashes []common.Hash) ([]common.Hash, error) {
+	return w.removeOrders(hashes, w.db.GetOrderV4)
+}
+
+// SetOrdersPinnedV4 is the v4 equivalent of SetOrdersPinned.
+func (w *Watcher) SetOrdersPinnedV4(hashes []common.Hash, pinned bool) ([]common.Hash, error) {
+	return setOrdersPinned(hashes, pinned, w.db.UpdateOrderV4)
+}
+
 func (w *Watcher) meshSpecificOrderValidationV4(orders []*zeroex.SignedOrderV4, chainID int, pinned bool) (*ordervalidator.ValidationResults, []*zeroex.SignedOrderV4, error) {
 	results := &ordervalidator.ValidationResults{}
 	validMeshOrders := []*zeroex.SignedOrderV4{}
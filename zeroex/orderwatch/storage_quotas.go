@@ -0,0 +1,342 @@
+package orderwatch
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/db"
+	"github.com/0xProject/0x-mesh/zeroex"
+	"github.com/0xProject/0x-mesh/zeroex/ordervalidator"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Storage quotas limit the number of orders that are stored for a single maker
+// and the number of orders that are stored from a single peer. Without them,
+// a single maker (or peer) could fill up the database with orders and cause
+// the orders of everyone else to be removed when the database is full.
+//
+// v3 and v4 orders count towards the same quotas. Pinned orders and orders
+// which are marked as removed don't count towards any quota.
+
+// quotaUsage keeps track of the orders which count towards the storage quota
+// of a single maker or peer while a batch of new orders is checked against it.
+type quotaUsage struct {
+	numOrders int
+	// maxExpiration is the expiration time of the longest-lived order which
+	// counts towards the quota, or nil if there are no such orders.
+	maxExpiration *big.Int
+}
+
+// allows returns true if an order with the given expiration time can be
+// stored without exceeding the quota. Once the quota has been reached, an
+// order is still allowed if it expires sooner than the longest-lived order
+// which counts towards the quota, because that order is evicted to make room
+// for it. This is the same policy that is used when the database is full, but
+// it means that a maker (or peer) can only ever displace its own orders.
+func (u *quotaUsage) allows(expiration *big.Int, limit int) bool {
+	if u.numOrders < limit {
+		return true
+	}
+	return u.maxExpiration != nil && expiration.Cmp(u.maxExpiration) < 0
+}
+
+// add records that an order with the given expiration time will be stored.
+func (u *quotaUsage) add(expiration *big.Int, limit int) {
+	if u.numOrders >= limit {
+		// The order replaces one of the orders that will be evicted.
+		return
+	}
+	u.numOrders++
+	if u.maxExpiration == nil || expiration.Cmp(u.maxExpiration) > 0 {
+		u.maxExpiration = expiration
+	}
+}
+
+// enforceStorageQuotas checks the given new orders against the per-maker and
+// per-peer storage quotas. Orders which would exceed one of the quotas are
+// moved from the accepted orders to the rejected orders of results and are
+// left out of the returned orders. Pinned orders are not subject to the
+// quotas.
+func (w *Watcher) enforceStorageQuotas(results *ordervalidator.ValidationResults, orderInfos []*ordervalidator.AcceptedOrderInfo, pinned bool, opts *types.AddOrdersOpts) ([]*ordervalidator.AcceptedOrderInfo, error) {
+	checkPeerQuota := w.maxOrdersPerPeer != 0 && opts.SourcePeerID != ""
+	if pinned || len(orderInfos) == 0 || (w.maxOrdersPerMaker == 0 && !checkPeerQuota) {
+		return orderInfos, nil
+	}
+
+	var peerUsage *quotaUsage
+	if checkPeerQuota {
+		var err error
+		peerUsage, err = w.getQuotaUsage(peerQuotaFilters(opts.SourcePeerID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	makerUsages := map[common.Address]*quotaUsage{}
+
+	// Orders which expire sooner are checked first so that they are the ones
+	// which are stored if there is not enough room for all of them.
+	sortedOrderInfos := make([]*ordervalidator.AcceptedOrderInfo, len(orderInfos))
+	copy(sortedOrderInfos, orderInfos)
+	sort.SliceStable(sortedOrderInfos, func(i, j int) bool {
+		return orderInfoExpiration(sortedOrderInfos[i]).Cmp(orderInfoExpiration(sortedOrderInfos[j])) < 0
+	})
+	rejected := map[common.Hash]ordervalidator.RejectedOrderStatus{}
+	for _, orderInfo := range sortedOrderInfos {
+		expiration := orderInfoExpiration(orderInfo)
+		var makerUsage *quotaUsage
+		if w.maxOrdersPerMaker != 0 {
+			maker := orderInfoMaker(orderInfo)
+			makerUsage = makerUsages[maker]
+			if makerUsage == nil {
+				var err error
+				makerUsage, err = w.getQuotaUsage(makerQuotaFilters(maker))
+				if err != nil {
+					return nil, err
+				}
+				makerUsages[maker] = makerUsage
+			}
+			if !makerUsage.allows(expiration, w.maxOrdersPerMaker) {
+				rejected[orderInfo.OrderHash] = ordervalidator.ROMakerQuotaExceeded
+				continue
+			}
+		}
+		if peerUsage != nil && !peerUsage.allows(expiration, w.maxOrdersPerPeer) {
+			rejected[orderInfo.OrderHash] = ordervalidator.ROPeerQuotaExceeded
+			continue
+		}
+		if makerUsage != nil {
+			makerUsage.add(expiration, w.maxOrdersPerMaker)
+		}
+		if peerUsage != nil {
+			peerUsage.add(expiration, w.maxOrdersPerPeer)
+		}
+	}
+	if len(rejected) == 0 {
+		return orderInfos, nil
+	}
+
+	allowedOrderInfos := []*ordervalidator.AcceptedOrderInfo{}
+	for _, orderInfo := range orderInfos {
+		if _, found := rejected[orderInfo.OrderHash]; !found {
+			allowedOrderInfos = append(allowedOrderInfos, orderInfo)
+		}
+	}
+	// NOTE: Orders that are only stored because of one of the Keep* options
+	// have already been rejected by the 0x validation, so they are not in the
+	// accepted orders and keep their original rejection status.
+	accepted := []*ordervalidator.AcceptedOrderInfo{}
+	for _, acceptedOrderInfo := range results.Accepted {
+		status, found := rejected[acceptedOrderInfo.OrderHash]
+		if !found {
+			accepted = append(accepted, acceptedOrderInfo)
+			continue
+		}
+		results.Rejected = append(results.Rejected, &ordervalidator.RejectedOrderInfo{
+			OrderHash:     acceptedOrderInfo.OrderHash,
+			SignedOrder:   acceptedOrderInfo.SignedOrder,
+			SignedOrderV4: acceptedOrderInfo.SignedOrderV4,
+			Kind:          ordervalidator.MeshValidation,
+			Status:        status,
+		})
+	}
+	results.Accepted = accepted
+	return allowedOrderInfos, nil
+}
+
+// checkStorageQuotas is the equivalent of enforceStorageQuotas for orders
+// which are only validated and not stored. The new orders among the accepted
+// orders of results which would exceed one of the quotas are moved to the
+// rejected orders. The orders are treated as if they were not received from a
+// peer.
+func (w *Watcher) checkStorageQuotas(results *ordervalidator.ValidationResults, pinned bool) error {
+	newOrderInfos := []*ordervalidator.AcceptedOrderInfo{}
+	for _, acceptedOrderInfo := range results.Accepted {
+		if acceptedOrderInfo.IsNew {
+			newOrderInfos = append(newOrderInfos, acceptedOrderInfo)
+		}
+	}
+	_, err := w.enforceStorageQuotas(results, newOrderInfos, pinned, &types.AddOrdersOpts{})
+	return err
+}
+
+// evictOrdersOverQuota permanently deletes the longest-lived orders of the
+// makers of the given orders, and of the peer that they were received from,
+// until none of them has more orders than its storage quota allows. This
+// removes the orders that were displaced by the new orders (see
+// quotaUsage.allows) and corrects for orders that were stored concurrently.
+// It returns a STOPPED_WATCHING event for each of the deleted orders.
+func (w *Watcher) evictOrdersOverQuota(orderInfos []*ordervalidator.AcceptedOrderInfo, pinned bool, opts *types.AddOrdersOpts) ([]*zeroex.OrderEvent, error) {
+	if pinned || len(orderInfos) == 0 {
+		return nil, nil
+	}
+	orderEvents := []*zeroex.OrderEvent{}
+	if w.maxOrdersPerMaker != 0 {
+		makers := map[common.Address]struct{}{}
+		for _, orderInfo := range orderInfos {
+			makers[orderInfoMaker(orderInfo)] = struct{}{}
+		}
+		for maker := range makers {
+			filters, filtersV4 := makerQuotaFilters(maker)
+			evictionEvents, err := w.evictOrdersOverLimit(filters, filtersV4, w.maxOrdersPerMaker)
+			if err != nil {
+				return nil, err
+			}
+			orderEvents = append(orderEvents, evictionEvents...)
+		}
+	}
+	if w.maxOrdersPerPeer != 0 && opts.SourcePeerID != "" {
+		filters, filtersV4 := peerQuotaFilters(opts.SourcePeerID)
+		evictionEvents, err := w.evictOrdersOverLimit(filters, filtersV4, w.maxOrdersPerPeer)
+		if err != nil {
+			return nil, err
+		}
+		orderEvents = append(orderEvents, evictionEvents...)
+	}
+	return orderEvents, nil
+}
+
+// evictOrdersOverLimit permanently deletes the longest-lived v3 and v4 orders
+// which match the given filters until at most limit of them are left.
+func (w *Watcher) evictOrdersOverLimit(filters []db.OrderFilter, filtersV4 []db.OrderFilterV4, limit int) ([]*zeroex.OrderEvent, error) {
+	numOrders, err := w.db.CountOrders(&db.OrderQuery{Filters: filters})
+	if err != nil {
+		return nil, err
+	}
+	numOrdersV4, err := w.db.CountOrdersV4(&db.OrderQueryV4{Filters: filtersV4})
+	if err != nil {
+		return nil, err
+	}
+	numExcess := numOrders + numOrdersV4 - limit
+	if numExcess <= 0 {
+		return nil, nil
+	}
+
+	orders, err := w.db.FindOrders(&db.OrderQuery{
+		Filters: filters,
+		Sort:    []db.OrderSort{{Field: db.OFExpirationTimeSeconds, Direction: db.Descending}},
+		Limit:   uint(numExcess),
+	})
+	if err != nil {
+		return nil, err
+	}
+	ordersV4, err := w.db.FindOrdersV4(&db.OrderQueryV4{
+		Filters: filtersV4,
+		Sort:    []db.OrderSortV4{{Field: db.OV4FExpiry, Direction: db.Descending}},
+		Limit:   uint(numExcess),
+	})
+	if err != nil {
+		return nil, err
+	}
+	ordersToEvict := append(orders, ordersV4...)
+	sort.SliceStable(ordersToEvict, func(i, j int) bool {
+		return orderExpiration(ordersToEvict[i]).Cmp(orderExpiration(ordersToEvict[j])) > 0
+	})
+	if len(ordersToEvict) > numExcess {
+		ordersToEvict = ordersToEvict[:numExcess]
+	}
+
+	hashes := []common.Hash{}
+	hashesV4 := []common.Hash{}
+	for _, order := range ordersToEvict {
+		if order.OrderV4 != nil {
+			hashesV4 = append(hashesV4, order.Hash)
+		} else {
+			hashes = append(hashes, order.Hash)
+		}
+	}
+	_, orderEvents, err := w.deleteOrders(hashes, w.db.GetOrder)
+	if err != nil {
+		return nil, err
+	}
+	_, orderEventsV4, err := w.deleteOrders(hashesV4, w.db.GetOrderV4)
+	if err != nil {
+		return nil, err
+	}
+	return append(orderEvents, orderEventsV4...), nil
+}
+
+// getQuotaUsage returns the number of stored v3 and v4 orders which match the
+// given filters, along with the expiration time of the longest-lived one.
+func (w *Watcher) getQuotaUsage(filters []db.OrderFilter, filtersV4 []db.OrderFilterV4) (*quotaUsage, error) {
+	numOrders, err := w.db.CountOrders(&db.OrderQuery{Filters: filters})
+	if err != nil {
+		return nil, err
+	}
+	numOrdersV4, err := w.db.CountOrdersV4(&db.OrderQueryV4{Filters: filtersV4})
+	if err != nil {
+		return nil, err
+	}
+	usage := &quotaUsage{numOrders: numOrders + numOrdersV4}
+	orders, err := w.db.FindOrders(&db.OrderQuery{
+		Filters: filters,
+		Sort:    []db.OrderSort{{Field: db.OFExpirationTimeSeconds, Direction: db.Descending}},
+		Limit:   1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ordersV4, err := w.db.FindOrdersV4(&db.OrderQueryV4{
+		Filters: filtersV4,
+		Sort:    []db.OrderSortV4{{Field: db.OV4FExpiry, Direction: db.Descending}},
+		Limit:   1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, order := range append(orders, ordersV4...) {
+		if expiration := orderExpiration(order); usage.maxExpiration == nil || expiration.Cmp(usage.maxExpiration) > 0 {
+			usage.maxExpiration = expiration
+		}
+	}
+	return usage, nil
+}
+
+// makerQuotaFilters returns the filters that match the orders which count
+// towards the storage quota of the given maker.
+func makerQuotaFilters(maker common.Address) ([]db.OrderFilter, []db.OrderFilterV4) {
+	return []db.OrderFilter{
+			{Field: db.OFMakerAddress, Kind: db.Equal, Value: maker},
+			{Field: db.OFIsPinned, Kind: db.Equal, Value: false},
+			{Field: db.OFIsRemoved, Kind: db.Equal, Value: false},
+		}, []db.OrderFilterV4{
+			{Field: db.OV4FMaker, Kind: db.Equal, Value: maker},
+			{Field: db.OV4FIsPinned, Kind: db.Equal, Value: false},
+			{Field: db.OV4FIsRemoved, Kind: db.Equal, Value: false},
+		}
+}
+
+// peerQuotaFilters returns the filters that match the orders which count
+// towards the storage quota of the peer with the given ID.
+func peerQuotaFilters(peerID string) ([]db.OrderFilter, []db.OrderFilterV4) {
+	return []db.OrderFilter{
+			{Field: db.OFSourcePeerID, Kind: db.Equal, Value: peerID},
+			{Field: db.OFIsPinned, Kind: db.Equal, Value: false},
+			{Field: db.OFIsRemoved, Kind: db.Equal, Value: false},
+		}, []db.OrderFilterV4{
+			{Field: db.OV4FSourcePeerID, Kind: db.Equal, Value: peerID},
+			{Field: db.OV4FIsPinned, Kind: db.Equal, Value: false},
+			{Field: db.OV4FIsRemoved, Kind: db.Equal, Value: false},
+		}
+}
+
+func orderInfoMaker(orderInfo *ordervalidator.AcceptedOrderInfo) common.Address {
+	if orderInfo.SignedOrderV4 != nil {
+		return orderInfo.SignedOrderV4.Maker
+	}
+	return orderInfo.SignedOrder.MakerAddress
+}
+
+func orderInfoExpiration(orderInfo *ordervalidator.AcceptedOrderInfo) *big.Int {
+	if orderInfo.SignedOrderV4 != nil {
+		return orderInfo.SignedOrderV4.Expiry
+	}
+	return orderInfo.SignedOrder.ExpirationTimeSeconds
+}
+
+func orderExpiration(order *types.OrderWithMetadata) *big.Int {
+	if order.OrderV4 != nil {
+		return order.OrderV4.Expiry
+	}
+	return order.OrderV3.ExpirationTimeSeconds
+}
@@ -0,0 +1,163 @@
+// +build !js
+
+package orderwatch
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/0xProject/0x-mesh/common/types"
+	"github.com/0xProject/0x-mesh/constants"
+	"github.com/0xProject/0x-mesh/db"
+	"github.com/0xProject/0x-mesh/zeroex"
+	"github.com/0xProject/0x-mesh/zeroex/ordervalidator"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageQuotas(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	database, err := db.New(ctx, db.TestOptions())
+	require.NoError(t, err)
+	w, err := New(Config{
+		DB:                database,
+		ChainID:           constants.TestChainID,
+		ContractAddresses: ganacheAddresses,
+		MaxOrders:         1000,
+		MaxOrdersPerMaker: 3,
+		MaxOrdersPerPeer:  4,
+	})
+	require.NoError(t, err)
+
+	makerA := constants.GanacheAccount1
+	makerB := constants.GanacheAccount2
+	fromPeer := &types.AddOrdersOpts{SourcePeerID: "peer"}
+	expiration := time.Now().Add(24 * time.Hour).Unix()
+	newOrder := func(maker common.Address, secondsUntilExpiration int64) *zeroex.SignedOrder {
+		return newQuotaTestOrder(maker, big.NewInt(expiration+secondsUntilExpiration))
+	}
+
+	// The first orders of a maker are accepted until its quota is reached.
+	results := storeOrdersWithQuotas(t, w, []*zeroex.SignedOrder{newOrder(makerA, 100), newOrder(makerA, 200), newOrder(makerA, 300)}, false, fromPeer)
+	assert.Len(t, results.Accepted, 3)
+	assert.Empty(t, results.Rejected)
+
+	// Orders that don't expire sooner than any of the maker's stored orders are
+	// rejected once the quota has been reached.
+	longLivedOrder := newOrder(makerA, 400)
+	results = storeOrdersWithQuotas(t, w, []*zeroex.SignedOrder{longLivedOrder}, false, fromPeer)
+	assert.Empty(t, results.Accepted)
+	require.Len(t, results.Rejected, 1)
+	assert.Equal(t, ordervalidator.ROMakerQuotaExceeded, results.Rejected[0].Status)
+	assert.Equal(t, ordervalidator.MeshValidation, results.Rejected[0].Kind)
+
+	// Orders that expire sooner replace the maker's longest-lived order.
+	results = storeOrdersWithQuotas(t, w, []*zeroex.SignedOrder{newOrder(makerA, 150)}, false, fromPeer)
+	assert.Len(t, results.Accepted, 1)
+	assert.Empty(t, results.Rejected)
+	assertStoredExpirations(t, database, makerA, []int64{expiration + 100, expiration + 150, expiration + 200})
+
+	// Pinned orders don't count towards the quota.
+	results = storeOrdersWithQuotas(t, w, []*zeroex.SignedOrder{longLivedOrder}, true, fromPeer)
+	assert.Len(t, results.Accepted, 1)
+	assertStoredExpirations(t, database, makerA, []int64{expiration + 100, expiration + 150, expiration + 200, expiration + 400})
+
+	// The orders of other makers are not affected by the quota of makerA, but
+	// all orders count towards the quota of the peer they were received from.
+	results = storeOrdersWithQuotas(t, w, []*zeroex.SignedOrder{newOrder(makerB, 500), newOrder(makerB, 600)}, false, fromPeer)
+	require.Len(t, results.Accepted, 1)
+	assert.Equal(t, big.NewInt(expiration+500), results.Accepted[0].SignedOrder.ExpirationTimeSeconds)
+	require.Len(t, results.Rejected, 1)
+	assert.Equal(t, ordervalidator.ROPeerQuotaExceeded, results.Rejected[0].Status)
+
+	// Orders that were not received from a peer are only subject to the
+	// quota of their maker.
+	results = storeOrdersWithQuotas(t, w, []*zeroex.SignedOrder{newOrder(makerB, 600)}, false, &types.AddOrdersOpts{})
+	assert.Len(t, results.Accepted, 1)
+	assert.Empty(t, results.Rejected)
+
+	// Validating orders without storing them applies the maker quota too.
+	results = newQuotaTestResults(t, []*zeroex.SignedOrder{newOrder(makerA, 50), newOrder(makerA, 500)})
+	require.NoError(t, w.checkStorageQuotas(results, false))
+	require.Len(t, results.Accepted, 1)
+	assert.Equal(t, big.NewInt(expiration+50), results.Accepted[0].SignedOrder.ExpirationTimeSeconds)
+	require.Len(t, results.Rejected, 1)
+	assert.Equal(t, ordervalidator.ROMakerQuotaExceeded, results.Rejected[0].Status)
+	assertStoredExpirations(t, database, makerA, []int64{expiration + 100, expiration + 150, expiration + 200, expiration + 400})
+}
+
+// newQuotaTestResults returns validation results in which all of the given
+// orders are accepted as new orders.
+func newQuotaTestResults(t *testing.T, orders []*zeroex.SignedOrder) *ordervalidator.ValidationResults {
+	results := &ordervalidator.ValidationResults{}
+	for _, order := range orders {
+		orderHash, err := order.ComputeOrderHash()
+		require.NoError(t, err)
+		results.Accepted = append(results.Accepted, &ordervalidator.AcceptedOrderInfo{
+			OrderHash:                orderHash,
+			SignedOrder:              order,
+			FillableTakerAssetAmount: order.TakerAssetAmount,
+			IsNew:                    true,
+		})
+	}
+	return results
+}
+
+// storeOrdersWithQuotas stores the given orders in the same way as
+// ValidateAndStoreValidOrders, except that the orders are assumed to be valid.
+func storeOrdersWithQuotas(t *testing.T, w *Watcher, orders []*zeroex.SignedOrder, pinned bool, opts *types.AddOrdersOpts) *ordervalidator.ValidationResults {
+	results := newQuotaTestResults(t, orders)
+	orderInfos, err := w.enforceStorageQuotas(results, results.Accepted, pinned, opts)
+	require.NoError(t, err)
+	validationBlock := &types.MiniHeader{
+		Hash:      common.HexToHash("0x1"),
+		Number:    big.NewInt(1),
+		Timestamp: time.Now(),
+	}
+	_, err = w.add(orderInfos, validationBlock, pinned, opts)
+	require.NoError(t, err)
+	_, err = w.evictOrdersOverQuota(orderInfos, pinned, opts)
+	require.NoError(t, err)
+	return results
+}
+
+func assertStoredExpirations(t *testing.T, database *db.DB, maker common.Address, expected []int64) {
+	orders, err := database.FindOrders(&db.OrderQuery{
+		Filters: []db.OrderFilter{{Field: db.OFMakerAddress, Kind: db.Equal, Value: maker}},
+		Sort:    []db.OrderSort{{Field: db.OFExpirationTimeSeconds, Direction: db.Ascending}},
+	})
+	require.NoError(t, err)
+	actual := make([]int64, len(orders))
+	for i, order := range orders {
+		actual[i] = order.OrderV3.ExpirationTimeSeconds.Int64()
+	}
+	assert.Equal(t, expected, actual)
+}
+
+func newQuotaTestOrder(maker common.Address, expirationTimeSeconds *big.Int) *zeroex.SignedOrder {
+	return &zeroex.SignedOrder{
+		Order: zeroex.Order{
+			ChainID:               big.NewInt(constants.TestChainID),
+			ExchangeAddress:       ganacheAddresses.Exchange,
+			MakerAddress:          maker,
+			MakerAssetData:        constants.ZRXAssetData,
+			MakerFeeAssetData:     constants.NullBytes,
+			MakerAssetAmount:      big.NewInt(100),
+			MakerFee:              big.NewInt(0),
+			TakerAddress:          constants.NullAddress,
+			TakerAssetData:        constants.WETHAssetData,
+			TakerFeeAssetData:     constants.NullBytes,
+			TakerAssetAmount:      big.NewInt(42),
+			TakerFee:              big.NewInt(0),
+			SenderAddress:         constants.NullAddress,
+			FeeRecipientAddress:   constants.NullAddress,
+			ExpirationTimeSeconds: expirationTimeSeconds,
+			Salt:                  big.NewInt(time.Now().UnixNano()),
+		},
+		Signature: []byte{0x1},
+	}
+}